@@ -177,9 +177,11 @@ func TestOpenFromConfig_ServerModeFailsWithoutServer(t *testing.T) {
 	if openErr == nil {
 		t.Fatal("OpenFromConfig (server mode) should fail when no server is running")
 	}
-	// Should contain "unreachable" from the fail-fast TCP check
-	if !strings.Contains(openErr.Error(), "unreachable") {
-		t.Errorf("expected 'unreachable' in error, got: %v", openErr)
+	// Should carry the fail-fast guidance from the TCP check
+	for _, want := range []string{"not reachable", "bd dolt doctor"} {
+		if !strings.Contains(openErr.Error(), want) {
+			t.Errorf("expected %q in error, got: %v", want, openErr)
+		}
 	}
 }
 
@@ -261,8 +263,10 @@ func TestOpenBestAvailable_ServerMode_FailsWithoutServer(t *testing.T) {
 	if openErr == nil {
 		t.Fatal("OpenBestAvailable (server mode) should fail when no server is running")
 	}
-	if !strings.Contains(openErr.Error(), "unreachable") {
-		t.Errorf("expected 'unreachable' in error, got: %v", openErr)
+	for _, want := range []string{"not reachable", "bd dolt doctor"} {
+		if !strings.Contains(openErr.Error(), want) {
+			t.Errorf("expected %q in error, got: %v", want, openErr)
+		}
 	}
 }
 
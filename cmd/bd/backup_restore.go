@@ -92,6 +92,7 @@ func runBackupRestore(ctx context.Context, s storage.DoltStorage, dir string, fo
 	if err := bs.RestoreDatabase(ctx, dir, force); err != nil {
 		return err
 	}
+	storage.InvalidateBlockedCache(s)
 
 	// After a force restore, the database's _project_id may differ from
 	// metadata.json (the backup came from a different project). Sync
@@ -140,6 +141,11 @@ func syncProjectIDFromDB(ctx context.Context, s storage.DoltStorage) error {
 		return err
 	}
 
+	return syncProjectID(dbID)
+}
+
+// syncProjectID records projectID as the workspace identity in metadata.json.
+func syncProjectID(projectID string) error {
 	beadsDir := beads.FindBeadsDir()
 	if beadsDir == "" {
 		return fmt.Errorf("%s; %s", activeWorkspaceNotFoundError(), diagHint())
@@ -150,11 +156,11 @@ func syncProjectIDFromDB(ctx context.Context, s storage.DoltStorage) error {
 		return err
 	}
 
-	if cfg.ProjectID == dbID {
+	if cfg.ProjectID == projectID {
 		return nil // already in sync
 	}
 
-	cfg.ProjectID = dbID
+	cfg.ProjectID = projectID
 	return cfg.Save(beadsDir)
 }
 
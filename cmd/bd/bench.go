@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+var benchCmd = &cobra.Command{
+	Use:    "bench",
+	Short:  "Internal: benchmark the configured backend",
+	Hidden: true,
+	Long: `Create N throwaway issues, run M ready/list/search queries against the
+configured backend, and report throughput and latency percentiles.
+
+The issues carry a per-run bench label and are deleted afterwards unless
+--keep is given. Use this to compare builds or backends for regressions;
+--quick runs a handful of queries per kind for smoke checks.
+
+Examples:
+  bd bench                      # 100 issues, 20 queries per kind
+  bd bench --n 1000 --m 50      # larger run
+  bd bench --n 10 --quick --json`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		n, _ := cmd.Flags().GetInt("n")
+		m, _ := cmd.Flags().GetInt("m")
+		quick, _ := cmd.Flags().GetBool("quick")
+		keep, _ := cmd.Flags().GetBool("keep")
+		if n < 1 {
+			return HandleErrorRespectJSON("--n must be at least 1")
+		}
+		if m < 1 {
+			return HandleErrorRespectJSON("--m must be at least 1")
+		}
+		if usesProxiedServer() {
+			return HandleErrorRespectJSON("'bd bench' is not supported in proxied-server mode")
+		}
+		CheckReadonly("bench")
+
+		report, err := runBench(rootCtx, store, benchOptions{N: n, M: m, Quick: quick, Keep: keep}, actor)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		commandDidWrite.Store(true)
+
+		if jsonOutput {
+			return outputJSON(report)
+		}
+		printBenchReport(report)
+		return nil
+	},
+}
+
+// benchQuickQueries is the per-kind query count used by --quick.
+const benchQuickQueries = 3
+
+type benchOptions struct {
+	N     int  // issues to create
+	M     int  // queries per kind
+	Quick bool // cap M at benchQuickQueries
+	Keep  bool // leave the bench issues in place
+}
+
+// benchPhase summarises one kind of operation.
+type benchPhase struct {
+	Name      string        `json:"name"`
+	Count     int           `json:"count"`
+	Rows      int           `json:"rows"` // rows returned by the last query; 0 for create
+	Elapsed   time.Duration `json:"elapsed_ns"`
+	OpsPerSec float64       `json:"ops_per_sec"`
+	Min       time.Duration `json:"min_ns"`
+	P50       time.Duration `json:"p50_ns"`
+	P95       time.Duration `json:"p95_ns"`
+	P99       time.Duration `json:"p99_ns"`
+	Max       time.Duration `json:"max_ns"`
+}
+
+type benchReport struct {
+	Backend string        `json:"backend"`
+	Label   string        `json:"label"`
+	Issues  int           `json:"issues"`
+	Queries int           `json:"queries_per_kind"`
+	Kept    bool          `json:"kept"`
+	Phases  []*benchPhase `json:"phases"`
+}
+
+// runBench creates opts.N labelled issues, times opts.M ready, list and search
+// queries each, then deletes the issues (unless opts.Keep).
+func runBench(ctx context.Context, s storage.DoltStorage, opts benchOptions, actor string) (report *benchReport, err error) {
+	if opts.Quick && opts.M > benchQuickQueries {
+		opts.M = benchQuickQueries
+	}
+	label := fmt.Sprintf("bench-%d", time.Now().UnixNano())
+	report = &benchReport{
+		Backend: fmt.Sprintf("%T", s),
+		Label:   label,
+		Issues:  opts.N,
+		Queries: opts.M,
+		Kept:    opts.Keep,
+	}
+
+	var created []string
+	defer func() {
+		if opts.Keep {
+			return
+		}
+		// Clean up with a fresh context so an interrupted run still removes
+		// what it created.
+		for _, id := range created {
+			if delErr := s.DeleteIssue(context.Background(), id); delErr != nil && err == nil {
+				err = fmt.Errorf("cleaning up bench issue %s: %w", id, delErr)
+			}
+		}
+	}()
+
+	var lat []time.Duration
+	start := time.Now()
+	for i := 0; i < opts.N; i++ {
+		issue := &types.Issue{
+			Title:     fmt.Sprintf("%s issue %d", label, i),
+			Status:    types.StatusOpen,
+			Priority:  i % 5,
+			IssueType: types.TypeTask,
+			Labels:    []string{label},
+		}
+		t0 := time.Now()
+		if err := s.CreateIssue(ctx, issue, actor); err != nil {
+			return nil, fmt.Errorf("creating bench issue %d: %w", i, err)
+		}
+		lat = append(lat, time.Since(t0))
+		created = append(created, issue.ID)
+	}
+	report.Phases = append(report.Phases, summarizeBench("create", lat, time.Since(start), 0))
+
+	queries := []struct {
+		name string
+		run  func() (int, error)
+	}{
+		{"ready", func() (int, error) {
+			issues, err := s.GetReadyWork(ctx, types.WorkFilter{Labels: []string{label}, Limit: opts.N})
+			return len(issues), err
+		}},
+		{"list", func() (int, error) {
+			issues, err := s.SearchIssues(ctx, "", types.IssueFilter{Labels: []string{label}, Limit: opts.N})
+			return len(issues), err
+		}},
+		{"search", func() (int, error) {
+			issues, err := s.SearchIssues(ctx, label, types.IssueFilter{Limit: opts.N})
+			return len(issues), err
+		}},
+	}
+	for _, q := range queries {
+		lat = lat[:0]
+		rows := 0
+		start := time.Now()
+		for i := 0; i < opts.M; i++ {
+			t0 := time.Now()
+			if rows, err = q.run(); err != nil {
+				return nil, fmt.Errorf("bench %s query: %w", q.name, err)
+			}
+			lat = append(lat, time.Since(t0))
+		}
+		report.Phases = append(report.Phases, summarizeBench(q.name, lat, time.Since(start), rows))
+	}
+	return report, nil
+}
+
+// summarizeBench computes throughput and nearest-rank latency percentiles.
+func summarizeBench(name string, lat []time.Duration, elapsed time.Duration, rows int) *benchPhase {
+	p := &benchPhase{Name: name, Count: len(lat), Rows: rows, Elapsed: elapsed}
+	if len(lat) == 0 {
+		return p
+	}
+	sorted := append([]time.Duration(nil), lat...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	pct := func(q float64) time.Duration {
+		return sorted[int(q*float64(len(sorted)-1)+0.5)]
+	}
+	p.Min, p.P50, p.P95, p.P99, p.Max = sorted[0], pct(0.50), pct(0.95), pct(0.99), sorted[len(sorted)-1]
+	if elapsed > 0 {
+		p.OpsPerSec = float64(len(lat)) / elapsed.Seconds()
+	}
+	return p
+}
+
+func printBenchReport(r *benchReport) {
+	fmt.Printf("bd bench: %d issues, %d queries per kind (%s)\n\n", r.Issues, r.Queries, r.Backend)
+	fmt.Printf("%-8s %6s %6s %10s %9s %9s %9s %9s %9s\n", "phase", "count", "rows", "ops/s", "min", "p50", "p95", "p99", "max")
+	for _, p := range r.Phases {
+		fmt.Printf("%-8s %6d %6d %10.1f %9s %9s %9s %9s %9s\n", p.Name, p.Count, p.Rows, p.OpsPerSec,
+			p.Min.Round(time.Microsecond), p.P50.Round(time.Microsecond), p.P95.Round(time.Microsecond),
+			p.P99.Round(time.Microsecond), p.Max.Round(time.Microsecond))
+	}
+	if r.Kept {
+		fmt.Fprintf(os.Stderr, "\nBench issues kept (label %s)\n", r.Label)
+	}
+}
+
+func init() {
+	benchCmd.Flags().Int("n", 100, "Number of issues to create")
+	benchCmd.Flags().Int("m", 20, "Number of queries to run per kind (ready, list, search)")
+	benchCmd.Flags().Bool("quick", false, "Run only a few queries per kind (smoke check)")
+	benchCmd.Flags().Bool("keep", false, "Keep the bench issues instead of deleting them")
+	rootCmd.AddCommand(benchCmd)
+}
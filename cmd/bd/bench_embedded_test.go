@@ -0,0 +1,60 @@
+//go:build cgo
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestEmbeddedBench(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+	dir, _, _ := bdInit(t, bd, "--prefix", "bn")
+	kept := bdCreate(t, bd, dir, "Real issue", "--type", "task")
+
+	cmd := exec.Command(bd, "bench", "--n", "10", "--quick", "--json")
+	cmd.Dir = dir
+	cmd.Env = bdEnv(dir)
+	stdout, stderr, err := runCommandBuffers(t, cmd)
+	if err != nil {
+		t.Fatalf("bd bench failed: %v\nstdout:\n%s\nstderr:\n%s", err, stdout.String(), stderr.String())
+	}
+	s := strings.TrimSpace(stdout.String())
+	var report benchReport
+	if err := json.Unmarshal([]byte(s[strings.Index(s, "{"):]), &report); err != nil {
+		t.Fatalf("parse bench JSON: %v\n%s", err, s)
+	}
+
+	if report.Issues != 10 || report.Queries != benchQuickQueries {
+		t.Errorf("report issues=%d queries=%d, want 10/%d", report.Issues, report.Queries, benchQuickQueries)
+	}
+	want := map[string]int{"create": 10, "ready": benchQuickQueries, "list": benchQuickQueries, "search": benchQuickQueries}
+	if len(report.Phases) != len(want) {
+		t.Fatalf("phases = %d, want %d", len(report.Phases), len(want))
+	}
+	for _, p := range report.Phases {
+		if p.Count != want[p.Name] {
+			t.Errorf("%s count = %d, want %d", p.Name, p.Count, want[p.Name])
+		}
+		if p.P50 <= 0 || p.Max < p.P95 || p.P95 < p.P50 || p.OpsPerSec <= 0 {
+			t.Errorf("%s reported implausible numbers: %+v", p.Name, p)
+		}
+		if p.Name != "create" && p.Rows != 10 {
+			t.Errorf("%s returned %d rows, want the 10 bench issues", p.Name, p.Rows)
+		}
+	}
+
+	// The bench issues are cleaned up; real issues are untouched.
+	issues := bdListJSON(t, bd, dir, "--all")
+	if len(issues) != 1 || issues[0].ID != kept.ID {
+		t.Errorf("after bench, list = %d issues, want only %s", len(issues), kept.ID)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarizeBench(t *testing.T) {
+	var lat []time.Duration
+	for i := 100; i >= 1; i-- {
+		lat = append(lat, time.Duration(i)*time.Millisecond)
+	}
+	p := summarizeBench("list", lat, 2*time.Second, 7)
+	if p.Count != 100 || p.Rows != 7 {
+		t.Errorf("count=%d rows=%d, want 100/7", p.Count, p.Rows)
+	}
+	if p.Min != time.Millisecond || p.Max != 100*time.Millisecond {
+		t.Errorf("min=%v max=%v", p.Min, p.Max)
+	}
+	if p.P50 != 51*time.Millisecond || p.P95 != 95*time.Millisecond || p.P99 != 99*time.Millisecond {
+		t.Errorf("p50=%v p95=%v p99=%v", p.P50, p.P95, p.P99)
+	}
+	if p.OpsPerSec != 50 {
+		t.Errorf("ops/sec = %v, want 50", p.OpsPerSec)
+	}
+	if lat[0] != 100*time.Millisecond {
+		t.Error("summarizeBench reordered the caller's slice")
+	}
+
+	if empty := summarizeBench("ready", nil, 0, 0); empty.Count != 0 || empty.P50 != 0 {
+		t.Errorf("empty summary = %+v", empty)
+	}
+}
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
 )
 
 func TestEmbeddedBlocked(t *testing.T) {
@@ -80,6 +83,120 @@ func TestEmbeddedBlocked(t *testing.T) {
 			t.Errorf("invalid JSON in blocked output: %s", s[:min(200, len(s))])
 		}
 	})
+
+	// ===== --format md =====
+
+	t.Run("blocked_format_md", func(t *testing.T) {
+		blocker := bdCreate(t, bd, dir, "MD blocker", "--type", "task")
+		blocked := bdCreate(t, bd, dir, "MD blocked a|b", "--type", "task")
+		bdDepAdd(t, bd, dir, blocked.ID, blocker.ID)
+
+		cmd := exec.Command(bd, "blocked", "--format", "md", "--md-links", "https://example.com/i")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd blocked --format md failed: %v\nstdout:\n%s\nstderr:\n%s", err, stdout.String(), stderr.String())
+		}
+		out := stdout.String()
+		if !strings.HasPrefix(out, "| ID | Title | Status | Priority | Assignee |\n") {
+			t.Errorf("expected a Markdown table header:\n%s", out)
+		}
+		row := fmt.Sprintf("| [`%s`](https://example.com/i/%s) | MD blocked a\\|b |", blocked.ID, blocked.ID)
+		if !strings.Contains(out, row) {
+			t.Errorf("expected row starting %q:\n%s", row, out)
+		}
+		if strings.Contains(out, "`"+blocker.ID+"`") {
+			t.Errorf("blocker %s is not blocked and must not be listed:\n%s", blocker.ID, out)
+		}
+	})
+
+	t.Run("blocked_parent_must_exist", func(t *testing.T) {
+		cmd := exec.Command(bd, "blocked", "--parent", "bl-nonexistent999")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		out, err := cmd.CombinedOutput()
+		if err == nil {
+			t.Fatalf("expected blocked --parent of a nonexistent issue to fail, got: %s", out)
+		}
+		if !strings.Contains(string(out), "parent issue not found") {
+			t.Errorf("expected 'parent issue not found', got: %s", out)
+		}
+
+		// A real parent without blocked children succeeds with an empty list.
+		parent := bdCreate(t, bd, dir, "Parent without blocked children", "--type", "epic")
+		bdCreate(t, bd, dir, "Unblocked child", "--type", "task", "--parent", parent.ID)
+		cmd = exec.Command(bd, "blocked", "--parent", parent.ID, "--json")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd blocked --parent %s failed: %v\nstderr:\n%s", parent.ID, err, stderr.String())
+		}
+		if s := strings.TrimSpace(stdout.String()); !strings.HasSuffix(s, "[]") {
+			t.Errorf("expected an empty JSON list, got: %s", s)
+		}
+	})
+
+	// ===== Direct vs --transitive =====
+
+	t.Run("blocked_direct_vs_transitive", func(t *testing.T) {
+		tdir, _, _ := bdInit(t, bd, "--prefix", "bt")
+		blocker := bdCreate(t, bd, tdir, "Blocker", "--type", "task")
+		epic := bdCreate(t, bd, tdir, "Blocked epic", "--type", "epic")
+		child := bdCreate(t, bd, tdir, "Child", "--type", "epic", "--parent", epic.ID)
+		grandchild := bdCreate(t, bd, tdir, "Grandchild", "--type", "task", "--parent", child.ID)
+		bdDep(t, bd, tdir, "add", epic.ID, blocker.ID)
+
+		blockedJSON := func(args ...string) map[string][]types.BlockedReason {
+			t.Helper()
+			cmd := exec.Command(bd, append([]string{"blocked", "--json"}, args...)...)
+			cmd.Dir = tdir
+			cmd.Env = bdEnv(tdir)
+			stdout, stderr, err := runCommandBuffers(t, cmd)
+			if err != nil {
+				t.Fatalf("bd blocked --json %v failed: %v\nstdout:\n%s\nstderr:\n%s", args, err, stdout.String(), stderr.String())
+			}
+			s := stdout.String()
+			start := strings.Index(s, "[")
+			if start < 0 {
+				t.Fatalf("no JSON array in blocked output: %s", s)
+			}
+			var issues []types.BlockedIssue
+			if err := json.Unmarshal([]byte(s[start:]), &issues); err != nil {
+				t.Fatalf("parse blocked JSON: %v\n%s", err, s)
+			}
+			reasons := make(map[string][]types.BlockedReason, len(issues))
+			for _, issue := range issues {
+				reasons[issue.ID] = issue.Reason
+			}
+			return reasons
+		}
+
+		// By default only the epic, which has an open blocker of its own.
+		direct := blockedJSON()
+		if len(direct) != 1 || direct[epic.ID] == nil {
+			t.Errorf("default blocked set = %v, want only %s", direct, epic.ID)
+		}
+
+		// --transitive adds the parent chain under it, which bd ready skips too.
+		all := blockedJSON("--transitive")
+		if len(all) != 3 {
+			t.Errorf("--transitive blocked set = %v, want epic, child and grandchild", all)
+		}
+		wantEpic := []types.BlockedReason{{Type: string(types.DepBlocks), ID: blocker.ID}}
+		if got := all[epic.ID]; !reflect.DeepEqual(got, wantEpic) {
+			t.Errorf("reason for %s = %v, want %v", epic.ID, got, wantEpic)
+		}
+		wantChild := []types.BlockedReason{{Type: types.BlockedReasonParent, ID: epic.ID}}
+		if got := all[child.ID]; !reflect.DeepEqual(got, wantChild) {
+			t.Errorf("reason for %s = %v, want %v", child.ID, got, wantChild)
+		}
+		wantGrandchild := []types.BlockedReason{{Type: types.BlockedReasonParent, ID: child.ID}}
+		if got := all[grandchild.ID]; !reflect.DeepEqual(got, wantGrandchild) {
+			t.Errorf("reason for %s = %v, want %v", grandchild.ID, got, wantGrandchild)
+		}
+	})
 }
 
 func TestEmbeddedBlockedConcurrent(t *testing.T) {
@@ -1,9 +1,13 @@
 package main
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/utils"
 )
 
 var childrenCmd = &cobra.Command{
@@ -15,6 +19,8 @@ var childrenCmd = &cobra.Command{
 This is a convenience alias for 'bd list --parent <id> --status all'.
 Unlike plain 'bd list', children includes closed issues by default,
 since the primary use case is inspecting all work under a parent.
+The parent ID may be partial, as with 'bd show'; a parent that does not
+exist is an error rather than an empty list.
 
 Examples:
   bd children hq-abc123        # List all children of hq-abc123
@@ -31,14 +37,20 @@ Examples:
 			}
 		}()
 
-		parentID := args[0]
+		parentID, err := resolveParentID(rootCtx, args[0])
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
 		pretty, _ := cmd.Flags().GetBool("pretty")
 
 		_ = listCmd.Flags().Set("parent", parentID)
 		defer func() { _ = listCmd.Flags().Set("parent", "") }()
 
-		_ = listCmd.Flags().Set("status", "all")
-		defer func() { _ = listCmd.Flags().Set("status", "") }()
+		// --status is a slice flag: Set appends once it has been set, so
+		// replace the value outright and clear it again afterwards.
+		status := listCmd.Flags().Lookup("status").Value.(interface{ Replace([]string) error })
+		_ = status.Replace([]string{"all"})
+		defer func() { _ = status.Replace(nil) }()
 
 		if pretty {
 			_ = listCmd.Flags().Set("pretty", "true")
@@ -51,6 +63,25 @@ Examples:
 	},
 }
 
+// resolveParentID resolves a parent argument the way bd show resolves an ID,
+// so "no such parent" is reported instead of reading as "no children".
+func resolveParentID(ctx context.Context, id string) (string, error) {
+	if usesProxiedServer() {
+		return resolveParentIDProxiedServer(ctx, id)
+	}
+	if store == nil {
+		return "", fmt.Errorf("database not initialized")
+	}
+	fullID, err := utils.ResolvePartialID(ctx, store, id)
+	if isNotFoundErr(err) {
+		return "", fmt.Errorf("parent issue not found: %s", id)
+	}
+	if err != nil {
+		return "", fmt.Errorf("resolving parent %s: %w", id, err)
+	}
+	return fullID, nil
+}
+
 func init() {
 	childrenCmd.Flags().Bool("pretty", false, "Show children in tree format")
 	rootCmd.AddCommand(childrenCmd)
@@ -78,9 +78,15 @@ func TestEmbeddedChildren(t *testing.T) {
 
 	t.Run("children_empty", func(t *testing.T) {
 		parent := bdCreate(t, bd, dir, "No children parent", "--type", "task")
-		out := bdChildren(t, bd, dir, parent.ID)
-		// Should not error, may show empty message
-		_ = out
+		// A real parent with no children succeeds with an empty list.
+		out := bdChildren(t, bd, dir, parent.ID, "--json")
+		var issues []map[string]interface{}
+		if err := json.Unmarshal([]byte(out[max(strings.Index(out, "["), 0):]), &issues); err != nil {
+			t.Fatalf("parse children JSON: %v\n%s", err, out)
+		}
+		if len(issues) != 0 {
+			t.Errorf("expected no children, got %d: %s", len(issues), out)
+		}
 	})
 
 	t.Run("children_includes_all_statuses", func(t *testing.T) {
@@ -108,6 +114,9 @@ func TestEmbeddedChildren(t *testing.T) {
 		if err == nil {
 			t.Fatalf("expected children of nonexistent to fail, got: %s", out)
 		}
+		if !strings.Contains(string(out), "parent issue not found") {
+			t.Errorf("expected 'parent issue not found', got: %s", out)
+		}
 	})
 }
 
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"context"
+	"fmt"
+)
+
+func resolveParentIDProxiedServer(ctx context.Context, id string) (string, error) {
+	uw, err := openProxiedListUOW(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer uw.Close(ctx)
+
+	parent, _ := proxiedResolveIssueOrWisp(ctx, uw, id)
+	if parent == nil {
+		return "", fmt.Errorf("parent issue not found: %s", id)
+	}
+	return parent.ID, nil
+}
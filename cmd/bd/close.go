@@ -32,7 +32,24 @@ create, update, show, or close operation).
 When closing multiple issues, provide one --reason for all IDs or repeat
 --reason once per ID. Reasons map positionally: the first --reason applies
 to the first ID, the second --reason to the second ID, regardless of where
-the flags appear in the command line.`,
+the flags appear in the command line.
+
+With --ready-delta, also report the issues the close made ready (or blocked),
+so an agent loop can pick up freed work without a second bd ready call:
+
+  bd close bd-42 --ready-delta --json
+  {"closed": [...], "newly_ready": [...], "newly_blocked": [...]}
+
+With --cascade, closing an epic (or any parent) also closes all of its open
+descendants, whatever blocks them, in the same transaction. If the epic
+itself cannot close (an unsatisfied gate or an open blocker), nothing is
+closed. The descendants' close reason records the cascade, so
+"bd reopen <epic> --cascade" can reopen exactly those children later.
+
+A blocked issue is refused, and the close write itself re-checks the
+blockers: it only lands while no blocks, conditional-blocks or waits-for
+dependency is open, so a blocker reopened by another writer mid-close
+rejects the close instead of slipping past the guard. --force skips both.`,
 	Args:          cobra.MinimumNArgs(0),
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -69,9 +86,14 @@ the flags appear in the command line.`,
 		}
 
 		force, _ := cmd.Flags().GetBool("force")
+		cascade, _ := cmd.Flags().GetBool("cascade")
 		continueFlag, _ := cmd.Flags().GetBool("continue")
 		noAuto, _ := cmd.Flags().GetBool("no-auto")
 		suggestNext, _ := cmd.Flags().GetBool("suggest-next")
+		readyDeltaFlag, _ := cmd.Flags().GetBool("ready-delta")
+		if err := validateReadyDeltaFlags(cmd); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
 
 		claimNext, _ := cmd.Flags().GetBool("claim-next")
 
@@ -100,6 +122,20 @@ the flags appear in the command line.`,
 			resolvedIDs = append(resolvedIDs, r.ResolvedID)
 		}
 
+		// --ready-delta diffs the ready/blocked sets around the close. Like the
+		// other post-close flags it reads the first resolved ID's store.
+		deltaStore := store
+		if len(results) > 0 && results[0].Store != nil {
+			deltaStore = results[0].Store
+		}
+		var deltaBefore readySnapshot
+		if readyDeltaFlag {
+			deltaBefore, err = takeReadySnapshot(ctx, deltaStore)
+			if err != nil {
+				return HandleErrorRespectJSON("ready delta: %v", err)
+			}
+		}
+
 		// Track which stores were mutated so routed closes can commit before
 		// cleanup closes the routed handle. Deduped by pointer.
 		mutatedStores := map[storage.DoltStorage][]string{}
@@ -122,21 +158,6 @@ the flags appear in the command line.`,
 				continue
 			}
 
-			// Open-children close guard: prevent closing any issue with open
-			// parent-child dependents (GH#3681). With --force the close proceeds
-			// but a warning is emitted so orphaned children are never silent.
-			if issue != nil {
-				openChildren := countOpenChildren(ctx, activeStore, id)
-				if openChildren > 0 {
-					if force {
-						fmt.Fprintf(os.Stderr, "warning: closing %s with %d open child issue(s) still active\n", id, openChildren)
-					} else {
-						fmt.Fprintf(os.Stderr, "cannot close %s: %d open child issue(s); close children first or use --force to override\n", id, openChildren)
-						continue
-					}
-				}
-			}
-
 			// Check gate satisfaction for machine-checkable gates (GH#1467)
 			if !force {
 				if err := checkGateSatisfaction(issue); err != nil {
@@ -145,42 +166,65 @@ the flags appear in the command line.`,
 				}
 			}
 
-			// Delegate the is_blocked guard to the engine (GH#962). CloseIssueChecked
-			// runs the guard and the close in ONE transaction, so there is no
-			// read-then-write TOCTOU window between the check and the close. --force
-			// bypasses the guard; ExpectedVersion is unused on this path.
-			//
-			// Fork seam: keep the offline write-spool wrapper (GH#4379,
-			// internal/spool) around upstream's single-transaction close. A
-			// transient server-unreachable error queues the close for replay;
-			// permanent errors (incl. ErrCloseBlocked) pass through untouched.
 			var res storage.CloseIssueResult
-			spoolRes, err := writeWithSpool(ctx, "close",
-				spoolPayload(map[string]interface{}{
-					"id":      id,
-					"reason":  reason,
-					"actor":   actor,
-					"session": session,
-				}),
-				func() error {
-					var cerr error
-					res, cerr = activeStore.CloseIssueChecked(ctx, id, actor, storage.CloseIssueOptions{
-						Reason:  reason,
-						Session: session,
-						Force:   force,
-					})
-					return cerr
-				},
-			)
-			if err != nil {
-				if errors.Is(err, storage.ErrCloseBlocked) {
-					// The guard refused atomically; ErrCloseBlocked's message names the
-					// blockers. Preserve the actionable hint.
-					fmt.Fprintf(os.Stderr, "%v (use --force to override)\n", err)
-				} else {
-					fmt.Fprintf(os.Stderr, "Error closing %s: %v\n", id, err)
+			var spoolRes spoolOutcome
+			if cascade && issue != nil && issue.Status != types.StatusClosed {
+				// --cascade closes the issue and its open descendants in one
+				// transaction, after the issue's own blocker guard, so a
+				// refusal closes nothing; bd reopen --cascade later restores
+				// exactly these descendants.
+				children, err := cascadeCloseSubtree(ctx, activeStore, id, reason, session, force)
+				if err != nil {
+					if errors.Is(err, storage.ErrCloseBlocked) {
+						fmt.Fprintf(os.Stderr, "%v (use --force to override)\n", err)
+					} else {
+						fmt.Fprintf(os.Stderr, "Error closing %s: %v\n", id, err)
+					}
+					continue
+				}
+				for _, child := range children {
+					mutatedStores[activeStore] = append(mutatedStores[activeStore], child.ID)
+					closedCount++
+					if jsonOutput {
+						if closedChild, _ := activeStore.GetIssue(ctx, child.ID); closedChild != nil {
+							closedIssues = append(closedIssues, closedChild)
+						}
+					} else {
+						debug.PrintNormal("%s Closed %s: %s\n", ui.RenderPass("✓"), formatFeedbackID(child.ID, child.Title), cascadeCloseReason(id, reason))
+					}
+				}
+			} else {
+				// Open-children close guard: prevent closing any issue with open
+				// parent-child dependents (GH#3681). With --force the close proceeds
+				// but a warning is emitted so orphaned children are never silent.
+				if issue != nil {
+					openChildren := countOpenChildren(ctx, activeStore, id)
+					if openChildren > 0 {
+						if force {
+							fmt.Fprintf(os.Stderr, "warning: closing %s with %d open child issue(s) still active\n", id, openChildren)
+						} else {
+							fmt.Fprintf(os.Stderr, "cannot close %s: %d open child issue(s); close children first or use --force to override\n", id, openChildren)
+							continue
+						}
+					}
+				}
+
+				var err error
+				res, spoolRes, err = closeIssueChecked(ctx, activeStore, id, storage.CloseIssueOptions{
+					Reason:  reason,
+					Session: session,
+					Force:   force,
+				})
+				if err != nil {
+					if errors.Is(err, storage.ErrCloseBlocked) {
+						// The guard refused atomically; ErrCloseBlocked's message names the
+						// blockers. Preserve the actionable hint.
+						fmt.Fprintf(os.Stderr, "%v (use --force to override)\n", err)
+					} else {
+						fmt.Fprintf(os.Stderr, "Error closing %s: %v\n", id, err)
+					}
+					continue
 				}
-				continue
 			}
 			if spoolRes.Spooled {
 				// The close is QUEUED, not applied: skip the success side
@@ -193,50 +237,31 @@ the flags appear in the command line.`,
 				}
 				continue
 			}
+			// The audit entry and molecule auto-close follow every close; see
+			// finishClose for why an already-closed re-close replays the latter.
+			molID := finishClose(ctx, activeStore, id, issue, reason, session, res.Unchanged)
 			if res.Unchanged {
 				// Already closed: an idempotent no-op on the step's stored state. The
 				// old CloseIssue path also returned nil here and still reported the
 				// (already-closed) issue, so keep OUTPUT parity via the shared display
 				// block below — the issue stays in --json output and the text report
 				// exactly as before. Suppress the step's own real-state-change side
-				// effects (the audit entry, so no spurious closed→closed; the
-				// closedCount bump; step-level pending-commit tracking, since the step
-				// write itself is a no-op), but still count the command as a successful
-				// close for its retry-safe post-close contracts (last-touched,
-				// --continue, --suggest-next, --claim-next) via alreadyClosed below.
-				// Exit stays 0.
+				// effects (the closedCount bump; step-level pending-commit tracking,
+				// since the step write itself is a no-op), but still count the command
+				// as a successful close for its retry-safe post-close contracts
+				// (last-touched, --continue, --suggest-next, --claim-next) via
+				// alreadyClosed below. Exit stays 0.
 				alreadyClosed++
 
-				// Molecule auto-close is itself a retry-safe, fully state-derived
-				// post-close contract, so it must replay on an already-closed re-close
-				// just like the contracts above. If the final step's real close
-				// persisted but its molecule auto-close did not (a crash between the two
-				// commits, or the root CloseIssue failing with only a warning), this
-				// idempotent re-close is the ONLY thing that re-drives it — otherwise the
-				// molecule root is stranded open forever. autoCloseCompletedMolecule
-				// early-returns unless the root is genuinely open, auto-close-eligible,
-				// and complete, so it heals only that case and reintroduces none of the
-				// suppressed real-close side effects (no audit, no closed→closed on the
-				// step). Register the store when it actually closed the root so the
-				// pending-commit sweep persists it — closedCount==0 would not commit.
-				if molID := autoCloseCompletedMolecule(ctx, activeStore, id, actor, session); molID != "" {
+				// Register the store when the replayed molecule auto-close actually
+				// closed the root so the pending-commit sweep persists it —
+				// closedCount==0 would not commit.
+				if molID != "" {
 					mutatedStores[activeStore] = append(mutatedStores[activeStore], molID)
 				}
 			} else {
 				mutatedStores[activeStore] = append(mutatedStores[activeStore], id)
-
-				// Audit log the close (survives Dolt GC flatten)
-				oldStatus := "open"
-				if issue != nil {
-					oldStatus = string(issue.Status)
-				}
-				audit.LogFieldChange(id, "status", oldStatus, "closed", actor, reason)
-
 				closedCount++
-
-				// Auto-close parent molecule if all steps are now complete.
-				// Runs against the same store the step was closed in.
-				autoCloseCompletedMolecule(ctx, activeStore, id, actor, session)
 			}
 
 			// First id this command settled as closed — a real close or an
@@ -366,6 +391,18 @@ the flags appear in the command line.`,
 			}
 		}
 
+		var delta readyDelta
+		if readyDeltaFlag && closedCount > 0 {
+			deltaAfter, err := takeReadySnapshot(ctx, deltaStore)
+			if err != nil {
+				return HandleErrorRespectJSON("ready delta: %v", err)
+			}
+			delta = readyDeltaBetween(deltaBefore, deltaAfter)
+			if !jsonOutput {
+				printReadyDelta(delta)
+			}
+		}
+
 		if jsonOutput && len(closedIssues) > 0 {
 			if claimedNextIssue != nil {
 				if err := outputJSON(map[string]interface{}{
@@ -374,6 +411,14 @@ the flags appear in the command line.`,
 				}); err != nil {
 					return err
 				}
+			} else if readyDeltaFlag {
+				if err := outputJSON(map[string]interface{}{
+					"closed":        closedIssues,
+					"newly_ready":   delta.NewlyReady,
+					"newly_blocked": delta.NewlyBlocked,
+				}); err != nil {
+					return err
+				}
 			} else {
 				if err := outputJSON(closedIssues); err != nil {
 					return err
@@ -421,10 +466,12 @@ func init() {
 	_ = closeCmd.Flags().MarkHidden("comment") // Hidden alias for agent/CLI ergonomics
 	closeCmd.Flags().String("reason-file", "", "Read close reason from file (use - for stdin)")
 	closeCmd.Flags().BoolP("force", "f", false, "Force close pinned issues or unsatisfied gates")
+	closeCmd.Flags().Bool("cascade", false, "Also close all open descendants (parent-child); bd reopen --cascade restores them")
 	closeCmd.Flags().Bool("continue", false, "Auto-advance to next step in molecule")
 	closeCmd.Flags().Bool("no-auto", false, "With --continue, show next step but don't claim it")
 	closeCmd.Flags().Bool("suggest-next", false, "Show newly unblocked issues after closing")
 	closeCmd.Flags().Bool("claim-next", false, "Automatically claim the next highest priority available issue")
+	closeCmd.Flags().Bool("ready-delta", false, "Report issues that became ready or blocked (--json: {closed, newly_ready, newly_blocked})")
 	closeCmd.Flags().String("session", "", "Claude Code session ID (or set CLAUDE_SESSION_ID env var)")
 	closeCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(closeCmd)
@@ -607,6 +654,55 @@ func checkGateSatisfaction(issue *types.Issue) error {
 	return fmt.Errorf("gate condition not satisfied: %s (use --force to override)", reason)
 }
 
+// closeIssueChecked is the close write bd close and bd update --status
+// closed share. It delegates the is_blocked guard to the engine (GH#962):
+// CloseIssueChecked runs the guard and the close in ONE transaction, so there
+// is no read-then-write TOCTOU window between the check and the close.
+// The close write is itself conditional on there being no open blocker;
+// opts.Force bypasses both checks.
+//
+// Fork seam: keep the offline write-spool wrapper (GH#4379, internal/spool)
+// around upstream's single-transaction close. A transient server-unreachable
+// error queues the close for replay; permanent errors (incl. ErrCloseBlocked)
+// pass through untouched.
+func closeIssueChecked(ctx context.Context, st storage.DoltStorage, id string, opts storage.CloseIssueOptions) (storage.CloseIssueResult, spoolOutcome, error) {
+	var res storage.CloseIssueResult
+	spoolRes, err := writeWithSpool(ctx, "close",
+		spoolPayload(map[string]interface{}{
+			"id":      id,
+			"reason":  opts.Reason,
+			"actor":   actor,
+			"session": opts.Session,
+		}),
+		func() error {
+			var cerr error
+			res, cerr = st.CloseIssueChecked(ctx, id, actor, opts)
+			return cerr
+		},
+	)
+	return res, spoolRes, err
+}
+
+// finishClose runs the side effects of a close that landed: the audit entry
+// (survives Dolt GC flatten) and the molecule auto-close, against the store
+// the issue was closed in. An already-closed re-close (unchanged) writes no
+// audit entry, so there is no spurious closed→closed, but still replays the
+// molecule auto-close: that is a retry-safe, fully state-derived contract,
+// and if the final step's real close persisted but its molecule auto-close
+// did not (a crash between the two commits, or the root close failing with
+// only a warning), the re-close is the ONLY thing that re-drives it. It
+// returns the molecule root ID when the auto-close closed it.
+func finishClose(ctx context.Context, st storage.DoltStorage, id string, issue *types.Issue, reason, session string, unchanged bool) string {
+	if !unchanged {
+		oldStatus := "open"
+		if issue != nil {
+			oldStatus = string(issue.Status)
+		}
+		audit.LogFieldChange(id, "status", oldStatus, "closed", actor, reason)
+	}
+	return autoCloseCompletedMolecule(ctx, st, id, actor, session)
+}
+
 // autoCloseCompletedMolecule checks if closing a step completed an auto-closing
 // parent molecule, and if so, closes the molecule root. Ordinary epics remain
 // open when all children finish so they can become explicitly close-eligible
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/audit"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// cascadeCloseReasonPrefix marks the close reason of an issue closed by
+// bd close --cascade. The reason names the root issue whose close cascaded,
+// which is how bd reopen --cascade finds exactly those issues again in the
+// event history.
+const cascadeCloseReasonPrefix = "cascade-closed with "
+
+// cascadeCloseReason is the close reason recorded on a descendant of rootID
+// closed by bd close --cascade, carrying the user's reason when one was given.
+func cascadeCloseReason(rootID, reason string) string {
+	if reason == "" {
+		return cascadeCloseReasonPrefix + rootID
+	}
+	return cascadeCloseReasonPrefix + rootID + ": " + reason
+}
+
+// isCascadeCloseOf reports whether a close reason was written by a cascade
+// close of rootID.
+func isCascadeCloseOf(reason, rootID string) bool {
+	rest, ok := strings.CutPrefix(reason, cascadeCloseReasonPrefix+rootID)
+	return ok && (rest == "" || strings.HasPrefix(rest, ": "))
+}
+
+// parentChildDescendants returns every issue below rootID in the parent-child
+// hierarchy, parents before their children, whatever their status.
+func parentChildDescendants(ctx context.Context, s storage.DoltStorage, rootID string) ([]*types.Issue, error) {
+	var out []*types.Issue
+	seen := map[string]bool{rootID: true}
+	var walk func(id string) error
+	walk = func(id string) error {
+		dependents, err := s.GetDependentsWithMetadata(ctx, id)
+		if err != nil {
+			return fmt.Errorf("loading children of %s: %w", id, err)
+		}
+		for _, dep := range dependents {
+			if dep.DependencyType != types.DepParentChild || seen[dep.Issue.ID] {
+				continue
+			}
+			seen[dep.Issue.ID] = true
+			child := dep.Issue
+			out = append(out, &child)
+			if err := walk(child.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(rootID); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// cascadeCloseSubtree closes rootID together with its open descendants in
+// one transaction, deepest first, tagging each descendant's close reason with
+// cascadeCloseReason. Descendants are closed regardless of blockers: the
+// cascade closes the subtree as a unit. The root keeps bd close's blocker
+// guard unless force is set; it runs before any write, so a refused root
+// leaves the whole subtree open. It returns the descendants it closed.
+func cascadeCloseSubtree(ctx context.Context, s storage.DoltStorage, rootID, reason, session string, force bool) ([]*types.Issue, error) {
+	descendants, err := parentChildDescendants(ctx, s, rootID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot cascade close %s: %w", rootID, err)
+	}
+	childReason := cascadeCloseReason(rootID, reason)
+	var closed []*types.Issue
+	commitMsg := fmt.Sprintf("bd: cascade close %s", rootID)
+	err = transactHonoringAutoCommit(ctx, s, commitMsg, func(tx storage.Transaction) error {
+		closed = nil
+		if !force {
+			blocked, blockers, err := tx.IsBlocked(ctx, rootID)
+			if err != nil {
+				return err
+			}
+			if blocked && len(blockers) > 0 {
+				return fmt.Errorf("%w: %s is blocked by %v", storage.ErrCloseBlocked, rootID, blockers)
+			}
+		}
+		for i := len(descendants) - 1; i >= 0; i-- {
+			child := descendants[i]
+			if child.Status == types.StatusClosed {
+				continue
+			}
+			if err := tx.CloseIssue(ctx, child.ID, childReason, actor, session); err != nil {
+				return fmt.Errorf("cascade closing %s: %w", child.ID, err)
+			}
+			closed = append(closed, child)
+		}
+		return tx.CloseIssue(ctx, rootID, reason, actor, session)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range closed {
+		audit.LogFieldChange(child.ID, "status", string(child.Status), "closed", actor, childReason)
+	}
+	return closed, nil
+}
+
+// cascadeClosedDescendants returns the closed descendants of rootID whose most
+// recent close came from a cascade close of rootID. Descendants closed on
+// their own, before or after the cascade, are left out.
+func cascadeClosedDescendants(ctx context.Context, s storage.DoltStorage, rootID string) ([]*types.Issue, error) {
+	descendants, err := parentChildDescendants(ctx, s, rootID)
+	if err != nil {
+		return nil, err
+	}
+	var out []*types.Issue
+	for _, child := range descendants {
+		if child.Status != types.StatusClosed {
+			continue
+		}
+		events, err := s.GetEvents(ctx, child.ID, 0)
+		if err != nil {
+			return nil, fmt.Errorf("loading history of %s: %w", child.ID, err)
+		}
+		if reason := lastCloseReason(events); reason != nil && isCascadeCloseOf(*reason, rootID) {
+			out = append(out, child)
+		}
+	}
+	return out, nil
+}
+
+// lastCloseReason returns the reason recorded by the newest closed event, or
+// nil if the history has none.
+func lastCloseReason(events []*types.Event) *string {
+	var latest *types.Event
+	for _, e := range events {
+		if e.EventType != types.EventClosed {
+			continue
+		}
+		if latest == nil || e.CreatedAt.After(latest.CreatedAt) {
+			latest = e
+		}
+	}
+	if latest == nil || latest.NewValue == nil {
+		return nil
+	}
+	return latest.NewValue
+}
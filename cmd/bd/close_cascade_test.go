@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestCascadeCloseReason(t *testing.T) {
+	for _, tc := range []struct {
+		reason, root string
+		want         bool
+	}{
+		{cascadeCloseReason("bd-1", ""), "bd-1", true},
+		{cascadeCloseReason("bd-1", "descoped"), "bd-1", true},
+		{cascadeCloseReason("bd-10", ""), "bd-1", false},
+		{cascadeCloseReason("bd-1", ""), "bd-10", false},
+		{"done", "bd-1", false},
+	} {
+		if got := isCascadeCloseOf(tc.reason, tc.root); got != tc.want {
+			t.Errorf("isCascadeCloseOf(%q, %q) = %v, want %v", tc.reason, tc.root, got, tc.want)
+		}
+	}
+}
+
+func TestLastCloseReason(t *testing.T) {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	event := func(typ types.EventType, value string, offset time.Duration) *types.Event {
+		return &types.Event{EventType: typ, NewValue: &value, CreatedAt: base.Add(offset)}
+	}
+	if got := lastCloseReason(nil); got != nil {
+		t.Errorf("empty history = %q, want nil", *got)
+	}
+	// GetEvents returns newest first; lastCloseReason must not rely on it.
+	events := []*types.Event{
+		event(types.EventReopened, "", 2*time.Minute),
+		event(types.EventClosed, "first", 0),
+		event(types.EventClosed, "second", 3*time.Minute),
+		event(types.EventUpdated, "", 4*time.Minute),
+	}
+	if got := lastCloseReason(events); got == nil || *got != "second" {
+		t.Errorf("lastCloseReason = %v, want second", got)
+	}
+}
@@ -482,6 +482,30 @@ func TestEmbeddedClose(t *testing.T) {
 		_ = child
 	})
 
+	t.Run("close_cascade_blocked_root_closes_nothing", func(t *testing.T) {
+		blocker := bdCreate(t, bd, dir, "Cascade blocker", "--type", "task")
+		epic := bdCreate(t, bd, dir, "Blocked cascade epic", "--type", "epic")
+		child := bdCreate(t, bd, dir, "Blocked cascade child", "--type", "task", "--parent", epic.ID)
+		bdDepAdd(t, bd, dir, epic.ID, blocker.ID)
+
+		out := bdCloseFail(t, bd, dir, epic.ID, "--cascade")
+		if !strings.Contains(out, "blocked") {
+			t.Errorf("expected blocked error, got: %s", out)
+		}
+		for _, id := range []string{epic.ID, child.ID} {
+			if got := bdShow(t, bd, dir, id); got.Status != types.StatusOpen {
+				t.Errorf("%s should stay open when the cascade root is blocked, got %s", id, got.Status)
+			}
+		}
+
+		bdClose(t, bd, dir, epic.ID, "--cascade", "--force")
+		for _, id := range []string{epic.ID, child.ID} {
+			if got := bdShow(t, bd, dir, id); got.Status != types.StatusClosed {
+				t.Errorf("%s should be closed by a forced cascade, got %s", id, got.Status)
+			}
+		}
+	})
+
 	t.Run("close_non_epic_parent_open_children_refuses", func(t *testing.T) {
 		parent := bdCreate(t, bd, dir, "Task parent guard", "--type", "task")
 		child := bdCreate(t, bd, dir, "Task child guard", "--type", "task")
@@ -555,6 +579,74 @@ func TestEmbeddedClose(t *testing.T) {
 		}
 	})
 
+	t.Run("close_reopen_ready_delta_json", func(t *testing.T) {
+		rdDir, _, _ := bdInit(t, bd, "--prefix", "rd")
+		blocker := bdCreate(t, bd, rdDir, "Delta blocker", "--type", "task")
+		blocked := bdCreate(t, bd, rdDir, "Delta blocked", "--type", "task")
+		bystander := bdCreate(t, bd, rdDir, "Delta bystander", "--type", "task")
+		bdDepAdd(t, bd, rdDir, blocked.ID, blocker.ID)
+
+		type deltaJSON struct {
+			Closed       []types.Issue `json:"closed"`
+			Reopened     []types.Issue `json:"reopened"`
+			NewlyReady   []types.Issue `json:"newly_ready"`
+			NewlyBlocked []types.Issue `json:"newly_blocked"`
+		}
+		run := func(args ...string) deltaJSON {
+			t.Helper()
+			cmd := exec.Command(bd, append(args, "--ready-delta", "--json")...)
+			cmd.Dir = rdDir
+			cmd.Env = bdEnv(rdDir)
+			stdout, stderr, err := runCommandBuffers(t, cmd)
+			if err != nil {
+				t.Fatalf("bd %v --ready-delta --json failed: %v\nstdout:\n%s\nstderr:\n%s", args, err, stdout.String(), stderr.String())
+			}
+			s := stdout.String()
+			var got deltaJSON
+			if err := json.Unmarshal([]byte(s[strings.Index(s, "{"):]), &got); err != nil {
+				t.Fatalf("parse ready-delta JSON: %v\n%s", err, s)
+			}
+			return got
+		}
+		ids := func(issues []types.Issue) []string {
+			out := []string{}
+			for _, issue := range issues {
+				out = append(out, issue.ID)
+			}
+			return out
+		}
+
+		closed := run("close", blocker.ID)
+		if len(closed.Closed) != 1 || closed.Closed[0].ID != blocker.ID {
+			t.Errorf("closed = %v, want [%s]", ids(closed.Closed), blocker.ID)
+		}
+		if got := ids(closed.NewlyReady); len(got) != 1 || got[0] != blocked.ID {
+			t.Errorf("newly_ready after close = %v, want [%s] (not bystander %s)", got, blocked.ID, bystander.ID)
+		}
+		if len(closed.NewlyBlocked) != 0 {
+			t.Errorf("newly_blocked after close = %v, want []", ids(closed.NewlyBlocked))
+		}
+
+		reopened := run("reopen", blocker.ID)
+		if got := ids(reopened.NewlyBlocked); len(got) != 1 || got[0] != blocked.ID {
+			t.Errorf("newly_blocked after reopen = %v, want [%s]", got, blocked.ID)
+		}
+		if got := ids(reopened.NewlyReady); len(got) != 1 || got[0] != blocker.ID {
+			t.Errorf("newly_ready after reopen = %v, want the reopened %s", got, blocker.ID)
+		}
+	})
+
+	t.Run("close_ready_delta_rejects_suggest_next", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Delta flag conflict", "--type", "task")
+		cmd := exec.Command(bd, "close", issue.ID, "--ready-delta", "--suggest-next")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		out, err := cmd.CombinedOutput()
+		if err == nil || !strings.Contains(string(out), "--ready-delta cannot be combined with --suggest-next") {
+			t.Errorf("expected flag conflict error, got err=%v\n%s", err, out)
+		}
+	})
+
 	// ===== Claim-Next Flag =====
 
 	t.Run("close_claim_next", func(t *testing.T) {
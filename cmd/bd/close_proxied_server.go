@@ -24,6 +24,7 @@ type closeProxiedInput struct {
 	noAuto      bool
 	suggestNext bool
 	claimNext   bool
+	readyDelta  bool
 	session     string
 	jsonOut     bool
 }
@@ -41,6 +42,7 @@ type closeProxiedTxResult struct {
 	outcomes         []closeProxiedOutcome
 	reasons          []string
 	unblocked        []*types.Issue
+	delta            *readyDelta
 	continueResult   *ContinueResult
 	claimedNextIssue *types.Issue
 	errors           []string
@@ -63,7 +65,13 @@ func runCloseProxiedServer(cmd *cobra.Command, ctx context.Context, args []strin
 	}
 
 	in := gatherCloseProxiedInput(cmd)
+	if err := validateReadyDeltaFlags(cmd); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
 
+	if cascade, _ := cmd.Flags().GetBool("cascade"); cascade {
+		return HandleErrorRespectJSON("--cascade is not supported in proxied-server mode")
+	}
 	if in.continueOn && len(args) > 1 {
 		return HandleErrorRespectJSON("--continue only works when closing a single issue")
 	}
@@ -78,6 +86,14 @@ func runCloseProxiedServer(cmd *cobra.Command, ctx context.Context, args []strin
 	res, err := uow.RunTxResult(ctx, uowProvider, func(ctx context.Context, uw uow.UnitOfWork) (closeProxiedTxResult, string, error) {
 		var result closeProxiedTxResult
 
+		var deltaBefore readySnapshot
+		if in.readyDelta {
+			var err error
+			if deltaBefore, err = takeReadySnapshot(ctx, proxiedReadyDeltaSource{uw: uw}); err != nil {
+				return result, "", fmt.Errorf("ready delta: %w", err)
+			}
+		}
+
 		for i, id := range args {
 			reason := reasonForCloseIndex(reasons, i)
 			outcome, ok := closeProxiedOne(ctx, uw, id, reason, in, &result.errors)
@@ -91,6 +107,15 @@ func runCloseProxiedServer(cmd *cobra.Command, ctx context.Context, args []strin
 			}
 		}
 
+		if in.readyDelta && len(result.outcomes) > 0 {
+			deltaAfter, err := takeReadySnapshot(ctx, proxiedReadyDeltaSource{uw: uw})
+			if err != nil {
+				return result, "", fmt.Errorf("ready delta: %w", err)
+			}
+			delta := readyDeltaBetween(deltaBefore, deltaAfter)
+			result.delta = &delta
+		}
+
 		if in.suggestNext && len(args) == 1 && len(result.outcomes) > 0 {
 			unblocked, warn := closeProxiedSuggestNext(ctx, uw, args[0])
 			result.unblocked = unblocked
@@ -154,6 +179,9 @@ func runCloseProxiedServer(cmd *cobra.Command, ctx context.Context, args []strin
 				fmt.Printf("  • %s (P%d)\n", formatFeedbackID(issue.ID, issue.Title), issue.Priority)
 			}
 		}
+		if res.delta != nil {
+			printReadyDelta(*res.delta)
+		}
 		if res.continueResult != nil {
 			PrintContinueResult(res.continueResult)
 		}
@@ -176,6 +204,8 @@ func runCloseProxiedServer(cmd *cobra.Command, ctx context.Context, args []strin
 			_ = outputJSON(map[string]interface{}{"closed": closedIssues, "continue": res.continueResult})
 		case res.claimedNextIssue != nil:
 			_ = outputJSON(map[string]interface{}{"closed": closedIssues, "claimed": res.claimedNextIssue})
+		case res.delta != nil:
+			_ = outputJSON(map[string]interface{}{"closed": closedIssues, "newly_ready": res.delta.NewlyReady, "newly_blocked": res.delta.NewlyBlocked})
 		default:
 			_ = outputJSON(closedIssues)
 		}
@@ -194,6 +224,7 @@ func gatherCloseProxiedInput(cmd *cobra.Command) closeProxiedInput {
 	in.noAuto, _ = cmd.Flags().GetBool("no-auto")
 	in.suggestNext, _ = cmd.Flags().GetBool("suggest-next")
 	in.claimNext, _ = cmd.Flags().GetBool("claim-next")
+	in.readyDelta, _ = cmd.Flags().GetBool("ready-delta")
 	in.session, _ = cmd.Flags().GetString("session")
 	if in.session == "" {
 		in.session = os.Getenv("CLAUDE_SESSION_ID")
@@ -236,18 +267,7 @@ func closeProxiedOne(ctx context.Context, uw uow.UnitOfWork, id, reason string,
 	}
 
 	params := domain.CloseIssueParams{Reason: reason, Session: in.session}
-	var (
-		res domain.CloseIssueResult
-		err error
-	)
-	// The is_blocked guard lives in the library's checked close, so the embedded
-	// and proxied paths converge on storage.ErrCloseBlocked and its message. The
-	// guard and the close share this unit-of-work transaction.
-	if isWisp {
-		res, err = uw.IssueUseCase().CloseWispChecked(ctx, id, params, actor, in.force)
-	} else {
-		res, err = uw.IssueUseCase().CloseIssueChecked(ctx, id, params, actor, in.force)
-	}
+	res, err := closeProxiedChecked(ctx, uw, id, isWisp, params, in.force)
 	if err != nil {
 		if errors.Is(err, storage.ErrCloseBlocked) {
 			*errs = append(*errs, fmt.Sprintf("%v (use --force to override)", err))
@@ -272,6 +292,18 @@ func closeProxiedOne(ctx context.Context, uw uow.UnitOfWork, id, reason string,
 	}, true
 }
 
+// closeProxiedChecked is the close write bd close and bd update --status
+// closed share in proxied-server mode. The is_blocked guard lives in the
+// library's checked close, so the embedded and proxied paths converge on
+// storage.ErrCloseBlocked and its message. The guard and the close share the
+// unit-of-work transaction.
+func closeProxiedChecked(ctx context.Context, uw uow.UnitOfWork, id string, isWisp bool, params domain.CloseIssueParams, force bool) (domain.CloseIssueResult, error) {
+	if isWisp {
+		return uw.IssueUseCase().CloseWispChecked(ctx, id, params, actor, force)
+	}
+	return uw.IssueUseCase().CloseIssueChecked(ctx, id, params, actor, force)
+}
+
 func closeProxiedCommitMessage(outcomes []closeProxiedOutcome, claimed *types.Issue, cont *ContinueResult) string {
 	ids := make([]string, 0, len(outcomes))
 	for _, o := range outcomes {
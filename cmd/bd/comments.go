@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -28,7 +29,10 @@ Examples:
   bd comments add bd-123 "This is a comment"
 
   # Add a comment from a file
-  bd comments add bd-123 -f notes.txt`,
+  bd comments add bd-123 -f notes.txt
+
+  # Add a multi-line comment from stdin
+  bd comments add bd-123 --stdin < notes.md`,
 	Args:          cobra.MinimumNArgs(1),
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -131,7 +135,10 @@ Examples:
   bd comments add bd-123 "Working on this now"
 
   # Add a comment from a file
-  bd comments add bd-123 -f notes.txt`,
+  bd comments add bd-123 -f notes.txt
+
+  # Add a multi-line comment from stdin (newlines and quotes kept as typed)
+  git log -3 --format='- %s' | bd comments add bd-123 --stdin`,
 	Args:          cobra.MinimumNArgs(1),
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -151,21 +158,9 @@ Examples:
 
 		issueID := args[0]
 
-		commentText, _ := cmd.Flags().GetString("file")
-		if commentText != "" {
-			data, err := os.ReadFile(commentText) // #nosec G304 - user-provided file path is intentional
-			if err != nil {
-				return HandleErrorRespectJSON("reading file: %v", err)
-			}
-			commentText = string(data)
-		} else if len(args) < 2 {
-			return HandleErrorRespectJSON("comment text required (use -f to read from file)")
-		} else {
-			commentText = args[1]
-		}
-
-		if strings.TrimSpace(commentText) == "" {
-			return HandleErrorRespectJSON("comment text cannot be empty")
+		commentText, err := readCommentsAddText(cmd, args)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
 		}
 
 		author, _ := cmd.Flags().GetString("author")
@@ -214,11 +209,50 @@ Examples:
 	},
 }
 
+// readCommentsAddText returns the comment body for bd comments add: the text
+// argument, the file named by --file, or all of stdin with --stdin. Reading a
+// body from a file or stdin keeps its quotes, brackets and newlines out of the
+// shell's hands; only the trailing newline a heredoc or echo adds is dropped.
+func readCommentsAddText(cmd *cobra.Command, args []string) (string, error) {
+	file, _ := cmd.Flags().GetString("file")
+	fromStdin, _ := cmd.Flags().GetBool("stdin")
+	if (file != "" || fromStdin) && len(args) > 1 {
+		return "", fmt.Errorf("comment text given both as an argument and with --stdin or --file")
+	}
+
+	var text string
+	switch {
+	case fromStdin:
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("reading from stdin: %w", err)
+		}
+		text = strings.TrimRight(string(data), "\n")
+	case file != "":
+		data, err := os.ReadFile(file) // #nosec G304 - user-provided file path is intentional
+		if err != nil {
+			return "", fmt.Errorf("reading file: %w", err)
+		}
+		text = string(data)
+	case len(args) < 2:
+		return "", fmt.Errorf("comment text required (use -f to read from a file or --stdin to read from stdin)")
+	default:
+		text = args[1]
+	}
+
+	if strings.TrimSpace(text) == "" {
+		return "", fmt.Errorf("comment text cannot be empty")
+	}
+	return text, nil
+}
+
 func init() {
 	commentsCmd.AddCommand(commentsMisplacedListCmd)
 	commentsCmd.AddCommand(commentsAddCmd)
 	commentsCmd.Flags().Bool("local-time", false, "Show timestamps in local time instead of UTC")
 	commentsAddCmd.Flags().StringP("file", "f", "", "Read comment text from file")
+	commentsAddCmd.Flags().Bool("stdin", false, "Read comment text from stdin")
+	commentsAddCmd.MarkFlagsMutuallyExclusive("stdin", "file")
 	commentsAddCmd.Flags().StringP("author", "a", "", "Add author to comment")
 
 	// Issue ID completions
@@ -82,6 +82,58 @@ func TestEmbeddedCommentsCLI(t *testing.T) {
 		}
 	})
 
+	t.Run("comments_add_stdin_round_trip", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Stdin comment", "--type", "task")
+		body := "Findings:\n  - \"quoted\" and 'single' [brackets] {braces}\n  - $HOME `ticks` \\ backslash\n\nDone."
+
+		cmd := exec.Command(bd, "comments", "add", issue.ID, "--stdin", "--json")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		cmd.Stdin = strings.NewReader(body + "\n")
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd comments add --stdin failed: %v\nstdout:\n%s\nstderr:\n%s", err, stdout.String(), stderr.String())
+		}
+
+		cmd = exec.Command(bd, "comments", issue.ID, "--json")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		stdout, stderr, err = runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("list failed: %v\nstdout:\n%s\nstderr:\n%s", err, stdout.String(), stderr.String())
+		}
+		var comments []map[string]interface{}
+		s := strings.TrimSpace(stdout.String())
+		if err := json.Unmarshal([]byte(s[strings.Index(s, "["):]), &comments); err != nil {
+			t.Fatalf("parse comments JSON: %v\n%s", err, s)
+		}
+		if len(comments) != 1 || comments[0]["text"] != body {
+			t.Errorf("stdin comment did not round-trip:\ngot  %v\nwant %q", comments, body)
+		}
+	})
+
+	t.Run("comments_add_stdin_rejects_blank_and_extra_text", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Stdin validation", "--type", "task")
+		for _, tc := range []struct {
+			args  []string
+			stdin string
+			want  string
+		}{
+			{[]string{"--stdin"}, " \n\t\n", "cannot be empty"},
+			{[]string{"--stdin", "also text"}, "body\n", "both as an argument"},
+			{[]string{"--stdin", "--file", "x.txt"}, "body\n", "stdin"},
+		} {
+			cmd := exec.Command(bd, append([]string{"comments", "add", issue.ID}, tc.args...)...)
+			cmd.Dir = dir
+			cmd.Env = bdEnv(dir)
+			cmd.Stdin = strings.NewReader(tc.stdin)
+			out, err := cmd.CombinedOutput()
+			if err == nil || !strings.Contains(string(out), tc.want) {
+				t.Errorf("comments add %v: expected failure mentioning %q, got err=%v: %s", tc.args, tc.want, err, out)
+			}
+		}
+	})
+
 	t.Run("comments_add_nonexistent_issue", func(t *testing.T) {
 		cmd := exec.Command(bd, "comments", "add", "cc-nonexistent999", "nope")
 		cmd.Dir = dir
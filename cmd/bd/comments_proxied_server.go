@@ -117,21 +117,9 @@ func runCommentProxiedServer(cmd *cobra.Command, ctx context.Context, args []str
 func runCommentsAddProxiedServer(cmd *cobra.Command, ctx context.Context, args []string) error {
 	issueID := args[0]
 
-	commentText, _ := cmd.Flags().GetString("file")
-	if commentText != "" {
-		data, err := os.ReadFile(commentText) // #nosec G304 - user-provided file path is intentional
-		if err != nil {
-			return HandleErrorRespectJSON("reading file: %v", err)
-		}
-		commentText = string(data)
-	} else if len(args) < 2 {
-		return HandleErrorRespectJSON("comment text required (use -f to read from file)")
-	} else {
-		commentText = args[1]
-	}
-
-	if strings.TrimSpace(commentText) == "" {
-		return HandleErrorRespectJSON("comment text cannot be empty")
+	commentText, err := readCommentsAddText(cmd, args)
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
 	}
 
 	author, _ := cmd.Flags().GetString("author")
@@ -140,6 +140,12 @@ var configSetCmd = &cobra.Command{
 			return SilentExit()
 		}
 
+		if key == "create.default_type" {
+			if err := validateDefaultIssueType(value); err != nil {
+				return HandleError("%v", err)
+			}
+		}
+
 		if !isRecognizedConfigKey(key) {
 			suggestion := suggestConfigKey(key)
 			if suggestion != "" {
@@ -974,9 +980,27 @@ var recognizedConfigKeys = map[string]bool{
 	"no-db": true, "json": true, "db": true, "actor": true,
 	"identity": true, "no-push": true, "no-git-ops": true,
 	"create.require-description": true, "beads.role": true,
+	"create.default_priority": true, "create.default_type": true,
+	"create.dup_check": true, "estimate.workday_hours": true,
 	"auto_compact_enabled": true, "schema_version": true,
 	"output.title-length": true,
 	"prime.max-memories":  true, "prime.max-memory-chars": true,
+	"metadata.schema": true,
+}
+
+// validateDefaultIssueType checks a create.default_type value against the
+// built-in types and, when a store is open, the configured custom types.
+func validateDefaultIssueType(value string) error {
+	var customTypes []string
+	if store != nil {
+		if ct, err := store.GetCustomTypes(rootCtx); err == nil {
+			customTypes = ct
+		}
+	}
+	if !types.IssueType(value).Normalize().IsValidWithCustom(customTypes) {
+		return fmt.Errorf("invalid create.default_type %q (built-in: bug, feature, task, epic, chore, decision, spike, story, milestone; or configure custom types via 'bd config set types.custom')", value)
+	}
+	return nil
 }
 
 func isRecognizedConfigKey(key string) bool {
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/configfile"
+	"github.com/steveyegge/beads/internal/doltserver"
+	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/schema"
+)
+
+// BackendInfo describes the storage backend a workspace resolves to.
+type BackendInfo struct {
+	Backend             string `json:"backend"`
+	Mode                string `json:"mode"`
+	SharedServer        bool   `json:"shared_server,omitempty"`
+	Host                string `json:"host,omitempty"`
+	Port                int    `json:"port,omitempty"`
+	PortSource          string `json:"port_source,omitempty"`
+	BeadsDir            string `json:"beads_dir"`
+	DataDir             string `json:"data_dir"`
+	Database            string `json:"database"`
+	DoltVersion         string `json:"dolt_version,omitempty"`
+	SchemaVersion       int    `json:"schema_version,omitempty"`
+	LatestSchemaVersion int    `json:"latest_schema_version"`
+	VersionError        string `json:"version_error,omitempty"`
+}
+
+var configBackendInfoCmd = &cobra.Command{
+	Use:   "backend-info",
+	Short: "Show the resolved storage backend, port, data dir and versions",
+	Long: `Show which storage backend this workspace uses and where it lives.
+
+Reports the backend type, the Dolt mode (embedded, server or proxied-server),
+the server host and port with the source the port was resolved from, the data
+directory, the database name, and the Dolt and schema versions.
+
+Port sources, by precedence:
+  env             BEADS_DOLT_SERVER_PORT
+  port_file       .beads/dolt-server.port, written by the managed server
+  dolt_config     listener.port in the Dolt server's config.yaml
+  config_yaml     dolt.port in bd's config.yaml
+  metadata_json   dolt_server_port in .beads/metadata.json (deprecated)
+  shared_default  the shared server's fixed port
+  none            no port configured; a managed server picks one on start
+
+The versions are read from the open database, so they are missing when the
+database cannot be opened.
+
+Examples:
+  bd config backend-info
+  bd config backend-info --json`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		evt := metrics.NewCommandEvent("config-backend-info")
+		defer func() {
+			if c := metrics.Global(); c != nil {
+				c.CloseEventAndAdd(evt)
+			}
+		}()
+
+		rc, err := beads.GetRepoContext()
+		if err != nil {
+			return HandleErrorRespectJSON("cannot resolve repo context: %v", err)
+		}
+		info, err := collectBackendInfo(cmd, rc.BeadsDir)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			return outputJSON(info)
+		}
+		printBackendInfo(info)
+		return nil
+	},
+}
+
+// collectBackendInfo resolves the backend of beadsDir the way store opening
+// does: metadata.json for the backend and mode, doltserver.DefaultConfig for
+// the port.
+func collectBackendInfo(cmd *cobra.Command, beadsDir string) (*BackendInfo, error) {
+	cfg, err := configfile.Load(beadsDir)
+	if err != nil || cfg == nil {
+		cfg = configfile.DefaultConfig()
+	}
+
+	info := &BackendInfo{
+		Backend:             cfg.GetBackend(),
+		Mode:                configfile.DoltModeEmbedded,
+		BeadsDir:            beadsDir,
+		DataDir:             filepath.Join(beadsDir, "embeddeddolt"),
+		Database:            cfg.GetDoltDatabase(),
+		LatestSchemaVersion: schema.LatestVersion(),
+	}
+	switch {
+	case cfg.IsDoltProxiedServerMode():
+		info.Mode = configfile.DoltModeProxiedServer
+		if info.DataDir, err = resolveProxiedServerRootPath(beadsDir); err != nil {
+			return nil, fmt.Errorf("resolve proxied server root: %w", err)
+		}
+	case cfg.IsDoltServerMode() || doltserver.IsSharedServerMode():
+		info.Mode = configfile.DoltModeServer
+		info.SharedServer = doltserver.IsSharedServerMode()
+		info.Host = cfg.GetDoltServerHost()
+		dsCfg := doltserver.DefaultConfig(beadsDir)
+		info.Port, info.PortSource = dsCfg.Port, dsCfg.PortSource
+		info.DataDir = doltserver.ResolveDoltDir(beadsDir)
+	}
+
+	if store != nil {
+		if locator, ok := storage.UnwrapStore(store).(storage.StoreLocator); ok && locator.Path() != "" && info.Mode == configfile.DoltModeEmbedded {
+			info.DataDir = locator.Path()
+		}
+		if reporter, ok := storage.UnwrapStore(store).(storage.BackendVersionReporter); ok {
+			info.DoltVersion, info.SchemaVersion, err = reporter.BackendVersions(cmd.Context())
+			if err != nil {
+				info.VersionError = err.Error()
+			}
+		}
+	} else {
+		info.VersionError = "database not open"
+	}
+	return info, nil
+}
+
+func printBackendInfo(info *BackendInfo) {
+	fmt.Printf("backend:        %s\n", info.Backend)
+	mode := info.Mode
+	if info.SharedServer {
+		mode += " (shared)"
+	}
+	fmt.Printf("mode:           %s\n", mode)
+	if info.Mode == configfile.DoltModeServer {
+		port := "auto (allocated on start)"
+		if info.Port > 0 {
+			port = fmt.Sprintf("%d", info.Port)
+		}
+		fmt.Printf("server:         %s, port %s (source: %s)\n", info.Host, port, info.PortSource)
+	}
+	fmt.Printf("beads dir:      %s\n", info.BeadsDir)
+	fmt.Printf("data dir:       %s\n", info.DataDir)
+	fmt.Printf("database:       %s\n", info.Database)
+	if info.DoltVersion != "" {
+		fmt.Printf("dolt version:   %s\n", info.DoltVersion)
+	}
+	if info.VersionError == "" {
+		fmt.Printf("schema version: %d (latest %d)\n", info.SchemaVersion, info.LatestSchemaVersion)
+	} else {
+		fmt.Printf("schema version: unknown, latest %d (%s)\n", info.LatestSchemaVersion, info.VersionError)
+	}
+}
+
+func init() {
+	configCmd.AddCommand(configBackendInfoCmd)
+}
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
+
+	"github.com/steveyegge/beads/internal/configfile"
 )
 
 // bdConfig runs "bd config" with the given args and returns stdout.
@@ -163,6 +166,37 @@ func TestEmbeddedConfig(t *testing.T) {
 	// Note: config validate checks dolt server connectivity which doesn't
 	// apply to embedded mode, so we skip it here.
 
+	// ===== Backend Info =====
+
+	t.Run("config_backend_info_matches_init", func(t *testing.T) {
+		beadsDir := filepath.Join(dir, ".beads")
+		cfg, err := configfile.Load(beadsDir)
+		if err != nil || cfg == nil {
+			t.Fatalf("load metadata.json: %v", err)
+		}
+
+		out := bdConfig(t, bd, dir, "backend-info", "--json")
+		var info BackendInfo
+		if err := json.Unmarshal([]byte(out[strings.Index(out, "{"):]), &info); err != nil {
+			t.Fatalf("parse backend-info JSON: %v\n%s", err, out)
+		}
+		if info.Backend != cfg.GetBackend() || info.Mode != configfile.DoltModeEmbedded {
+			t.Errorf("backend/mode = %s/%s, want %s/%s", info.Backend, info.Mode, cfg.GetBackend(), configfile.DoltModeEmbedded)
+		}
+		if info.Database != cfg.GetDoltDatabase() {
+			t.Errorf("database = %q, want %q", info.Database, cfg.GetDoltDatabase())
+		}
+		if info.Port != 0 || info.PortSource != "" {
+			t.Errorf("embedded mode should report no port, got %d (%s)", info.Port, info.PortSource)
+		}
+		if info.DoltVersion == "" || info.VersionError != "" {
+			t.Errorf("expected a dolt version, got %q (error %q)", info.DoltVersion, info.VersionError)
+		}
+		if info.SchemaVersion == 0 || info.SchemaVersion != info.LatestSchemaVersion {
+			t.Errorf("schema version = %d, want latest %d", info.SchemaVersion, info.LatestSchemaVersion)
+		}
+	})
+
 	// ===== Error Cases =====
 
 	t.Run("config_get_missing_key", func(t *testing.T) {
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// Test seams for confirmDestructive.
+var (
+	confirmIsInteractive           = func() bool { return term.IsTerminal(int(os.Stdin.Fd())) }
+	confirmInput         io.Reader = os.Stdin
+)
+
+// registerDestructiveFlags adds --dry-run and --yes to a command that can
+// change many issues at once. Commands that already define --dry-run keep
+// their own description.
+func registerDestructiveFlags(cmd *cobra.Command) {
+	if cmd.Flags().Lookup("dry-run") == nil {
+		cmd.Flags().Bool("dry-run", false, "Preview the changes without making them")
+	}
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt when more than one issue is affected")
+}
+
+// confirmDestructive asks before a destructive change that affects more than
+// one issue. action describes the change ("remove label 'x' from 3 issues").
+// --yes skips the prompt. Without a terminal, or with --json or --quiet, there
+// is no one to ask, so the change is refused unless --yes is given.
+func confirmDestructive(cmd *cobra.Command, action string, affected int) error {
+	if affected <= 1 {
+		return nil
+	}
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return nil
+	}
+	if jsonOutput || isQuiet() || !confirmIsInteractive() {
+		return fmt.Errorf("refusing to %s without confirmation: re-run with --yes (or --dry-run to preview)", action)
+	}
+	fmt.Fprintf(os.Stderr, "About to %s. Continue? [y/N] ", action)
+	line, _ := bufio.NewReader(confirmInput).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return nil
+	}
+	return fmt.Errorf("aborted: %s", action)
+}
+
+// registerStrictFlag adds --strict to a remove command whose targets may not
+// exist, e.g. a label the issue never carried.
+func registerStrictFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("strict", false, "Fail instead of warning when something to remove does not exist")
+}
+
+// reportNothingToRemove handles removals that matched nothing. Each one is
+// described by a message such as "no such label to remove: ...". A remove
+// command learns of them from the rows each DELETE removed, so without this
+// it would report success for a typo. The default is to warn and skip them.
+// --strict returns an error instead.
+func reportNothingToRemove(cmd *cobra.Command, missing []string) error {
+	if err := strictNothingToRemove(cmd, missing); err != nil {
+		return err
+	}
+	for _, msg := range missing {
+		WarnError("%s", msg)
+	}
+	return nil
+}
+
+// strictNothingToRemove returns the --strict error for removals that matched
+// nothing, and nil without --strict. Remove commands return it from inside
+// their transaction, so a strict run rolls back the removals that did match.
+func strictNothingToRemove(cmd *cobra.Command, missing []string) error {
+	if len(missing) == 0 {
+		return nil
+	}
+	if strict, _ := cmd.Flags().GetBool("strict"); strict {
+		return fmt.Errorf("%s", strings.Join(missing, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestConfirmDestructive(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: "test"}
+		registerDestructiveFlags(cmd)
+		if err := cmd.ParseFlags(args); err != nil {
+			t.Fatalf("ParseFlags: %v", err)
+		}
+		return cmd
+	}
+	withTerminal := func(t *testing.T, interactive bool, input string) {
+		oldInteractive, oldInput := confirmIsInteractive, confirmInput
+		confirmIsInteractive = func() bool { return interactive }
+		confirmInput = strings.NewReader(input)
+		t.Cleanup(func() { confirmIsInteractive, confirmInput = oldInteractive, oldInput })
+	}
+
+	t.Run("single_issue_needs_no_confirmation", func(t *testing.T) {
+		withTerminal(t, false, "")
+		if err := confirmDestructive(newCmd(), "remove label 'x' from 1 issues", 1); err != nil {
+			t.Errorf("err = %v, want nil", err)
+		}
+	})
+	t.Run("non_interactive_requires_yes", func(t *testing.T) {
+		withTerminal(t, false, "y\n")
+		err := confirmDestructive(newCmd(), "remove label 'x' from 2 issues", 2)
+		if err == nil || !strings.Contains(err.Error(), "--yes") {
+			t.Errorf("err = %v, want a refusal mentioning --yes", err)
+		}
+	})
+	t.Run("yes_skips_prompt", func(t *testing.T) {
+		withTerminal(t, false, "")
+		if err := confirmDestructive(newCmd("--yes"), "remove label 'x' from 2 issues", 2); err != nil {
+			t.Errorf("err = %v, want nil", err)
+		}
+	})
+	t.Run("json_is_non_interactive", func(t *testing.T) {
+		withTerminal(t, true, "y\n")
+		old := jsonOutput
+		jsonOutput = true
+		t.Cleanup(func() { jsonOutput = old })
+		if err := confirmDestructive(newCmd(), "remove label 'x' from 2 issues", 2); err == nil {
+			t.Error("want a refusal with --json and no --yes")
+		}
+	})
+	t.Run("prompt_answers", func(t *testing.T) {
+		for input, ok := range map[string]bool{"y\n": true, "YES\n": true, "n\n": false, "\n": false, "": false} {
+			withTerminal(t, true, input)
+			err := confirmDestructive(newCmd(), "remove 2 dependencies of bd-1", 2)
+			if ok != (err == nil) {
+				t.Errorf("answer %q: err = %v, want confirmed=%v", input, err, ok)
+			}
+		}
+	})
+}
+
+func TestReportNothingToRemove(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: "test"}
+		registerStrictFlag(cmd)
+		if err := cmd.ParseFlags(args); err != nil {
+			t.Fatalf("ParseFlags: %v", err)
+		}
+		return cmd
+	}
+	missing := []string{"no such label to remove: bd-1 does not have 'x'"}
+
+	if err := reportNothingToRemove(newCmd("--strict"), nil); err != nil {
+		t.Errorf("nothing missing: err = %v, want nil", err)
+	}
+	if err := reportNothingToRemove(newCmd(), missing); err != nil {
+		t.Errorf("without --strict: err = %v, want a warning only", err)
+	}
+	err := reportNothingToRemove(newCmd("--strict"), missing)
+	if err == nil || !strings.Contains(err.Error(), "no such label to remove") {
+		t.Errorf("with --strict: err = %v, want the missing label", err)
+	}
+}
+
+func TestMissingRemovals(t *testing.T) {
+	carried := map[string][]string{"bd-1": {"a", "b"}, "bd-2": {"b"}}
+	missing := missingLabelRemovals([]string{"bd-1", "bd-2"}, []string{"a", "b"}, carried)
+	if len(missing) != 1 || !strings.Contains(missing[0], "bd-2 does not have 'a'") {
+		t.Errorf("missingLabelRemovals = %v, want only bd-2/a", missing)
+	}
+
+	deps := []*types.Dependency{{IssueID: "bd-1", DependsOnID: "bd-2"}}
+	existing, missing := splitDepRemoveTargets("bd-1", deps, []string{"bd-2", "bd-3"})
+	if len(existing) != 1 || existing[0] != "bd-2" {
+		t.Errorf("existing = %v, want [bd-2]", existing)
+	}
+	if len(missing) != 1 || !strings.Contains(missing[0], "bd-1 does not depend on bd-3") {
+		t.Errorf("missing = %v, want bd-3", missing)
+	}
+}
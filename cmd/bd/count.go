@@ -22,8 +22,13 @@ var countCmd = &cobra.Command{
 By default, returns the total count of issues matching the filters.
 Use --by-* flags to group counts by different attributes.
 
+Like bd list, closed and pinned issues are left out unless --status selects
+them, so a bare bd count matches what bd list shows. --status all counts
+every issue; --closed-after and --closed-before count closed issues.
+
 Examples:
-  bd count                          # Count all issues
+  bd count                          # Count the issues bd list shows
+  bd count --status all             # Count all issues, closed included
   bd count --status open            # Count open issues
   bd count --by-status              # Group count by status
   bd count --by-priority            # Group count by priority
@@ -31,7 +36,7 @@ Examples:
   bd count --by-assignee            # Group count by assignee
   bd count --by-label               # Group count by label
   bd count --assignee alice --by-status  # Count alice's issues by status
-  bd count --include-infra          # Count issues + wisps tier (matches 'bd list --include-infra --all' cardinality)
+  bd count --include-infra          # Count issues + wisps tier (matches 'bd list --include-infra' cardinality)
 `,
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -53,11 +58,13 @@ Examples:
 		}
 
 		ctx := rootCtx
+		cfg, err := loadDirectListFilterConfig(ctx, store)
+		if err != nil {
+			return HandleError("%v", err)
+		}
+		status, _ := cmd.Flags().GetString("status")
+		applyCountDefaultStatus(&filter, status, cfg)
 		if includeInfra {
-			cfg, err := loadDirectListFilterConfig(ctx, store)
-			if err != nil {
-				return HandleError("%v", err)
-			}
 			applyCountIncludeInfra(&filter, issueType, cfg)
 		} else {
 			filter.SkipWisps = true
@@ -230,6 +237,14 @@ func parseCountFilter(cmd *cobra.Command) (types.IssueFilter, string, string, bo
 	if cmd.Flags().Changed("priority-max") {
 		filter.PriorityMax = &priorityMax
 	}
+	if filter.PriorityMin != nil && filter.PriorityMax != nil {
+		if err := priorityRangeError(priorityMin, priorityMax); err != nil {
+			return types.IssueFilter{}, "", "", false, HandleErrorRespectJSON("%v", err)
+		}
+	}
+	if err := filterTimeRangeError(filter); err != nil {
+		return types.IssueFilter{}, "", "", false, HandleErrorRespectJSON("%v", err)
+	}
 
 	includeInfra, _ := cmd.Flags().GetBool("include-infra")
 
@@ -298,11 +313,23 @@ func executeCount(ctx context.Context, backend countBackend, filter types.IssueF
 	return nil
 }
 
+// applyCountDefaultStatus gives bd count the default status filter of bd
+// list, so a bare bd count is the number of issues bd list shows. --status
+// (including --status all) replaces it, and so do --closed-after and
+// --closed-before, which only match closed issues.
+func applyCountDefaultStatus(filter *types.IssueFilter, status string, cfg listFilterConfig) {
+	if status != "" || filter.ClosedAfter != nil || filter.ClosedBefore != nil {
+		return
+	}
+	filter.ExcludeStatus = defaultExcludeStatuses(cfg)
+}
+
 // applyCountIncludeInfra switches the count filter to the wisps-inclusive
 // mode of `bd list --include-infra` (GH#4387). It mirrors the buildListFilter
 // defaults that determine list's cardinality so that, for any filter set,
 // `bd count --include-infra <filters>` returns exactly the number of rows
-// `bd list --include-infra <filters> --all` materializes:
+// `bd list --include-infra <filters>` materializes (the status defaults are
+// shared through applyCountDefaultStatus):
 //
 //   - the wisps table is merged into the count (SkipWisps=false), picking up
 //     no_history beads (durable work stored in the wisps tier) and ephemeral
@@ -349,12 +376,12 @@ func init() {
 	countCmd.Flags().String("notes-contains", "", "Filter by notes substring")
 
 	// Date ranges
-	countCmd.Flags().String("created-after", "", "Filter issues created after date (YYYY-MM-DD or RFC3339)")
-	countCmd.Flags().String("created-before", "", "Filter issues created before date (YYYY-MM-DD or RFC3339)")
-	countCmd.Flags().String("updated-after", "", "Filter issues updated after date (YYYY-MM-DD or RFC3339)")
-	countCmd.Flags().String("updated-before", "", "Filter issues updated before date (YYYY-MM-DD or RFC3339)")
-	countCmd.Flags().String("closed-after", "", "Filter issues closed after date (YYYY-MM-DD or RFC3339)")
-	countCmd.Flags().String("closed-before", "", "Filter issues closed before date (YYYY-MM-DD or RFC3339)")
+	countCmd.Flags().String("created-after", "", "Filter issues created after date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	countCmd.Flags().String("created-before", "", "Filter issues created before date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	countCmd.Flags().String("updated-after", "", "Filter issues updated after date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	countCmd.Flags().String("updated-before", "", "Filter issues updated before date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	countCmd.Flags().String("closed-after", "", "Filter issues closed after date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	countCmd.Flags().String("closed-before", "", "Filter issues closed before date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
 
 	// Empty/null checks
 	countCmd.Flags().Bool("empty-description", false, "Filter issues with empty description")
@@ -367,8 +394,8 @@ func init() {
 
 	// Wisps tier (GH#4387): mirrors bd list's flag of the same name so
 	// `bd count --include-infra <filters>` returns exactly the cardinality of
-	// `bd list --include-infra <filters> --all`.
-	countCmd.Flags().Bool("include-infra", false, "Include infrastructure beads and the wisps tier (matches 'bd list --include-infra --all' cardinality)")
+	// `bd list --include-infra <filters>`.
+	countCmd.Flags().Bool("include-infra", false, "Include infrastructure beads and the wisps tier (matches 'bd list --include-infra' cardinality)")
 
 	// Grouping flags
 	countCmd.Flags().Bool("by-status", false, "Group count by status")
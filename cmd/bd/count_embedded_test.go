@@ -94,14 +94,33 @@ func TestEmbeddedCount(t *testing.T) {
 
 	t.Run("basic_count_no_filters", func(t *testing.T) {
 		out := strings.TrimSpace(bdCount(t, bd, dir))
-		// Should return a number >= 8 (we created 8 issues)
+		// Should return a non-zero number (7 of the 8 issues are open)
 		if out == "0" {
 			t.Error("expected non-zero count")
 		}
 	})
 
+	t.Run("default_matches_list", func(t *testing.T) {
+		// Like bd list, a bare bd count leaves out the closed issue.
+		m := bdCountJSON(t, bd, dir)
+		count := int(m["count"].(float64))
+		if want := len(bdListJSON(t, bd, dir, "-n", "0")); count != want {
+			t.Errorf("bd count = %d, but bd list -n 0 returned %d issues", count, want)
+		}
+		if count != 7 {
+			t.Errorf("bd count = %d, want 7 (closed issue excluded)", count)
+		}
+	})
+
 	// ===== Status filter =====
 
+	t.Run("filter_by_status_all", func(t *testing.T) {
+		m := bdCountJSON(t, bd, dir, "--status", "all")
+		if count := int(m["count"].(float64)); count != 8 {
+			t.Errorf("bd count --status all = %d, want 8", count)
+		}
+	})
+
 	t.Run("filter_by_status_open", func(t *testing.T) {
 		m := bdCountJSON(t, bd, dir, "--status", "open")
 		count := int(m["count"].(float64))
@@ -131,7 +150,7 @@ func TestEmbeddedCount(t *testing.T) {
 	// ===== Assignee filter =====
 
 	t.Run("filter_by_assignee", func(t *testing.T) {
-		m := bdCountJSON(t, bd, dir, "--assignee", "alice")
+		m := bdCountJSON(t, bd, dir, "--status", "all", "--assignee", "alice")
 		count := int(m["count"].(float64))
 		if count < 3 {
 			t.Errorf("expected at least 3 issues assigned to alice, got %d", count)
@@ -204,7 +223,7 @@ func TestEmbeddedCount(t *testing.T) {
 
 	t.Run("filter_by_created_after", func(t *testing.T) {
 		// All issues were just created, so created-after yesterday should match all
-		m := bdCountJSON(t, bd, dir, "--created-after", "2000-01-01")
+		m := bdCountJSON(t, bd, dir, "--status", "all", "--created-after", "2000-01-01")
 		count := int(m["count"].(float64))
 		if count < 8 {
 			t.Errorf("expected at least 8 issues created after 2000-01-01, got %d", count)
@@ -220,8 +239,15 @@ func TestEmbeddedCount(t *testing.T) {
 		}
 	})
 
+	t.Run("filter_date_range_reversed", func(t *testing.T) {
+		out := bdCountFail(t, bd, dir, "--created-after", "2099-01-01", "--created-before", "2020-01-01")
+		if !strings.Contains(out, "is not earlier than --created-before") {
+			t.Errorf("expected the reversed range error, got: %s", out)
+		}
+	})
+
 	t.Run("filter_by_updated_after", func(t *testing.T) {
-		m := bdCountJSON(t, bd, dir, "--updated-after", "2000-01-01")
+		m := bdCountJSON(t, bd, dir, "--status", "all", "--updated-after", "2000-01-01")
 		count := int(m["count"].(float64))
 		if count < 8 {
 			t.Errorf("expected at least 8 issues updated after 2000-01-01, got %d", count)
@@ -277,10 +303,17 @@ func TestEmbeddedCount(t *testing.T) {
 		}
 	})
 
+	t.Run("filter_priority_range_reversed", func(t *testing.T) {
+		out := bdCountFail(t, bd, dir, "--priority-min", "2", "--priority-max", "1")
+		if !strings.Contains(out, "--priority-min 2 is greater than --priority-max 1") {
+			t.Errorf("expected the reversed range error, got: %s", out)
+		}
+	})
+
 	// ===== Group by status =====
 
 	t.Run("group_by_status", func(t *testing.T) {
-		m := bdCountJSON(t, bd, dir, "--by-status")
+		m := bdCountJSON(t, bd, dir, "--by-status", "--status", "all")
 		total := int(m["total"].(float64))
 		if total < 8 {
 			t.Errorf("expected total >= 8, got %d", total)
@@ -471,10 +504,10 @@ func TestEmbeddedCount(t *testing.T) {
 
 // TestEmbeddedCountIncludeInfra is the CLI-level guard for GH#4387:
 // `bd count --include-infra <filters>` must return exactly the cardinality of
-// `bd list --include-infra <filters> --all` (modulo list's --limit), including
-// the wisps tier (no_history + ephemeral beads) and honoring list's default
-// template exclusion. Without the flag, bd count keeps today's durable-only
-// semantics.
+// `bd list --include-infra <filters>` (modulo list's --limit), including the
+// wisps tier (no_history + ephemeral beads) and honoring list's default
+// template and status exclusions. Without the flag, bd count keeps today's
+// durable-only semantics.
 func TestEmbeddedCountIncludeInfra(t *testing.T) {
 	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
 		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
@@ -503,19 +536,19 @@ func TestEmbeddedCountIncludeInfra(t *testing.T) {
 	}
 	listCardinality := func(args ...string) int {
 		t.Helper()
-		fullArgs := append([]string{"--include-infra", "--all", "--limit", "0"}, args...)
+		fullArgs := append([]string{"--include-infra", "--limit", "0"}, args...)
 		return len(bdListJSON(t, bd, dir, fullArgs...))
 	}
 
 	t.Run("default_stays_durable_only", func(t *testing.T) {
-		if got := countOf("--type", "task"); got != 3 {
+		if got := countOf("--status", "all", "--type", "task"); got != 3 {
 			t.Errorf("bd count --type task = %d, want 3 (durable tasks only; default must stay byte-identical)", got)
 		}
 	})
 
 	t.Run("include_infra_counts_wisps_tier", func(t *testing.T) {
 		// 3 durable tasks + 2 no_history tasks + 1 ephemeral task.
-		if got := countOf("--include-infra", "--type", "task"); got != 6 {
+		if got := countOf("--include-infra", "--status", "all", "--type", "task"); got != 6 {
 			t.Errorf("bd count --include-infra --type task = %d, want 6", got)
 		}
 	})
@@ -527,19 +560,20 @@ func TestEmbeddedCountIncludeInfra(t *testing.T) {
 			{"--type", "bug"},
 			{"--status", "open"},
 			{"--status", "closed"},
+			{"--status", "all"},
 		} {
 			want := listCardinality(filters...)
 			got := countOf(append([]string{"--include-infra"}, filters...)...)
 			if got != want {
-				t.Errorf("bd count --include-infra %v = %d, but bd list --include-infra --all %v returned %d rows", filters, got, filters, want)
+				t.Errorf("bd count --include-infra %v = %d, but bd list --include-infra %v returned %d rows", filters, got, filters, want)
 			}
 		}
 	})
 
 	t.Run("include_infra_grouped_by_type", func(t *testing.T) {
-		m := bdCountJSON(t, bd, dir, "--include-infra", "--by-type")
+		m := bdCountJSON(t, bd, dir, "--include-infra", "--status", "all", "--by-type")
 		total := int(m["total"].(float64))
-		if want := listCardinality(); total != want {
+		if want := listCardinality("--all"); total != want {
 			t.Errorf("bd count --include-infra --by-type total = %d, want list cardinality %d", total, want)
 		}
 		groups, ok := m["groups"].([]interface{})
@@ -560,7 +594,7 @@ func TestEmbeddedCountIncludeInfra(t *testing.T) {
 	})
 
 	t.Run("grouped_without_flag_stays_durable_only", func(t *testing.T) {
-		m := bdCountJSON(t, bd, dir, "--by-type")
+		m := bdCountJSON(t, bd, dir, "--status", "all", "--by-type")
 		groups, ok := m["groups"].([]interface{})
 		if !ok {
 			t.Fatal("expected groups array")
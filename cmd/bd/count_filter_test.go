@@ -5,6 +5,7 @@ package main
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -51,9 +52,9 @@ func TestApplyCountIncludeInfraMirrorsListFilter(t *testing.T) {
 			if !reflect.DeepEqual(got.IssueType, want.IssueType) {
 				t.Errorf("IssueType = %v, list --include-infra --all uses %v", got.IssueType, want.IssueType)
 			}
-			// bd count defaults to all statuses and all pinned states, which is
-			// exactly what list's --all flag selects: none of these dimensions
-			// may carry a filter on either side.
+			// The status dimensions belong to applyCountDefaultStatus, so with
+			// no status default applied (bd count --status all) they must
+			// match list's --all selection: no filter on either side.
 			if !reflect.DeepEqual(got.Status, want.Status) {
 				t.Errorf("Status = %v, list --include-infra --all uses %v", got.Status, want.Status)
 			}
@@ -112,3 +113,38 @@ func ptrStr[T any](p *T) string {
 	}
 	return "&" + reflect.ValueOf(*p).String()
 }
+
+// TestApplyCountDefaultStatusMirrorsListFilter pins a bare bd count to the
+// default status filter of bd list, including custom done/frozen statuses,
+// and checks the flags that lift it.
+func TestApplyCountDefaultStatusMirrorsListFilter(t *testing.T) {
+	cfg := listFilterConfig{customStatuses: []types.CustomStatus{
+		{Name: "shipped", Category: types.CategoryDone},
+		{Name: "review", Category: types.CategoryActive},
+	}}
+	want, err := buildListFilter(listInput{}, cfg)
+	if err != nil {
+		t.Fatalf("buildListFilter: %v", err)
+	}
+
+	var got types.IssueFilter
+	applyCountDefaultStatus(&got, "", cfg)
+	if !reflect.DeepEqual(got.ExcludeStatus, want.ExcludeStatus) {
+		t.Errorf("ExcludeStatus = %v, bd list uses %v", got.ExcludeStatus, want.ExcludeStatus)
+	}
+
+	for _, status := range []string{"all", "closed", "open"} {
+		var f types.IssueFilter
+		applyCountDefaultStatus(&f, status, cfg)
+		if f.ExcludeStatus != nil {
+			t.Errorf("--status %s: ExcludeStatus = %v, want none", status, f.ExcludeStatus)
+		}
+	}
+
+	closedAfter := time.Now().Add(-24 * time.Hour)
+	closed := types.IssueFilter{ClosedAfter: &closedAfter}
+	applyCountDefaultStatus(&closed, "", cfg)
+	if closed.ExcludeStatus != nil {
+		t.Errorf("--closed-after: ExcludeStatus = %v, want none (closed issues only)", closed.ExcludeStatus)
+	}
+}
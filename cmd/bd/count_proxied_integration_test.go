@@ -69,19 +69,20 @@ func TestProxiedServerCount(t *testing.T) {
 		return int(v)
 	}
 
-	// listCount returns the cardinality of "list --all --limit 0 <filters>",
-	// the durable-only tier that "count" (without --include-infra) counts.
+	// listCount returns the cardinality of "list --limit 0 <filters>", the
+	// durable-only tier that "count" (without --include-infra) counts, with
+	// the same default status filter.
 	listCount := func(filters ...string) int {
-		return len(bdProxiedListJSON(t, bd, p, append([]string{"--all", "--limit", "0"}, filters...)...))
+		return len(bdProxiedListJSON(t, bd, p, append([]string{"--limit", "0"}, filters...)...))
 	}
 
 	// group names present in a "count --by-* --json" result.
-	groupNames := func(t *testing.T, byFlag string) map[string]int {
+	groupNames := func(t *testing.T, args ...string) map[string]int {
 		t.Helper()
-		m := countJSON(t, byFlag)
+		m := countJSON(t, args...)
 		groups, ok := m["groups"].([]interface{})
 		if !ok || len(groups) == 0 {
-			t.Fatalf("expected groups array for %s: %v", byFlag, m)
+			t.Fatalf("expected groups array for %v: %v", args, m)
 		}
 		names := make(map[string]int)
 		for _, g := range groups {
@@ -96,13 +97,23 @@ func TestProxiedServerCount(t *testing.T) {
 	t.Run("basic_count_no_filters", func(t *testing.T) {
 		got := countInt(t)
 		if want := listCount(); got != want {
-			t.Errorf("count = %d, want %d (list --all cardinality)", got, want)
+			t.Errorf("count = %d, want %d (list cardinality)", got, want)
 		}
 		if got == 0 {
 			t.Error("expected non-zero count")
 		}
 	})
 
+	t.Run("status_all_counts_closed", func(t *testing.T) {
+		got := countJSONInt(t, "--status", "all")
+		if want := listCount("--all"); got != want {
+			t.Errorf("count --status all = %d, want %d (list --all cardinality)", got, want)
+		}
+		if open := countJSONInt(t); got != open+1 {
+			t.Errorf("count --status all = %d, want default count %d plus the closed issue", got, open)
+		}
+	})
+
 	// ===== Status filter =====
 
 	t.Run("filter_by_status_open", func(t *testing.T) {
@@ -279,12 +290,12 @@ func TestProxiedServerCount(t *testing.T) {
 	// ===== Group by status =====
 
 	t.Run("group_by_status", func(t *testing.T) {
-		m := countJSON(t, "--by-status")
+		m := countJSON(t, "--by-status", "--status", "all")
 		total := int(m["total"].(float64))
-		if want := listCount(); total != want {
+		if want := listCount("--all"); total != want {
 			t.Errorf("by-status total = %d, want %d", total, want)
 		}
-		names := groupNames(t, "--by-status")
+		names := groupNames(t, "--by-status", "--status", "all")
 		// by-status buckets are mutually exclusive, so they sum to the total.
 		sum := 0
 		for _, c := range names {
@@ -436,8 +447,8 @@ func TestProxiedServerCount(t *testing.T) {
 
 // TestProxiedServerCountIncludeInfra is the proxied-server parity for GH#4387:
 // `bd count --include-infra <filters>` must return exactly the cardinality of
-// `bd list --include-infra <filters> --all`, including the wisps tier (no_history
-// + ephemeral beads). Without the flag, count keeps durable-only semantics.
+// `bd list --include-infra <filters>`, including the wisps tier (no_history +
+// ephemeral beads). Without the flag, count keeps durable-only semantics.
 func TestProxiedServerCountIncludeInfra(t *testing.T) {
 	requireSharedProxiedServer(t)
 	t.Parallel()
@@ -471,19 +482,19 @@ func TestProxiedServerCountIncludeInfra(t *testing.T) {
 		return int(m["count"].(float64))
 	}
 	listCardinality := func(filters ...string) int {
-		full := append([]string{"--include-infra", "--all", "--limit", "0"}, filters...)
+		full := append([]string{"--include-infra", "--limit", "0"}, filters...)
 		return len(bdProxiedListJSON(t, bd, p, full...))
 	}
 
 	t.Run("default_stays_durable_only", func(t *testing.T) {
-		if got := countOf(t, "--type", "task"); got != 3 {
+		if got := countOf(t, "--status", "all", "--type", "task"); got != 3 {
 			t.Errorf("count --type task = %d, want 3 (durable tasks only)", got)
 		}
 	})
 
 	t.Run("include_infra_counts_wisps_tier", func(t *testing.T) {
 		// 3 durable tasks + 2 no_history tasks + 1 ephemeral task.
-		if got := countOf(t, "--include-infra", "--type", "task"); got != 6 {
+		if got := countOf(t, "--include-infra", "--status", "all", "--type", "task"); got != 6 {
 			t.Errorf("count --include-infra --type task = %d, want 6", got)
 		}
 	})
@@ -495,17 +506,18 @@ func TestProxiedServerCountIncludeInfra(t *testing.T) {
 			{"--type", "bug"},
 			{"--status", "open"},
 			{"--status", "closed"},
+			{"--status", "all"},
 		} {
 			want := listCardinality(filters...)
 			got := countOf(t, append([]string{"--include-infra"}, filters...)...)
 			if got != want {
-				t.Errorf("count --include-infra %v = %d, but list --include-infra --all %v returned %d rows", filters, got, filters, want)
+				t.Errorf("count --include-infra %v = %d, but list --include-infra %v returned %d rows", filters, got, filters, want)
 			}
 		}
 	})
 
 	t.Run("include_infra_grouped_by_type", func(t *testing.T) {
-		out, err := bdProxiedRun(t, bd, p.dir, "count", "--json", "--include-infra", "--by-type")
+		out, err := bdProxiedRun(t, bd, p.dir, "count", "--json", "--include-infra", "--status", "all", "--by-type")
 		if err != nil {
 			t.Fatalf("count --include-infra --by-type: %v\n%s", err, out)
 		}
@@ -514,7 +526,7 @@ func TestProxiedServerCountIncludeInfra(t *testing.T) {
 			t.Fatalf("unmarshal: %v\n%s", err, out)
 		}
 		total := int(m["total"].(float64))
-		if want := listCardinality(); total != want {
+		if want := listCardinality("--all"); total != want {
 			t.Errorf("count --include-infra --by-type total = %d, want %d", total, want)
 		}
 		byType := make(map[string]int)
@@ -531,7 +543,7 @@ func TestProxiedServerCountIncludeInfra(t *testing.T) {
 	})
 
 	t.Run("grouped_without_flag_stays_durable_only", func(t *testing.T) {
-		out, err := bdProxiedRun(t, bd, p.dir, "count", "--json", "--by-type")
+		out, err := bdProxiedRun(t, bd, p.dir, "count", "--json", "--status", "all", "--by-type")
 		if err != nil {
 			t.Fatalf("count --by-type: %v\n%s", err, out)
 		}
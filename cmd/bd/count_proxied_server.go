@@ -18,11 +18,13 @@ func runCountProxiedServer(cmd *cobra.Command, ctx context.Context) error {
 	}
 	defer uw.Close(ctx)
 
+	cfg, err := loadProxiedListFilterConfig(ctx, uw)
+	if err != nil {
+		return HandleError("%v", err)
+	}
+	status, _ := cmd.Flags().GetString("status")
+	applyCountDefaultStatus(&filter, status, cfg)
 	if includeInfra {
-		cfg, err := loadProxiedListFilterConfig(ctx, uw)
-		if err != nil {
-			return HandleError("%v", err)
-		}
 		applyCountIncludeInfra(&filter, issueType, cfg)
 	} else {
 		filter.SkipWisps = true
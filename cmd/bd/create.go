@@ -20,7 +20,6 @@ import (
 	"github.com/steveyegge/beads/internal/routing"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/dolt"
-	"github.com/steveyegge/beads/internal/timeparsing"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/validation"
@@ -44,7 +43,16 @@ var createCmd = &cobra.Command{
 			}
 		}()
 
+		batchCommit, _ := cmd.Flags().GetBool("batch-commit")
+		var batchTitles []string
+		if batchCommit && len(args) > 1 {
+			batchTitles = args
+		}
+
 		if usesProxiedServer() {
+			if len(batchTitles) > 0 {
+				return HandleError("--batch-commit with multiple titles is not supported in proxied-server mode")
+			}
 			in, err := gatherCreateInput(cmd, args)
 			if err != nil {
 				return err
@@ -88,7 +96,20 @@ var createCmd = &cobra.Command{
 		titleFlag, _ := cmd.Flags().GetString("title")
 		var title string
 
-		if len(args) > 0 && titleFlag != "" {
+		if len(batchTitles) > 0 {
+			if titleFlag != "" {
+				return HandleError("cannot combine --title with multiple positional titles")
+			}
+			if err := validateBatchCreateFlags(cmd); err != nil {
+				return err
+			}
+			for _, t := range batchTitles {
+				if strings.HasPrefix(t, "-") {
+					return HandleError("title %q looks like a flag (starts with '-').\n  Run 'bd create --help' for available options.", t)
+				}
+			}
+			title = batchTitles[0]
+		} else if len(args) > 0 && titleFlag != "" {
 			if args[0] != titleFlag {
 				return HandleError("cannot specify different titles as both positional argument and --title flag\n  Positional: %q\n  --title:    %q", args[0], titleFlag)
 			}
@@ -121,6 +142,16 @@ var createCmd = &cobra.Command{
 			}
 		}
 
+		if store != nil && dupCheckEnabled(cmd) {
+			titles := batchTitles
+			if len(titles) == 0 {
+				titles = []string{title}
+			}
+			warnTitleDuplicates(rootCtx, func(ctx context.Context, filter types.IssueFilter) ([]*types.Issue, error) {
+				return store.SearchIssues(ctx, "", filter)
+			}, titles)
+		}
+
 		description, _, err := getDescriptionFlag(cmd)
 		if err != nil {
 			return err
@@ -156,13 +187,13 @@ var createCmd = &cobra.Command{
 		notes, _ := cmd.Flags().GetString("notes")
 		specID, _ := cmd.Flags().GetString("spec-id")
 
-		priorityStr, _ := cmd.Flags().GetString("priority")
+		priorityStr := createFlagOrDefault(cmd, "priority", "create.default_priority")
 		priority, err := validation.ValidatePriority(priorityStr)
 		if err != nil {
 			return HandleError("%v", err)
 		}
 
-		issueType, _ := cmd.Flags().GetString("type")
+		issueType := createFlagOrDefault(cmd, "type", "create.default_type")
 		assignee, _ := cmd.Flags().GetString("assignee")
 		statusFlag, _ := cmd.Flags().GetString("status")
 		if statusFlag != "" {
@@ -228,9 +259,9 @@ var createCmd = &cobra.Command{
 		var dueAt *time.Time
 		dueStr, _ := cmd.Flags().GetString("due")
 		if dueStr != "" {
-			t, err := timeparsing.ParseRelativeTime(dueStr, time.Now())
+			t, err := parseTimeFlag(dueStr)
 			if err != nil {
-				return HandleError("invalid --due format %q. Examples: +6h, tomorrow, next monday, 2025-01-15", dueStr)
+				return HandleError("invalid --due format %q. Examples: %s", dueStr, timeFlagExamples)
 			}
 			dueAt = &t
 		}
@@ -238,14 +269,14 @@ var createCmd = &cobra.Command{
 		var deferUntil *time.Time
 		deferStr, _ := cmd.Flags().GetString("defer")
 		if deferStr != "" {
-			t, err := timeparsing.ParseRelativeTime(deferStr, time.Now())
+			t, err := parseTimeFlag(deferStr)
 			if err != nil {
-				return HandleError("invalid --defer format %q. Examples: +1h, tomorrow, next monday, 2025-01-15", deferStr)
+				return HandleError("invalid --defer format %q. Examples: %s", deferStr, timeFlagExamples)
 			}
 			// Warn if defer date is in the past (user probably meant future)
 			if t.Before(time.Now()) && !silent && !debug.IsQuiet() {
 				fmt.Fprintf(os.Stderr, "%s Defer date %q is in the past. Issue will appear in bd ready immediately.\n",
-					ui.RenderWarn("!"), t.Format("2006-01-02 15:04"))
+					ui.RenderWarn("!"), t.Local().Format("2006-01-02 15:04"))
 				fmt.Fprintf(os.Stderr, "  Did you mean a future date? Use --defer=+1h or --defer=tomorrow\n")
 			}
 			deferUntil = &t
@@ -271,6 +302,12 @@ var createCmd = &cobra.Command{
 			}
 			metadata = json.RawMessage(metadataJSON)
 		}
+		if cmd.Flags().Changed("link") {
+			var err error
+			if metadata, err = metadataWithLinkFlag(cmd, metadata); err != nil {
+				return HandleError("%v", err)
+			}
+		}
 
 		validateTemplate, _ := cmd.Flags().GetBool("validate")
 		validationMode := config.GetString("validation.on-create")
@@ -292,9 +329,9 @@ var createCmd = &cobra.Command{
 
 		var estimatedMinutes *int
 		if cmd.Flags().Changed("estimate") {
-			est, _ := cmd.Flags().GetInt("estimate")
-			if est < 0 {
-				return HandleError("estimate must be a non-negative number of minutes")
+			est, err := parseEstimateFlag(cmd)
+			if err != nil {
+				return HandleError("%v", err)
 			}
 			estimatedMinutes = &est
 		}
@@ -525,6 +562,10 @@ var createCmd = &cobra.Command{
 
 		ctx := createCtx
 
+		if len(batchTitles) > 0 {
+			return createIssuesBatchCommit(ctx, issue, batchTitles, silent)
+		}
+
 		// Parse every requested dependency edge BEFORE creating anything so
 		// a malformed spec aborts with no orphan issue behind it.
 		depSpecs, err := parseDepSpecs(deps)
@@ -629,6 +670,7 @@ var createCmd = &cobra.Command{
 		}
 
 		if jsonOutput {
+			issue.NormalizeOrder()
 			if err := outputJSON(issue); err != nil {
 				return err
 			}
@@ -921,8 +963,10 @@ func init() {
 	createCmd.Flags().String("title", "", "Issue title (alternative to positional argument)")
 	createCmd.Flags().Bool("silent", false, "Output only the issue ID (for scripting)")
 	createCmd.Flags().Bool("dry-run", false, "Preview what would be created without actually creating")
+	createCmd.Flags().Bool("dup-check", false, "Warn when open issues have a similar title (default from create.dup_check)")
+	createCmd.Flags().Bool("batch-commit", false, "Create every positional title in one transaction with a single Dolt commit (all-or-nothing)")
 	registerPriorityFlag(createCmd, "2")
-	createCmd.Flags().StringP("type", "t", "task", "Issue type (bug|feature|task|epic|chore|decision|spike|story|milestone); custom types require types.custom config; aliases: enhancement/feat→feature, dec/adr→decision")
+	createCmd.Flags().StringP("type", "t", "task", "Issue type (bug|feature|task|epic|chore|decision|spike|story|milestone); custom types require types.custom config; aliases: enhancement/feat→feature, dec/adr→decision; default from create.default_type when set")
 	createCmd.Flags().StringP("status", "s", "", "Initial status")
 	registerCommonIssueFlags(createCmd)
 	createCmd.Flags().String("spec-id", "", "Link to specification document")
@@ -939,7 +983,7 @@ func init() {
 	createCmd.Flags().String("waits-for-gate", "all-children", "Gate type: all-children (wait for all) or any-children (wait for first)")
 	createCmd.Flags().Bool("force", false, "Force creation even if prefix doesn't match database prefix")
 	createCmd.Flags().String("repo", "", "Target repository for issue (overrides auto-routing)")
-	createCmd.Flags().IntP("estimate", "e", 0, "Time estimate in minutes (e.g., 60 for 1 hour)")
+	createCmd.Flags().StringP("estimate", "e", "", estimateFlagUsage)
 	createCmd.Flags().Bool("ephemeral", false, "Create as ephemeral (short-lived, subject to TTL compaction)")
 	createCmd.Flags().Bool("no-history", false, "Skip Dolt commit history without making GC-eligible (for permanent agent beads)")
 	createCmd.Flags().String("mol-type", "", "Molecule type: swarm (multi-agent), patrol (recurring ops), work (default)")
@@ -961,6 +1005,7 @@ func init() {
 	createCmd.Flags().String("due", "", "Due date/time. Formats: +6h, +1d, +2w, tomorrow, next monday, 2025-01-15")
 	createCmd.Flags().String("defer", "", "Defer until date (issue hidden from bd ready until then). Same formats as --due")
 	createCmd.Flags().String("metadata", "", "Set custom metadata (JSON string or @file.json to read from file)")
+	createCmd.Flags().StringArray("link", nil, "Cross-reference an issue in an external tracker by URL (repeatable; stored in metadata.external_links)")
 	// Note: --json flag is defined as a persistent flag in main.go, not here
 	rootCmd.AddCommand(createCmd)
 }
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// batchCreateRejectedFlags are create flags that only make sense for a single
+// issue (explicit ID, hierarchy, per-issue edges, routing, preview).
+var batchCreateRejectedFlags = []string{"id", "parent", "deps", "waits-for", "repo", "dry-run"}
+
+// validateBatchCreateFlags rejects flags --batch-commit cannot apply to every
+// title in the batch.
+func validateBatchCreateFlags(cmd *cobra.Command) error {
+	for _, name := range batchCreateRejectedFlags {
+		if cmd.Flags().Changed(name) {
+			return HandleError("--%s is not supported with --batch-commit and multiple titles", name)
+		}
+	}
+	return nil
+}
+
+// createIssuesBatchCommit creates one issue per title, each a copy of tmpl,
+// in a single transaction followed by a single Dolt commit. Either every
+// issue is created or none is.
+func createIssuesBatchCommit(ctx context.Context, tmpl *types.Issue, titles []string, silent bool) error {
+	issues := make([]*types.Issue, 0, len(titles))
+	for _, title := range titles {
+		issue := *tmpl
+		issue.Title = title
+		issue.Labels = append([]string(nil), tmpl.Labels...)
+		issues = append(issues, &issue)
+	}
+
+	if err := store.CreateIssues(ctx, issues, actor); err != nil {
+		return HandleErrorRespectJSON("creating issues (nothing was created): %v", err)
+	}
+
+	issueIDs := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		issueIDs = append(issueIDs, issue.ID)
+	}
+	if err := commitPendingIfEmbedded(ctx, store, actor, doltAutoCommitParams{
+		Command:         "create",
+		IssueIDs:        issueIDs,
+		MessageOverride: fmt.Sprintf("bd: create %d issue(s)", len(issues)),
+	}); err != nil {
+		WarnError("failed to commit: %v", err)
+	}
+	SetLastTouchedID(issueIDs[len(issueIDs)-1])
+
+	if jsonOutput {
+		return outputJSON(issues)
+	}
+	if silent {
+		for _, id := range issueIDs {
+			fmt.Println(id)
+		}
+		return nil
+	}
+	fmt.Printf("%s Created %d issues in one commit:\n", ui.RenderPass("✓"), len(issues))
+	for _, issue := range issues {
+		fmt.Printf("  %s: %s [P%d, %s]\n", issue.ID, issue.Title, issue.Priority, issue.IssueType)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+const (
+	// dupCheckThreshold is the title similarity at which an open issue is
+	// reported as a possible duplicate. Titles are short, so this sits above
+	// find-duplicates' title+description default.
+	dupCheckThreshold = 0.6
+	// dupCheckLimit caps how many candidates the warning lists.
+	dupCheckLimit = 5
+)
+
+// dupCandidate is an open issue whose title resembles a new issue's title.
+type dupCandidate struct {
+	Issue      *types.Issue
+	Similarity float64
+}
+
+// dupCheckEnabled reports whether bd create should look for near-duplicate
+// titles: --dup-check when given, otherwise create.dup_check.
+func dupCheckEnabled(cmd *cobra.Command) bool {
+	if cmd.Flags().Changed("dup-check") {
+		enabled, _ := cmd.Flags().GetBool("dup-check")
+		return enabled
+	}
+	return config.GetBool("create.dup_check")
+}
+
+// dupCheckFilter selects the issues a new title is compared against.
+func dupCheckFilter() types.IssueFilter {
+	return types.IssueFilter{ExcludeStatus: []types.Status{types.StatusClosed}}
+}
+
+// titleTokens normalizes a title for comparison. Unlike tokenize on its own,
+// hyphenated words are split so "login-redirect" matches "login redirect".
+func titleTokens(title string) map[string]int {
+	return tokenize(strings.ReplaceAll(title, "-", " "))
+}
+
+// findTitleDuplicates scores the titles of issues against title with the
+// same token similarity as find-duplicates and returns the closest matches,
+// most similar first.
+func findTitleDuplicates(title string, issues []*types.Issue) []dupCandidate {
+	want := titleTokens(title)
+	if len(want) == 0 {
+		return nil
+	}
+	var candidates []dupCandidate
+	for _, issue := range issues {
+		if issue.Status == types.StatusClosed {
+			continue
+		}
+		got := titleTokens(issue.Title)
+		similarity := (jaccardSimilarity(want, got) + cosineSimilarity(want, got)) / 2
+		if similarity >= dupCheckThreshold {
+			candidates = append(candidates, dupCandidate{Issue: issue, Similarity: similarity})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Similarity > candidates[j].Similarity
+	})
+	if len(candidates) > dupCheckLimit {
+		candidates = candidates[:dupCheckLimit]
+	}
+	return candidates
+}
+
+// warnTitleDuplicates searches open issues and prints a stderr warning for
+// each title that resembles an existing one. The check is advisory: search
+// failures are reported and creation continues.
+func warnTitleDuplicates(ctx context.Context, search func(context.Context, types.IssueFilter) ([]*types.Issue, error), titles []string) {
+	issues, err := search(ctx, dupCheckFilter())
+	if err != nil {
+		WarnError("duplicate check failed: %v", err)
+		return
+	}
+	for _, title := range titles {
+		candidates := findTitleDuplicates(title, issues)
+		if len(candidates) == 0 {
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "%s %q looks similar to %d open issue(s):\n", ui.RenderWarn("⚠"), title, len(candidates))
+		for _, c := range candidates {
+			fmt.Fprintf(os.Stderr, "  %s: %s (%.0f%% similar)\n", c.Issue.ID, c.Issue.Title, c.Similarity*100)
+		}
+	}
+}
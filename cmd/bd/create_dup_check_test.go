@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestFindTitleDuplicates(t *testing.T) {
+	issues := []*types.Issue{
+		{ID: "bd-1", Title: "Fix login redirect bug", Status: types.StatusOpen},
+		{ID: "bd-2", Title: "Add dark mode support", Status: types.StatusOpen},
+		{ID: "bd-3", Title: "Fix login redirect bug!", Status: types.StatusClosed},
+		{ID: "bd-4", Title: "Login redirect bug", Status: types.StatusInProgress},
+	}
+
+	got := findTitleDuplicates("fix the Login-redirect bug", issues)
+	if len(got) == 0 {
+		t.Fatal("expected near-duplicate candidates, got none")
+	}
+	if got[0].Issue.ID != "bd-1" {
+		t.Errorf("closest candidate = %s, want bd-1", got[0].Issue.ID)
+	}
+	for _, c := range got {
+		if c.Issue.ID == "bd-2" || c.Issue.ID == "bd-3" {
+			t.Errorf("unexpected candidate %s (similarity %.2f)", c.Issue.ID, c.Similarity)
+		}
+	}
+
+	if got := findTitleDuplicates("Add dark mode support", issues[:1]); len(got) != 0 {
+		t.Errorf("unrelated title matched: %+v", got)
+	}
+	if got := findTitleDuplicates("!!", issues); got != nil {
+		t.Errorf("title without tokens matched: %+v", got)
+	}
+}
+
+func TestFindTitleDuplicatesLimit(t *testing.T) {
+	var issues []*types.Issue
+	for i := 0; i < dupCheckLimit+3; i++ {
+		issues = append(issues, &types.Issue{ID: "bd-x", Title: "Flaky sync test", Status: types.StatusOpen})
+	}
+	if got := findTitleDuplicates("Flaky sync test", issues); len(got) != dupCheckLimit {
+		t.Errorf("got %d candidates, want %d", len(got), dupCheckLimit)
+	}
+}
@@ -262,6 +262,67 @@ func TestEmbeddedCreate(t *testing.T) {
 		}
 	})
 
+	t.Run("configured_defaults", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "cd")
+		for _, kv := range [][2]string{{"create.default_priority", "P1"}, {"create.default_type", "bug"}} {
+			cmd := exec.Command(bd, "config", "set", kv[0], kv[1])
+			cmd.Dir = dir
+			cmd.Env = bdEnv(dir)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("bd config set %s failed: %v\n%s", kv[0], err, out)
+			}
+		}
+
+		issue := bdCreate(t, bd, dir, "Uses configured defaults")
+		if issue.Priority != 1 || issue.IssueType != types.TypeBug {
+			t.Errorf("no flags: got P%d %q, want P1 bug", issue.Priority, issue.IssueType)
+		}
+		explicit := bdCreate(t, bd, dir, "Explicit flags win", "-p", "3", "-t", "task")
+		if explicit.Priority != 3 || explicit.IssueType != types.TypeTask {
+			t.Errorf("explicit flags: got P%d %q, want P3 task", explicit.Priority, explicit.IssueType)
+		}
+
+		for _, kv := range [][2]string{{"create.default_priority", "high"}, {"create.default_type", "nonsense"}} {
+			cmd := exec.Command(bd, "config", "set", kv[0], kv[1])
+			cmd.Dir = dir
+			cmd.Env = bdEnv(dir)
+			if out, err := cmd.CombinedOutput(); err == nil {
+				t.Errorf("bd config set %s %s should fail:\n%s", kv[0], kv[1], out)
+			}
+		}
+	})
+
+	t.Run("dup_check", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "cq")
+		existing := bdCreate(t, bd, dir, "Fix login redirect bug")
+		bdCreate(t, bd, dir, "Add dark mode support")
+
+		cmd := exec.Command(bd, "create", "--dup-check", "--silent", "Fix the login-redirect bug")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd create --dup-check failed: %v\nstdout:\n%s\nstderr:\n%s", err, stdout.String(), stderr.String())
+		}
+		if !strings.Contains(stderr.String(), existing.ID) {
+			t.Errorf("expected duplicate candidate %s in warning, got stderr:\n%s", existing.ID, stderr.String())
+		}
+		if strings.TrimSpace(stdout.String()) == "" {
+			t.Errorf("--dup-check should warn, not block creation")
+		}
+
+		cmd = exec.Command(bd, "create", "--silent", "Fix login redirect bug again")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		_, stderr, err = runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd create failed: %v\n%s", err, stderr.String())
+		}
+		if strings.Contains(stderr.String(), existing.ID) {
+			t.Errorf("dup check should be off by default, got stderr:\n%s", stderr.String())
+		}
+	})
+
 	t.Run("description", func(t *testing.T) {
 		dir, _, _ := bdInit(t, bd, "--prefix", "ds")
 		issue := bdCreate(t, bd, dir, "Desc issue", "-d", "This is the description")
@@ -574,6 +635,25 @@ func TestEmbeddedCreate(t *testing.T) {
 		}
 	})
 
+	t.Run("estimate_workday_hours", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "ew")
+		issue := bdCreate(t, bd, dir, "Default workday", "-e", "1d")
+		if issue.EstimatedMinutes == nil || *issue.EstimatedMinutes != 480 {
+			t.Errorf("1d with default workday: got %v, want 480", issue.EstimatedMinutes)
+		}
+
+		cmd := exec.Command(bd, "config", "set", "estimate.workday_hours", "6")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("bd config set estimate.workday_hours failed: %v\n%s", err, out)
+		}
+		issue = bdCreate(t, bd, dir, "Short workday", "-e", "1d")
+		if issue.EstimatedMinutes == nil || *issue.EstimatedMinutes != 360 {
+			t.Errorf("1d with 6h workday: got %v, want 360", issue.EstimatedMinutes)
+		}
+	})
+
 	t.Run("notes", func(t *testing.T) {
 		dir, _, _ := bdInit(t, bd, "--prefix", "nt")
 		issue := bdCreate(t, bd, dir, "Notes issue", "--notes", "Some notes here")
@@ -966,6 +1046,57 @@ func TestEmbeddedCreateCommitPending(t *testing.T) {
 	})
 }
 
+func TestEmbeddedCreateBatchCommit(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt create tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+	dir, beadsDir, _ := bdInit(t, bd, "--prefix", "bc")
+
+	countCommits := func() int {
+		db, cleanup, err := embeddeddolt.OpenSQL(t.Context(), filepath.Join(beadsDir, "embeddeddolt"), "bc", "main")
+		if err != nil {
+			t.Fatalf("OpenSQL: %v", err)
+		}
+		defer cleanup()
+		var count int
+		if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM dolt_log").Scan(&count); err != nil {
+			t.Fatalf("query dolt_log: %v", err)
+		}
+		return count
+	}
+
+	t.Run("one_commit_for_all_titles", func(t *testing.T) {
+		before := countCommits()
+		out := bdCreateSilent(t, bd, dir, "--batch-commit", "Batch one", "Batch two", "Batch three", "-l", "bulk", "-p", "1")
+		ids := strings.Fields(out)
+		if len(ids) != 3 {
+			t.Fatalf("expected 3 IDs, got %q", out)
+		}
+		if got := countCommits() - before; got != 1 {
+			t.Errorf("batch create made %d commits, want 1", got)
+		}
+		for i, id := range ids {
+			issue := bdShow(t, bd, dir, id)
+			if want := []string{"Batch one", "Batch two", "Batch three"}[i]; issue.Title != want {
+				t.Errorf("%s title = %q, want %q", id, issue.Title, want)
+			}
+			if issue.Priority != 1 || len(issue.Labels) != 1 || issue.Labels[0] != "bulk" {
+				t.Errorf("%s did not inherit shared flags: priority=%d labels=%v", id, issue.Priority, issue.Labels)
+			}
+		}
+	})
+
+	t.Run("rejects_single_issue_flags", func(t *testing.T) {
+		out := bdCreateFail(t, bd, dir, "--batch-commit", "A", "B", "--parent", "bc-1")
+		if !strings.Contains(out, "--parent is not supported with --batch-commit") {
+			t.Errorf("unexpected error: %s", out)
+		}
+	})
+}
+
 func TestEmbeddedCreateFormCommitsLabelOnlyCreate(t *testing.T) {
 	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
 		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt create tests")
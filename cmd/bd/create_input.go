@@ -10,7 +10,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/debug"
-	"github.com/steveyegge/beads/internal/timeparsing"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/validation"
@@ -39,6 +38,7 @@ type createInput struct {
 	waitsFor           string
 	waitsForGate       string
 	silent             bool
+	dupCheck           bool
 	dryRun             bool
 	force              bool
 	validate           bool
@@ -94,6 +94,7 @@ func gatherCreateInput(cmd *cobra.Command, args []string) (createInput, error) {
 	}
 
 	in.silent, _ = cmd.Flags().GetBool("silent")
+	in.dupCheck = dupCheckEnabled(cmd)
 	in.force, _ = cmd.Flags().GetBool("force")
 	in.validate, _ = cmd.Flags().GetBool("validate")
 	in.noInheritLabels, _ = cmd.Flags().GetBool("no-inherit-labels")
@@ -149,14 +150,14 @@ func gatherCreateInput(cmd *cobra.Command, args []string) (createInput, error) {
 		}
 	}
 
-	priorityStr, _ := cmd.Flags().GetString("priority")
+	priorityStr := createFlagOrDefault(cmd, "priority", "create.default_priority")
 	priority, err := validation.ValidatePriority(priorityStr)
 	if err != nil {
 		return in, HandleError("%v", err)
 	}
 	in.priority = priority
 
-	in.issueType, _ = cmd.Flags().GetString("type")
+	in.issueType = createFlagOrDefault(cmd, "type", "create.default_type")
 	in.status, _ = cmd.Flags().GetString("status")
 	in.assignee, _ = cmd.Flags().GetString("assignee")
 	in.externalRef, _ = cmd.Flags().GetString("external-ref")
@@ -203,21 +204,21 @@ func gatherCreateInput(cmd *cobra.Command, args []string) (createInput, error) {
 	}
 
 	if dueStr, _ := cmd.Flags().GetString("due"); dueStr != "" {
-		t, err := timeparsing.ParseRelativeTime(dueStr, time.Now())
+		t, err := parseTimeFlag(dueStr)
 		if err != nil {
-			return in, HandleError("invalid --due format %q. Examples: +6h, tomorrow, next monday, 2025-01-15", dueStr)
+			return in, HandleError("invalid --due format %q. Examples: %s", dueStr, timeFlagExamples)
 		}
 		in.dueAt = &t
 	}
 
 	if deferStr, _ := cmd.Flags().GetString("defer"); deferStr != "" {
-		t, err := timeparsing.ParseRelativeTime(deferStr, time.Now())
+		t, err := parseTimeFlag(deferStr)
 		if err != nil {
-			return in, HandleError("invalid --defer format %q. Examples: +1h, tomorrow, next monday, 2025-01-15", deferStr)
+			return in, HandleError("invalid --defer format %q. Examples: %s", deferStr, timeFlagExamples)
 		}
 		if t.Before(time.Now()) && !in.silent && !debug.IsQuiet() {
 			fmt.Fprintf(os.Stderr, "%s Defer date %q is in the past. Issue will appear in bd ready immediately.\n",
-				ui.RenderWarn("!"), t.Format("2006-01-02 15:04"))
+				ui.RenderWarn("!"), t.Local().Format("2006-01-02 15:04"))
 			fmt.Fprintf(os.Stderr, "  Did you mean a future date? Use --defer=+1h or --defer=tomorrow\n")
 		}
 		in.deferUntil = &t
@@ -243,11 +244,19 @@ func gatherCreateInput(cmd *cobra.Command, args []string) (createInput, error) {
 		in.metadata = json.RawMessage(metadataJSON)
 		in.metadataSet = true
 	}
+	if cmd.Flags().Changed("link") {
+		metadata, err := metadataWithLinkFlag(cmd, in.metadata)
+		if err != nil {
+			return in, HandleError("%v", err)
+		}
+		in.metadata = metadata
+		in.metadataSet = true
+	}
 
 	if cmd.Flags().Changed("estimate") {
-		est, _ := cmd.Flags().GetInt("estimate")
-		if est < 0 {
-			return in, HandleError("estimate must be a non-negative number of minutes")
+		est, err := parseEstimateFlag(cmd)
+		if err != nil {
+			return in, HandleError("%v", err)
 		}
 		in.estimatedMinutes = &est
 	}
@@ -276,7 +285,7 @@ var singleIssueOnlyFlags = []string{
 	"labels", "label", "skills", "context",
 	"event-category", "event-actor", "event-target", "event-payload",
 	"due", "defer",
-	"metadata", "estimate", "force", "wisp-type",
+	"metadata", "link", "estimate", "force", "wisp-type",
 }
 
 func rejectSingleIssueFlagsForMarkdown(cmd *cobra.Command) error {
@@ -322,3 +331,16 @@ func resolveTitle(args []string, titleFlag, markdownFile, graphFile string) (str
 		return "", HandleError("title required (or use --file to create from markdown)")
 	}
 }
+
+// createFlagOrDefault returns the value of a create flag, falling back to the
+// configured default (create.default_priority, create.default_type) when the
+// flag was not given on the command line.
+func createFlagOrDefault(cmd *cobra.Command, flag, configKey string) string {
+	value, _ := cmd.Flags().GetString(flag)
+	if !cmd.Flags().Changed(flag) {
+		if def := strings.TrimSpace(config.GetString(configKey)); def != "" {
+			return def
+		}
+	}
+	return value
+}
@@ -61,6 +61,10 @@ func runCreateProxiedSingle(_ *cobra.Command, ctx context.Context, in createInpu
 		return HandleError("proxied-server UOW provider not initialized")
 	}
 
+	if in.dupCheck {
+		warnTitleDuplicates(ctx, searchIssuesForDupCheckProxied, []string{in.title})
+	}
+
 	if in.dryRun {
 		previewLabels := in.labels
 		if in.parentID != "" {
@@ -160,6 +164,7 @@ func runCreateProxiedSingle(_ *cobra.Command, ctx context.Context, in createInpu
 
 	switch {
 	case in.jsonOutput:
+		res.NormalizeOrder()
 		if err := outputJSON(res); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		}
@@ -173,6 +178,21 @@ func runCreateProxiedSingle(_ *cobra.Command, ctx context.Context, in createInpu
 	return nil
 }
 
+// searchIssuesForDupCheckProxied runs the --dup-check search in its own
+// read-only unit of work, outside the create transaction.
+func searchIssuesForDupCheckProxied(ctx context.Context, filter types.IssueFilter) ([]*types.Issue, error) {
+	uw, err := openProxiedListUOW(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer uw.Close(ctx)
+	page, err := uw.IssueUseCase().SearchIssues(ctx, "", filter)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
 func runCreateLintIssue(in createInput) error {
 	if in.validationMode != "error" && in.validationMode != "warn" {
 		return nil
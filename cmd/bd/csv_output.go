@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/uow"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// csvDefaultColumns are the --format csv columns when --columns is not given.
+var csvDefaultColumns = []string{"id", "title", "status", "priority", "assignee", "due_at"}
+
+// csvColumns renders each --columns value from an issue. Multi-valued
+// fields are joined with the --csv-sep separator.
+var csvColumns = map[string]func(issue *types.Issue, labels []string, sep string) string{
+	"id":          func(i *types.Issue, _ []string, _ string) string { return i.ID },
+	"title":       func(i *types.Issue, _ []string, _ string) string { return i.Title },
+	"description": func(i *types.Issue, _ []string, _ string) string { return i.Description },
+	"status":      func(i *types.Issue, _ []string, _ string) string { return string(i.Status) },
+	"priority":    func(i *types.Issue, _ []string, _ string) string { return strconv.Itoa(i.Priority) },
+	"issue_type":  func(i *types.Issue, _ []string, _ string) string { return string(i.IssueType) },
+	"assignee":    func(i *types.Issue, _ []string, _ string) string { return i.Assignee },
+	"owner":       func(i *types.Issue, _ []string, _ string) string { return i.Owner },
+	"created_by":  func(i *types.Issue, _ []string, _ string) string { return i.CreatedBy },
+	"labels":      func(_ *types.Issue, labels []string, sep string) string { return strings.Join(labels, sep) },
+	"external_ref": func(i *types.Issue, _ []string, _ string) string {
+		if i.ExternalRef == nil {
+			return ""
+		}
+		return *i.ExternalRef
+	},
+	"estimated_minutes": func(i *types.Issue, _ []string, _ string) string {
+		if i.EstimatedMinutes == nil {
+			return ""
+		}
+		return strconv.Itoa(*i.EstimatedMinutes)
+	},
+	"close_reason": func(i *types.Issue, _ []string, _ string) string { return i.CloseReason },
+	"created_at":   func(i *types.Issue, _ []string, _ string) string { return csvTime(i.CreatedAt) },
+	"updated_at":   func(i *types.Issue, _ []string, _ string) string { return csvTime(i.UpdatedAt) },
+	"closed_at":    func(i *types.Issue, _ []string, _ string) string { return csvTimePtr(i.ClosedAt) },
+	"due_at":       func(i *types.Issue, _ []string, _ string) string { return csvTimePtr(i.DueAt) },
+	"defer_until":  func(i *types.Issue, _ []string, _ string) string { return csvTimePtr(i.DeferUntil) },
+}
+
+func csvTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func csvTimePtr(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return csvTime(*t)
+}
+
+// csvOptions is the validated --columns/--csv-sep flag set of --format csv.
+type csvOptions struct {
+	columns []string
+	sep     string
+}
+
+// registerCSVFlags adds the --format csv column flags to cmd.
+func registerCSVFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("columns", nil, "With --format csv, the columns to emit (default: "+strings.Join(csvDefaultColumns, ",")+")")
+	cmd.Flags().String("csv-sep", ";", "With --format csv, the separator joining multi-valued fields such as labels")
+}
+
+// readCSVOptions returns the CSV options when format is csv, and nil
+// otherwise. The column flags are rejected without --format csv.
+func readCSVOptions(cmd *cobra.Command, format string) (*csvOptions, error) {
+	if !strings.EqualFold(format, "csv") {
+		for _, name := range []string{"columns", "csv-sep"} {
+			if cmd.Flags().Changed(name) {
+				return nil, fmt.Errorf("--%s requires --format csv", name)
+			}
+		}
+		return nil, nil
+	}
+	if jsonOutput {
+		return nil, fmt.Errorf("--format csv cannot be combined with --json")
+	}
+	opts := &csvOptions{columns: csvDefaultColumns}
+	opts.sep, _ = cmd.Flags().GetString("csv-sep")
+	if raw, _ := cmd.Flags().GetStringSlice("columns"); len(raw) > 0 {
+		columns, err := parseCSVColumns(raw)
+		if err != nil {
+			return nil, err
+		}
+		opts.columns = columns
+	}
+	return opts, nil
+}
+
+// parseCSVColumns validates --columns values, dropping blanks and duplicates
+// while keeping the caller's order. "type" is accepted for issue_type.
+func parseCSVColumns(raw []string) ([]string, error) {
+	var columns []string
+	for _, column := range raw {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if column == "type" {
+			column = "issue_type"
+		}
+		if column == "" || slices.Contains(columns, column) {
+			continue
+		}
+		if _, ok := csvColumns[column]; !ok {
+			return nil, fmt.Errorf("unknown --columns value %q (valid: %s)", column, strings.Join(csvColumnNames(), ", "))
+		}
+		columns = append(columns, column)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("--columns needs at least one column")
+	}
+	return columns, nil
+}
+
+func csvColumnNames() []string {
+	names := make([]string, 0, len(csvColumns))
+	for name := range csvColumns {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// labelsLookup returns the labels of each of ids that has any.
+type labelsLookup func(ctx context.Context, ids []string) (map[string][]string, error)
+
+// proxiedLabelsLookup reads labels through the unit of work, from the wisp
+// label table for the ephemeral ones among issues.
+func proxiedLabelsLookup(uw uow.UnitOfWork, issues []*types.Issue) labelsLookup {
+	return func(ctx context.Context, _ []string) (map[string][]string, error) {
+		var permIDs, wispIDs []string
+		for _, issue := range issues {
+			if issue.Ephemeral {
+				wispIDs = append(wispIDs, issue.ID)
+			} else {
+				permIDs = append(permIDs, issue.ID)
+			}
+		}
+		labels := make(map[string][]string)
+		if len(permIDs) > 0 {
+			byIssue, err := uw.LabelUseCase().GetLabelsForIssues(ctx, permIDs)
+			if err != nil {
+				return nil, err
+			}
+			maps.Copy(labels, byIssue)
+		}
+		if len(wispIDs) > 0 {
+			byWisp, err := uw.LabelUseCase().GetLabelsForWisps(ctx, wispIDs)
+			if err != nil {
+				return nil, err
+			}
+			maps.Copy(labels, byWisp)
+		}
+		return labels, nil
+	}
+}
+
+// outputIssuesCSV writes issues to stdout as CSV, loading labels through
+// lookup when the labels column is requested.
+func outputIssuesCSV(ctx context.Context, issues []*types.Issue, opts *csvOptions, lookup labelsLookup) error {
+	var labels map[string][]string
+	if slices.Contains(opts.columns, "labels") && len(issues) > 0 {
+		ids := make([]string, len(issues))
+		for i, issue := range issues {
+			ids[i] = issue.ID
+		}
+		var err error
+		if labels, err = lookup(ctx, ids); err != nil {
+			return fmt.Errorf("loading labels: %w", err)
+		}
+	}
+	return writeIssuesCSV(os.Stdout, issues, opts, labels)
+}
+
+// writeIssuesCSV writes a header row and one row per issue. Values with
+// commas, quotes or newlines are quoted per RFC 4180. Labels come from
+// labels when it has an entry for the issue, else from the issue itself.
+func writeIssuesCSV(w io.Writer, issues []*types.Issue, opts *csvOptions, labels map[string][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(opts.columns); err != nil {
+		return err
+	}
+	row := make([]string, len(opts.columns))
+	for _, issue := range issues {
+		issueLabels, ok := labels[issue.ID]
+		if !ok {
+			issueLabels = issue.Labels
+		}
+		issueLabels = slices.Sorted(slices.Values(issueLabels))
+		for i, column := range opts.columns {
+			row[i] = csvColumns[column](issue, issueLabels, opts.sep)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestWriteIssuesCSV(t *testing.T) {
+	due := time.Date(2026, 7, 1, 17, 0, 0, 0, time.FixedZone("CEST", 2*3600))
+	issues := []*types.Issue{
+		{ID: "bd-1", Title: `Say "hi", then
+leave`, Status: types.StatusOpen, Priority: 0, Assignee: "alice", DueAt: &due, Labels: []string{"ui", "backend"}},
+		{ID: "bd-2", Title: "Plain", Status: types.StatusInProgress, Priority: 3},
+	}
+	opts := &csvOptions{columns: []string{"id", "title", "priority", "labels", "due_at"}, sep: ";"}
+
+	var buf bytes.Buffer
+	labels := map[string][]string{"bd-2": {"ops"}}
+	if err := writeIssuesCSV(&buf, issues, opts, labels); err != nil {
+		t.Fatalf("writeIssuesCSV: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"Say ""hi"", then`) {
+		t.Errorf("title with quotes, comma and newline should be RFC 4180 quoted:\n%s", buf.String())
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+	want := [][]string{
+		{"id", "title", "priority", "labels", "due_at"},
+		{"bd-1", "Say \"hi\", then\nleave", "0", "backend;ui", "2026-07-01T15:00:00Z"},
+		{"bd-2", "Plain", "3", "ops", ""},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("got %d records, want %d: %q", len(records), len(want), records)
+	}
+	for i := range want {
+		if !slices.Equal(records[i], want[i]) {
+			t.Errorf("record %d = %q, want %q", i, records[i], want[i])
+		}
+	}
+}
+
+func TestParseCSVColumns(t *testing.T) {
+	got, err := parseCSVColumns([]string{" ID", "type", "", "id", "labels"})
+	if err != nil {
+		t.Fatalf("parseCSVColumns: %v", err)
+	}
+	if !slices.Equal(got, []string{"id", "issue_type", "labels"}) {
+		t.Errorf("columns = %v, want [id issue_type labels]", got)
+	}
+	if _, err := parseCSVColumns([]string{"id", "nope"}); err == nil || !strings.Contains(err.Error(), `"nope"`) {
+		t.Errorf("unknown column: err = %v", err)
+	}
+	if _, err := parseCSVColumns([]string{" "}); err == nil {
+		t.Error("blank --columns should be rejected")
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -8,7 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/metrics"
-	"github.com/steveyegge/beads/internal/timeparsing"
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/utils"
@@ -25,10 +26,24 @@ dependency keeping them from being worked. Unlike closed issues, they will
 be revisited.
 
 Deferred issues don't show in 'bd ready' but remain visible in 'bd list'.
+Once the --until date passes, the issue comes back on its own: 'bd ready'
+and 'bd list --status open' count it as open. Its stored status changes only
+when the issue itself is written: 'bd undefer', 'bd update' and a claim
+reopen it. A recurring deferral (--every) stays deferred until 'bd undefer'
+moves it on. A date in the past is rejected.
+
+--until takes relative expressions (+2d, tomorrow, "next monday") as well as
+absolute dates, and stores the resulting time in UTC.
+
+--every makes the deferral recurring, for reviews that come back on a
+schedule: each 'bd undefer' moves the issue to its next occurrence instead
+of reopening it, and 'bd undefer --end-recurrence' stops the cycle. Without
+--until the first occurrence is one interval from now.
 
 Examples:
   bd defer bd-abc                  # Defer a single issue (status-based)
   bd defer bd-abc --until=tomorrow # Defer until specific time
+  bd defer bd-abc --until="next monday" --every week  # Weekly review
   bd defer bd-abc --reason="waiting on API access"
   bd defer bd-abc bd-def           # Defer multiple issues`,
 	Args:          cobra.MinimumNArgs(1),
@@ -45,17 +60,31 @@ Examples:
 		var deferUntil *time.Time
 		untilStr, _ := cmd.Flags().GetString("until")
 		if untilStr != "" {
-			t, err := timeparsing.ParseRelativeTime(untilStr, time.Now())
+			t, err := parseTimeFlag(untilStr)
 			if err != nil {
-				return HandleError("invalid --until format %q. Examples: +1h, tomorrow, next monday, 2025-01-15", untilStr)
+				return HandleError("invalid --until format %q. Examples: %s", untilStr, timeFlagExamples)
 			}
-			if t.Before(time.Now()) && !jsonOutput {
-				fmt.Fprintf(os.Stderr, "%s Defer date %q is in the past. Issue will appear in bd ready immediately.\n",
-					ui.RenderWarn("!"), t.Format("2006-01-02 15:04"))
-				fmt.Fprintf(os.Stderr, "  Did you mean a future date? Use --until=+1h or --until=tomorrow\n")
+			if err := checkDeferDate(t); err != nil {
+				return HandleError("%v", err)
 			}
 			deferUntil = &t
 		}
+		var every string
+		if cmd.Flags().Changed("every") {
+			everyStr, _ := cmd.Flags().GetString("every")
+			e, err := parseDeferEvery(everyStr)
+			if err != nil {
+				return HandleError("%v", err)
+			}
+			every = e
+			if deferUntil == nil {
+				t, err := nextDeferOccurrence(nil, every, time.Now())
+				if err != nil {
+					return HandleError("%v", err)
+				}
+				deferUntil = &t
+			}
+		}
 		reason, _ := cmd.Flags().GetString("reason")
 		reason = strings.TrimSpace(reason)
 		if cmd.Flags().Changed("reason") && reason == "" {
@@ -65,7 +94,7 @@ Examples:
 		CheckReadonly("defer")
 
 		if usesProxiedServer() {
-			return runDeferProxiedServer(rootCtx, args, deferUntil, reason)
+			return runDeferProxiedServer(rootCtx, args, deferUntil, every, reason)
 		}
 
 		ctx := rootCtx
@@ -88,12 +117,7 @@ Examples:
 				continue
 			}
 
-			updates := map[string]interface{}{
-				"status": string(types.StatusDeferred),
-			}
-			if deferUntil != nil {
-				updates["defer_until"] = *deferUntil
-			}
+			updates := deferUpdates(deferUntil, every)
 			if reason != "" {
 				issue, err := store.GetIssue(ctx, fullID)
 				if err != nil {
@@ -139,9 +163,133 @@ Examples:
 	},
 }
 
+// wakeExpiredDeferrals reopens the issues deferred to a date that has
+// passed, so bd ready --claim can take them: the claim only takes rows whose
+// stored status is open. Recurring deferrals are left to bd undefer, which
+// moves them to their next occurrence, and nothing is written in read-only
+// mode. It returns the reopened IDs.
+func wakeExpiredDeferrals(ctx context.Context, s storage.DoltStorage) ([]string, error) {
+	if readonlyMode || s == nil {
+		return nil, nil
+	}
+	now := time.Now()
+	deferred := types.StatusDeferred
+	issues, err := s.SearchIssues(ctx, "", types.IssueFilter{Status: &deferred, DeferBefore: &now})
+	if err != nil {
+		return nil, fmt.Errorf("finding expired deferrals: %w", err)
+	}
+	due := expiredDeferrals(issues, now)
+	if len(due) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(due))
+	commitMsg := fmt.Sprintf("bd: reopen %d issue(s) past their defer date", len(due))
+	err = transactHonoringAutoCommit(ctx, s, commitMsg, func(tx storage.Transaction) error {
+		for _, issue := range due {
+			updates, _, err := undeferUpdates(issue, false, now)
+			if err != nil {
+				return err
+			}
+			if err := tx.UpdateIssue(ctx, issue.ID, updates, actor); err != nil {
+				return fmt.Errorf("reopening %s: %w", issue.ID, err)
+			}
+			ids = append(ids, issue.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	commandDidWrite.Store(true)
+	return ids, nil
+}
+
+// expiredDeferrals picks the deferred issues whose defer date is at or
+// before now, skipping recurring ones.
+func expiredDeferrals(issues []*types.Issue, now time.Time) []*types.Issue {
+	var due []*types.Issue
+	for _, issue := range issues {
+		if issue.Status != types.StatusDeferred || issue.DeferUntil == nil || issue.DeferUntil.After(now) {
+			continue
+		}
+		if deferEveryOf(issue) != "" {
+			continue
+		}
+		due = append(due, issue)
+	}
+	return due
+}
+
+// expiredDeferralReopen returns the fields that reopen issue when it is
+// deferred to a date that has passed, and nil otherwise. bd ready and bd list
+// already count such an issue as open; bd update stores that when it writes
+// the issue, unless fields sets the status or defer date itself.
+func expiredDeferralReopen(issue *types.Issue, fields map[string]interface{}) map[string]interface{} {
+	if _, ok := fields["status"]; ok {
+		return nil
+	}
+	if _, ok := fields["defer_until"]; ok {
+		return nil
+	}
+	now := time.Now()
+	if len(expiredDeferrals([]*types.Issue{issue}, now)) == 0 {
+		return nil
+	}
+	updates, _, err := undeferUpdates(issue, false, now)
+	if err != nil {
+		return nil
+	}
+	return updates
+}
+
+// checkDeferDate applies bd defer's date check: a defer date in the past
+// would make the issue ready again immediately, so it is rejected.
+func checkDeferDate(t time.Time) error {
+	if t.Before(time.Now()) {
+		return fmt.Errorf("defer date %q is in the past, so the issue would be ready again immediately. Did you mean a future date? Use +1h or tomorrow",
+			t.Local().Format("2006-01-02 15:04"))
+	}
+	return nil
+}
+
+// checkDeferredHasDate routes a --status deferred update through bd defer's
+// checks: the issue needs a future date to come back on, either given with
+// --defer or already set. Without one it would stay deferred until someone
+// remembered to undefer it.
+func checkDeferredHasDate(id string, fields map[string]interface{}, issue *types.Issue) error {
+	if status, _ := fields["status"].(string); status != string(types.StatusDeferred) {
+		return nil
+	}
+	if until, ok := fields["defer_until"]; ok {
+		if t, isTime := until.(time.Time); isTime {
+			if err := checkDeferDate(t); err != nil {
+				return fmt.Errorf("cannot defer %s: %w", id, err)
+			}
+			return nil
+		}
+	} else if issue != nil && issue.DeferUntil != nil && issue.DeferUntil.After(time.Now()) {
+		return nil
+	}
+	return fmt.Errorf("cannot set %s to deferred without a date to come back on: add --defer <date>, or use 'bd defer %s --until <date>'", id, id)
+}
+
+// reportWokenDeferrals tells the user which issues wakeExpiredDeferrals
+// reopened; a failed wake is only a warning, the command goes on.
+func reportWokenDeferrals(ids []string, err error) {
+	if err != nil {
+		WarnError("%v", err)
+		return
+	}
+	if len(ids) == 0 || jsonOutput {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "%s Reopened %d issue(s) past their defer date: %s\n", ui.RenderAccent("*"), len(ids), strings.Join(ids, ", "))
+}
+
 func init() {
 	// Time-based scheduling flag (GH#820)
 	deferCmd.Flags().String("until", "", "Defer until specific time (e.g., +1h, tomorrow, next monday)")
+	deferCmd.Flags().String("every", "", "Recur: bd undefer re-defers to the next occurrence (day, week, month, year, or e.g. 2w)")
 	deferCmd.Flags().String("reason", "", "Record why this issue is being deferred (appended to notes)")
 	deferCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(deferCmd)
@@ -10,6 +10,9 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // bdDefer runs "bd defer" with the given args and returns stdout.
@@ -129,6 +132,67 @@ func TestEmbeddedDefer(t *testing.T) {
 		}
 	})
 
+	t.Run("defer_until_past_rejected", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Defer past test", "--type", "task")
+		cmd := exec.Command(bd, "defer", issue.ID, "--until", "2020-01-01")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		out, err := cmd.CombinedOutput()
+		if err == nil || !strings.Contains(string(out), "is in the past") {
+			t.Errorf("expected a past --until to be rejected, got err=%v: %s", err, out)
+		}
+		if status := getIssueStatus(t, bd, dir, issue.ID); status != "open" {
+			t.Errorf("rejected defer changed the status to %q", status)
+		}
+	})
+
+	// ===== Expired Deferral =====
+
+	t.Run("defer_expired_reads_open_and_reopens_on_update", func(t *testing.T) {
+		// A past date is rejected, so defer a couple of seconds ahead and
+		// let the date pass.
+		issue := bdCreate(t, bd, dir, "Expired defer", "--type", "task")
+		other := bdCreate(t, bd, dir, "Expired defer, updated", "--type", "task")
+		until := time.Now().Add(2 * time.Second).UTC().Format(time.RFC3339)
+		bdDefer(t, bd, dir, issue.ID, "--until", until)
+		bdDefer(t, bd, dir, other.ID, "--until", until)
+		time.Sleep(3 * time.Second)
+
+		cmd := exec.Command(bd, "ready", "-n", "0", "--json")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd ready failed: %v\n%s", err, stderr.String())
+		}
+		if !strings.Contains(stdout.String(), issue.ID) {
+			t.Errorf("expected %s past its defer date in bd ready: %s", issue.ID, stdout.String())
+		}
+		if !containsID(bdListJSON(t, bd, dir, "--status", "open", "-n", "0"), issue.ID) {
+			t.Errorf("expected %s past its defer date in bd list --status open", issue.ID)
+		}
+		// list and ready are read-only: the stored status is untouched.
+		if got := bdShow(t, bd, dir, issue.ID); got.Status != types.StatusDeferred {
+			t.Errorf("expected bd ready and bd list to leave %s deferred, got status %s", issue.ID, got.Status)
+		}
+
+		// An unrelated write leaves it alone too.
+		bdCreate(t, bd, dir, "Any write", "--type", "task")
+		if got := bdShow(t, bd, dir, issue.ID); got.Status != types.StatusDeferred {
+			t.Errorf("expected an unrelated write to leave %s deferred, got status %s", issue.ID, got.Status)
+		}
+
+		// Updating or claiming the issue itself stores the reopen.
+		bdUpdate(t, bd, dir, other.ID, "--priority", "1")
+		if got := bdShow(t, bd, dir, other.ID); got.Status != types.StatusOpen || got.DeferUntil != nil {
+			t.Errorf("expected %s reopened by bd update, got status %s defer_until %v", other.ID, got.Status, got.DeferUntil)
+		}
+		bdUpdate(t, bd, dir, issue.ID, "--claim")
+		if got := bdShow(t, bd, dir, issue.ID); got.Status != types.StatusInProgress || got.DeferUntil != nil {
+			t.Errorf("expected %s claimed, got status %s defer_until %v", issue.ID, got.Status, got.DeferUntil)
+		}
+	})
+
 	// ===== Already Deferred =====
 
 	t.Run("defer_already_deferred", func(t *testing.T) {
@@ -140,6 +204,50 @@ func TestEmbeddedDefer(t *testing.T) {
 			t.Errorf("expected 'Deferred' on second defer: %s", out)
 		}
 	})
+
+	// ===== Recurring =====
+
+	t.Run("defer_every_redefers_on_undefer", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Weekly review", "--type", "task")
+		bdDefer(t, bd, dir, issue.ID, "--until", "next monday", "--every", "week")
+		first := bdShow(t, bd, dir, issue.ID)
+		if first.DeferUntil == nil || first.DeferUntil.Local().Weekday() != time.Monday {
+			t.Fatalf("expected defer_until on a Monday, got %v", first.DeferUntil)
+		}
+
+		out := bdUndefer(t, bd, dir, issue.ID)
+		if !strings.Contains(out, "Re-deferred") {
+			t.Errorf("expected a recurring issue to be re-deferred: %s", out)
+		}
+		second := bdShow(t, bd, dir, issue.ID)
+		if second.Status != types.StatusDeferred || second.DeferUntil == nil {
+			t.Fatalf("expected the issue to stay deferred, got status %s defer_until %v", second.Status, second.DeferUntil)
+		}
+		if got := second.DeferUntil.Sub(*first.DeferUntil); got != 7*24*time.Hour {
+			t.Errorf("expected the next occurrence a week later, moved by %v", got)
+		}
+
+		out = bdUndefer(t, bd, dir, issue.ID, "--end-recurrence")
+		if !strings.Contains(out, "now open") {
+			t.Errorf("expected --end-recurrence to reopen: %s", out)
+		}
+		final := bdShow(t, bd, dir, issue.ID)
+		if final.Status != types.StatusOpen || final.DeferUntil != nil || strings.Contains(string(final.Metadata), "defer_every") {
+			t.Errorf("expected an open issue with no recurrence, got status %s defer_until %v metadata %s",
+				final.Status, final.DeferUntil, final.Metadata)
+		}
+	})
+
+	t.Run("defer_every_invalid", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Bad recurrence", "--type", "task")
+		cmd := exec.Command(bd, "defer", issue.ID, "--every", "fortnight")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		out, err := cmd.CombinedOutput()
+		if err == nil || !strings.Contains(string(out), "invalid --every") {
+			t.Errorf("expected an invalid --every error, got err=%v: %s", err, out)
+		}
+	})
 }
 
 // TestEmbeddedDeferConcurrent exercises defer operations concurrently.
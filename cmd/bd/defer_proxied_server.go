@@ -9,6 +9,7 @@ import (
 	"github.com/steveyegge/beads/internal/storage/uow"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/validation"
 )
 
 type deferProxiedResult struct {
@@ -32,7 +33,7 @@ func proxiedGetByID(ctx context.Context, uw uow.UnitOfWork, id string, isWisp bo
 	return iss
 }
 
-func runDeferProxiedServer(ctx context.Context, args []string, deferUntil *time.Time, reason string) error {
+func runDeferProxiedServer(ctx context.Context, args []string, deferUntil *time.Time, every, reason string) error {
 	if uowProvider == nil {
 		return HandleError("proxied-server UOW provider not initialized")
 	}
@@ -47,12 +48,7 @@ func runDeferProxiedServer(ctx context.Context, args []string, deferUntil *time.
 			}
 			fullID := issue.ID
 
-			updates := map[string]interface{}{
-				"status": string(types.StatusDeferred),
-			}
-			if deferUntil != nil {
-				updates["defer_until"] = *deferUntil
-			}
+			updates := deferUpdates(deferUntil, every)
 			if reason != "" {
 				notes := issue.Notes
 				if notes != "" {
@@ -100,7 +96,7 @@ func runDeferProxiedServer(ctx context.Context, args []string, deferUntil *time.
 	return nil
 }
 
-func runUndeferProxiedServer(ctx context.Context, args []string) error {
+func runUndeferProxiedServer(ctx context.Context, args []string, endRecurrence bool) error {
 	if uowProvider == nil {
 		return HandleError("proxied-server UOW provider not initialized")
 	}
@@ -114,14 +110,16 @@ func runUndeferProxiedServer(ctx context.Context, args []string) error {
 				continue
 			}
 			fullID := issue.ID
-			if issue.Status != types.StatusDeferred {
-				r.errs = append(r.errs, fmt.Sprintf("%s is not deferred (status: %s)", fullID, string(issue.Status)))
+			now := time.Now()
+			if verr := validation.ForUndefer(now)(fullID, issue); verr != nil {
+				r.errs = append(r.errs, fmt.Sprintf("Error undeferring %s: %v", fullID, verr))
 				continue
 			}
 
-			updates := map[string]interface{}{
-				"status":      string(types.StatusOpen),
-				"defer_until": nil,
+			updates, _, uerr := undeferUpdates(issue, endRecurrence, now)
+			if uerr != nil {
+				r.errs = append(r.errs, fmt.Sprintf("Error undeferring %s: %v", fullID, uerr))
+				continue
 			}
 			if uerr := proxiedUpdateByID(ctx, uw, fullID, isWisp, updates); uerr != nil {
 				r.errs = append(r.errs, fmt.Sprintf("Error undeferring %s: %v", fullID, uerr))
@@ -152,6 +150,10 @@ func runUndeferProxiedServer(ctx context.Context, args []string) error {
 		}
 	} else {
 		for _, iss := range res.issues {
+			if iss.Status == types.StatusDeferred && iss.DeferUntil != nil {
+				printRedeferred(iss.ID, *iss.DeferUntil)
+				continue
+			}
 			fmt.Printf("%s Undeferred %s (now open)\n", ui.RenderPass("*"), iss.ID)
 		}
 	}
@@ -159,5 +161,38 @@ func runUndeferProxiedServer(ctx context.Context, args []string) error {
 	if len(args) > 0 {
 		commandDidWrite.Store(true)
 	}
+	if len(res.errs) > 0 {
+		return SilentExit()
+	}
 	return nil
 }
+
+// wakeExpiredDeferralsProxied is wakeExpiredDeferrals for the proxied server.
+func wakeExpiredDeferralsProxied(ctx context.Context) ([]string, error) {
+	if readonlyMode || uowProvider == nil {
+		return nil, nil
+	}
+	return uow.RunTxResult(ctx, uowProvider, func(ctx context.Context, uw uow.UnitOfWork) ([]string, string, error) {
+		now := time.Now()
+		deferred := types.StatusDeferred
+		page, err := uw.IssueUseCase().SearchIssues(ctx, "", types.IssueFilter{Status: &deferred, DeferBefore: &now})
+		if err != nil {
+			return nil, "", fmt.Errorf("finding expired deferrals: %w", err)
+		}
+		var ids []string
+		for _, issue := range expiredDeferrals(page.Items, now) {
+			updates, _, err := undeferUpdates(issue, false, now)
+			if err != nil {
+				return nil, "", err
+			}
+			if err := proxiedUpdateByID(ctx, uw, issue.ID, issue.Ephemeral, updates); err != nil {
+				return nil, "", fmt.Errorf("reopening %s: %w", issue.ID, err)
+			}
+			ids = append(ids, issue.ID)
+		}
+		if len(ids) == 0 {
+			return nil, "", nil
+		}
+		return ids, fmt.Sprintf("bd: reopen %d issue(s) past their defer date", len(ids)), nil
+	})
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/timeparsing"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// deferEveryMetadataKey is the issue metadata key holding the recurrence set
+// by bd defer --every, as a compact interval such as "1w". bd undefer on an
+// issue carrying it re-defers to the next occurrence instead of reopening.
+const deferEveryMetadataKey = "defer_every"
+
+var deferEveryRe = regexp.MustCompile(`^(\d+)\s*(d|days?|w|weeks?|m|months?|y|years?)$`)
+
+// parseDeferEvery normalizes a --every value to a compact interval: day,
+// week, month and year (or daily, weekly, ...) mean one of that unit, and
+// "2w" or "3 days" give a count.
+func parseDeferEvery(s string) (string, error) {
+	v := strings.ToLower(strings.TrimSpace(s))
+	switch v {
+	case "day", "daily":
+		return "1d", nil
+	case "week", "weekly":
+		return "1w", nil
+	case "month", "monthly":
+		return "1m", nil
+	case "year", "yearly":
+		return "1y", nil
+	}
+	m := deferEveryRe.FindStringSubmatch(v)
+	if m == nil {
+		return "", fmt.Errorf("invalid --every %q. Examples: day, week, month, 2w, 3 days", s)
+	}
+	n, err := strconv.Atoi(m[1])
+	if err != nil || n < 1 {
+		return "", fmt.Errorf("invalid --every %q: the interval must be at least 1", s)
+	}
+	return fmt.Sprintf("%d%c", n, m[2][0]), nil
+}
+
+// deferEveryOf returns the recurrence stored on issue, or "" when it has none
+// (or the stored value is malformed).
+func deferEveryOf(issue *types.Issue) string {
+	if issue == nil || len(issue.Metadata) == 0 {
+		return ""
+	}
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(issue.Metadata, &data); err != nil {
+		return ""
+	}
+	var every string
+	if err := json.Unmarshal(data[deferEveryMetadataKey], &every); err != nil {
+		return ""
+	}
+	if _, err := parseDeferEvery(every); err != nil {
+		return ""
+	}
+	return every
+}
+
+// nextDeferOccurrence steps from anchor by every, at least once, until it
+// passes now. A nil anchor (deferred without --until) counts from now. The
+// result is in UTC.
+func nextDeferOccurrence(anchor *time.Time, every string, now time.Time) (time.Time, error) {
+	next := now
+	if anchor != nil {
+		next = *anchor
+	}
+	for {
+		t, err := timeparsing.ParseCompactDuration("+"+every, next)
+		if err != nil {
+			return time.Time{}, err
+		}
+		next = t
+		if next.After(now) {
+			return next.UTC(), nil
+		}
+	}
+}
+
+// deferUpdates builds the update for bd defer. every, when set, is stored as
+// the issue's recurrence.
+func deferUpdates(deferUntil *time.Time, every string) map[string]interface{} {
+	updates := map[string]interface{}{
+		"status": string(types.StatusDeferred),
+	}
+	if deferUntil != nil {
+		updates["defer_until"] = *deferUntil
+	}
+	if every != "" {
+		updates[issueops.OpSetMetadata] = []string{deferEveryMetadataKey + "=" + every}
+	}
+	return updates
+}
+
+// undeferUpdates builds the update for bd undefer. A recurring issue stays
+// deferred with defer_until moved to its next occurrence, which is returned;
+// otherwise, or when endRecurrence drops the recurrence, it is reopened.
+func undeferUpdates(issue *types.Issue, endRecurrence bool, now time.Time) (map[string]interface{}, *time.Time, error) {
+	every := deferEveryOf(issue)
+	if every != "" && !endRecurrence {
+		next, err := nextDeferOccurrence(issue.DeferUntil, every, now)
+		if err != nil {
+			return nil, nil, err
+		}
+		return map[string]interface{}{"defer_until": next}, &next, nil
+	}
+	updates := map[string]interface{}{
+		"status":      string(types.StatusOpen),
+		"defer_until": nil,
+	}
+	if endRecurrence {
+		updates[issueops.OpUnsetMetadata] = []string{deferEveryMetadataKey}
+	}
+	return updates, nil, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestParseDeferEvery(t *testing.T) {
+	for in, want := range map[string]string{
+		"week": "1w", "Weekly": "1w", "day": "1d", "month": "1m", "year": "1y",
+		"2w": "2w", "3 days": "3d", "1 month": "1m", " 10d ": "10d",
+	} {
+		got, err := parseDeferEvery(in)
+		if err != nil || got != want {
+			t.Errorf("parseDeferEvery(%q) = (%q, %v), want %q", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "fortnight", "0w", "2h", "-1w"} {
+		if got, err := parseDeferEvery(in); err == nil {
+			t.Errorf("parseDeferEvery(%q) = %q, want an error", in, got)
+		}
+	}
+}
+
+func TestNextDeferOccurrence(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC) // Friday
+
+	// A past Monday anchor lands on the first Monday after now.
+	anchor := time.Date(2026, 9, 28, 9, 0, 0, 0, time.UTC)
+	got, err := nextDeferOccurrence(&anchor, "1w", now)
+	if err != nil || !got.Equal(time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("weekly from a past anchor = %v, %v", got, err)
+	}
+
+	// A future anchor still advances one interval.
+	future := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	got, _ = nextDeferOccurrence(&future, "1w", now)
+	if !got.Equal(time.Date(2026, 10, 26, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("weekly from a future anchor = %v", got)
+	}
+
+	// No anchor counts from now.
+	got, _ = nextDeferOccurrence(nil, "1m", now)
+	if !got.Equal(time.Date(2026, 11, 16, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("monthly from now = %v", got)
+	}
+}
+
+func TestUndeferUpdatesRecurring(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	recurring := &types.Issue{
+		ID:         "bd-1",
+		Status:     types.StatusDeferred,
+		DeferUntil: &due,
+		Metadata:   json.RawMessage(`{"defer_every":"1w","team":"ops"}`),
+	}
+
+	updates, next, err := undeferUpdates(recurring, false, now)
+	if err != nil {
+		t.Fatalf("undeferUpdates: %v", err)
+	}
+	want := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	if next == nil || !next.Equal(want) {
+		t.Fatalf("next = %v, want %v", next, want)
+	}
+	if !reflect.DeepEqual(updates, map[string]interface{}{"defer_until": want}) {
+		t.Errorf("a recurring undefer should only move defer_until, got %v", updates)
+	}
+
+	updates, next, _ = undeferUpdates(recurring, true, now)
+	if next != nil || updates["status"] != string(types.StatusOpen) || updates["defer_until"] != nil {
+		t.Errorf("--end-recurrence should reopen, got %v (next %v)", updates, next)
+	}
+	if keys, _ := updates[issueops.OpUnsetMetadata].([]string); !reflect.DeepEqual(keys, []string{deferEveryMetadataKey}) {
+		t.Errorf("--end-recurrence should unset %s, got %v", deferEveryMetadataKey, updates[issueops.OpUnsetMetadata])
+	}
+
+	plain := &types.Issue{ID: "bd-2", Status: types.StatusDeferred, DeferUntil: &due}
+	updates, next, _ = undeferUpdates(plain, false, now)
+	if next != nil || updates["status"] != string(types.StatusOpen) {
+		t.Errorf("a non-recurring undefer should reopen, got %v", updates)
+	}
+	if _, ok := updates[issueops.OpUnsetMetadata]; ok {
+		t.Error("a plain undefer should not touch metadata")
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestExpiredDeferrals(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	issues := []*types.Issue{
+		{ID: "bd-1", Status: types.StatusDeferred, DeferUntil: &past},
+		{ID: "bd-2", Status: types.StatusDeferred, DeferUntil: &future},
+		{ID: "bd-3", Status: types.StatusDeferred},
+		{ID: "bd-4", Status: types.StatusOpen, DeferUntil: &past},
+		{ID: "bd-5", Status: types.StatusDeferred, DeferUntil: &past, Metadata: json.RawMessage(`{"defer_every":"1w"}`)},
+		{ID: "bd-6", Status: types.StatusDeferred, DeferUntil: &now},
+	}
+
+	var got []string
+	for _, issue := range expiredDeferrals(issues, now) {
+		got = append(got, issue.ID)
+	}
+	if len(got) != 2 || got[0] != "bd-1" || got[1] != "bd-6" {
+		t.Errorf("expiredDeferrals = %v, want [bd-1 bd-6]", got)
+	}
+}
+
+func TestCheckDeferredHasDate(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	deferred := string(types.StatusDeferred)
+
+	tests := []struct {
+		name    string
+		fields  map[string]interface{}
+		issue   *types.Issue
+		wantErr bool
+	}{
+		{"other status", map[string]interface{}{"status": "open"}, &types.Issue{}, false},
+		{"no date", map[string]interface{}{"status": deferred}, &types.Issue{}, true},
+		{"future date given", map[string]interface{}{"status": deferred, "defer_until": future}, &types.Issue{}, false},
+		{"past date given", map[string]interface{}{"status": deferred, "defer_until": past}, &types.Issue{}, true},
+		{"past date without deferred status", map[string]interface{}{"defer_until": past}, &types.Issue{}, false},
+		{"date cleared", map[string]interface{}{"status": deferred, "defer_until": nil}, &types.Issue{DeferUntil: &future}, true},
+		{"future date kept", map[string]interface{}{"status": deferred}, &types.Issue{DeferUntil: &future}, false},
+		{"past date kept", map[string]interface{}{"status": deferred}, &types.Issue{DeferUntil: &past}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDeferredHasDate("bd-1", tt.fields, tt.issue)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkDeferredHasDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -37,6 +37,10 @@ Delete from file (one ID per line):
 Preview before deleting:
   bd delete --from-file deletions.txt --dry-run
 
+Without --force or --yes, delete only shows a preview. --yes confirms the
+deletion under the default dependency handling below; --force also orphans
+dependents.
+
 DEPENDENCY HANDLING:
 Default: Fails if any issue has dependents not in deletion set
   bd delete bd-1 bd-2
@@ -65,6 +69,7 @@ Force: Delete and orphan dependents
 
 		fromFile, _ := cmd.Flags().GetString("from-file")
 		force, _ := cmd.Flags().GetBool("force")
+		yes, _ := cmd.Flags().GetBool("yes")
 		dryRun, _ := cmd.Flags().GetBool("dry-run")
 		cascade, _ := cmd.Flags().GetBool("cascade")
 		issueIDs := make([]string, 0, len(args))
@@ -88,8 +93,10 @@ Force: Delete and orphan dependents
 			}
 		}
 
-		if len(issueIDs) > 1 || cascade {
-			if err := deleteBatch(cmd, issueIDs, force, dryRun, cascade, jsonOutput, false); err != nil {
+		// --yes without --force keeps the default dependency check, which
+		// only the batch path applies.
+		if len(issueIDs) > 1 || cascade || (yes && !force) {
+			if err := deleteBatch(cmd, issueIDs, force, dryRun, cascade, jsonOutput, yes); err != nil {
 				return HandleError("%v", err)
 			}
 			return nil
@@ -238,7 +245,7 @@ func deleteIssue(ctx context.Context, issueID string) error {
 }
 
 //nolint:unparam // cmd parameter required for potential future use
-func deleteBatch(_ *cobra.Command, issueIDs []string, force bool, dryRun bool, cascade bool, jsonOutput bool, _ bool, _ ...string) error {
+func deleteBatch(_ *cobra.Command, issueIDs []string, force bool, dryRun bool, cascade bool, jsonOutput bool, yes bool, _ ...string) error {
 	if store == nil {
 		if err := ensureStoreActive(); err != nil {
 			return err
@@ -275,7 +282,7 @@ func deleteBatch(_ *cobra.Command, issueIDs []string, force bool, dryRun bool, c
 	if routedStore != nil {
 		batchStore = routedStore
 	}
-	if dryRun || !force {
+	if dryRun || (!force && !yes) {
 		result, err := batchStore.DeleteIssues(ctx, issueIDs, cascade, false, true)
 		if err != nil {
 			showDeletionPreview(issueIDs, issues, cascade, err)
@@ -464,6 +471,7 @@ func init() {
 	deleteCmd.Flags().BoolP("force", "f", false, "Actually delete (without this flag, shows preview)")
 	deleteCmd.Flags().String("from-file", "", "Read issue IDs from file (one per line)")
 	deleteCmd.Flags().Bool("dry-run", false, "Preview what would be deleted without making changes")
+	deleteCmd.Flags().BoolP("yes", "y", false, "Confirm the deletion without orphaning dependents (unlike --force)")
 	deleteCmd.Flags().Bool("cascade", false, "Recursively delete all dependent issues")
 	deleteCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(deleteCmd)
@@ -38,6 +38,10 @@ func gatherDeleteInput(cmd *cobra.Command, args []string) (*deleteInput, error)
 	in.ids = uniqueStrings(in.ids)
 
 	in.force, _ = cmd.Flags().GetBool("force")
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		// Proxied delete always cascades, so --yes confirms exactly as --force does.
+		in.force = true
+	}
 	in.dryRun, _ = cmd.Flags().GetBool("dry-run")
 	in.jsonOutput = jsonOutput
 	return in, nil
@@ -5,10 +5,12 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -188,7 +190,7 @@ Examples:
 			}
 			defer fromCleanup()
 
-			toID, _, toCleanup, err := resolveIDWithRouting(ctx, store, blockerID)
+			toID, toStore, toCleanup, err := resolveIDWithRouting(ctx, store, blockerID)
 			if err != nil {
 				return HandleErrorRespectJSON("%v", err)
 			}
@@ -212,6 +214,9 @@ Examples:
 			if !noCycleCheck {
 				warnIfCyclesExist(fromStore)
 			}
+			if noWarn, _ := cmd.Flags().GetBool("no-warn"); !noWarn {
+				warnIfClosedBlocker(ctx, toStore, fromID, toID, types.DepBlocks)
+			}
 
 			if err := commitPendingIfEmbedded(ctx, fromStore, actor, doltAutoCommitParams{
 				Command:  "dep add",
@@ -239,6 +244,25 @@ Examples:
 	},
 }
 
+// depAddTargetFlags name the other end of a single `bd dep add` edge in
+// place of the second positional argument; at most one may be given.
+var depAddTargetFlags = []string{"blocked-by", "depends-on", "after", "before"}
+
+// depAddEndpoints returns the dependent issue and the issue it depends on for
+// a single `bd dep add`. --before flips the positional issue into the
+// blocker: "bd dep add A --before B" is "bd dep add B A".
+func depAddEndpoints(cmd *cobra.Command, args []string) (from, dependsOn string) {
+	if before, _ := cmd.Flags().GetString("before"); before != "" {
+		return before, args[0]
+	}
+	for _, name := range []string{"blocked-by", "depends-on", "after"} {
+		if v, _ := cmd.Flags().GetString(name); v != "" {
+			return args[0], v
+		}
+	}
+	return args[0], args[1]
+}
+
 var depAddCmd = &cobra.Command{
 	Use:   "add [issue-id] [depends-on-id]",
 	Short: "Add a dependency",
@@ -252,6 +276,11 @@ The depends-on-id can be provided as:
 The --blocked-by and --depends-on flags are aliases and both mean "issue-123
 depends on (is blocked by) the specified issue."
 
+If the direction is hard to remember, say it in terms of order instead:
+  - bd dep add B --after A     B starts after A finishes (B depends on A)
+  - bd dep add A --before B    A must finish before B (B depends on A)
+Both always add a blocks dependency.
+
 The depends-on-id can be:
   - A local issue ID (e.g., bd-xyz)
   - An external reference: external:<project>:<capability>
@@ -264,42 +293,72 @@ External references are stored as-is and resolved at query time using
 the external_projects config. They block the issue until the capability
 is "shipped" in the target project.
 
+A dependency that would close a cycle is refused. --force allows the loop for
+types that cycle detection checks but that do not affect readiness:
+supersedes and duplicates. It never applies to blocks, conditional-blocks,
+waits-for or parent-child, where a cycle would leave its issues never ready.
+Other types (related, tracks, ...) are not cycle-checked and need no --force.
+A forced loop is only accepted, not hidden: 'bd dep cycles' still reports it.
+
+A blocks dependency on an issue that is already closed gates nothing now, but
+it starts blocking if that issue is reopened. bd dep add warns when it adds
+one; --no-warn suppresses the warning for scripts.
+
 Examples:
   bd dep add bd-42 bd-41                              # Positional args
   bd dep add bd-42 --blocked-by bd-41                 # Flag syntax (same effect)
   bd dep add bd-42 --depends-on bd-41                 # Alias (same effect)
+  bd dep add bd-42 --after bd-41                      # Order alias (same effect)
+  bd dep add bd-41 --before bd-42                     # Order alias (same effect)
   bd dep add gt-xyz external:beads:mol-run-assignee   # Cross-project dependency
   bd dep add bd-42 bd-41 --no-cycle-check             # Skip cycle check (bulk wiring)
+  bd dep add bd-42 bd-41 --type supersedes --force    # Allow a supersedes loop
   bd dep add --file deps.jsonl                        # Bulk JSONL: {"from":"bd-42","to":"bd-41"}`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		file, _ := cmd.Flags().GetString("file")
-		blockedBy, _ := cmd.Flags().GetString("blocked-by")
-		dependsOn, _ := cmd.Flags().GetString("depends-on")
-		hasFlag := blockedBy != "" || dependsOn != ""
+		var setFlags []string
+		for _, name := range depAddTargetFlags {
+			if v, _ := cmd.Flags().GetString(name); v != "" {
+				setFlags = append(setFlags, "--"+name)
+			}
+		}
+		hasFlag := len(setFlags) > 0
 
 		if file != "" {
 			if len(args) != 0 {
 				return fmt.Errorf("--file cannot be used with positional issue IDs")
 			}
+			if force, _ := cmd.Flags().GetBool("force"); force {
+				return fmt.Errorf("--force cannot be used with --file; add forced edges one at a time")
+			}
 			if hasFlag {
-				return fmt.Errorf("--file cannot be used with --blocked-by or --depends-on")
+				return fmt.Errorf("--file cannot be used with %s", setFlags[0])
 			}
 			return nil
 		}
 
+		if len(setFlags) > 1 {
+			return fmt.Errorf("%s and %s cannot be used together", setFlags[0], setFlags[1])
+		}
+		if slices.Contains(setFlags, "--after") || slices.Contains(setFlags, "--before") {
+			if depType, _ := cmd.Flags().GetString("type"); depType != string(types.DepBlocks) {
+				return fmt.Errorf("--after and --before always add a blocks dependency; drop --type %s", depType)
+			}
+		}
+
 		if hasFlag {
 			// If a flag is provided, we only need 1 positional arg (the dependent issue)
 			if len(args) < 1 {
 				return fmt.Errorf("requires at least 1 arg(s), only received %d", len(args))
 			}
 			if len(args) > 1 {
-				return fmt.Errorf("cannot use both positional depends-on-id and --blocked-by/--depends-on flag")
+				return fmt.Errorf("cannot use both positional depends-on-id and %s", setFlags[0])
 			}
 			return nil
 		}
 		// No flag provided, need exactly 2 positional args
 		if len(args) != 2 {
-			return fmt.Errorf("requires 2 arg(s), only received %d (or use --blocked-by/--depends-on flag)", len(args))
+			return fmt.Errorf("requires 2 arg(s), only received %d (or use --blocked-by/--depends-on/--after/--before)", len(args))
 		}
 		return nil
 	},
@@ -329,21 +388,12 @@ Examples:
 			return nil
 		}
 
-		blockedBy, _ := cmd.Flags().GetString("blocked-by")
-		dependsOn, _ := cmd.Flags().GetString("depends-on")
-
-		var dependsOnArg string
-		if blockedBy != "" {
-			dependsOnArg = blockedBy
-		} else if dependsOn != "" {
-			dependsOnArg = dependsOn
-		} else {
-			dependsOnArg = args[1]
-		}
+		fromArg, dependsOnArg := depAddEndpoints(cmd, args)
 
 		ctx := rootCtx
 
 		var fromID, toID string
+		var toStore storage.DoltStorage
 
 		isExternalRef := strings.HasPrefix(dependsOnArg, "external:")
 
@@ -351,7 +401,7 @@ Examples:
 		// below, so the routed source must open writable (#4141). The depends-on
 		// target is only resolved by ID and stays read-only, so resolving it can
 		// never open a foreign project writable (bd-6dnrw.32, GH#3231).
-		fromID, fromStore, fromCleanup, err := resolveIDForMutation(ctx, store, args[0])
+		fromID, fromStore, fromCleanup, err := resolveIDForMutation(ctx, store, fromArg)
 		if err != nil {
 			return HandleErrorRespectJSON("%v", err)
 		}
@@ -364,7 +414,7 @@ Examples:
 			}
 		} else {
 			var toCleanup func()
-			toID, _, toCleanup, err = resolveIDWithRouting(ctx, store, dependsOnArg)
+			toID, toStore, toCleanup, err = resolveIDWithRouting(ctx, store, dependsOnArg)
 			if err != nil {
 				srcPrefix := types.ExtractPrefix(fromID)
 				tgtPrefix := types.ExtractPrefix(dependsOnArg)
@@ -386,6 +436,12 @@ Examples:
 		if !dt.IsValid() {
 			return HandleErrorRespectJSON("invalid dependency type %q: must be non-empty and at most 50 characters", depType)
 		}
+		force, _ := cmd.Flags().GetBool("force")
+		if force {
+			if err := depForceCycleError(dt); err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
+		}
 
 		dep := &types.Dependency{
 			IssueID:     fromID,
@@ -393,7 +449,15 @@ Examples:
 			Type:        dt,
 		}
 
-		if err := fromStore.AddDependencyWithOptions(ctx, dep, actor, storage.DependencyAddOptions{EmitEvent: true}); err != nil {
+		addOpts := storage.DependencyAddOptions{
+			EmitEvent:       true,
+			RejectDuplicate: true,
+			SkipCycleCheck:  force && dt.IsCycleForceable(),
+		}
+		if err := fromStore.AddDependencyWithOptions(ctx, dep, actor, addOpts); err != nil {
+			if errors.Is(err, domain.ErrDuplicateDependency) {
+				return reportExistingDependency(fromID, lookupTitle(fromID), toID, lookupTitle(toID), depType)
+			}
 			return HandleErrorRespectJSON("%v", err)
 		}
 
@@ -401,6 +465,9 @@ Examples:
 		if !noCycleCheck {
 			warnIfCyclesExist(fromStore)
 		}
+		if noWarn, _ := cmd.Flags().GetBool("no-warn"); !noWarn {
+			warnIfClosedBlocker(ctx, toStore, fromID, toID, dt)
+		}
 
 		if err := commitPendingIfEmbedded(ctx, fromStore, actor, doltAutoCommitParams{
 			Command:  "dep add",
@@ -424,6 +491,64 @@ Examples:
 	},
 }
 
+// isClosedBlocker reports whether a dependency of type dt on target is a
+// blocks edge onto an issue that is already closed.
+func isClosedBlocker(dt types.DependencyType, target *types.Issue) bool {
+	return dt == types.DepBlocks && target != nil && target.Status == types.StatusClosed
+}
+
+// warnClosedBlocker explains that a blocks dependency on a closed issue gates
+// nothing now but starts blocking fromID if the blocker is reopened. It only
+// informs: readiness follows the blocker's status either way.
+func warnClosedBlocker(fromID, toID string) {
+	fmt.Fprintf(os.Stderr, "%s Warning: %s is closed, so this dependency has no effect now; %s will become blocked if %s is reopened\n",
+		ui.RenderWarn("⚠"), toID, fromID, toID)
+}
+
+// warnIfClosedBlocker looks up toID in s and warns when the new dependency is
+// a blocks edge onto an already-closed issue.
+func warnIfClosedBlocker(ctx context.Context, s storage.DoltStorage, fromID, toID string, dt types.DependencyType) {
+	if s == nil || dt != types.DepBlocks {
+		return
+	}
+	if blocker, err := s.GetIssue(ctx, toID); err == nil && isClosedBlocker(dt, blocker) {
+		warnClosedBlocker(fromID, toID)
+	}
+}
+
+// depForceCycleError refuses --force for a dependency type whose cycles it
+// may not allow: a loop of ready-gating edges would leave every issue on it
+// permanently blocked, so those types are always cycle-checked.
+func depForceCycleError(dt types.DependencyType) error {
+	if !dt.IsCycleRelevant() || dt.IsCycleForceable() {
+		return nil
+	}
+	var forceable []string
+	for _, t := range types.CycleRelevantDependencyTypes() {
+		if t.IsCycleForceable() {
+			forceable = append(forceable, string(t))
+		}
+	}
+	return fmt.Errorf("--force cannot allow a cycle of %s dependencies, which gate readiness; it only applies to %s", dt, strings.Join(forceable, ", "))
+}
+
+// reportExistingDependency answers a `bd dep add` whose exact edge is already
+// in place. Nothing is written, but re-asserting an edge is not an error
+// (protocol clause G1.3), so the command still exits 0.
+func reportExistingDependency(fromID, fromTitle, toID, toTitle, depType string) error {
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{
+			"status":        "exists",
+			"issue_id":      fromID,
+			"depends_on_id": toID,
+			"type":          depType,
+		})
+	}
+	fmt.Printf("%s Dependency already exists: %s depends on %s (%s); nothing changed\n",
+		ui.RenderWarn("○"), formatFeedbackIDParen(fromID, fromTitle), formatFeedbackIDParen(toID, toTitle), depType)
+	return nil
+}
+
 type bulkDepInput struct {
 	From        string `json:"from"`
 	To          string `json:"to"`
@@ -918,10 +1043,23 @@ Examples:
 }
 
 var depRemoveCmd = &cobra.Command{
-	Use:           "remove [issue-id] [depends-on-id]",
-	Aliases:       []string{"rm"},
-	Short:         "Remove a dependency",
-	Args:          cobra.ExactArgs(2),
+	Use:     "remove [issue-id] [depends-on-id...]",
+	Aliases: []string{"rm"},
+	Short:   "Remove one or more dependencies",
+	Long: `Remove the dependencies of an issue on one or more other issues.
+
+Removing more than one dependency asks for confirmation. Pass --yes to skip
+the prompt; without a terminal, or with --json or --quiet, --yes is required.
+Use --dry-run to preview the removal.
+
+A dependency that does not exist is skipped with a warning; pass --strict to
+fail instead.
+
+Examples:
+  bd dep remove bd-42 bd-17
+  bd dep rm bd-42 bd-17 bd-18 --yes
+  bd dep rm bd-42 bd-17 bd-18 --dry-run`,
+	Args:          cobra.MinimumNArgs(2),
 	SilenceUsage:  true,
 	SilenceErrors: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -942,66 +1080,179 @@ var depRemoveCmd = &cobra.Command{
 
 		// Resolve partial IDs with routing support. The source issue's store is
 		// mutated by RemoveDependency below, so resolve it write-intent (#4141);
-		// the depends-on target is only resolved by ID and stays read-only
+		// the depends-on targets are only resolved by ID and stay read-only
 		// (bd-6dnrw.32, GH#3231).
-		var fromID, toID string
 		fromID, fromStore, fromCleanup, err := resolveIDForMutation(ctx, store, args[0])
 		if err != nil {
 			return HandleErrorRespectJSON("%v", err)
 		}
 		defer fromCleanup()
 
-		isExternalRef := strings.HasPrefix(args[1], "external:")
-
-		if isExternalRef {
-			toID = args[1]
-			if err := validateExternalRef(toID); err != nil {
+		toIDs := make([]string, 0, len(args)-1)
+		for _, arg := range args[1:] {
+			toID, toCleanup, err := resolveDepRemoveTarget(ctx, fromID, arg)
+			if err != nil {
 				return HandleErrorRespectJSON("%v", err)
 			}
-		} else {
-			var toCleanup func()
-			toID, _, toCleanup, err = resolveIDWithRouting(ctx, store, args[1])
+			defer toCleanup()
+			toIDs = append(toIDs, toID)
+		}
+		toIDs = uniqueStrings(toIDs)
+
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			deps, err := fromStore.GetDependencyRecords(ctx, fromID)
 			if err != nil {
-				srcPrefix := types.ExtractPrefix(fromID)
-				tgtPrefix := types.ExtractPrefix(args[1])
-				if srcPrefix != "" && tgtPrefix != "" && srcPrefix != tgtPrefix {
-					toID = args[1]
-				} else {
-					return HandleErrorRespectJSON("resolving dependency ID %s: %v", args[1], err)
-				}
-			} else {
-				defer toCleanup()
+				return HandleErrorRespectJSON("%v", err)
+			}
+			existing, missing := splitDepRemoveTargets(fromID, deps, toIDs)
+			if err := reportNothingToRemove(cmd, missing); err != nil {
+				return HandleErrorRespectJSON("%v", err)
 			}
+			if len(existing) == 0 {
+				return renderNothingRemoved()
+			}
+			return renderDepRemove(fromID, existing, depRemoveTitles(fromID, existing), true)
+		}
+		if err := confirmDestructive(cmd, depRemoveAction(fromID, len(toIDs)), len(toIDs)); err != nil {
+			return HandleErrorRespectJSON("%v", err)
 		}
-
-		fullFromID := fromID
-		fullToID := toID
 
 		// Explicit dep verb: record a dependency_removed history event (parity
-		// with bd dep add's EmitEvent and the proxied bd dep remove path).
-		if err := fromStore.RemoveDependencyWithOptions(ctx, fullFromID, fullToID, actor, storage.DependencyRemoveOptions{EmitEvent: true}); err != nil {
+		// with bd dep add's EmitEvent and the proxied bd dep remove path). A
+		// target whose DELETE removed no row was never a dependency.
+		var removed, missing []string
+		commitMsg := formatDoltAutoCommitMessage("dep remove", actor, append([]string{fromID}, toIDs...))
+		err = transactHonoringAutoCommit(ctx, fromStore, commitMsg, func(tx storage.Transaction) error {
+			removed, missing = nil, nil
+			for _, toID := range toIDs {
+				n, err := tx.RemoveDependencyWithOptions(ctx, fromID, toID, actor, storage.DependencyRemoveOptions{EmitEvent: true})
+				if err != nil {
+					return err
+				}
+				if n == 0 {
+					missing = append(missing, noSuchDependency(fromID, toID))
+					continue
+				}
+				removed = append(removed, toID)
+			}
+			return strictNothingToRemove(cmd, missing)
+		})
+		if err != nil {
 			return HandleErrorRespectJSON("%v", err)
 		}
+		if err := reportNothingToRemove(cmd, missing); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		if len(removed) == 0 {
+			return renderNothingRemoved()
+		}
+		commandDidWrite.Store(true)
 
-		if err := commitPendingIfEmbedded(ctx, fromStore, actor, doltAutoCommitParams{
-			Command:  "dep remove",
-			IssueIDs: []string{fullFromID, fullToID},
-		}); err != nil {
-			return HandleErrorRespectJSON("failed to commit: %v", err)
+		return renderDepRemove(fromID, removed, depRemoveTitles(fromID, removed), false)
+	},
+}
+
+// resolveDepRemoveTarget resolves a depends-on ID of bd dep remove. External
+// refs are validated but not resolved, and an ID that does not resolve is
+// kept as given when its prefix differs from the source issue's, since the
+// dependency may point into another rig. The returned cleanup is never nil.
+func resolveDepRemoveTarget(ctx context.Context, fromID, arg string) (string, func(), error) {
+	noop := func() {}
+	if strings.HasPrefix(arg, "external:") {
+		if err := validateExternalRef(arg); err != nil {
+			return "", noop, err
+		}
+		return arg, noop, nil
+	}
+	toID, _, cleanup, err := resolveIDWithRouting(ctx, store, arg)
+	if err != nil {
+		srcPrefix := types.ExtractPrefix(fromID)
+		tgtPrefix := types.ExtractPrefix(arg)
+		if srcPrefix != "" && tgtPrefix != "" && srcPrefix != tgtPrefix {
+			return arg, noop, nil
 		}
+		return "", noop, fmt.Errorf("resolving dependency ID %s: %v", arg, err)
+	}
+	return toID, cleanup, nil
+}
 
-		if jsonOutput {
-			return outputJSON(map[string]interface{}{
-				"status":        "removed",
-				"issue_id":      fullFromID,
-				"depends_on_id": fullToID,
+// splitDepRemoveTargets splits the depends-on IDs of bd dep remove --dry-run
+// into the dependencies fromID actually has and messages for the ones it does
+// not. A real remove learns the same from the rows each DELETE removed.
+func splitDepRemoveTargets(fromID string, deps []*types.Dependency, toIDs []string) (existing, missing []string) {
+	for _, toID := range toIDs {
+		if slices.ContainsFunc(deps, func(dep *types.Dependency) bool { return dep.DependsOnID == toID }) {
+			existing = append(existing, toID)
+		} else {
+			missing = append(missing, noSuchDependency(fromID, toID))
+		}
+	}
+	return existing, missing
+}
+
+// noSuchDependency describes a bd dep remove target fromID does not depend on.
+func noSuchDependency(fromID, toID string) string {
+	return fmt.Sprintf("no such dependency to remove: %s does not depend on %s", fromID, toID)
+}
+
+// depRemoveTitles looks up the titles bd dep remove prints next to each ID.
+func depRemoveTitles(fromID string, toIDs []string) map[string]string {
+	titles := make(map[string]string, len(toIDs)+1)
+	for _, id := range append([]string{fromID}, toIDs...) {
+		titles[id] = lookupTitle(id)
+	}
+	return titles
+}
+
+// renderNothingRemoved reports a remove command whose targets all did not
+// exist; the warnings have already gone to stderr.
+func renderNothingRemoved() error {
+	if jsonOutput {
+		return outputJSON([]map[string]interface{}{})
+	}
+	return nil
+}
+
+// depRemoveAction describes a bd dep remove for confirmDestructive.
+func depRemoveAction(fromID string, count int) string {
+	return fmt.Sprintf("remove %d dependencies of %s", count, fromID)
+}
+
+// renderDepRemove reports removed dependencies, or the ones --dry-run would
+// remove. A single dependency is a JSON object, as it always was; several
+// are an array.
+func renderDepRemove(fromID string, toIDs []string, titles map[string]string, dryRun bool) error {
+	if jsonOutput {
+		status := "removed"
+		if dryRun {
+			status = "would_remove"
+		}
+		results := make([]map[string]interface{}, 0, len(toIDs))
+		for _, toID := range toIDs {
+			results = append(results, map[string]interface{}{
+				"status":        status,
+				"issue_id":      fromID,
+				"depends_on_id": toID,
 			})
 		}
+		if len(results) == 1 {
+			return outputJSON(results[0])
+		}
+		return outputJSON(results)
+	}
 
-		fmt.Printf("%s Removed dependency: %s no longer depends on %s\n",
-			ui.RenderPass("✓"), formatFeedbackIDParen(fullFromID, lookupTitle(fullFromID)), formatFeedbackIDParen(fullToID, lookupTitle(fullToID)))
-		return nil
-	},
+	for _, toID := range toIDs {
+		from, to := formatFeedbackIDParen(fromID, titles[fromID]), formatFeedbackIDParen(toID, titles[toID])
+		if dryRun {
+			fmt.Printf("Would remove dependency: %s no longer depends on %s\n", from, to)
+		} else {
+			fmt.Printf("%s Removed dependency: %s no longer depends on %s\n", ui.RenderPass("✓"), from, to)
+		}
+	}
+	if dryRun {
+		fmt.Printf("\n(Dry-run mode - no changes made)\n")
+	}
+	return nil
 }
 
 var depTreeCmd = &cobra.Command{
@@ -1019,6 +1270,10 @@ Examples:
   bd dep tree gt-0iqq --direction=up     # Show what gt-0iqq blocks
   bd dep tree gt-0iqq --status=open      # Only show open issues
   bd dep tree gt-0iqq --depth=3          # Limit to 3 levels deep
+  bd dep tree gt-0iqq --direction=up --highlight gt-8x2k  # Mark one node
+
+--highlight marks a node so it stands out in large trees: ">>> ... <<<" in
+text output, "highlighted": true in JSON, and a thicker border in mermaid.
 
 --max-rows / BEADS_MAX_ROWS caveat: the tree walk has no query filter to
 thread the cap through, so the full tree is always built first and the
@@ -1074,31 +1329,19 @@ node count is checked afterward (post-hoc), not during the walk.`,
 			return HandleErrorRespectJSON("--max-depth must be >= 1")
 		}
 
-		var tree []*types.TreeNode
-
-		if direction == "both" {
-			downTree, err := treeStore.GetDependencyTree(ctx, fullID, maxDepth, showAllPaths, false)
-			if err != nil {
-				return HandleErrorRespectJSON("%v", err)
-			}
-
-			upTree, err := treeStore.GetDependencyTree(ctx, fullID, maxDepth, showAllPaths, true)
-			if err != nil {
-				return HandleErrorRespectJSON("%v", err)
-			}
-
-			tree = mergeBidirectionalTrees(downTree, upTree, fullID)
-		} else {
-			tree, err = treeStore.GetDependencyTree(ctx, fullID, maxDepth, showAllPaths, direction == "up")
-			if err != nil {
-				return HandleErrorRespectJSON("%v", err)
-			}
+		tree, err := buildDependencyTree(ctx, treeStore, fullID, maxDepth, showAllPaths, direction)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
 		}
 
 		if statusFilter != "" {
 			tree = filterTreeByStatus(tree, types.Status(statusFilter))
 		}
 
+		if highlight, _ := cmd.Flags().GetString("highlight"); highlight != "" {
+			highlightTreeNode(tree, highlight)
+		}
+
 		// Apply defensive row cap (be-x42v) on the final tree-node count.
 		// Tree walks have no IssueFilter to thread through, so the cap is
 		// enforced at the CLI layer instead of in storage.
@@ -1191,18 +1434,62 @@ var depCyclesCmd = &cobra.Command{
 			return nil
 		}
 
-		fmt.Printf("\n%s Found %d dependency cycles:\n\n", ui.RenderFail("⚠"), len(cycles))
-		for i, cycle := range cycles {
-			fmt.Printf("%d. Cycle involving:\n", i+1)
-			for _, issue := range cycle {
-				fmt.Printf("   - %s: %s\n", issue.ID, issue.Title)
-			}
-			fmt.Println()
+		deps, err := store.GetDependencyRecordsForIssues(ctx, cycleMemberIDs(cycles))
+		if err != nil {
+			return HandleErrorRespectJSON("loading cycle edges: %v", err)
 		}
+		printDependencyCycles(cycles, deps)
 		return nil
 	},
 }
 
+func cycleMemberIDs(cycles [][]*types.Issue) []string {
+	var ids []string
+	for _, cycle := range cycles {
+		for _, issue := range cycle {
+			ids = append(ids, issue.ID)
+		}
+	}
+	return ids
+}
+
+// printDependencyCycles renders bd dep cycles' text report. Each cycle ends
+// with its path, every hop labeled with the dependency type that closes it.
+func printDependencyCycles(cycles [][]*types.Issue, deps map[string][]*types.Dependency) {
+	fmt.Printf("\n%s Found %d dependency cycles:\n\n", ui.RenderFail("⚠"), len(cycles))
+	for i, cycle := range cycles {
+		fmt.Printf("%d. Cycle involving:\n", i+1)
+		for _, issue := range cycle {
+			fmt.Printf("   - %s: %s\n", issue.ID, issue.Title)
+		}
+		fmt.Printf("   Path: %s\n", formatCyclePath(cycle, deps))
+		fmt.Println()
+	}
+}
+
+// formatCyclePath renders a cycle as "a --blocks--> b --waits-for--> a".
+// Each member depends on the next and the last on the first; a hop whose
+// edge is not among deps is labeled "?".
+func formatCyclePath(cycle []*types.Issue, deps map[string][]*types.Dependency) string {
+	if len(cycle) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(cycle[0].ID)
+	for i, issue := range cycle {
+		next := cycle[(i+1)%len(cycle)].ID
+		edge := "?"
+		for _, dep := range deps[issue.ID] {
+			if dep.DependsOnID == next && dep.Type.IsCycleRelevant() {
+				edge = string(dep.Type)
+				break
+			}
+		}
+		fmt.Fprintf(&b, " --%s--> %s", edge, next)
+	}
+	return b.String()
+}
+
 // outputMermaidTree outputs a dependency tree in Mermaid.js flowchart format
 func outputMermaidTree(tree []*types.TreeNode, rootID string) {
 	if len(tree) == 0 {
@@ -1211,31 +1498,80 @@ func outputMermaidTree(tree []*types.TreeNode, rootID string) {
 		return
 	}
 
-	fmt.Println("flowchart TD")
-
-	// Output nodes
-	nodesSeen := make(map[string]bool)
+	nodes := make([]*types.Issue, 0, len(tree))
+	highlighted := make(map[string]bool)
+	for _, node := range tree {
+		nodes = append(nodes, &node.Issue)
+		if node.Highlighted {
+			highlighted[node.ID] = true
+		}
+	}
+	// Edges use the explicit parent relationships from ParentID.
+	var edges []mermaidEdge
 	for _, node := range tree {
-		if !nodesSeen[node.ID] {
-			emoji := getStatusEmoji(node.Status)
-			label := fmt.Sprintf("%s %s: %s", emoji, node.ID, node.Title)
-			// Escape quotes and backslashes in label
-			label = strings.ReplaceAll(label, "\\", "\\\\")
-			label = strings.ReplaceAll(label, "\"", "\\\"")
-			fmt.Printf("  %s[\"%s\"]\n", node.ID, label)
+		if node.ParentID != "" && node.ParentID != node.ID {
+			edges = append(edges, mermaidEdge{from: node.ParentID, to: node.ID})
+		}
+	}
+	_ = writeMermaidFlowchart(os.Stdout, nodes, highlighted, edges)
+}
+
+// mermaidEdge is one arrow of a Mermaid flowchart. An empty label draws an
+// unlabeled arrow; thick draws a heavy one, dotted a dotted one.
+type mermaidEdge struct {
+	from, to string
+	label    string
+	thick    bool
+	dotted   bool
+}
 
-			nodesSeen[node.ID] = true
+// writeMermaidFlowchart writes a Mermaid "flowchart TD" block: one node per
+// issue (duplicates skipped), labeled with its status symbol, ID and title,
+// then the edges. Highlighted issues get a heavy stroke. It is the Mermaid
+// writer behind bd dep tree --format mermaid and bd dep graph.
+func writeMermaidFlowchart(w io.Writer, nodes []*types.Issue, highlighted map[string]bool, edges []mermaidEdge) error {
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+
+	nodesSeen := make(map[string]bool, len(nodes))
+	for _, issue := range nodes {
+		if nodesSeen[issue.ID] {
+			continue
+		}
+		nodesSeen[issue.ID] = true
+		label := fmt.Sprintf("%s %s: %s", getStatusEmoji(issue.Status), issue.ID, issue.Title)
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", issue.ID, mermaidLabel(label))
+		if highlighted[issue.ID] {
+			fmt.Fprintf(&b, "  style %s stroke-width:4px\n", issue.ID)
 		}
 	}
 
-	fmt.Println()
+	b.WriteString("\n")
 
-	// Output edges - use explicit parent relationships from ParentID
-	for _, node := range tree {
-		if node.ParentID != "" && node.ParentID != node.ID {
-			fmt.Printf("  %s --> %s\n", node.ParentID, node.ID)
+	for _, e := range edges {
+		arrow := "-->"
+		switch {
+		case e.thick:
+			arrow = "==>"
+		case e.dotted:
+			arrow = "-.->"
+		}
+		if e.label != "" {
+			fmt.Fprintf(&b, "  %s %s|\"%s\"| %s\n", e.from, arrow, mermaidLabel(e.label), e.to)
+		} else {
+			fmt.Fprintf(&b, "  %s %s %s\n", e.from, arrow, e.to)
 		}
 	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// mermaidLabel escapes quotes and backslashes in a quoted Mermaid label and
+// folds line breaks into spaces.
+func mermaidLabel(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "\"", "\\\"")
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(s)
 }
 
 // getStatusEmoji returns a symbol indicator for a given status
@@ -1360,6 +1696,10 @@ func (r *treeRenderer) renderNode(node *types.TreeNode, children map[string][]*t
 		line += ui.RenderWarn(" …")
 	}
 
+	if node.Highlighted {
+		line = ui.RenderAccent(">>> ") + line + ui.RenderAccent(" <<<")
+	}
+
 	fmt.Printf("%s%s\n", prefix.String(), line)
 
 	// Render children
@@ -1428,6 +1768,31 @@ func formatTreeNode(node *types.TreeNode, isBlocked bool) string {
 	return line
 }
 
+// highlightTreeNode marks the nodes for id so renderers can call them out.
+// id may be a full ID or the hash part after the prefix (e.g. "a3f8" for
+// "bd-a3f8"). A node not in the tree only earns a warning; the tree is still
+// shown.
+func highlightTreeNode(tree []*types.TreeNode, id string) {
+	matched := false
+	for _, node := range tree {
+		if node.ID == id {
+			node.Highlighted = true
+			matched = true
+		}
+	}
+	if !matched {
+		for _, node := range tree {
+			if strings.HasSuffix(node.ID, "-"+id) {
+				node.Highlighted = true
+				matched = true
+			}
+		}
+	}
+	if !matched {
+		WarnError("--highlight %s is not in the tree", id)
+	}
+}
+
 // filterTreeByStatus filters the tree to only include nodes with the given status
 // Note: keeps parent chain to maintain tree structure
 func filterTreeByStatus(tree []*types.TreeNode, status types.Status) []*types.TreeNode {
@@ -1484,6 +1849,24 @@ func filterTreeByStatus(tree []*types.TreeNode, status types.Status) []*types.Tr
 	return filtered
 }
 
+// buildDependencyTree walks the dependency tree of fullID in direction
+// (down, up or both). For both, the dependents come first, then the root and
+// its dependencies.
+func buildDependencyTree(ctx context.Context, s storage.DoltStorage, fullID string, maxDepth int, showAllPaths bool, direction string) ([]*types.TreeNode, error) {
+	if direction != "both" {
+		return s.GetDependencyTree(ctx, fullID, maxDepth, showAllPaths, direction == "up")
+	}
+	downTree, err := s.GetDependencyTree(ctx, fullID, maxDepth, showAllPaths, false)
+	if err != nil {
+		return nil, err
+	}
+	upTree, err := s.GetDependencyTree(ctx, fullID, maxDepth, showAllPaths, true)
+	if err != nil {
+		return nil, err
+	}
+	return mergeBidirectionalTrees(downTree, upTree, fullID), nil
+}
+
 // mergeBidirectionalTrees merges up and down trees into a single visualization
 // The root appears once, with dependencies shown below and dependents shown above
 func mergeBidirectionalTrees(downTree, upTree []*types.TreeNode, rootID string) []*types.TreeNode {
@@ -1572,12 +1955,17 @@ func init() {
 	// dep command shorthand flag
 	depCmd.Flags().StringP("blocks", "b", "", "Issue ID that this issue blocks (shorthand for: bd dep add <blocked> <blocker>)")
 	depCmd.Flags().Bool("no-cycle-check", false, "Skip per-edge cycle checks for speed (bulk wiring); bulk --file adds still run one final whole-graph check before commit")
+	depCmd.Flags().Bool("no-warn", false, "Don't warn when the blocker is already closed (for scripts)")
 
 	depAddCmd.Flags().StringP("type", "t", "blocks", "Dependency type (blocks|tracks|related|parent-child|discovered-from|until|caused-by|validates|relates-to|supersedes)")
 	depAddCmd.Flags().String("blocked-by", "", "Issue ID that blocks the first issue (alternative to positional arg)")
 	depAddCmd.Flags().String("depends-on", "", "Issue ID that the first issue depends on (alias for --blocked-by)")
+	depAddCmd.Flags().String("after", "", "Issue ID that must finish before the first issue (alias for --blocked-by)")
+	depAddCmd.Flags().String("before", "", "Issue ID that must wait for the first issue (the first issue blocks it)")
 	depAddCmd.Flags().String("file", "", "Read dependency edges from JSONL file, or '-' for stdin")
 	depAddCmd.Flags().Bool("no-cycle-check", false, "Skip per-edge cycle checks for speed (bulk wiring); bulk --file adds still run one final whole-graph check before commit")
+	depAddCmd.Flags().BoolP("force", "f", false, "Allow the edge to close a cycle (supersedes and duplicates only; ready-gating types are always cycle-checked)")
+	depAddCmd.Flags().Bool("no-warn", false, "Don't warn when the blocker is already closed (for scripts)")
 
 	depTreeCmd.Flags().Bool("show-all-paths", false, "Show all paths to nodes (no deduplication for diamond dependencies)")
 	depTreeCmd.Flags().IntP("max-depth", "d", 50, "Maximum tree depth to display (safety limit)")
@@ -1585,6 +1973,7 @@ func init() {
 	depTreeCmd.Flags().String("direction", "", "Tree direction: 'down' (dependencies), 'up' (dependents), or 'both'")
 	depTreeCmd.Flags().String("status", "", "Filter to only show issues with this status (open, in_progress, blocked, deferred, closed)")
 	depTreeCmd.Flags().String("format", "", "Output format: 'mermaid' for Mermaid.js flowchart")
+	depTreeCmd.Flags().String("highlight", "", "Mark this issue in the tree (>>> id <<< in text, \"highlighted\" in JSON)")
 	// Defensive row cap (be-x42v): applied to TreeNode count after the tree is built.
 	addMaxRowsFlag(depTreeCmd)
 	// Note: --type flag intentionally omitted from depTreeCmd — TreeNode lacks
@@ -1596,6 +1985,8 @@ func init() {
 	// Issue ID completions for dep subcommands
 	depAddCmd.ValidArgsFunction = issueIDCompletion
 	depRemoveCmd.ValidArgsFunction = issueIDCompletion
+	registerDestructiveFlags(depRemoveCmd)
+	registerStrictFlag(depRemoveCmd)
 	depListCmd.ValidArgsFunction = issueIDCompletion
 	depTreeCmd.ValidArgsFunction = issueIDCompletion
 
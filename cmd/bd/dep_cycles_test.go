@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestFormatCyclePath(t *testing.T) {
+	cycle := []*types.Issue{{ID: "bd-1"}, {ID: "bd-2"}, {ID: "bd-3"}}
+	dep := func(from, to string, typ types.DependencyType) *types.Dependency {
+		return &types.Dependency{IssueID: from, DependsOnID: to, Type: typ}
+	}
+	deps := map[string][]*types.Dependency{
+		"bd-1": {dep("bd-1", "bd-2", types.DepRelated), dep("bd-1", "bd-2", types.DepBlocks)},
+		"bd-2": {dep("bd-2", "bd-3", types.DepWaitsFor)},
+		"bd-3": {dep("bd-3", "bd-1", types.DepSupersedes)},
+	}
+	want := "bd-1 --blocks--> bd-2 --waits-for--> bd-3 --supersedes--> bd-1"
+	if got := formatCyclePath(cycle, deps); got != want {
+		t.Errorf("formatCyclePath = %q, want %q", got, want)
+	}
+
+	delete(deps, "bd-3")
+	want = "bd-1 --blocks--> bd-2 --waits-for--> bd-3 --?--> bd-1"
+	if got := formatCyclePath(cycle, deps); got != want {
+		t.Errorf("formatCyclePath with a missing edge = %q, want %q", got, want)
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"strings"
 	"sync"
 	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // bdDep runs "bd dep" with the given args and returns raw stdout.
@@ -101,7 +103,7 @@ func TestEmbeddedDep(t *testing.T) {
 	t.Parallel()
 
 	bd := buildEmbeddedBD(t)
-	dir, _, _ := bdInit(t, bd, "--prefix", "dp")
+	dir, beadsDir, _ := bdInit(t, bd, "--prefix", "dp")
 
 	// Pre-create issues for dependency testing.
 	issueA := bdCreate(t, bd, dir, "Dep issue A", "--type", "task")
@@ -177,6 +179,31 @@ func TestEmbeddedDep(t *testing.T) {
 		}
 	})
 
+	t.Run("add_after_before_flags", func(t *testing.T) {
+		first := bdCreate(t, bd, dir, "Goes first", "--type", "task")
+		second := bdCreate(t, bd, dir, "Goes second", "--type", "task")
+		third := bdCreate(t, bd, dir, "Goes third", "--type", "task")
+
+		// second --after first: second depends on first.
+		bdDep(t, bd, dir, "add", second.ID, "--after", first.ID)
+		assertDepExistsWithType(t, beadsDir, "dp", second.ID, first.ID, "blocks")
+
+		// second --before third: third depends on second.
+		bdDep(t, bd, dir, "add", second.ID, "--before", third.ID)
+		assertDepExistsWithType(t, beadsDir, "dp", third.ID, second.ID, "blocks")
+
+		if out := bdDep(t, bd, dir, "list", second.ID); !strings.Contains(out, first.ID) || strings.Contains(out, third.ID) {
+			t.Errorf("second should depend only on first:\n%s", out)
+		}
+
+		out := bdDepFail(t, bd, dir, "add", second.ID, "--after", first.ID, "--type", "related")
+		if !strings.Contains(out, "blocks") {
+			t.Errorf("--after with --type related should be rejected: %s", out)
+		}
+		bdDepFail(t, bd, dir, "add", second.ID, "--after", first.ID, "--before", third.ID)
+		bdDepFail(t, bd, dir, "add", second.ID, third.ID, "--before", first.ID)
+	})
+
 	t.Run("add_cycle_rejected", func(t *testing.T) {
 		// A->B already exists, add B->A to create cycle — should be rejected
 		cyA := bdCreate(t, bd, dir, "Cycle A", "--type", "task")
@@ -188,6 +215,37 @@ func TestEmbeddedDep(t *testing.T) {
 		}
 	})
 
+	t.Run("add_force_cycle", func(t *testing.T) {
+		// related is not cycle-checked; --force is accepted and changes nothing.
+		r1 := bdCreate(t, bd, dir, "Force related 1", "--type", "task")
+		r2 := bdCreate(t, bd, dir, "Force related 2", "--type", "task")
+		bdDep(t, bd, dir, "add", r1.ID, r2.ID, "--type", "related")
+		bdDep(t, bd, dir, "add", r2.ID, r1.ID, "--type", "related", "--force")
+
+		// supersedes is cycle-checked but does not gate readiness: --force
+		// allows the loop.
+		s1 := bdCreate(t, bd, dir, "Force supersedes 1", "--type", "task")
+		s2 := bdCreate(t, bd, dir, "Force supersedes 2", "--type", "task")
+		bdDep(t, bd, dir, "add", s1.ID, s2.ID, "--type", "supersedes")
+		if out := bdDepFail(t, bd, dir, "add", s2.ID, s1.ID, "--type", "supersedes"); !strings.Contains(out, "cycle") {
+			t.Errorf("supersedes loop without --force should be refused: %s", out)
+		}
+		bdDep(t, bd, dir, "add", s2.ID, s1.ID, "--type", "supersedes", "--force")
+		assertDepExistsWithType(t, beadsDir, "dp", s2.ID, s1.ID, "supersedes")
+
+		// blocks gates readiness: --force never allows the loop.
+		b1 := bdCreate(t, bd, dir, "Force blocks 1", "--type", "task")
+		b2 := bdCreate(t, bd, dir, "Force blocks 2", "--type", "task")
+		bdDep(t, bd, dir, "add", b1.ID, b2.ID)
+		out := bdDepFail(t, bd, dir, "add", b2.ID, b1.ID, "--force")
+		if !strings.Contains(out, "--force cannot allow a cycle of blocks dependencies") {
+			t.Errorf("expected --force to be refused for blocks: %s", out)
+		}
+		if out := bdDep(t, bd, dir, "list", b2.ID); strings.Contains(out, b1.ID) {
+			t.Errorf("refused blocks edge should not be added:\n%s", out)
+		}
+	})
+
 	t.Run("add_child_parent_antipattern", func(t *testing.T) {
 		p := bdCreate(t, bd, dir, "AP Parent", "--type", "epic")
 		// Create child with hierarchical ID
@@ -206,6 +264,50 @@ func TestEmbeddedDep(t *testing.T) {
 		}
 	})
 
+	t.Run("add_closed_blocker_warns", func(t *testing.T) {
+		dependent := bdCreate(t, bd, dir, "Closed blocker dependent", "--type", "task")
+		done := bdCreate(t, bd, dir, "Closed blocker", "--type", "task")
+		bdClose(t, bd, dir, done.ID)
+
+		depRun := func(args ...string) (string, string) {
+			t.Helper()
+			cmd := exec.Command(bd, append([]string{"dep"}, args...)...)
+			cmd.Dir = dir
+			cmd.Env = bdEnv(dir)
+			stdout, stderr, err := runCommandBuffers(t, cmd)
+			if err != nil {
+				t.Fatalf("bd dep %s failed: %v\nstdout:\n%s\nstderr:\n%s", strings.Join(args, " "), err, stdout.String(), stderr.String())
+			}
+			return stdout.String(), stderr.String()
+		}
+		const warning = "is closed, so this dependency has no effect now"
+
+		stdout, stderr := depRun("add", dependent.ID, done.ID, "--json")
+		if !strings.Contains(stderr, warning) || !strings.Contains(stderr, done.ID) {
+			t.Errorf("expected a closed-blocker warning on stderr, got:\n%s", stderr)
+		}
+		var m map[string]interface{}
+		if err := json.Unmarshal([]byte(stdout), &m); err != nil || m["status"] != "added" {
+			t.Errorf("the warning must not disturb --json stdout: %v\n%s", err, stdout)
+		}
+
+		other := bdCreate(t, bd, dir, "Closed blocker quiet", "--type", "task")
+		if _, stderr := depRun("add", other.ID, done.ID, "--no-warn"); strings.Contains(stderr, warning) {
+			t.Errorf("--no-warn should suppress the warning, got:\n%s", stderr)
+		}
+		if _, stderr := depRun("add", other.ID, dependent.ID); strings.Contains(stderr, warning) {
+			t.Errorf("an open blocker should not warn, got:\n%s", stderr)
+		}
+		related := bdCreate(t, bd, dir, "Closed related", "--type", "task")
+		if _, stderr := depRun("add", related.ID, done.ID, "--type", "related"); strings.Contains(stderr, warning) {
+			t.Errorf("a non-blocking type should not warn, got:\n%s", stderr)
+		}
+		blocked := bdCreate(t, bd, dir, "Closed blocker shorthand", "--type", "task")
+		if _, stderr := depRun(done.ID, "--blocks", blocked.ID); !strings.Contains(stderr, warning) {
+			t.Errorf("bd dep --blocks from a closed blocker should warn, got:\n%s", stderr)
+		}
+	})
+
 	t.Run("add_bulk_file_jsonl", func(t *testing.T) {
 		b1 := bdCreate(t, bd, dir, "Bulk dep A", "--type", "task")
 		b2 := bdCreate(t, bd, dir, "Bulk dep B", "--type", "task")
@@ -281,6 +383,66 @@ func TestEmbeddedDep(t *testing.T) {
 		}
 	})
 
+	t.Run("remove_many_requires_yes", func(t *testing.T) {
+		from := bdCreate(t, bd, dir, "RmMany From", "--type", "task")
+		to1 := bdCreate(t, bd, dir, "RmMany To 1", "--type", "task")
+		to2 := bdCreate(t, bd, dir, "RmMany To 2", "--type", "task")
+		bdDep(t, bd, dir, "add", from.ID, to1.ID)
+		bdDep(t, bd, dir, "add", from.ID, to2.ID)
+
+		// The test subprocess has no terminal, so there is no one to ask.
+		out := bdDepFail(t, bd, dir, "rm", from.ID, to1.ID, to2.ID)
+		if !strings.Contains(out, "--yes") {
+			t.Errorf("expected a hint to re-run with --yes: %s", out)
+		}
+		bdDepFail(t, bd, dir, "rm", from.ID, to1.ID, to2.ID, "--json")
+		out = bdDep(t, bd, dir, "rm", from.ID, to1.ID, to2.ID, "--dry-run")
+		if strings.Count(out, "Would remove dependency") != 2 {
+			t.Errorf("expected two dry-run lines: %s", out)
+		}
+		if out := bdDep(t, bd, dir, "list", from.ID, "--json"); !strings.Contains(out, to1.ID) || !strings.Contains(out, to2.ID) {
+			t.Fatalf("dependencies should survive a refused remove: %s", out)
+		}
+
+		out = bdDep(t, bd, dir, "rm", from.ID, to1.ID, to2.ID, "--yes")
+		if strings.Count(out, "Removed dependency") != 2 {
+			t.Errorf("expected two removals: %s", out)
+		}
+		if out := bdDep(t, bd, dir, "list", from.ID, "--json"); strings.Contains(out, to1.ID) || strings.Contains(out, to2.ID) {
+			t.Errorf("dependencies should be gone after remove --yes: %s", out)
+		}
+	})
+
+	t.Run("remove_nonexistent_warns", func(t *testing.T) {
+		from := bdCreate(t, bd, dir, "RmMissing From", "--type", "task")
+		to1 := bdCreate(t, bd, dir, "RmMissing To 1", "--type", "task")
+		to2 := bdCreate(t, bd, dir, "RmMissing To 2", "--type", "task")
+		bdDep(t, bd, dir, "add", from.ID, to1.ID)
+
+		out := bdDepFail(t, bd, dir, "rm", from.ID, to2.ID, "--strict")
+		if !strings.Contains(out, "no such dependency to remove") {
+			t.Errorf("expected --strict to name the missing dependency: %s", out)
+		}
+		bdDepFail(t, bd, dir, "rm", from.ID, to1.ID, to2.ID, "--yes", "--strict")
+		if out := bdDep(t, bd, dir, "list", from.ID, "--json"); !strings.Contains(out, to1.ID) {
+			t.Fatalf("--strict should roll back the removals that matched: %s", out)
+		}
+
+		cmd := exec.Command(bd, "dep", "rm", from.ID, to1.ID, to2.ID, "--yes")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd dep rm failed: %v\nstderr:\n%s", err, stderr.String())
+		}
+		if !strings.Contains(stderr.String(), "no such dependency to remove") || !strings.Contains(stderr.String(), to2.ID) {
+			t.Errorf("expected a warning for %s: %s", to2.ID, stderr.String())
+		}
+		if strings.Count(stdout.String(), "Removed dependency") != 1 {
+			t.Errorf("expected only the real dependency to be reported removed: %s", stdout.String())
+		}
+	})
+
 	// ===== dep list =====
 
 	t.Run("list_default_direction_down", func(t *testing.T) {
@@ -363,6 +525,93 @@ func TestEmbeddedDep(t *testing.T) {
 		}
 	})
 
+	t.Run("graph_mermaid", func(t *testing.T) {
+		root := bdCreate(t, bd, dir, "Graph root", "--type", "task")
+		blocker := bdCreate(t, bd, dir, "Graph blocker", "--type", "task")
+		tracked := bdCreate(t, bd, dir, "Graph tracked", "--type", "task")
+		dependent := bdCreate(t, bd, dir, "Graph dependent", "--type", "task")
+		bdDep(t, bd, dir, "add", root.ID, blocker.ID, "--type", "blocks")
+		bdDep(t, bd, dir, "add", root.ID, tracked.ID, "--type", "tracks")
+		bdDep(t, bd, dir, "add", dependent.ID, root.ID, "--type", "blocks")
+		cmd := exec.Command(bd, "close", blocker.ID)
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("close %s: %v\n%s", blocker.ID, err, out)
+		}
+
+		out := bdDep(t, bd, dir, "graph", root.ID, "--format", "mermaid")
+		if !strings.HasPrefix(out, "flowchart TD\n") {
+			t.Fatalf("expected a flowchart TD block: %s", out)
+		}
+		for _, issue := range []*types.Issue{root, blocker, tracked, dependent} {
+			if !strings.Contains(out, issue.ID+": "+issue.Title) {
+				t.Errorf("expected node for %s: %s", issue.ID, out)
+			}
+		}
+		if strings.Count(out, `==>|"blocks"|`) != 2 || strings.Count(out, `-.->|"tracks"|`) != 1 {
+			t.Errorf("expected two thick blocks edges and one dotted tracks edge: %s", out)
+		}
+		if !strings.Contains(out, "☑ "+blocker.ID) {
+			t.Errorf("expected the closed blocker to show the closed status symbol: %s", out)
+		}
+		if !strings.Contains(out, "style "+root.ID+" stroke-width:4px") {
+			t.Errorf("expected the requested issue to be highlighted: %s", out)
+		}
+
+		down := bdDep(t, bd, dir, "graph", root.ID, "--direction", "down")
+		if strings.Contains(down, dependent.ID) {
+			t.Errorf("--direction down should leave out dependents: %s", down)
+		}
+		shallow := bdDep(t, bd, dir, "graph", root.ID, "--max-depth", "1")
+		if strings.Contains(shallow, blocker.ID) || strings.Contains(shallow, "==>") {
+			t.Errorf("--max-depth 1 should show only the root: %s", shallow)
+		}
+	})
+
+	t.Run("export_dot", func(t *testing.T) {
+		exDir, _, _ := bdInit(t, bd, "--prefix", "dx")
+		epic := bdCreate(t, bd, exDir, "Export epic", "--type", "epic")
+		child := bdCreate(t, bd, exDir, "Export child", "--parent", epic.ID)
+		blocker := bdCreate(t, bd, exDir, "Export blocker")
+		related := bdCreate(t, bd, exDir, "Export related")
+		done := bdCreate(t, bd, exDir, "Export done")
+		bdDep(t, bd, exDir, "add", child.ID, blocker.ID)
+		bdDep(t, bd, exDir, "add", child.ID, related.ID, "--type", "related")
+		bdDep(t, bd, exDir, "add", blocker.ID, done.ID)
+		bdClose(t, bd, exDir, done.ID)
+
+		out := bdDep(t, bd, exDir, "export", "--format", "dot")
+		if !strings.HasPrefix(out, "digraph dependencies {") {
+			t.Fatalf("expected a DOT digraph: %s", out)
+		}
+		for _, want := range []string{
+			fmt.Sprintf("%q [label=%q", child.ID, child.ID+": Export child"),
+			fmt.Sprintf("%q -> %q [label=\"blocks\"", child.ID, blocker.ID),
+			fmt.Sprintf("%q -> %q [label=\"related\"", child.ID, related.ID),
+			fmt.Sprintf("%q -> %q [label=\"parent-child\"", child.ID, epic.ID),
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("export missing %s:\n%s", want, out)
+			}
+		}
+		if strings.Contains(out, done.ID) {
+			t.Errorf("closed issues should be left out of the export:\n%s", out)
+		}
+
+		blocks := bdDep(t, bd, exDir, "export", "--only", "blocks", "--cluster-by", "parent")
+		if strings.Contains(blocks, `"related"`) || !strings.Contains(blocks, `"blocks"`) {
+			t.Errorf("--only blocks should drop the related edge:\n%s", blocks)
+		}
+		if !strings.Contains(blocks, fmt.Sprintf("subgraph %q {", "cluster_"+epic.ID)) {
+			t.Errorf("--cluster-by parent should draw the epic as a cluster:\n%s", blocks)
+		}
+
+		if out := bdDepFail(t, bd, exDir, "export", "--only", "tracks"); !strings.Contains(out, "--only") {
+			t.Errorf("unsupported --only should be rejected: %s", out)
+		}
+	})
+
 	t.Run("tree_json_output", func(t *testing.T) {
 		fullArgs := []string{"dep", "tree", epic.ID, "--json"}
 		cmd := exec.Command(bd, fullArgs...)
@@ -416,6 +665,18 @@ func TestEmbeddedDep(t *testing.T) {
 		}
 	})
 
+	t.Run("cycles_reports_forced_supersedes_loop", func(t *testing.T) {
+		dir3, _, _ := bdInit(t, bd, "--prefix", "dp3")
+		s1 := bdCreate(t, bd, dir3, "Forced loop 1", "--type", "task")
+		s2 := bdCreate(t, bd, dir3, "Forced loop 2", "--type", "task")
+		bdDep(t, bd, dir3, "add", s1.ID, s2.ID, "--type", "supersedes")
+		bdDep(t, bd, dir3, "add", s2.ID, s1.ID, "--type", "supersedes", "--force")
+		out := bdDep(t, bd, dir3, "cycles")
+		if strings.Contains(out, "No dependency cycles detected") || !strings.Contains(out, s1.ID) || !strings.Contains(out, s2.ID) {
+			t.Errorf("a forced supersedes loop should still be reported as a cycle: %s", out)
+		}
+	})
+
 	t.Run("cycles_no_cycles", func(t *testing.T) {
 		// Fresh init with no cycles
 		dir2, _, _ := bdInit(t, bd, "--prefix", "dp2")
@@ -576,3 +837,72 @@ func TestEmbeddedDepBulkNoCycleCheckKeepsWholeGraphGate(t *testing.T) {
 		t.Fatalf("expected rolled-back bulk add to leave the graph acyclic, got: %s", cycles)
 	}
 }
+
+// TestEmbeddedDepVerify seeds relations that lost their pair and checks that
+// bd dep verify reports each one, and passes once they are repaired.
+func TestEmbeddedDepVerify(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+	dir, _, _ := bdInit(t, bd, "--prefix", "dv")
+
+	a := bdCreate(t, bd, dir, "Verify A", "--type", "task")
+	b := bdCreate(t, bd, dir, "Verify B", "--type", "task")
+	c := bdCreate(t, bd, dir, "Verify C", "--type", "task")
+	oldIssue := bdCreate(t, bd, dir, "Verify old", "--type", "task")
+	newIssue := bdCreate(t, bd, dir, "Verify new", "--type", "task")
+
+	// bd relate records both sides; bd dep add only the one it is given.
+	bdDep(t, bd, dir, "relate", a.ID, b.ID)
+	if out := bdDep(t, bd, dir, "verify"); !strings.Contains(out, "No inconsistent relations") {
+		t.Fatalf("expected a clean graph after bd relate: %s", out)
+	}
+	bdDep(t, bd, dir, "add", a.ID, c.ID, "--type", "relates-to")
+	bdSupersede(t, bd, dir, oldIssue.ID, "--with", newIssue.ID, "--keep-open")
+
+	cmd := exec.Command(bd, "dep", "verify", "--json")
+	cmd.Dir = dir
+	cmd.Env = bdEnv(dir)
+	stdout, stderr, err := runCommandBuffers(t, cmd)
+	if err == nil {
+		t.Fatalf("expected bd dep verify to fail on seeded asymmetries:\n%s", stdout.String())
+	}
+	var result struct {
+		OK       bool `json:"ok"`
+		Problems []struct {
+			Kind     string `json:"kind"`
+			IssueID  string `json:"issue_id"`
+			TargetID string `json:"target_id"`
+		} `json:"problems"`
+	}
+	s := stdout.String()
+	start := strings.Index(s, "{")
+	if start < 0 {
+		t.Fatalf("no JSON in dep verify output: %s\nstderr: %s", s, stderr.String())
+	}
+	if err := json.Unmarshal([]byte(s[start:]), &result); err != nil {
+		t.Fatalf("parse dep verify JSON: %v\n%s", err, s)
+	}
+	found := map[string]string{}
+	for _, p := range result.Problems {
+		found[p.Kind] = p.IssueID + "->" + p.TargetID
+	}
+	if result.OK || len(result.Problems) != 2 {
+		t.Errorf("expected two problems, got %+v", result)
+	}
+	if found["asymmetric_related"] != a.ID+"->"+c.ID {
+		t.Errorf("one-sided relates-to not reported: %+v", result.Problems)
+	}
+	if found["superseded_not_closed"] != oldIssue.ID+"->"+newIssue.ID {
+		t.Errorf("open superseded issue not reported: %+v", result.Problems)
+	}
+
+	bdDep(t, bd, dir, "add", c.ID, a.ID, "--type", "relates-to")
+	bdClose(t, bd, dir, oldIssue.ID)
+	if out := bdDep(t, bd, dir, "verify"); !strings.Contains(out, "No inconsistent relations") {
+		t.Errorf("expected a clean graph after repairing both relations: %s", out)
+	}
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+var depExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the dependency graph of all open issues as GraphViz DOT",
+	Long: `Export every open issue and the dependencies between them as a GraphViz
+DOT digraph, for rendering the whole project graph in architecture reviews.
+
+Nodes are labeled "id: title" and colored by status. Edges point from an issue
+to the issue it depends on and are colored and styled by dependency type, the
+same way bd list --format dot draws them. Dependencies on closed issues are
+left out, since they no longer constrain anything.
+
+  --only blocks        Keep only the edges that affect readiness (blocks,
+                       conditional-blocks, waits-for, parent-child), the ones
+                       bd ready evaluates
+  --cluster-by parent  Draw each epic (any issue with children) as a DOT
+                       cluster holding its children; nested epics nest
+
+Examples:
+  bd dep export --format dot > deps.dot && dot -Tsvg deps.dot > deps.svg
+  bd dep export --only blocks --cluster-by parent | dot -Tpng > blockers.png`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		evt := metrics.NewCommandEvent("dep-export")
+		defer func() {
+			if c := metrics.Global(); c != nil {
+				c.CloseEventAndAdd(evt)
+			}
+		}()
+
+		opts, err := depExportOptions(cmd)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+
+		if usesProxiedServer() {
+			return runDepExportProxiedServer(rootCtx, opts)
+		}
+
+		ctx := rootCtx
+		issues, err := store.SearchIssues(ctx, "", depExportFilter())
+		if err != nil {
+			return HandleErrorRespectJSON("loading issues: %v", err)
+		}
+		ids := make([]string, len(issues))
+		for i, issue := range issues {
+			ids[i] = issue.ID
+		}
+		deps, err := store.GetDependencyRecordsForIssues(ctx, ids)
+		if err != nil {
+			return HandleErrorRespectJSON("loading dependencies: %v", err)
+		}
+		return writeDotDepGraph(os.Stdout, issues, deps, opts)
+	},
+}
+
+// dotExportOptions is the validated flag set of bd dep export.
+type dotExportOptions struct {
+	onlyBlocking    bool // --only blocks
+	clusterByParent bool // --cluster-by parent
+}
+
+func depExportOptions(cmd *cobra.Command) (dotExportOptions, error) {
+	var opts dotExportOptions
+	if format, _ := cmd.Flags().GetString("format"); !strings.EqualFold(format, "dot") {
+		return opts, fmt.Errorf("unsupported --format %q (supported: dot)", format)
+	}
+	if jsonOutput {
+		return opts, fmt.Errorf("bd dep export emits DOT and has no --json form")
+	}
+	switch only, _ := cmd.Flags().GetString("only"); only {
+	case "":
+	case "blocks":
+		opts.onlyBlocking = true
+	default:
+		return opts, fmt.Errorf("unsupported --only %q (supported: blocks)", only)
+	}
+	switch by, _ := cmd.Flags().GetString("cluster-by"); by {
+	case "":
+	case "parent":
+		opts.clusterByParent = true
+	default:
+		return opts, fmt.Errorf("unsupported --cluster-by %q (supported: parent)", by)
+	}
+	return opts, nil
+}
+
+// depExportFilter selects the issues bd dep export draws: everything not closed.
+func depExportFilter() types.IssueFilter {
+	return types.IssueFilter{ExcludeStatus: []types.Status{types.StatusClosed}}
+}
+
+// writeDotDepGraph writes issues and the dependencies among them as a DOT
+// digraph. Dependencies whose target is not in issues are dropped.
+func writeDotDepGraph(w io.Writer, issues []*types.Issue, deps map[string][]*types.Dependency, opts dotExportOptions) error {
+	inSet := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		inSet[issue.ID] = true
+	}
+
+	var edges []*types.Dependency
+	parentOf := make(map[string]string)
+	children := make(map[string][]string)
+	for _, issue := range issues {
+		issueDeps := slices.Clone(deps[issue.ID])
+		slices.SortFunc(issueDeps, func(a, b *types.Dependency) int { return strings.Compare(a.DependsOnID, b.DependsOnID) })
+		for _, dep := range issueDeps {
+			if !inSet[dep.DependsOnID] || dep.DependsOnID == issue.ID {
+				continue
+			}
+			if dep.Type == types.DepParentChild && parentOf[issue.ID] == "" {
+				parentOf[issue.ID] = dep.DependsOnID
+				children[dep.DependsOnID] = append(children[dep.DependsOnID], issue.ID)
+			}
+			if opts.onlyBlocking && !dep.Type.AffectsReadyWork() {
+				continue
+			}
+			edges = append(edges, dep)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph dependencies {\n")
+	b.WriteString("  rankdir=TB;\n")
+	b.WriteString("  node [shape=box, style=rounded];\n\n")
+
+	byID := make(map[string]*types.Issue, len(issues))
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+	writeNode := func(issue *types.Issue, indent string) {
+		fillColor, fontColor := dotStatusColors(issue.Status)
+		fmt.Fprintf(&b, "%s%q [label=%q, style=\"rounded,filled\", fillcolor=%q, fontcolor=%q];\n",
+			indent, issue.ID, issue.ID+": "+issue.Title, fillColor, fontColor)
+	}
+	if !opts.clusterByParent {
+		for _, issue := range issues {
+			writeNode(issue, "  ")
+		}
+	} else {
+		// Each node is written once: a parent-child cycle is drawn from the
+		// first member reached.
+		written := make(map[string]bool, len(issues))
+		var writeTree func(id, indent string)
+		writeTree = func(id, indent string) {
+			if written[id] {
+				return
+			}
+			written[id] = true
+			issue := byID[id]
+			if len(children[id]) == 0 {
+				writeNode(issue, indent)
+				return
+			}
+			fmt.Fprintf(&b, "%ssubgraph %q {\n", indent, "cluster_"+id)
+			fmt.Fprintf(&b, "%s  label=%q;\n", indent, issue.ID+": "+issue.Title)
+			fmt.Fprintf(&b, "%s  style=dashed;\n", indent)
+			writeNode(issue, indent+"  ")
+			for _, child := range children[id] {
+				writeTree(child, indent+"  ")
+			}
+			fmt.Fprintf(&b, "%s}\n", indent)
+		}
+		for _, issue := range issues {
+			if parentOf[issue.ID] == "" {
+				writeTree(issue.ID, "  ")
+			}
+		}
+		for _, issue := range issues {
+			writeTree(issue.ID, "  ")
+		}
+	}
+	b.WriteString("\n")
+
+	for _, dep := range edges {
+		color, style := dotDepEdgeAttrs(dep.Type)
+		fmt.Fprintf(&b, "  %q -> %q [label=%q, color=%s, style=%s];\n",
+			dep.IssueID, dep.DependsOnID, dep.Type, color, style)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func init() {
+	depExportCmd.Flags().String("format", "dot", "Output format (dot)")
+	depExportCmd.Flags().String("only", "", "Restrict edges: 'blocks' keeps only readiness-affecting dependencies")
+	depExportCmd.Flags().String("cluster-by", "", "Group nodes: 'parent' draws each epic as a cluster of its children")
+	depCmd.AddCommand(depExportCmd)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestWriteDotDepGraph(t *testing.T) {
+	issues := []*types.Issue{
+		{ID: "bd-1", Title: "Epic", Status: types.StatusOpen},
+		{ID: "bd-2", Title: `Child "one"`, Status: types.StatusInProgress},
+		{ID: "bd-3", Title: "Sub epic", Status: types.StatusOpen},
+		{ID: "bd-4", Title: "Grandchild", Status: types.StatusBlocked},
+		{ID: "bd-5", Title: "Loose", Status: types.StatusOpen},
+	}
+	dep := func(from, to string, typ types.DependencyType) *types.Dependency {
+		return &types.Dependency{IssueID: from, DependsOnID: to, Type: typ}
+	}
+	deps := map[string][]*types.Dependency{
+		"bd-2": {dep("bd-2", "bd-1", types.DepParentChild), dep("bd-2", "bd-5", types.DepBlocks)},
+		"bd-3": {dep("bd-3", "bd-1", types.DepParentChild)},
+		"bd-4": {dep("bd-4", "bd-3", types.DepParentChild), dep("bd-4", "bd-9", types.DepBlocks)},
+		"bd-5": {dep("bd-5", "bd-2", types.DepRelated)},
+	}
+	render := func(opts dotExportOptions) string {
+		var buf bytes.Buffer
+		if err := writeDotDepGraph(&buf, issues, deps, opts); err != nil {
+			t.Fatalf("writeDotDepGraph: %v", err)
+		}
+		return buf.String()
+	}
+
+	out := render(dotExportOptions{})
+	for _, want := range []string{
+		"digraph dependencies {\n",
+		`  "bd-2" [label="bd-2: Child \"one\"", style="rounded,filled", fillcolor="lightyellow", fontcolor="black"];`,
+		`  "bd-4" [label="bd-4: Grandchild", style="rounded,filled", fillcolor="lightcoral", fontcolor="black"];`,
+		`  "bd-2" -> "bd-5" [label="blocks", color=red, style=bold];`,
+		`  "bd-2" -> "bd-1" [label="parent-child", color=blue, style=solid];`,
+		`  "bd-5" -> "bd-2" [label="related", color=gray, style=dashed];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "bd-9") || strings.Contains(out, "subgraph") {
+		t.Errorf("edges to issues outside the export and unrequested clusters should not appear:\n%s", out)
+	}
+
+	blocksOnly := render(dotExportOptions{onlyBlocking: true})
+	if strings.Contains(blocksOnly, `"related"`) || !strings.Contains(blocksOnly, `"blocks"`) || !strings.Contains(blocksOnly, `"parent-child"`) {
+		t.Errorf("--only blocks should keep only readiness-affecting edges:\n%s", blocksOnly)
+	}
+
+	clustered := render(dotExportOptions{clusterByParent: true})
+	outer := strings.Index(clustered, `subgraph "cluster_bd-1" {`)
+	inner := strings.Index(clustered, `    subgraph "cluster_bd-3" {`)
+	grandchild := strings.Index(clustered, `      "bd-4" [`)
+	if outer < 0 || inner < outer || grandchild < inner {
+		t.Errorf("expected bd-4 nested in cluster bd-3 nested in cluster bd-1:\n%s", clustered)
+	}
+	if strings.Count(clustered, `"bd-4" [label`) != 1 || !strings.Contains(clustered, "\n  \"bd-5\" [label") {
+		t.Errorf("each node should be written once, unparented ones at top level:\n%s", clustered)
+	}
+}
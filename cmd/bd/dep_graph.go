@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+var depGraphCmd = &cobra.Command{
+	Use:   "graph <issue-id>",
+	Short: "Export the dependency graph of an issue as Mermaid",
+	Long: `Export the issue and its transitive dependencies and dependents as a
+Mermaid "flowchart TD" block, ready to paste into Markdown. Nodes are drawn
+as bd dep tree --format mermaid draws them, with the requested issue
+highlighted.
+
+Edges point from the prerequisite to the issue that waits on it and are
+labeled with the dependency type. Blocking edges (blocks, conditional-blocks,
+waits-for) are drawn thick; other edges are dotted.
+
+The walk is the one bd dep tree uses; every edge between two issues it
+reaches is drawn, so diamonds show all their paths. relates-to links are not
+part of the walk and are left out.
+
+Examples:
+  bd dep graph bd-42                      # Dependencies and dependents
+  bd dep graph bd-42 --direction down     # Only what bd-42 waits on
+  bd dep graph bd-42 --max-depth 2 > deps.mmd`,
+	Args:          cobra.ExactArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		evt := metrics.NewCommandEvent("dep-graph")
+		defer func() {
+			if c := metrics.Global(); c != nil {
+				c.CloseEventAndAdd(evt)
+			}
+		}()
+
+		maxDepth, direction, err := depGraphOptions(cmd)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+
+		if usesProxiedServer() {
+			return runDepGraphProxiedServer(rootCtx, args[0], maxDepth, direction)
+		}
+
+		ctx := rootCtx
+		fullID, graphStore, cleanup, err := resolveIDWithRouting(ctx, store, args[0])
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		defer cleanup()
+
+		tree, err := buildDependencyTree(ctx, graphStore, fullID, maxDepth, false, direction)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		records, err := graphStore.GetDependencyRecordsForIssues(ctx, treeNodeIDs(tree))
+		if err != nil {
+			return HandleErrorRespectJSON("loading dependencies: %v", err)
+		}
+		return writeMermaidDepGraph(os.Stdout, newDependencyGraph(fullID, tree, records))
+	},
+}
+
+// depGraphOptions validates the bd dep graph flags.
+func depGraphOptions(cmd *cobra.Command) (maxDepth int, direction string, err error) {
+	if format, _ := cmd.Flags().GetString("format"); !strings.EqualFold(format, "mermaid") {
+		return 0, "", fmt.Errorf("unsupported --format %q (supported: mermaid)", format)
+	}
+	if jsonOutput {
+		return 0, "", fmt.Errorf("bd dep graph emits Mermaid; use bd dep tree --json for JSON")
+	}
+	maxDepth, _ = cmd.Flags().GetInt("max-depth")
+	if maxDepth < 1 {
+		return 0, "", fmt.Errorf("--max-depth must be >= 1")
+	}
+	direction, _ = cmd.Flags().GetString("direction")
+	if direction != "down" && direction != "up" && direction != "both" {
+		return 0, "", fmt.Errorf("--direction must be 'down', 'up', or 'both'")
+	}
+	return maxDepth, direction, nil
+}
+
+// dependencyGraph is the issue set of a dependency walk together with every
+// dependency between two of its issues.
+type dependencyGraph struct {
+	rootID string
+	nodes  []*types.Issue      // walk order, each issue once
+	edges  []*types.Dependency // sorted by endpoints, then type
+}
+
+func treeNodeIDs(tree []*types.TreeNode) []string {
+	ids := make([]string, 0, len(tree))
+	seen := make(map[string]bool, len(tree))
+	for _, node := range tree {
+		if !seen[node.ID] {
+			seen[node.ID] = true
+			ids = append(ids, node.ID)
+		}
+	}
+	return ids
+}
+
+// newDependencyGraph keeps the records whose endpoints are both in tree,
+// skipping relates-to links just as the tree walk does.
+func newDependencyGraph(rootID string, tree []*types.TreeNode, records map[string][]*types.Dependency) *dependencyGraph {
+	g := &dependencyGraph{rootID: rootID}
+	inTree := make(map[string]bool, len(tree))
+	for _, node := range tree {
+		if !inTree[node.ID] {
+			inTree[node.ID] = true
+			g.nodes = append(g.nodes, &node.Issue)
+		}
+	}
+	type edgeKey struct {
+		from, to string
+		typ      types.DependencyType
+	}
+	seen := make(map[edgeKey]bool)
+	for _, deps := range records {
+		for _, dep := range deps {
+			key := edgeKey{dep.IssueID, dep.DependsOnID, dep.Type}
+			if dep.Type == types.DepRelatesTo || seen[key] || !inTree[dep.IssueID] || !inTree[dep.DependsOnID] {
+				continue
+			}
+			seen[key] = true
+			g.edges = append(g.edges, dep)
+		}
+	}
+	slices.SortFunc(g.edges, func(a, b *types.Dependency) int {
+		if c := strings.Compare(a.DependsOnID, b.DependsOnID); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.IssueID, b.IssueID); c != 0 {
+			return c
+		}
+		return strings.Compare(string(a.Type), string(b.Type))
+	})
+	return g
+}
+
+// writeMermaidDepGraph writes g with the shared Mermaid flowchart writer.
+// Each edge runs from the prerequisite to the issue waiting on it, labeled
+// with its dependency type: thick for blocking types, dotted otherwise.
+func writeMermaidDepGraph(w io.Writer, g *dependencyGraph) error {
+	edges := make([]mermaidEdge, 0, len(g.edges))
+	for _, dep := range g.edges {
+		blocking := dep.Type.IsBlockingEdge()
+		edges = append(edges, mermaidEdge{
+			from:   dep.DependsOnID,
+			to:     dep.IssueID,
+			label:  string(dep.Type),
+			thick:  blocking,
+			dotted: !blocking,
+		})
+	}
+	return writeMermaidFlowchart(w, g.nodes, map[string]bool{g.rootID: true}, edges)
+}
+
+func init() {
+	depGraphCmd.Flags().String("format", "mermaid", "Output format (mermaid)")
+	depGraphCmd.Flags().IntP("max-depth", "d", 50, "Maximum walk depth from the issue")
+	depGraphCmd.Flags().String("direction", "both", "Walk direction: 'down' (dependencies), 'up' (dependents), or 'both'")
+	depGraphCmd.ValidArgsFunction = issueIDCompletion
+	depCmd.AddCommand(depGraphCmd)
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestWriteMermaidDepGraph(t *testing.T) {
+	node := func(id, title string, status types.Status, parent string) *types.TreeNode {
+		return &types.TreeNode{Issue: types.Issue{ID: id, Title: title, Status: status}, ParentID: parent}
+	}
+	// bd-1 waits on bd-2 and bd-3, both of which wait on bd-4 (a diamond the
+	// tree walk reaches through bd-2 only). bd-5 is a child of bd-1.
+	tree := []*types.TreeNode{
+		node("bd-5", "Child", types.StatusOpen, "bd-1"),
+		node("bd-1", `Say "hi"`, types.StatusOpen, ""),
+		node("bd-2", "Left", types.StatusClosed, "bd-1"),
+		node("bd-4", "Base", types.StatusOpen, "bd-2"),
+		node("bd-3", "Right", types.StatusOpen, "bd-1"),
+	}
+	dep := func(from, to string, typ types.DependencyType) *types.Dependency {
+		return &types.Dependency{IssueID: from, DependsOnID: to, Type: typ}
+	}
+	records := map[string][]*types.Dependency{
+		"bd-1": {dep("bd-1", "bd-2", types.DepBlocks), dep("bd-1", "bd-3", types.DepWaitsFor), dep("bd-1", "bd-9", types.DepBlocks)},
+		"bd-2": {dep("bd-2", "bd-4", types.DepBlocks)},
+		"bd-3": {dep("bd-3", "bd-4", types.DepTracks), dep("bd-3", "bd-4", types.DepBlocks), dep("bd-3", "bd-1", types.DepRelatesTo)},
+		"bd-5": {dep("bd-5", "bd-1", types.DepParentChild)},
+	}
+
+	var buf bytes.Buffer
+	if err := writeMermaidDepGraph(&buf, newDependencyGraph("bd-1", tree, records)); err != nil {
+		t.Fatalf("writeMermaidDepGraph: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"flowchart TD\n",
+		`  bd-1["☐ bd-1: Say \"hi\""]`,
+		"  style bd-1 stroke-width:4px\n",
+		`  bd-2["☑ bd-2: Left"]`,
+		`  bd-1 -.->|"parent-child"| bd-5`,
+		`  bd-2 ==>|"blocks"| bd-1`,
+		`  bd-3 ==>|"waits-for"| bd-1`,
+		`  bd-4 ==>|"blocks"| bd-2`,
+		// Two dependency types between the same pair are both drawn.
+		`  bd-4 ==>|"blocks"| bd-3`,
+		`  bd-4 -.->|"tracks"| bd-3`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "bd-9") || strings.Contains(out, "relates-to") {
+		t.Errorf("edges leaving the walk and relates-to links should be dropped:\n%s", out)
+	}
+	if got := strings.Count(out, "|"); got != 2*6 {
+		t.Errorf("want 6 labeled edges, got %d pipes:\n%s", got, out)
+	}
+}
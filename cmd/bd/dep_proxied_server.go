@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/cmd/bd/doctor"
 
 	"github.com/steveyegge/beads/internal/storage/domain"
 	"github.com/steveyegge/beads/internal/storage/uow"
@@ -19,6 +21,8 @@ type depAddResult struct {
 	toTitle   string
 	cycles    [][]*types.Issue
 	cycleErr  error
+	exists    bool // the exact edge was already present; nothing was written
+	toClosed  bool // a blocks edge onto an already-closed issue
 }
 
 func proxiedLookupTitle(ctx context.Context, uw uow.UnitOfWork, id string) string {
@@ -65,6 +69,15 @@ func printCycleWarnings(cycles [][]*types.Issue) {
 	fmt.Fprintf(os.Stderr, "\nRun 'bd dep cycles' for detailed analysis.\n\n")
 }
 
+// proxiedIsClosedBlocker is isClosedBlocker with the target read through uw.
+func proxiedIsClosedBlocker(ctx context.Context, uw uow.UnitOfWork, dt types.DependencyType, toID string) bool {
+	if dt != types.DepBlocks || IsExternalRef(toID) {
+		return false
+	}
+	blocker, err := uw.IssueUseCase().GetIssue(ctx, toID)
+	return err == nil && isClosedBlocker(dt, blocker)
+}
+
 func runDepBlocksProxiedServer(cmd *cobra.Command, ctx context.Context, blockerID, blockedID string) error {
 	if isDisallowedHierarchicalDependency(blockedID, blockerID, types.DepBlocks) {
 		return HandleErrorRespectJSON("cannot add dependency: %s is already a child of %s. Children inherit dependency on parent completion via hierarchy. Adding an explicit dependency would create a deadlock", blockedID, blockerID)
@@ -97,6 +110,7 @@ func runDepBlocksProxiedServer(cmd *cobra.Command, ctx context.Context, blockerI
 			toTitle:   proxiedLookupTitle(ctx, uw, blockerID),
 			cycles:    cycles,
 			cycleErr:  cycleErr,
+			toClosed:  proxiedIsClosedBlocker(ctx, uw, types.DepBlocks, blockerID),
 		}, fmt.Sprintf("bd: dep add %s %s", blockedID, blockerID), nil
 	})
 	if err != nil {
@@ -105,6 +119,9 @@ func runDepBlocksProxiedServer(cmd *cobra.Command, ctx context.Context, blockerI
 
 	printCycleDetectionError(res.cycleErr)
 	printCycleWarnings(res.cycles)
+	if noWarn, _ := cmd.Flags().GetBool("no-warn"); !noWarn && res.toClosed {
+		warnClosedBlocker(blockedID, blockerID)
+	}
 
 	if jsonOutput {
 		_ = outputJSON(map[string]interface{}{
@@ -131,20 +148,7 @@ func runDepAddProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 		return runDepAddBulkProxied(cmd, ctx, file, depType)
 	}
 
-	blockedBy, _ := cmd.Flags().GetString("blocked-by")
-	dependsOn, _ := cmd.Flags().GetString("depends-on")
-
-	var dependsOnArg string
-	switch {
-	case blockedBy != "":
-		dependsOnArg = blockedBy
-	case dependsOn != "":
-		dependsOnArg = dependsOn
-	default:
-		dependsOnArg = args[1]
-	}
-
-	fromID := args[0]
+	fromID, dependsOnArg := depAddEndpoints(cmd, args)
 	var toID string
 	if strings.HasPrefix(dependsOnArg, "external:") {
 		if err := validateExternalRef(dependsOnArg); err != nil {
@@ -164,6 +168,13 @@ func runDepAddProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 		return HandleErrorRespectJSON("invalid dependency type %q: must be non-empty and at most 50 characters", depType)
 	}
 
+	force, _ := cmd.Flags().GetBool("force")
+	if force {
+		if err := depForceCycleError(dt); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+	}
+
 	if uowProvider == nil {
 		return HandleErrorRespectJSON("proxied-server UOW provider not initialized")
 	}
@@ -172,7 +183,15 @@ func runDepAddProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 
 	res, err := uow.RunTxResult(ctx, uowProvider, func(ctx context.Context, uw uow.UnitOfWork) (depAddResult, string, error) {
 		dep := &types.Dependency{IssueID: fromID, DependsOnID: toID, Type: dt}
-		if _, err := uw.DependencyUseCase().AddDependencies(ctx, []*types.Dependency{dep}, actor, domain.BulkAddDepsOpts{}); err != nil {
+		opts := domain.BulkAddDepsOpts{RejectDuplicate: true, ForceCycles: force}
+		if _, err := uw.DependencyUseCase().AddDependencies(ctx, []*types.Dependency{dep}, actor, opts); err != nil {
+			if errors.Is(err, domain.ErrDuplicateDependency) {
+				return depAddResult{
+					exists:    true,
+					fromTitle: proxiedLookupTitle(ctx, uw, fromID),
+					toTitle:   proxiedLookupTitle(ctx, uw, toID),
+				}, "", nil
+			}
 			return depAddResult{}, "", err
 		}
 
@@ -187,14 +206,22 @@ func runDepAddProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 			toTitle:   proxiedLookupTitle(ctx, uw, toID),
 			cycles:    cycles,
 			cycleErr:  cycleErr,
+			toClosed:  proxiedIsClosedBlocker(ctx, uw, dt, toID),
 		}, fmt.Sprintf("bd: dep add %s %s", fromID, toID), nil
 	})
 	if err != nil {
 		return HandleErrorRespectJSON("%v", err)
 	}
 
+	if res.exists {
+		return reportExistingDependency(fromID, res.fromTitle, toID, res.toTitle, depType)
+	}
+
 	printCycleDetectionError(res.cycleErr)
 	printCycleWarnings(res.cycles)
+	if noWarn, _ := cmd.Flags().GetBool("no-warn"); !noWarn && res.toClosed {
+		warnClosedBlocker(fromID, toID)
+	}
 
 	if jsonOutput {
 		_ = outputJSON(map[string]interface{}{
@@ -292,12 +319,14 @@ func runDepAddBulkProxied(cmd *cobra.Command, ctx context.Context, file, default
 	return nil
 }
 
-func runDepRemoveProxiedServer(_ *cobra.Command, ctx context.Context, args []string) error {
+func runDepRemoveProxiedServer(cmd *cobra.Command, ctx context.Context, args []string) error {
 	fromID := args[0]
-	toID := args[1]
-	if strings.HasPrefix(toID, "external:") {
-		if err := validateExternalRef(toID); err != nil {
-			return HandleErrorRespectJSON("%v", err)
+	toIDs := uniqueStrings(args[1:])
+	for _, toID := range toIDs {
+		if strings.HasPrefix(toID, "external:") {
+			if err := validateExternalRef(toID); err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
 		}
 	}
 
@@ -305,33 +334,70 @@ func runDepRemoveProxiedServer(_ *cobra.Command, ctx context.Context, args []str
 		return HandleErrorRespectJSON("proxied-server UOW provider not initialized")
 	}
 
-	res, err := uow.RunTxResult(ctx, uowProvider, func(ctx context.Context, uw uow.UnitOfWork) (depAddResult, string, error) {
-		if err := uw.DependencyUseCase().RemoveDependency(ctx, fromID, toID, actor); err != nil {
-			return depAddResult{}, "", err
+	lookupTitles := func(ctx context.Context, uw uow.UnitOfWork, toIDs []string) map[string]string {
+		titles := make(map[string]string, len(toIDs)+1)
+		for _, id := range append([]string{fromID}, toIDs...) {
+			titles[id] = proxiedLookupTitle(ctx, uw, id)
 		}
-		return depAddResult{
-			fromTitle: proxiedLookupTitle(ctx, uw, fromID),
-			toTitle:   proxiedLookupTitle(ctx, uw, toID),
-		}, fmt.Sprintf("bd: dep remove %s %s", fromID, toID), nil
+		return titles
+	}
+
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		uw, err := proxiedOpenReadUOW(ctx)
+		if err != nil {
+			return err
+		}
+		defer uw.Close(ctx)
+		depMap, err := uw.DependencyUseCase().GetIssueDependencyRecords(ctx, []string{fromID})
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		existing, missing := splitDepRemoveTargets(fromID, depMap[fromID], toIDs)
+		if err := reportNothingToRemove(cmd, missing); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		if len(existing) == 0 {
+			return renderNothingRemoved()
+		}
+		return renderDepRemove(fromID, existing, lookupTitles(ctx, uw, existing), true)
+	}
+	if err := confirmDestructive(cmd, depRemoveAction(fromID, len(toIDs)), len(toIDs)); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+
+	var removed, missing []string
+	titles, err := uow.RunTxResult(ctx, uowProvider, func(ctx context.Context, uw uow.UnitOfWork) (map[string]string, string, error) {
+		removed, missing = nil, nil
+		for _, toID := range toIDs {
+			n, err := uw.DependencyUseCase().RemoveDependency(ctx, fromID, toID, actor)
+			if err != nil {
+				return nil, "", err
+			}
+			if n == 0 {
+				missing = append(missing, noSuchDependency(fromID, toID))
+				continue
+			}
+			removed = append(removed, toID)
+		}
+		if err := strictNothingToRemove(cmd, missing); err != nil {
+			return nil, "", err
+		}
+		if len(removed) == 0 {
+			return nil, "", nil
+		}
+		return lookupTitles(ctx, uw, removed), fmt.Sprintf("bd: dep remove %s %s", fromID, strings.Join(removed, " ")), nil
 	})
 	if err != nil {
 		return HandleErrorRespectJSON("%v", err)
 	}
-
-	if jsonOutput {
-		_ = outputJSON(map[string]interface{}{
-			"status":        "removed",
-			"issue_id":      fromID,
-			"depends_on_id": toID,
-		})
-		return nil
+	if err := reportNothingToRemove(cmd, missing); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	if len(removed) == 0 {
+		return renderNothingRemoved()
 	}
 
-	fmt.Printf("%s Removed dependency: %s no longer depends on %s\n",
-		ui.RenderPass("✓"),
-		formatFeedbackIDParen(fromID, res.fromTitle),
-		formatFeedbackIDParen(toID, res.toTitle))
-	return nil
+	return renderDepRemove(fromID, removed, titles, false)
 }
 
 func runDepListProxiedServer(cmd *cobra.Command, ctx context.Context, args []string) error {
@@ -454,6 +520,33 @@ func runDepListProxiedServer(cmd *cobra.Command, ctx context.Context, args []str
 	return nil
 }
 
+// proxiedBuildDependencyTree is buildDependencyTree over the unit of work.
+func proxiedBuildDependencyTree(ctx context.Context, depUC domain.DependencyUseCase, fullID string, maxDepth int, showAllPaths bool, direction string) ([]*types.TreeNode, error) {
+	walk := func(dir domain.DepDirection) ([]*types.TreeNode, error) {
+		return depUC.GetDependencyTree(ctx, fullID, domain.DepTreeOpts{
+			MaxDepth:     maxDepth,
+			ShowAllPaths: showAllPaths,
+			Direction:    dir,
+		})
+	}
+	switch direction {
+	case "up":
+		return walk(domain.DepDirectionIn)
+	case "both":
+		downTree, err := walk(domain.DepDirectionOut)
+		if err != nil {
+			return nil, err
+		}
+		upTree, err := walk(domain.DepDirectionIn)
+		if err != nil {
+			return nil, err
+		}
+		return mergeBidirectionalTrees(downTree, upTree, fullID), nil
+	default:
+		return walk(domain.DepDirectionOut)
+	}
+}
+
 func runDepTreeProxiedServer(cmd *cobra.Command, ctx context.Context, args []string) error {
 	fullID := args[0]
 	showAllPaths, _ := cmd.Flags().GetBool("show-all-paths")
@@ -487,47 +580,19 @@ func runDepTreeProxiedServer(cmd *cobra.Command, ctx context.Context, args []str
 	}
 	defer uw.Close(ctx)
 
-	depUC := uw.DependencyUseCase()
-	var tree []*types.TreeNode
-
-	if direction == "both" {
-		downTree, err := depUC.GetDependencyTree(ctx, fullID, domain.DepTreeOpts{
-			MaxDepth:     maxDepth,
-			ShowAllPaths: showAllPaths,
-			Direction:    domain.DepDirectionOut,
-		})
-		if err != nil {
-			return HandleErrorRespectJSON("%v", err)
-		}
-		upTree, err := depUC.GetDependencyTree(ctx, fullID, domain.DepTreeOpts{
-			MaxDepth:     maxDepth,
-			ShowAllPaths: showAllPaths,
-			Direction:    domain.DepDirectionIn,
-		})
-		if err != nil {
-			return HandleErrorRespectJSON("%v", err)
-		}
-		tree = mergeBidirectionalTrees(downTree, upTree, fullID)
-	} else {
-		treeDir := domain.DepDirectionOut
-		if direction == "up" {
-			treeDir = domain.DepDirectionIn
-		}
-		var err error
-		tree, err = depUC.GetDependencyTree(ctx, fullID, domain.DepTreeOpts{
-			MaxDepth:     maxDepth,
-			ShowAllPaths: showAllPaths,
-			Direction:    treeDir,
-		})
-		if err != nil {
-			return HandleErrorRespectJSON("%v", err)
-		}
+	tree, err := proxiedBuildDependencyTree(ctx, uw.DependencyUseCase(), fullID, maxDepth, showAllPaths, direction)
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
 	}
 
 	if statusFilter != "" {
 		tree = filterTreeByStatus(tree, types.Status(statusFilter))
 	}
 
+	if highlight, _ := cmd.Flags().GetString("highlight"); highlight != "" {
+		highlightTreeNode(tree, highlight)
+	}
+
 	if formatStr == "mermaid" {
 		outputMermaidTree(tree, args[0])
 		return nil
@@ -567,6 +632,49 @@ func runDepTreeProxiedServer(cmd *cobra.Command, ctx context.Context, args []str
 	return nil
 }
 
+func runDepGraphProxiedServer(ctx context.Context, id string, maxDepth int, direction string) error {
+	if uowProvider == nil {
+		return HandleErrorRespectJSON("proxied-server UOW provider not initialized")
+	}
+	uw, err := uowProvider.NewUOW(ctx)
+	if err != nil {
+		return HandleErrorRespectJSON("open unit of work: %v", err)
+	}
+	defer uw.Close(ctx)
+
+	depUC := uw.DependencyUseCase()
+	tree, err := proxiedBuildDependencyTree(ctx, depUC, id, maxDepth, false, direction)
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	records, err := depUC.GetForIssueIDs(ctx, treeNodeIDs(tree))
+	if err != nil {
+		return HandleErrorRespectJSON("loading dependencies: %v", err)
+	}
+	return writeMermaidDepGraph(os.Stdout, newDependencyGraph(id, tree, records))
+}
+
+func runDepExportProxiedServer(ctx context.Context, opts dotExportOptions) error {
+	if uowProvider == nil {
+		return HandleErrorRespectJSON("proxied-server UOW provider not initialized")
+	}
+	uw, err := uowProvider.NewUOW(ctx)
+	if err != nil {
+		return HandleErrorRespectJSON("open unit of work: %v", err)
+	}
+	defer uw.Close(ctx)
+
+	page, err := uw.IssueUseCase().SearchIssues(ctx, "", depExportFilter())
+	if err != nil {
+		return HandleErrorRespectJSON("loading issues: %v", err)
+	}
+	deps, err := loadDepsForIssues(ctx, uw, page.Items)
+	if err != nil {
+		return HandleErrorRespectJSON("loading dependencies: %v", err)
+	}
+	return writeDotDepGraph(os.Stdout, page.Items, deps, opts)
+}
+
 func runDepCyclesProxiedServer(_ *cobra.Command, ctx context.Context) error {
 	if uowProvider == nil {
 		return HandleErrorRespectJSON("proxied-server UOW provider not initialized")
@@ -595,13 +703,41 @@ func runDepCyclesProxiedServer(_ *cobra.Command, ctx context.Context) error {
 		return nil
 	}
 
-	fmt.Printf("\n%s Found %d dependency cycles:\n\n", ui.RenderFail("⚠"), len(cycles))
-	for i, cycle := range cycles {
-		fmt.Printf("%d. Cycle involving:\n", i+1)
-		for _, issue := range cycle {
-			fmt.Printf("   - %s: %s\n", issue.ID, issue.Title)
-		}
-		fmt.Println()
+	deps, err := uw.DependencyUseCase().GetForIssueIDs(ctx, cycleMemberIDs(cycles))
+	if err != nil {
+		return HandleErrorRespectJSON("loading cycle edges: %v", err)
 	}
+	printDependencyCycles(cycles, deps)
 	return nil
 }
+
+// proxiedRelationStore adapts a unit of work to doctor.RelationStore.
+type proxiedRelationStore struct {
+	uw uow.UnitOfWork
+}
+
+func (s proxiedRelationStore) GetAllDependencyRecords(ctx context.Context) (map[string][]*types.Dependency, error) {
+	page, err := s.uw.IssueUseCase().SearchIssues(ctx, "", types.IssueFilter{})
+	if err != nil {
+		return nil, err
+	}
+	return loadDepsForIssues(ctx, s.uw, page.Items)
+}
+
+func (s proxiedRelationStore) GetIssuesByIDs(ctx context.Context, ids []string) ([]*types.Issue, error) {
+	return s.uw.IssueUseCase().GetIssuesByIDs(ctx, ids)
+}
+
+func runDepVerifyProxiedServer(ctx context.Context) error {
+	uw, err := proxiedOpenReadUOW(ctx)
+	if err != nil {
+		return err
+	}
+	defer uw.Close(ctx)
+
+	problems, err := doctor.FindRelationProblemsInStore(ctx, proxiedRelationStore{uw: uw})
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	return renderDepVerify(problems)
+}
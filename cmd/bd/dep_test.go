@@ -15,6 +15,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/dolt"
 	"github.com/steveyegge/beads/internal/storage/domain"
@@ -478,6 +479,52 @@ func TestDepAddFlagAliases(t *testing.T) {
 	}
 }
 
+func TestDepForceCycleError(t *testing.T) {
+	for _, dt := range []types.DependencyType{types.DepSupersedes, types.DepDuplicates, types.DepRelated, types.DepTracks} {
+		if err := depForceCycleError(dt); err != nil {
+			t.Errorf("--force should be accepted for %s: %v", dt, err)
+		}
+	}
+	for _, dt := range []types.DependencyType{types.DepBlocks, types.DepConditionalBlocks, types.DepWaitsFor, types.DepParentChild} {
+		err := depForceCycleError(dt)
+		if err == nil || !strings.Contains(err.Error(), "supersedes, duplicates") {
+			t.Errorf("--force should be refused for %s naming the forceable types, got %v", dt, err)
+		}
+	}
+}
+
+func TestDepAddEndpoints(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            []string
+		flags           map[string]string
+		from, dependsOn string
+	}{
+		{"positional", []string{"bd-b", "bd-a"}, nil, "bd-b", "bd-a"},
+		{"blocked-by", []string{"bd-b"}, map[string]string{"blocked-by": "bd-a"}, "bd-b", "bd-a"},
+		{"depends-on", []string{"bd-b"}, map[string]string{"depends-on": "bd-a"}, "bd-b", "bd-a"},
+		{"after", []string{"bd-b"}, map[string]string{"after": "bd-a"}, "bd-b", "bd-a"},
+		{"before", []string{"bd-a"}, map[string]string{"before": "bd-b"}, "bd-b", "bd-a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			for _, name := range depAddTargetFlags {
+				cmd.Flags().String(name, "", "")
+			}
+			for name, value := range tt.flags {
+				if err := cmd.Flags().Set(name, value); err != nil {
+					t.Fatal(err)
+				}
+			}
+			from, dependsOn := depAddEndpoints(cmd, tt.args)
+			if from != tt.from || dependsOn != tt.dependsOn {
+				t.Errorf("depAddEndpoints = (%s, %s), want %s depends on %s", from, dependsOn, tt.from, tt.dependsOn)
+			}
+		})
+	}
+}
+
 func TestDepBlocksFlag(t *testing.T) {
 	// Test that the --blocks flag exists on depCmd
 	flag := depCmd.Flags().Lookup("blocks")
@@ -1024,6 +1071,46 @@ func TestRenderTreeOutput(t *testing.T) {
 	}
 }
 
+func TestRenderTreeHighlight(t *testing.T) {
+	tree := []*types.TreeNode{
+		{Issue: types.Issue{ID: "bd-1", Title: "Root", Status: types.StatusOpen, Priority: 1}},
+		{Issue: types.Issue{ID: "bd-2", Title: "Child 1", Status: types.StatusOpen, Priority: 2}, Depth: 1, ParentID: "bd-1"},
+		{Issue: types.Issue{ID: "bd-3", Title: "Child 2", Status: types.StatusOpen, Priority: 2}, Depth: 1, ParentID: "bd-1"},
+	}
+
+	// Hash-only IDs resolve against the tree like full IDs.
+	highlightTreeNode(tree, "2")
+	for _, node := range tree {
+		if want := node.ID == "bd-2"; node.Highlighted != want {
+			t.Errorf("%s Highlighted = %v, want %v", node.ID, node.Highlighted, want)
+		}
+	}
+
+	old := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+	renderTree(tree, 50, "down")
+	w.Close()
+	os.Stdout = old
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		marked := strings.Contains(line, ">>> ") && strings.Contains(line, " <<<")
+		if want := strings.Contains(line, "bd-2"); marked != want {
+			t.Errorf("line %q marked = %v, want %v", line, marked, want)
+		}
+	}
+
+	got, err := json.Marshal(tree)
+	if err != nil {
+		t.Fatalf("json.Marshal(tree): %v", err)
+	}
+	if n := strings.Count(string(got), `"highlighted":true`); n != 1 {
+		t.Errorf("expected exactly one highlighted node in JSON, got %d: %s", n, got)
+	}
+}
+
 func TestRenderTreeOutputShowsDependencyTypeLabelsInMixedGraph(t *testing.T) {
 	downTree := []*types.TreeNode{
 		{
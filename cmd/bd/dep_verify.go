@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/cmd/bd/doctor"
+	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var depVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Report inconsistent or asymmetric relations",
+	Long: `Scan the dependency graph for relations that should be symmetric or paired
+but are not:
+
+  asymmetric_related     A relates-to B, but B does not relate back to A
+                         (bd relate records both sides)
+  superseded_not_closed  A is superseded by B but A is not closed
+  duplicate_of_missing   A is a duplicate of B, but B no longer exists
+
+Exits non-zero when any problem is found. The same scan runs as the
+"Relation Consistency" check of bd doctor --check=validate.
+
+Examples:
+  bd dep verify
+  bd dep verify --json`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		evt := metrics.NewCommandEvent("dep-verify")
+		defer func() {
+			if c := metrics.Global(); c != nil {
+				c.CloseEventAndAdd(evt)
+			}
+		}()
+
+		if usesProxiedServer() {
+			return runDepVerifyProxiedServer(rootCtx)
+		}
+
+		problems, err := doctor.FindRelationProblemsInStore(rootCtx, store)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		return renderDepVerify(problems)
+	},
+}
+
+// renderDepVerify prints the relation problems and fails the command when
+// there are any, so scripts can gate on bd dep verify.
+func renderDepVerify(problems []doctor.RelationProblem) error {
+	if jsonOutput {
+		if problems == nil {
+			problems = []doctor.RelationProblem{}
+		}
+		if err := outputJSON(map[string]interface{}{
+			"ok":       len(problems) == 0,
+			"problems": problems,
+		}); err != nil {
+			return err
+		}
+	} else if len(problems) == 0 {
+		fmt.Printf("%s No inconsistent relations found\n", ui.RenderPass("✓"))
+	} else {
+		fmt.Printf("%s Found %d inconsistent relation(s):\n\n", ui.RenderWarn("⚠"), len(problems))
+		for _, p := range problems {
+			fmt.Printf("  [%s] %s\n", p.Kind, p.Message)
+		}
+	}
+	if len(problems) > 0 {
+		return SilentExit()
+	}
+	return nil
+}
+
+func init() {
+	depCmd.AddCommand(depVerifyCmd)
+}
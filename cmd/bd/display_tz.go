@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Embedded zone database so --tz works where the OS has none (Windows,
+	// minimal containers).
+	_ "time/tzdata"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// registerTimezoneFlag adds --tz to a command whose output shows timestamps.
+func registerTimezoneFlag(cmd *cobra.Command) {
+	cmd.Flags().String("tz", "", "Render timestamps in this IANA time zone (e.g. America/New_York, Local); filters and sorting still compare in UTC")
+}
+
+// readTimezoneFlag resolves --tz. It returns nil when the flag is unset, which
+// leaves timestamps in UTC as stored.
+func readTimezoneFlag(cmd *cobra.Command) (*time.Location, error) {
+	name, _ := cmd.Flags().GetString("tz")
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --tz %q: not a known IANA time zone (e.g. UTC, Europe/Berlin, America/New_York)", name)
+	}
+	return loc, nil
+}
+
+// localizeIssueTimes moves every timestamp of issues into loc for display.
+// Only the zone changes, never the instant, but it must run after filtering
+// and sorting so those keep working on the stored UTC values. A nil loc is a
+// no-op.
+func localizeIssueTimes(loc *time.Location, issues ...*types.Issue) {
+	if loc == nil {
+		return
+	}
+	in := func(t *time.Time) {
+		if t != nil && !t.IsZero() {
+			*t = t.In(loc)
+		}
+	}
+	for _, issue := range issues {
+		if issue == nil {
+			continue
+		}
+		in(&issue.CreatedAt)
+		in(&issue.UpdatedAt)
+		in(issue.StartedAt)
+		in(issue.ClosedAt)
+		in(issue.LeaseExpiresAt)
+		in(issue.HeartbeatAt)
+		in(issue.DueAt)
+		in(issue.DeferUntil)
+		in(issue.CompactedAt)
+	}
+}
+
+// localizeIssuesWithCounts is localizeIssueTimes for IssueWithCounts results.
+func localizeIssuesWithCounts(loc *time.Location, issues []*types.IssueWithCounts) {
+	if loc == nil {
+		return
+	}
+	for _, issue := range issues {
+		localizeIssueTimes(loc, issue.Issue)
+	}
+}
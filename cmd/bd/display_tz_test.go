@@ -0,0 +1,65 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestReadTimezoneFlag(t *testing.T) {
+	newCmd := func(tz string) *cobra.Command {
+		cmd := &cobra.Command{}
+		registerTimezoneFlag(cmd)
+		if tz != "" {
+			_ = cmd.Flags().Set("tz", tz)
+		}
+		return cmd
+	}
+	if loc, err := readTimezoneFlag(newCmd("")); loc != nil || err != nil {
+		t.Errorf("unset --tz = %v, %v; want nil, nil", loc, err)
+	}
+	if loc, err := readTimezoneFlag(newCmd("America/New_York")); err != nil || loc.String() != "America/New_York" {
+		t.Errorf("--tz America/New_York = %v, %v", loc, err)
+	}
+	if _, err := readTimezoneFlag(newCmd("Mars/Olympus_Mons")); err == nil {
+		t.Error("unknown zone should be rejected")
+	}
+}
+
+func TestLocalizeIssueTimes(t *testing.T) {
+	created := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 7, 1, 3, 30, 0, 0, time.UTC)
+	render := func(zone string) *types.Issue {
+		issue := &types.Issue{ID: "bd-1", CreatedAt: created, UpdatedAt: created}
+		dueCopy := due
+		issue.DueAt = &dueCopy
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			t.Fatalf("LoadLocation(%s): %v", zone, err)
+		}
+		localizeIssueTimes(loc, issue)
+		return issue
+	}
+
+	ny, tokyo := render("America/New_York"), render("Asia/Tokyo")
+	if got, want := ny.CreatedAt.Format(time.RFC3339), "2026-01-15T07:00:00-05:00"; got != want {
+		t.Errorf("New York created_at = %s, want %s", got, want)
+	}
+	if got, want := tokyo.CreatedAt.Format(time.RFC3339), "2026-01-15T21:00:00+09:00"; got != want {
+		t.Errorf("Tokyo created_at = %s, want %s", got, want)
+	}
+	if got, want := ny.DueAt.Format(time.RFC3339), "2026-06-30T23:30:00-04:00"; got != want {
+		t.Errorf("New York due_at = %s, want %s (DST)", got, want)
+	}
+	if !ny.CreatedAt.Equal(tokyo.CreatedAt) || !ny.DueAt.Equal(*tokyo.DueAt) || !ny.CreatedAt.Equal(created) {
+		t.Error("localizing must keep the instant")
+	}
+
+	unchanged := &types.Issue{CreatedAt: created}
+	localizeIssueTimes(nil, unchanged)
+	if unchanged.CreatedAt.Location() != time.UTC {
+		t.Error("nil location should leave timestamps in UTC")
+	}
+}
@@ -87,7 +87,7 @@ This command checks:
 Storage Availability:
   Full diagnostics, --perf, --deep, --server, --migration, and
   --check=validate currently require Dolt server mode. Embedded Dolt
-  supports --check=artifacts, --check=conventions, and
+  supports --check=artifacts, --check=conventions, --check=env, and
   --check=pollution. --check-health has a limited hook-health fallback.
   Unsupported combinations return a notice without changing storage.
 
@@ -108,6 +108,10 @@ Specific Check Mode (--check):
     (stale JSONL, SQLite files, cruft .beads dirs). Use with --clean.
   - conventions: Check for convention drift (lint warnings, stale
     issues, orphaned issues). Advisory only - warns, never blocks.
+  - env: Check the environment: dolt installed, dolt identity, server
+    port resolution, git hooks, and schema version. Use with --fix to
+    set the dolt identity, install or migrate hooks, and migrate the
+    schema.
   - pollution: Detect and optionally clean test issues from database
   - validate: Run focused data-integrity checks (duplicates, orphaned
     deps, test pollution, git conflicts). Use with --fix to auto-repair.
@@ -176,6 +180,8 @@ Examples:
   bd doctor --check=artifacts           # Show classic artifacts (JSONL, SQLite, cruft dirs)
   bd doctor --check=artifacts --clean  # Delete safe-to-delete artifacts (with confirmation)
   bd doctor --check=conventions        # Convention drift check (lint, stale, orphans)
+  bd doctor --check=env                # Environment checks (dolt, identity, port, hooks, schema)
+  bd doctor --check=env --fix --yes    # Apply the safe environment fixes
   bd doctor --check=pollution          # Show potential test issues
   bd doctor --check=pollution --clean  # Delete test issues (with confirmation)
   bd doctor --check=validate         # Data-integrity checks only
@@ -243,15 +249,17 @@ Examples:
 			return nil
 		}
 
-		// artifacts, conventions, and pollution work in embedded mode and run
-		// unconditionally; validate still requires a server-mode connection
-		// and stays gated (GH#3597).
+		// artifacts, conventions, env, and pollution work in embedded mode and
+		// run unconditionally; validate still requires a server-mode
+		// connection and stays gated (GH#3597).
 		if doctorCheckFlag != "" {
 			switch doctorCheckFlag {
 			case "artifacts":
 				return runArtifactsCheck(absPath, doctorClean, doctorYes)
 			case "conventions":
 				return runConventionsCheck(absPath)
+			case "env":
+				return runEnvCheck(absPath)
 			case "pollution":
 				return runPollutionCheck(absPath, doctorClean, doctorYes)
 			case "validate":
@@ -261,7 +269,7 @@ Examples:
 				}
 				return runValidateCheck(absPath)
 			default:
-				return HandleErrorWithHint(fmt.Sprintf("unknown check %q", doctorCheckFlag), "Available checks: artifacts, conventions, pollution, validate")
+				return HandleErrorWithHint(fmt.Sprintf("unknown check %q", doctorCheckFlag), "Available checks: artifacts, conventions, env, pollution, validate")
 			}
 		}
 
@@ -380,7 +388,7 @@ func printEmbeddedUnsupported(commandLabel string) {
 		"Reinitialize if needed:  bd init --reinit-local",
 		"Switch to server mode:   bd init --server",
 	}
-	supported := []string{"artifacts", "conventions", "pollution"}
+	supported := []string{"artifacts", "conventions", "env", "pollution"}
 	unsupported := []string{"validate"}
 
 	if jsonOutput || doctorAgent {
@@ -409,6 +417,7 @@ func printEmbeddedUnsupported(commandLabel string) {
 	fmt.Fprintln(os.Stderr, "Checks available in embedded mode:")
 	fmt.Fprintln(os.Stderr, "  • bd doctor --check=artifacts")
 	fmt.Fprintln(os.Stderr, "  • bd doctor --check=conventions")
+	fmt.Fprintln(os.Stderr, "  • bd doctor --check=env")
 	fmt.Fprintln(os.Stderr, "  • bd doctor --check=pollution")
 }
 
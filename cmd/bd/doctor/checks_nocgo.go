@@ -13,6 +13,10 @@ func CheckDuplicateIssues(_ string, _ bool, _ int) DoctorCheck {
 	return DoctorCheck{Name: "Duplicate Issues", Status: StatusWarning, Message: "Skipped: requires CGO"}
 }
 
+func CheckRelationConsistency(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Relation Consistency", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
 func CheckTestPollution(_ string) DoctorCheck {
 	return DoctorCheck{Name: "Test Pollution", Status: StatusWarning, Message: "Skipped: requires CGO"}
 }
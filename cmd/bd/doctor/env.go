@@ -0,0 +1,131 @@
+package doctor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/doltserver"
+)
+
+// Seams for tests: the identity checks shell out to the dolt CLI.
+var (
+	lookPathDolt       = func() (string, error) { return exec.LookPath("dolt") }
+	readDoltIdentity   = doltserver.DoltIdentity
+	ensureDoltIdentity = doltserver.EnsureDoltIdentity
+)
+
+const doltInstallURL = "https://docs.dolthub.com/introduction/installation"
+
+// CheckDoltInstalled verifies the dolt binary is on PATH. Server mode needs
+// it to run the sql-server, so a missing binary is an error there; embedded
+// mode only needs it for dolt CLI operations, so it is a warning.
+func CheckDoltInstalled(embedded bool) DoctorCheck {
+	path, err := lookPathDolt()
+	if err == nil {
+		return DoctorCheck{
+			Name:     "Dolt Installed",
+			Status:   StatusOK,
+			Message:  path,
+			Category: CategoryDolt,
+		}
+	}
+	check := DoctorCheck{
+		Name:     "Dolt Installed",
+		Status:   StatusError,
+		Message:  "dolt not found on PATH",
+		Detail:   "Server mode starts a dolt sql-server and cannot run without it",
+		Fix:      "Install dolt: " + doltInstallURL,
+		Category: CategoryDolt,
+	}
+	if embedded {
+		check.Status = StatusWarning
+		check.Detail = "Embedded mode works without it; dolt CLI operations and server mode need it"
+	}
+	return check
+}
+
+// CheckDoltIdentity verifies the dolt global user.name and user.email are
+// set. Dolt refuses to commit without them.
+func CheckDoltIdentity() DoctorCheck {
+	if _, err := lookPathDolt(); err != nil {
+		return DoctorCheck{
+			Name:     "Dolt Identity",
+			Status:   StatusOK,
+			Message:  "N/A (dolt not installed)",
+			Category: CategoryDolt,
+		}
+	}
+	name, email := readDoltIdentity()
+	if name != "" && email != "" {
+		return DoctorCheck{
+			Name:     "Dolt Identity",
+			Status:   StatusOK,
+			Message:  fmt.Sprintf("%s <%s>", name, email),
+			Category: CategoryDolt,
+		}
+	}
+	var missing []string
+	if name == "" {
+		missing = append(missing, "user.name")
+	}
+	if email == "" {
+		missing = append(missing, "user.email")
+	}
+	return DoctorCheck{
+		Name:     "Dolt Identity",
+		Status:   StatusWarning,
+		Message:  fmt.Sprintf("dolt global %s not set", strings.Join(missing, " and ")),
+		Detail:   "Dolt commits need an author; bd copies it from git config (or beads <beads@localhost>)",
+		Fix:      "Run 'bd doctor --check=env --fix' or 'dolt config --global --add user.name <name>'",
+		Category: CategoryDolt,
+	}
+}
+
+// FixDoltIdentity sets the missing dolt global identity from git config.
+func FixDoltIdentity() error {
+	return ensureDoltIdentity()
+}
+
+// CheckDoltPort reports the port a server-mode workspace resolves to, using
+// the same precedence as server startup (BEADS_DOLT_SERVER_PORT, the port
+// file, config). Port 0 means the server picks a free port when it starts.
+func CheckDoltPort(path string, embedded bool) DoctorCheck {
+	if embedded {
+		return DoctorCheck{
+			Name:     "Dolt Port",
+			Status:   StatusOK,
+			Message:  "N/A (embedded mode)",
+			Category: CategoryDolt,
+		}
+	}
+	if p := os.Getenv("BEADS_DOLT_SERVER_PORT"); p != "" {
+		if port, err := strconv.Atoi(p); err != nil || port <= 0 || port > 65535 {
+			return DoctorCheck{
+				Name:     "Dolt Port",
+				Status:   StatusError,
+				Message:  fmt.Sprintf("BEADS_DOLT_SERVER_PORT=%q is not a valid port", p),
+				Detail:   "The invalid value is ignored and the port falls back to the port file or config",
+				Fix:      "Unset BEADS_DOLT_SERVER_PORT or set it to a port between 1 and 65535",
+				Category: CategoryDolt,
+			}
+		}
+	}
+	cfg := doltserver.DefaultConfig(ResolveBeadsDirForRepo(path))
+	if cfg.Port == 0 {
+		return DoctorCheck{
+			Name:     "Dolt Port",
+			Status:   StatusOK,
+			Message:  "Allocated when the server starts",
+			Category: CategoryDolt,
+		}
+	}
+	return DoctorCheck{
+		Name:     "Dolt Port",
+		Status:   StatusOK,
+		Message:  fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+		Category: CategoryDolt,
+	}
+}
@@ -0,0 +1,98 @@
+package doctor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// stubDoltEnv replaces the dolt seams for one test.
+func stubDoltEnv(t *testing.T, installed bool, name, email *string) (fixCalls *int) {
+	t.Helper()
+	oldLook, oldRead, oldEnsure := lookPathDolt, readDoltIdentity, ensureDoltIdentity
+	t.Cleanup(func() { lookPathDolt, readDoltIdentity, ensureDoltIdentity = oldLook, oldRead, oldEnsure })
+
+	lookPathDolt = func() (string, error) {
+		if !installed {
+			return "", errors.New("not found")
+		}
+		return "/usr/local/bin/dolt", nil
+	}
+	readDoltIdentity = func() (string, string) { return *name, *email }
+	calls := 0
+	ensureDoltIdentity = func() error {
+		calls++
+		if *name == "" {
+			*name = "Git User"
+		}
+		if *email == "" {
+			*email = "git@example.com"
+		}
+		return nil
+	}
+	return &calls
+}
+
+func TestCheckDoltIdentityMissingIsDetectedAndFixed(t *testing.T) {
+	name, email := "", ""
+	calls := stubDoltEnv(t, true, &name, &email)
+
+	check := CheckDoltIdentity()
+	if check.Status != StatusWarning {
+		t.Fatalf("missing identity status = %q, want %q", check.Status, StatusWarning)
+	}
+	if !strings.Contains(check.Message, "user.name and user.email") {
+		t.Errorf("message %q should name both missing keys", check.Message)
+	}
+
+	if err := FixDoltIdentity(); err != nil {
+		t.Fatalf("FixDoltIdentity: %v", err)
+	}
+	if *calls != 1 {
+		t.Fatalf("ensureDoltIdentity called %d times, want 1", *calls)
+	}
+	check = CheckDoltIdentity()
+	if check.Status != StatusOK || check.Message != "Git User <git@example.com>" {
+		t.Fatalf("after fix: %+v, want ok with the git identity", check)
+	}
+}
+
+func TestCheckDoltIdentityPartial(t *testing.T) {
+	name, email := "Someone", ""
+	stubDoltEnv(t, true, &name, &email)
+
+	check := CheckDoltIdentity()
+	if check.Status != StatusWarning || !strings.Contains(check.Message, "user.email") || strings.Contains(check.Message, "user.name") {
+		t.Fatalf("partial identity: %+v, want a warning about user.email only", check)
+	}
+}
+
+func TestCheckDoltInstalled(t *testing.T) {
+	name, email := "", ""
+	stubDoltEnv(t, false, &name, &email)
+
+	if got := CheckDoltInstalled(false).Status; got != StatusError {
+		t.Errorf("server mode without dolt = %q, want %q", got, StatusError)
+	}
+	if got := CheckDoltInstalled(true).Status; got != StatusWarning {
+		t.Errorf("embedded mode without dolt = %q, want %q", got, StatusWarning)
+	}
+	if got := CheckDoltIdentity().Status; got != StatusOK {
+		t.Errorf("identity without dolt = %q, want %q (not applicable)", got, StatusOK)
+	}
+}
+
+func TestCheckDoltPortRejectsInvalidEnv(t *testing.T) {
+	t.Setenv("BEADS_DOLT_SERVER_PORT", "not-a-port")
+	if got := CheckDoltPort(t.TempDir(), false).Status; got != StatusError {
+		t.Errorf("invalid BEADS_DOLT_SERVER_PORT = %q, want %q", got, StatusError)
+	}
+	if got := CheckDoltPort(t.TempDir(), true).Status; got != StatusOK {
+		t.Errorf("embedded mode = %q, want %q", got, StatusOK)
+	}
+
+	t.Setenv("BEADS_DOLT_SERVER_PORT", "3307")
+	if check := CheckDoltPort(t.TempDir(), false); check.Status != StatusOK || !strings.HasSuffix(check.Message, ":3307") {
+		t.Errorf("valid port: %+v, want ok on 3307", check)
+	}
+}
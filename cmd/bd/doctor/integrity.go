@@ -156,37 +156,9 @@ func CheckDependencyCyclesWithStore(ss *SharedStore) DoctorCheck {
 }
 
 func checkDependencyCyclesWithStore(store *dolt.DoltStore) DoctorCheck {
-	db := store.UnderlyingDB()
-
-	// Query for cycles using simplified SQL (CONCAT for Dolt/MySQL compatibility)
-	query := `
-		WITH RECURSIVE paths AS (
-			SELECT
-				issue_id,
-				COALESCE(depends_on_issue_id, depends_on_wisp_id, depends_on_external) AS depends_on_id,
-				issue_id as start_id,
-				CONCAT(issue_id, '→', COALESCE(depends_on_issue_id, depends_on_wisp_id, depends_on_external)) as path,
-				0 as depth
-			FROM dependencies
-
-			UNION ALL
-
-			SELECT
-				d.issue_id,
-				COALESCE(d.depends_on_issue_id, d.depends_on_wisp_id, d.depends_on_external) AS depends_on_id,
-				p.start_id,
-				CONCAT(p.path, '→', COALESCE(d.depends_on_issue_id, d.depends_on_wisp_id, d.depends_on_external)),
-				p.depth + 1
-			FROM dependencies d
-			JOIN paths p ON d.issue_id = p.depends_on_id
-			WHERE p.depth < 100
-			  AND p.path NOT LIKE CONCAT('%', COALESCE(d.depends_on_issue_id, d.depends_on_wisp_id, d.depends_on_external), '→%')
-		)
-		SELECT DISTINCT start_id
-		FROM paths
-		WHERE depends_on_id = start_id`
-
-	rows, err := db.Query(query)
+	// The same detection bd dep cycles and bd health run, so the three
+	// agree on what counts as a cycle.
+	cycles, err := store.DetectCycles(context.Background())
 	if err != nil {
 		return DoctorCheck{
 			Name:    "Dependency Cycles",
@@ -195,27 +167,11 @@ func checkDependencyCyclesWithStore(store *dolt.DoltStore) DoctorCheck {
 			Detail:  err.Error(),
 		}
 	}
-	defer rows.Close()
 
-	cycleCount := 0
+	cycleCount := len(cycles)
 	var firstCycle string
-	for rows.Next() {
-		var startID string
-		if err := rows.Scan(&startID); err != nil {
-			continue
-		}
-		cycleCount++
-		if cycleCount == 1 {
-			firstCycle = startID
-		}
-	}
-	if err := rows.Err(); err != nil {
-		return DoctorCheck{
-			Name:    "Dependency Cycles",
-			Status:  StatusWarning,
-			Message: "Row iteration error",
-			Detail:  err.Error(),
-		}
+	if cycleCount > 0 && len(cycles[0]) > 0 {
+		firstCycle = cycles[0][0].ID
 	}
 
 	if cycleCount == 0 {
@@ -0,0 +1,166 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// Relation problem kinds reported by FindRelationProblems.
+const (
+	RelationAsymmetricRelated = "asymmetric_related"
+	RelationSupersededOpen    = "superseded_not_closed"
+	RelationDuplicateMissing  = "duplicate_of_missing"
+)
+
+// RelationProblem is one inconsistent relation: a relates-to edge recorded on
+// only one side, a supersedes edge whose superseded issue is still open, or a
+// duplicates edge whose canonical issue no longer exists. It is shared
+// between 'bd dep verify' and 'bd doctor --check=validate'.
+type RelationProblem struct {
+	Kind     string `json:"kind"`
+	IssueID  string `json:"issue_id"`
+	TargetID string `json:"target_id"`
+	Message  string `json:"message"`
+}
+
+// RelationStore is the part of the storage layer FindRelationProblemsInStore
+// reads from.
+type RelationStore interface {
+	GetAllDependencyRecords(ctx context.Context) (map[string][]*types.Dependency, error)
+	GetIssuesByIDs(ctx context.Context, ids []string) ([]*types.Issue, error)
+}
+
+// FindRelationProblemsInStore loads every dependency and the issues at both
+// ends of the relations FindRelationProblems checks.
+func FindRelationProblemsInStore(ctx context.Context, store RelationStore) ([]RelationProblem, error) {
+	deps, err := store.GetAllDependencyRecords(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading dependencies: %w", err)
+	}
+	seen := make(map[string]bool)
+	var ids []string
+	for _, list := range deps {
+		for _, dep := range list {
+			if !isCheckedRelation(dep.Type) {
+				continue
+			}
+			for _, id := range []string{dep.IssueID, dep.DependsOnID} {
+				if !seen[id] && !strings.HasPrefix(id, "external:") {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+	statuses := make(map[string]types.Status, len(ids))
+	if len(ids) > 0 {
+		issues, err := store.GetIssuesByIDs(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("loading related issues: %w", err)
+		}
+		for _, issue := range issues {
+			statuses[issue.ID] = issue.Status
+		}
+	}
+	return FindRelationProblems(deps, statuses), nil
+}
+
+func isCheckedRelation(t types.DependencyType) bool {
+	return t == types.DepRelatesTo || t == types.DepSupersedes || t == types.DepDuplicates
+}
+
+// FindRelationProblems checks the relations that must be symmetric or paired.
+// deps maps issue IDs to their outgoing dependencies; statuses holds the
+// status of every issue that exists, so an ID missing from it was deleted.
+// Problems come out sorted by issue ID, then target ID.
+func FindRelationProblems(deps map[string][]*types.Dependency, statuses map[string]types.Status) []RelationProblem {
+	hasEdge := func(from, to string, t types.DependencyType) bool {
+		for _, dep := range deps[from] {
+			if dep.DependsOnID == to && dep.Type == t {
+				return true
+			}
+		}
+		return false
+	}
+
+	var problems []RelationProblem
+	for _, list := range deps {
+		for _, dep := range list {
+			from, to := dep.IssueID, dep.DependsOnID
+			if strings.HasPrefix(to, "external:") {
+				continue
+			}
+			_, targetExists := statuses[to]
+			switch dep.Type {
+			case types.DepRelatesTo:
+				if targetExists && !hasEdge(to, from, types.DepRelatesTo) {
+					problems = append(problems, RelationProblem{
+						Kind: RelationAsymmetricRelated, IssueID: from, TargetID: to,
+						Message: fmt.Sprintf("%s relates to %s, but %s does not relate back", from, to, to),
+					})
+				}
+			case types.DepSupersedes:
+				if status, ok := statuses[from]; ok && status != types.StatusClosed {
+					problems = append(problems, RelationProblem{
+						Kind: RelationSupersededOpen, IssueID: from, TargetID: to,
+						Message: fmt.Sprintf("%s is superseded by %s but is still %s", from, to, status),
+					})
+				}
+			case types.DepDuplicates:
+				if !targetExists {
+					problems = append(problems, RelationProblem{
+						Kind: RelationDuplicateMissing, IssueID: from, TargetID: to,
+						Message: fmt.Sprintf("%s is a duplicate of %s, which no longer exists", from, to),
+					})
+				}
+			}
+		}
+	}
+	sort.Slice(problems, func(i, j int) bool {
+		if problems[i].IssueID != problems[j].IssueID {
+			return problems[i].IssueID < problems[j].IssueID
+		}
+		return problems[i].TargetID < problems[j].TargetID
+	})
+	return problems
+}
+
+// CheckRelationConsistencyWithStore reports the relation problems as a
+// data-integrity check.
+func CheckRelationConsistencyWithStore(ctx context.Context, store RelationStore) DoctorCheck {
+	problems, err := FindRelationProblemsInStore(ctx, store)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Relation Consistency",
+			Status:  StatusWarning,
+			Message: "Unable to check relations",
+			Detail:  err.Error(),
+		}
+	}
+	if len(problems) == 0 {
+		return DoctorCheck{
+			Name:    "Relation Consistency",
+			Status:  StatusOK,
+			Message: "No inconsistent relations",
+		}
+	}
+	messages := make([]string, 0, len(problems))
+	for _, p := range problems {
+		messages = append(messages, p.Message)
+	}
+	detail := strings.Join(messages, "; ")
+	if len(detail) > 200 {
+		detail = detail[:200] + "..."
+	}
+	return DoctorCheck{
+		Name:    "Relation Consistency",
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d inconsistent relation(s)", len(problems)),
+		Detail:  detail,
+		Fix:     "Run 'bd dep verify' for the full list",
+	}
+}
@@ -0,0 +1,79 @@
+package doctor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestFindRelationProblems(t *testing.T) {
+	edge := func(from, to string, depType types.DependencyType) *types.Dependency {
+		return &types.Dependency{IssueID: from, DependsOnID: to, Type: depType}
+	}
+	deps := map[string][]*types.Dependency{
+		// bd-1 ↔ bd-2 is symmetric; bd-3 → bd-4 is one-sided.
+		"bd-1": {edge("bd-1", "bd-2", types.DepRelatesTo)},
+		"bd-2": {edge("bd-2", "bd-1", types.DepRelatesTo)},
+		"bd-3": {edge("bd-3", "bd-4", types.DepRelatesTo), edge("bd-3", "external:other:bd-9", types.DepRelatesTo)},
+		// bd-5 is superseded but still open; bd-6 is superseded and closed.
+		"bd-5": {edge("bd-5", "bd-7", types.DepSupersedes)},
+		"bd-6": {edge("bd-6", "bd-7", types.DepSupersedes)},
+		// bd-8 duplicates a deleted issue; bd-10 duplicates bd-7.
+		"bd-8":  {edge("bd-8", "bd-gone", types.DepDuplicates)},
+		"bd-10": {edge("bd-10", "bd-7", types.DepDuplicates)},
+		// Ordinary one-way edges are not relations that need a pair.
+		"bd-11": {edge("bd-11", "bd-7", types.DepBlocks), edge("bd-11", "bd-1", types.DepRelated)},
+	}
+	statuses := map[string]types.Status{
+		"bd-1": types.StatusOpen, "bd-2": types.StatusOpen, "bd-3": types.StatusOpen, "bd-4": types.StatusOpen,
+		"bd-5": types.StatusOpen, "bd-6": types.StatusClosed, "bd-7": types.StatusOpen,
+		"bd-8": types.StatusClosed, "bd-10": types.StatusClosed, "bd-11": types.StatusOpen,
+	}
+
+	got := FindRelationProblems(deps, statuses)
+	want := []struct{ kind, issueID, targetID string }{
+		{RelationAsymmetricRelated, "bd-3", "bd-4"},
+		{RelationSupersededOpen, "bd-5", "bd-7"},
+		{RelationDuplicateMissing, "bd-8", "bd-gone"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d problems, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		if got[i].Kind != w.kind || got[i].IssueID != w.issueID || got[i].TargetID != w.targetID {
+			t.Errorf("problem %d = %+v, want %s %s -> %s", i, got[i], w.kind, w.issueID, w.targetID)
+		}
+	}
+}
+
+type fakeRelationStore struct {
+	deps   map[string][]*types.Dependency
+	issues []*types.Issue
+}
+
+func (f fakeRelationStore) GetAllDependencyRecords(context.Context) (map[string][]*types.Dependency, error) {
+	return f.deps, nil
+}
+
+func (f fakeRelationStore) GetIssuesByIDs(context.Context, []string) ([]*types.Issue, error) {
+	return f.issues, nil
+}
+
+func TestCheckRelationConsistencyWithStore(t *testing.T) {
+	store := fakeRelationStore{
+		deps: map[string][]*types.Dependency{
+			"bd-1": {{IssueID: "bd-1", DependsOnID: "bd-2", Type: types.DepRelatesTo}},
+		},
+		issues: []*types.Issue{{ID: "bd-1", Status: types.StatusOpen}, {ID: "bd-2", Status: types.StatusOpen}},
+	}
+	check := CheckRelationConsistencyWithStore(context.Background(), store)
+	if check.Status != StatusWarning || check.Message != "1 inconsistent relation(s)" {
+		t.Errorf("check = %+v, want a warning for the one-sided relation", check)
+	}
+
+	store.deps["bd-2"] = []*types.Dependency{{IssueID: "bd-2", DependsOnID: "bd-1", Type: types.DepRelatesTo}}
+	if check := CheckRelationConsistencyWithStore(context.Background(), store); check.Status != StatusOK {
+		t.Errorf("check = %+v, want ok once both sides are recorded", check)
+	}
+}
@@ -199,6 +199,24 @@ func checkDuplicateIssuesDB(db *sql.DB, orchestratorMode bool, orchestratorThres
 	}
 }
 
+// CheckRelationConsistency detects relations that should be symmetric or
+// paired but are not; see FindRelationProblems.
+func CheckRelationConsistency(path string) DoctorCheck {
+	beadsDir := ResolveBeadsDirForRepo(path)
+
+	_, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Relation Consistency",
+			Status:  "ok",
+			Message: "N/A (no database)",
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return CheckRelationConsistencyWithStore(context.Background(), store)
+}
+
 // CheckTestPollution detects test issues that may have leaked into the database.
 func CheckTestPollution(path string) DoctorCheck {
 	beadsDir := ResolveBeadsDirForRepo(path)
@@ -83,6 +83,39 @@ func TestDoctorEmbeddedSupportedChecks(t *testing.T) {
 	}
 }
 
+// TestDoctorEmbeddedEnvCheck verifies --check=env runs in embedded mode and
+// reports every environment check. The statuses depend on the host (dolt may
+// be absent), so only the shape is asserted.
+func TestDoctorEmbeddedEnvCheck(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+	dir, _, _ := bdInit(t, bd, "--prefix", "dv")
+
+	stdout, stderr, _ := runBDSplit(t, bd, dir, "doctor", "--check=env", "--json")
+	var result struct {
+		Checks []doctorCheck `json:"checks"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("bd doctor --check=env --json did not emit valid JSON: %v\nstdout:\n%s\nstderr:\n%s", err, stdout, stderr)
+	}
+	got := make(map[string]string)
+	for _, c := range result.Checks {
+		got[c.Name] = c.Status
+	}
+	for _, name := range []string{"Dolt Installed", "Dolt Identity", "Dolt Port", "Git Hooks", "Pending Migrations", "Database"} {
+		if _, ok := got[name]; !ok {
+			t.Errorf("missing %q check in %v", name, got)
+		}
+	}
+	if got["Dolt Port"] != statusOK || got["Database"] != statusOK {
+		t.Errorf("port and schema checks are not applicable in embedded mode, got %v", got)
+	}
+}
+
 // TestDoctorEmbeddedUnsupportedJSON verifies that variants which still require
 // server mode (bare doctor, --check=validate) emit a structured JSON payload
 // when --json or --agent --json is set, rather than the prose stub. Tooling
@@ -172,7 +205,7 @@ func TestDoctorEmbeddedUnsupportedProse(t *testing.T) {
 	}
 
 	// The stub should advertise the embedded-mode-supported checks.
-	for _, want := range []string{"--check=artifacts", "--check=conventions", "--check=pollution"} {
+	for _, want := range []string{"--check=artifacts", "--check=conventions", "--check=env", "--check=pollution"} {
 		if !strings.Contains(out, want) {
 			t.Errorf("expected stub to mention %q so users know what works:\n%s", want, out)
 		}
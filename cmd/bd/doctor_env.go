@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/beads/cmd/bd/doctor"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// runEnvCheck runs the environment checks (--check=env): dolt binary and
+// identity, server port resolution, git hooks, and schema version. With --fix
+// it applies the safe remediations and re-checks. Unlike the data checks it
+// needs no database connection, so it runs in embedded mode too.
+func runEnvCheck(path string) error {
+	ok, err := runEnvCheckInner(path)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return SilentExit()
+	}
+	return nil
+}
+
+func runEnvCheckInner(path string) (bool, error) {
+	checks := collectEnvChecks(path)
+
+	if doctorFix {
+		applyValidateFixes(path, checks, "bd doctor --check=env --fix --yes")
+		checks = collectEnvChecks(path)
+	}
+
+	overallOK := validateOverallOK(checks)
+
+	if jsonOutput {
+		result := struct {
+			Path      string        `json:"path"`
+			Checks    []doctorCheck `json:"checks"`
+			OverallOK bool          `json:"overall_ok"`
+		}{
+			Path:      path,
+			OverallOK: overallOK,
+		}
+		for _, cr := range checks {
+			result.Checks = append(result.Checks, cr.check)
+		}
+		return overallOK, outputJSON(result)
+	}
+
+	printCheckResults("Environment", checks)
+
+	if !doctorFix && !overallOK {
+		for _, cr := range checks {
+			if cr.fixable && cr.check.Status != statusOK {
+				fmt.Printf("\n%s\n", ui.RenderMuted("Tip: Use 'bd doctor --check=env --fix' to apply the safe fixes"))
+				break
+			}
+		}
+	}
+
+	if overallOK {
+		fmt.Println()
+		fmt.Printf("%s\n", ui.RenderPass("✓ All environment checks passed"))
+	}
+	return overallOK, nil
+}
+
+// collectEnvChecks runs the environment checks. The schema check opens the
+// server database; embedded databases migrate themselves when bd opens them.
+func collectEnvChecks(path string) []validateCheckResult {
+	embedded := isEmbeddedMode()
+	checks := []validateCheckResult{
+		{check: convertDoctorCheck(doctor.CheckDoltInstalled(embedded))},
+		{check: convertDoctorCheck(doctor.CheckDoltIdentity()), fixable: true},
+		{check: convertDoctorCheck(doctor.CheckDoltPort(path, embedded))},
+		{check: convertDoctorCheck(doctor.CheckGitHooks(Version)), fixable: true},
+		{check: convertDoctorCheck(doctor.CheckPendingMigrations(path)), fixable: true},
+	}
+	if embedded {
+		checks = append(checks, validateCheckResult{check: doctorCheck{
+			Name:     "Database",
+			Status:   statusOK,
+			Message:  "N/A (embedded mode migrates the schema on open)",
+			Category: doctor.CategoryCore,
+		}})
+	} else {
+		checks = append(checks, validateCheckResult{check: convertDoctorCheck(doctor.CheckDatabaseVersion(path, Version)), fixable: true})
+	}
+	return checks
+}
@@ -32,7 +32,7 @@ func runValidateCheckInner(path string) (bool, error) {
 
 	// Apply fixes if --fix is set, then re-check to reflect post-fix state
 	if doctorFix {
-		applyValidateFixes(path, checks)
+		applyValidateFixes(path, checks, "bd doctor --check=validate --fix --yes")
 		checks = collectValidateChecks(path)
 	}
 
@@ -58,7 +58,7 @@ func runValidateCheckInner(path string) (bool, error) {
 	}
 
 	// Human-readable output
-	printValidateChecks(checks)
+	printCheckResults("Data Integrity", checks)
 
 	if !doctorFix && !overallOK {
 		// Suggest --fix if there are fixable issues
@@ -84,6 +84,7 @@ func collectValidateChecks(path string) []validateCheckResult {
 		{check: convertDoctorCheck(doctor.CheckCrossTableDuplicates(path)), fixable: true},
 		{check: convertDoctorCheck(doctor.CheckDuplicateIssues(path, doctorOrchestrator, orchestratorDuplicatesThreshold))},
 		{check: convertDoctorCheck(doctor.CheckOrphanedDependencies(path)), fixable: true},
+		{check: convertDoctorCheck(doctor.CheckRelationConsistency(path))},
 		{check: convertDoctorCheck(doctor.CheckTestPollution(path))},
 		{check: convertDoctorCheck(doctor.CheckGitConflicts(path))},
 	}
@@ -98,9 +99,11 @@ func validateOverallOK(checks []validateCheckResult) bool {
 	return true
 }
 
-func printValidateChecks(checks []validateCheckResult) {
+// printCheckResults prints one category of --check results with pass, warn,
+// and fail counts.
+func printCheckResults(title string, checks []validateCheckResult) {
 	fmt.Println()
-	fmt.Println(ui.RenderCategory("Data Integrity"))
+	fmt.Println(ui.RenderCategory(title))
 
 	var passCount, warnCount, failCount int
 	for _, cr := range checks {
@@ -125,6 +128,9 @@ func printValidateChecks(checks []validateCheckResult) {
 		if cr.check.Detail != "" {
 			fmt.Printf("     %s%s\n", ui.MutedStyle.Render(ui.TreeLast), ui.RenderMuted(cr.check.Detail))
 		}
+		if cr.check.Fix != "" && cr.check.Status != statusOK {
+			fmt.Printf("     %s\n", ui.RenderMuted("Fix: "+cr.check.Fix))
+		}
 	}
 
 	fmt.Println()
@@ -139,7 +145,8 @@ func printValidateChecks(checks []validateCheckResult) {
 // applyValidateFixes auto-repairs fixable validation issues.
 // Reuses doctor's applyFixList for dispatch (doctor_fix.go), which already
 // handles the "Orphaned Dependencies" case and any future fixable checks.
-func applyValidateFixes(path string, checks []validateCheckResult) {
+// yesCommand is the command suggested when stdin is not a terminal.
+func applyValidateFixes(path string, checks []validateCheckResult, yesCommand string) {
 	var fixable []doctorCheck
 	for _, cr := range checks {
 		if cr.fixable && cr.check.Status != statusOK {
@@ -158,7 +165,7 @@ func applyValidateFixes(path string, checks []validateCheckResult) {
 		if !isInteractive {
 			// In non-interactive mode without --yes, skip with helpful message
 			fmt.Fprintf(os.Stderr, "\n%s Running in non-interactive mode\n", ui.RenderWarn("⚠"))
-			fmt.Fprintf(os.Stderr, "  To auto-fix issues without prompting, use: %s\n\n", ui.RenderAccent("bd doctor --validate --yes"))
+			fmt.Fprintf(os.Stderr, "  To auto-fix issues without prompting, use: %s\n\n", ui.RenderAccent(yesCommand))
 			return
 		}
 
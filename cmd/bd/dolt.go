@@ -45,6 +45,7 @@ Configuration:
   bd dolt show         Show current Dolt configuration with connection test
   bd dolt set <k> <v>  Set a configuration value
   bd dolt test         Test server connection
+  bd dolt doctor       Diagnose the server connection
 
 Version control:
   bd dolt commit       Commit pending changes
@@ -996,37 +997,92 @@ orphans and will be killed.
 
 In standalone mode, only dolt sql-server processes using the current
 project's Dolt data directory are eligible for cleanup. Other projects'
-servers are preserved.`,
+servers are preserved.
+
+The matching servers are listed with their port and data directory and you
+are asked to confirm before anything is killed. Use --port or --data-dir to
+narrow the cleanup further, and --yes to skip the prompt (required when
+stdin is not a terminal).
+
+Examples:
+  bd dolt killall                 # list orphans, confirm, kill
+  bd dolt killall --port 3307     # only the orphan listening on 3307
+  bd dolt killall --yes --json    # non-interactive`,
+	Args:          cobra.NoArgs,
 	SilenceUsage:  true,
 	SilenceErrors: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		port, _ := cmd.Flags().GetInt("port")
+		dataDir, _ := cmd.Flags().GetString("data-dir")
+		yes, _ := cmd.Flags().GetBool("yes")
+		if port < 0 {
+			return HandleErrorRespectJSON("--port must be a positive port number")
+		}
+
 		beadsDir := selectedDoltBeadsDir()
 		if beadsDir != "" {
 			if _, err := loadDoltBackendConfig(beadsDir); err != nil {
-				return HandleError("%v", err)
+				return HandleErrorRespectJSON("%v", err)
 			}
 		}
 		if !usesSQLServer() {
-			return HandleError("'bd dolt killall' is not supported in embedded mode (no Dolt server)")
+			return HandleErrorRespectJSON("'bd dolt killall' is not supported in embedded mode (no Dolt server)")
 		}
 		if beadsDir == "" {
 			beadsDir = "." // best effort
 		}
 
-		killed, err := doltserver.KillStaleServers(beadsDir)
-		if err != nil {
-			return HandleError("%v", err)
+		candidates := doltserver.FindStaleServers(beadsDir, doltserver.KillScope{Port: port, DataDir: dataDir})
+		if len(candidates) == 0 {
+			if jsonOutput {
+				return outputJSON(map[string]interface{}{"killed": []doltserver.StaleServer{}})
+			}
+			fmt.Println("No orphan dolt servers found.")
+			return nil
+		}
+
+		if !yes {
+			if jsonOutput || !term.IsTerminal(int(os.Stdin.Fd())) {
+				return HandleErrorRespectJSON("refusing to kill %d dolt server(s) without confirmation; re-run with --yes", len(candidates))
+			}
+			fmt.Printf("Found %d orphan dolt server(s):\n", len(candidates))
+			for _, srv := range candidates {
+				fmt.Printf("  %s\n", describeStaleServer(srv))
+			}
+			if !confirmPrompt("Kill them?", false) {
+				fmt.Println("Canceled.")
+				return nil
+			}
 		}
 
+		killed := doltserver.KillServers(candidates)
+		if jsonOutput {
+			if killed == nil {
+				killed = []doltserver.StaleServer{}
+			}
+			return outputJSON(map[string]interface{}{"killed": killed})
+		}
 		if len(killed) == 0 {
-			fmt.Println("No orphan dolt servers found.")
-		} else {
-			fmt.Printf("Killed %d orphan dolt server(s): %v\n", len(killed), killed)
+			fmt.Println("No orphan dolt servers killed (they exited or changed since the lookup).")
+			return nil
+		}
+		fmt.Printf("Killed %d orphan dolt server(s):\n", len(killed))
+		for _, srv := range killed {
+			fmt.Printf("  %s\n", describeStaleServer(srv))
 		}
 		return nil
 	},
 }
 
+// describeStaleServer renders one killall line: PID, port and data dir.
+func describeStaleServer(srv doltserver.StaleServer) string {
+	port := "unknown"
+	if srv.Port > 0 {
+		port = strconv.Itoa(srv.Port)
+	}
+	return fmt.Sprintf("PID %d  port %s  data dir %s", srv.PID, port, srv.DataDir)
+}
+
 // staleDatabasePrefixes lists database name prefixes that
 // `bd dolt clean-databases` will drop. This is the cleanup side of the
 // test/prod split. Two sibling lists must converge with it (be-avn):
@@ -1544,6 +1600,9 @@ func isTimeoutError(err error) bool {
 func init() {
 	doltSetCmd.Flags().Bool("update-config", false, "Also write to config.yaml for team-wide defaults")
 	doltStopCmd.Flags().Bool("force", false, "Force stop the server")
+	doltKillallCmd.Flags().Int("port", 0, "Only kill orphan servers listening on this port")
+	doltKillallCmd.Flags().String("data-dir", "", "Only kill orphan servers using this Dolt data directory")
+	doltKillallCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
 	doltPushCmd.Flags().Bool("force", false, "Force push (overwrite remote changes)")
 	doltPushCmd.Flags().String("remote", "", "Push to a specific named remote instead of the default")
 	doltPullCmd.Flags().String("remote", "", "Pull from a specific named remote instead of the default")
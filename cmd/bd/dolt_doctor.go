@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var doltDoctorCmd = &cobra.Command{
+	Use:           "doctor",
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Short:         "Diagnose the Dolt server connection",
+	Long: `Run the Dolt server health checks: whether the server answers on the
+configured host and port, its version, and the database schema.
+
+This is 'bd doctor --server', reachable from the dolt command group. It needs
+no open database, so it works when the server is down — which is when bd
+points you here.
+
+Examples:
+  bd dolt doctor
+  bd dolt doctor --json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if isEmbeddedMode() {
+			return HandleError("'bd dolt doctor' is not supported in embedded mode (no Dolt server)")
+		}
+		path, err := healthWorkspacePath()
+		if err != nil {
+			return HandleError("failed to resolve path: %v", err)
+		}
+		return runServerHealth(path)
+	},
+}
+
+func init() {
+	doltCmd.AddCommand(doltDoctorCmd)
+}
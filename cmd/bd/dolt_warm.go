@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/doltserver"
+)
+
+var doltWarmCmd = &cobra.Command{
+	Use:           "warm",
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Short:         "Pre-start the Dolt server and warm a connection",
+	Long: `Ensure the Dolt SQL server is running and open a pinged connection, so
+the next interactive bd command does not pay server start-up and connect cost.
+
+By default warm pings once and exits. With --hold it keeps the connection
+open, re-pinging every --interval, until the hold elapses or the command is
+interrupted. The hold is capped at 30 minutes so a forgotten warm-up never
+keeps the server up forever.
+
+Examples:
+  bd dolt warm                 # start (if needed) and ping once
+  bd dolt warm --hold 10m &    # keep the server warm for the next 10 minutes`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		beadsDir := selectedDoltBeadsDir()
+		if beadsDir == "" {
+			return HandleErrorWithHint(activeWorkspaceNotFoundError(), diagHint())
+		}
+		cfg, err := loadDoltBackendConfig(beadsDir)
+		if err != nil {
+			return HandleError("%v", err)
+		}
+		if !usesSQLServer() {
+			return HandleError("'bd dolt warm' is not supported in embedded mode (no Dolt server)")
+		}
+
+		hold, _ := cmd.Flags().GetDuration("hold")
+		interval, _ := cmd.Flags().GetDuration("interval")
+		if hold < 0 || interval < 0 {
+			return HandleError("--hold and --interval must not be negative")
+		}
+		maxHold := doltserver.DefaultWarmMaxHold
+
+		port := doltserver.DefaultConfig(beadsDir).Port
+		opts := doltserver.WarmOptions{
+			Host:     cfg.GetDoltServerHost(),
+			User:     cfg.GetDoltServerUser(),
+			Password: cfg.GetDoltServerPasswordForPort(port),
+			TLS:      cfg.GetDoltServerTLS(),
+			Hold:     hold,
+			Interval: interval,
+			MaxHold:  maxHold,
+		}
+
+		if hold > 0 && !jsonOutput {
+			fmt.Printf("Holding a warm connection for up to %s (Ctrl-C to stop)\n", min(hold, maxHold))
+		}
+
+		start := time.Now()
+		result, err := doltserver.Warm(rootCtx, beadsDir, opts)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			return outputJSON(result)
+		}
+		state := "already running"
+		if result.StartedServer {
+			state = "started"
+		}
+		fmt.Printf("Dolt server warm (port %d, %s, %s)\n", result.Port, state, time.Since(start).Round(time.Millisecond))
+		if result.Clamped {
+			fmt.Printf("  Hold capped at %s\n", maxHold)
+		}
+		return nil
+	},
+}
+
+func init() {
+	doltWarmCmd.Flags().Duration("hold", 0, "Keep the connection warm for this long (capped at 30m)")
+	doltWarmCmd.Flags().Duration("interval", 0, "Keep-alive ping interval while holding (default 30s)")
+	doltCmd.AddCommand(doltWarmCmd)
+}
@@ -19,8 +19,15 @@ var duplicateCmd = &cobra.Command{
 The duplicate issue is automatically closed with a reference to the canonical.
 This is essential for large issue databases with many similar reports.
 
+With --keep-open only the duplicates link is recorded and the duplicate keeps
+its current status, e.g. while its reporters are redirected to the canonical.
+
+The relation is visible from both sides in 'bd show --json': the duplicate
+carries "duplicate_of" and the canonical lists it under "duplicated_by".
+
 Examples:
-  bd duplicate bd-abc --of bd-xyz    # Mark bd-abc as duplicate of bd-xyz`,
+  bd duplicate bd-abc --of bd-xyz               # Mark bd-abc as duplicate of bd-xyz and close it
+  bd duplicate bd-abc --of bd-xyz --keep-open   # Link only; bd-abc stays open`,
 	Args: cobra.ExactArgs(1),
 	RunE: runDuplicate,
 }
@@ -34,25 +41,34 @@ var supersedeCmd = &cobra.Command{
 The superseded issue is automatically closed with a reference to the replacement.
 Useful for design docs, specs, and evolving artifacts.
 
+With --keep-open only the supersedes link is recorded and the old issue keeps
+its current status. Use it for phased migrations where the old issue stays in
+service until the replacement lands; close it later with 'bd close'.
+
 Examples:
-  bd supersede bd-old --with bd-new    # Mark bd-old as superseded by bd-new`,
+  bd supersede bd-old --with bd-new               # Mark bd-old as superseded by bd-new and close it
+  bd supersede bd-old --with bd-new --keep-open   # Link only; bd-old stays open`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSupersede,
 }
 
 var (
-	duplicateOf    string
-	supersededWith string
+	duplicateOf       string
+	duplicateKeepOpen bool
+	supersededWith    string
+	supersedeKeepOpen bool
 )
 
 func init() {
 	duplicateCmd.Flags().StringVar(&duplicateOf, "of", "", "Canonical issue ID (required)")
 	_ = duplicateCmd.MarkFlagRequired("of") // Only fails if flag missing (caught in tests)
+	duplicateCmd.Flags().BoolVar(&duplicateKeepOpen, "keep-open", false, "Record the duplicates link but leave the duplicate open")
 	duplicateCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(duplicateCmd)
 
 	supersedeCmd.Flags().StringVar(&supersededWith, "with", "", "Replacement issue ID (required)")
 	_ = supersedeCmd.MarkFlagRequired("with") // Only fails if flag missing (caught in tests)
+	supersedeCmd.Flags().BoolVar(&supersedeKeepOpen, "keep-open", false, "Record the supersedes link but leave the old issue open")
 	supersedeCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(supersedeCmd)
 }
@@ -107,13 +123,21 @@ func runDuplicate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to add duplicate link: %w", err)
 	}
 
-	// Close the duplicate issue
-	closedStatus := string(types.StatusClosed)
-	updates := map[string]interface{}{
-		"status": closedStatus,
-	}
-	if err := store.UpdateIssue(ctx, duplicateID, updates, actor); err != nil {
-		return fmt.Errorf("failed to close duplicate: %w", err)
+	// Close the duplicate issue, unless the caller wants it kept open.
+	status := string(types.StatusClosed)
+	if duplicateKeepOpen {
+		dupe, err := store.GetIssue(ctx, duplicateID)
+		if err != nil || dupe == nil {
+			return fmt.Errorf("duplicate issue not found: %s", duplicateID)
+		}
+		status = string(dupe.Status)
+	} else {
+		updates := map[string]interface{}{
+			"status": status,
+		}
+		if err := store.UpdateIssue(ctx, duplicateID, updates, actor); err != nil {
+			return fmt.Errorf("failed to close duplicate: %w", err)
+		}
 	}
 
 	commandDidWrite.Store(true)
@@ -122,11 +146,15 @@ func runDuplicate(cmd *cobra.Command, args []string) error {
 		return outputJSON(map[string]interface{}{
 			"duplicate": duplicateID,
 			"canonical": canonicalID,
-			"status":    "closed",
+			"status":    status,
 		})
 	}
 
-	fmt.Printf("%s Marked %s as duplicate of %s (closed)\n", ui.RenderPass("✓"), duplicateID, canonicalID)
+	state := "closed"
+	if duplicateKeepOpen {
+		state = "kept " + status
+	}
+	fmt.Printf("%s Marked %s as duplicate of %s (%s)\n", ui.RenderPass("✓"), duplicateID, canonicalID, state)
 	return nil
 }
 
@@ -180,13 +208,21 @@ func runSupersede(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to add supersede link: %w", err)
 	}
 
-	// Close the superseded issue
-	closedStatus := string(types.StatusClosed)
-	updates := map[string]interface{}{
-		"status": closedStatus,
-	}
-	if err := store.UpdateIssue(ctx, oldID, updates, actor); err != nil {
-		return fmt.Errorf("failed to close superseded issue: %w", err)
+	// Close the superseded issue, unless the caller is phasing it out.
+	status := string(types.StatusClosed)
+	if supersedeKeepOpen {
+		oldIssue, err := store.GetIssue(ctx, oldID)
+		if err != nil || oldIssue == nil {
+			return fmt.Errorf("superseded issue not found: %s", oldID)
+		}
+		status = string(oldIssue.Status)
+	} else {
+		updates := map[string]interface{}{
+			"status": status,
+		}
+		if err := store.UpdateIssue(ctx, oldID, updates, actor); err != nil {
+			return fmt.Errorf("failed to close superseded issue: %w", err)
+		}
 	}
 
 	commandDidWrite.Store(true)
@@ -195,10 +231,14 @@ func runSupersede(cmd *cobra.Command, args []string) error {
 		return outputJSON(map[string]interface{}{
 			"superseded":  oldID,
 			"replacement": newID,
-			"status":      "closed",
+			"status":      status,
 		})
 	}
 
-	fmt.Printf("%s Marked %s as superseded by %s (closed)\n", ui.RenderPass("✓"), oldID, newID)
+	state := "closed"
+	if supersedeKeepOpen {
+		state = "kept " + status
+	}
+	fmt.Printf("%s Marked %s as superseded by %s (%s)\n", ui.RenderPass("✓"), oldID, newID, state)
 	return nil
 }
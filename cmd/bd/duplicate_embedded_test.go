@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -77,6 +78,52 @@ func TestEmbeddedDuplicate(t *testing.T) {
 		}
 	})
 
+	// ===== --keep-open links without closing =====
+
+	t.Run("keep_open", func(t *testing.T) {
+		canonical := bdCreate(t, bd, dir, "Canon keep", "--type", "bug")
+		dupe := bdCreate(t, bd, dir, "Dupe keep", "--type", "bug")
+		out := bdDuplicate(t, bd, dir, dupe.ID, "--of", canonical.ID, "--keep-open")
+		if !strings.Contains(out, "kept open") {
+			t.Errorf("expected 'kept open' in output: %s", out)
+		}
+
+		s := openStore(t, beadsDir, "du")
+		issue, err := s.GetIssue(t.Context(), dupe.ID)
+		if err != nil {
+			t.Fatalf("GetIssue: %v", err)
+		}
+		if issue.Status != "open" {
+			t.Errorf("expected status=open with --keep-open, got %s", issue.Status)
+		}
+	})
+
+	// ===== Both endpoints show the relation =====
+
+	t.Run("show_json_both_sides", func(t *testing.T) {
+		canonical := bdCreate(t, bd, dir, "Canon both", "--type", "bug")
+		dupe1 := bdCreate(t, bd, dir, "Dupe both 1", "--type", "bug")
+		dupe2 := bdCreate(t, bd, dir, "Dupe both 2", "--type", "bug")
+		bdDuplicate(t, bd, dir, dupe1.ID, "--of", canonical.ID)
+		bdDuplicate(t, bd, dir, dupe2.ID, "--of", canonical.ID, "--keep-open")
+
+		for _, dupe := range []string{dupe1.ID, dupe2.ID} {
+			if got := bdShowDetails(t, bd, dir, dupe)["duplicate_of"]; got != canonical.ID {
+				t.Errorf("%s duplicate_of = %v, want %s", dupe, got, canonical.ID)
+			}
+		}
+		// duplicated_by is sorted by ID.
+		want := []string{dupe1.ID, dupe2.ID}
+		slices.Sort(want)
+		got, _ := bdShowDetails(t, bd, dir, canonical.ID)["duplicated_by"].([]interface{})
+		if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+			t.Errorf("canonical duplicated_by = %v, want %v", got, want)
+		}
+		if _, ok := bdShowDetails(t, bd, dir, canonical.ID)["duplicate_of"]; ok {
+			t.Error("canonical should not carry duplicate_of")
+		}
+	})
+
 	// ===== Creates dependency link =====
 
 	t.Run("creates_dep_link", func(t *testing.T) {
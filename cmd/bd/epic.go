@@ -57,10 +57,7 @@ func renderEpicStatus(epics []*types.EpicStatus, eligibleOnly bool) error {
 	}
 	for _, epicStatus := range epics {
 		epic := epicStatus.Epic
-		percentage := 0
-		if epicStatus.TotalChildren > 0 {
-			percentage = (epicStatus.ClosedChildren * 100) / epicStatus.TotalChildren
-		}
+		percentage := completionPercent(epicStatus.ClosedChildren, epicStatus.TotalChildren)
 		statusIcon := ""
 		if epicStatus.EligibleForClose {
 			statusIcon = ui.RenderPass("✓")
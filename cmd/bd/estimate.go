@@ -0,0 +1,17 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/timeparsing"
+)
+
+// estimateFlagUsage is the --estimate help shared by create and update.
+const estimateFlagUsage = "Time estimate: minutes (60) or a duration like 45m, 1h30m, 1d, 2w (a day is estimate.workday_hours, default 8)"
+
+// parseEstimateFlag reads --estimate as minutes, converting human durations
+// with the configured workday length.
+func parseEstimateFlag(cmd *cobra.Command) (int, error) {
+	raw, _ := cmd.Flags().GetString("estimate")
+	return timeparsing.ParseEstimate(raw, config.GetInt("estimate.workday_hours"))
+}
@@ -24,13 +24,23 @@ var exportCmd = &cobra.Command{
 	Long: `Export all issues to JSONL (newline-delimited JSON) format.
 
 Each line is a complete JSON object representing one issue, including its
-labels, dependencies, and comments.
+labels, dependencies, and comments (--jsonl, the default). With --format json
+the same records are written as a JSON array instead, one record per line.
+Both formats are read back by 'bd import'.
+
+Issues are ordered by created_at, then ID, so two exports of the same data
+are byte-identical and diffs between exports show only real changes. All
+statuses are exported by default; use --status to narrow the set and --since
+for an incremental export of issues updated after a point in time.
 
 This command is for issue export, migration, and interoperability. It exports
 records from the issues table; it is not a full database backup and does not
 capture Dolt branches, commit history, working-set state, or non-issue tables.
 For supported full backup/restore flows, use 'bd backup init', 'bd backup sync',
-and 'bd backup restore'.
+and 'bd backup restore'. For a one-off portable snapshot of the whole database
+(schema and data of every table, as SQL), use --dolt-dump with an --output
+directory: it writes Dolt's dump as beads.sql plus a manifest.json describing
+it, and requires the dolt CLI and local database files.
 
 By default, exports only regular issues (excluding infrastructure beads
 like agents, roles, and messages). Use --all to include everything.
@@ -41,10 +51,14 @@ include them.
 
 EXAMPLES:
   bd export                              # Export issues to stdout
-  bd export -o issues.jsonl              # Export issues to file
+  bd export --jsonl -o issues.jsonl      # Export issues to file
   bd export --include-memories           # Export issues + memories
   bd export --all -o full.jsonl          # Include infra + templates + gates + memories
-  bd export --scrub -o clean.jsonl       # Exclude test/pollution records`,
+  bd export --scrub -o clean.jsonl       # Exclude test/pollution records
+  bd export --format json -o issues.json # JSON array instead of JSONL
+  bd export --since 2026-01-01           # Only issues updated since then
+  bd export --status open,in_progress    # Only open and in-progress issues
+  bd export --dolt-dump -o backups/      # Full database snapshot (beads.sql + manifest.json)`,
 	GroupID:       "sync",
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -60,6 +74,11 @@ var (
 	exportIncludeMemories bool
 	exportExcludeOwners   []string
 	exportVerbose         bool
+	exportJSONL           bool
+	exportFormat          string
+	exportStatus          string
+	exportSince           string
+	exportDoltDump        bool
 )
 
 func init() {
@@ -72,6 +91,11 @@ func init() {
 	_ = exportCmd.Flags().MarkHidden("no-memories")
 	exportCmd.Flags().StringArrayVar(&exportExcludeOwners, "exclude-owner", nil, "Exclude issues created by this identity (repeatable; also reads export.exclude_owners config)")
 	exportCmd.Flags().BoolVar(&exportVerbose, "verbose", false, "Print filtered issue count when owners are excluded")
+	exportCmd.Flags().BoolVar(&exportJSONL, "jsonl", false, "Write JSONL, one record per line (the default)")
+	exportCmd.Flags().StringVar(&exportFormat, "format", "jsonl", "Output format: jsonl (one record per line) or json (a JSON array)")
+	exportCmd.Flags().StringVar(&exportStatus, "status", "all", "Only export issues with these statuses (comma-separated, or all)")
+	exportCmd.Flags().StringVar(&exportSince, "since", "", "Only export issues updated after this time (e.g. 2026-01-02, RFC3339, -7d)")
+	exportCmd.Flags().BoolVar(&exportDoltDump, "dolt-dump", false, "Write a full database snapshot (Dolt dump + manifest) to the --output directory")
 	rootCmd.AddCommand(exportCmd)
 }
 
@@ -86,8 +110,49 @@ func runExport(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	if exportDoltDump {
+		return runExportDoltDump(cmd)
+	}
+
 	ctx := rootCtx
 
+	var asArray bool
+	switch strings.ToLower(exportFormat) {
+	case "jsonl":
+	case "json":
+		if exportJSONL {
+			return HandleErrorRespectJSON("--jsonl and --format json are mutually exclusive")
+		}
+		asArray = true
+	default:
+		return HandleErrorRespectJSON("invalid --format %q (want json or jsonl)", exportFormat)
+	}
+
+	// Build filter for issues table. Export all statuses by default.
+	// Opt out of BEADS_MAX_ROWS (designer §4.1) — export is a data-integrity
+	// path and must never abort partway through an export run.
+	filter := types.IssueFilter{
+		Limit:         0,
+		MaxRows:       0,
+		MaxRowsSource: "",
+	}
+
+	if exportStatus != "" && exportStatus != "all" {
+		customStatuses, _ := store.GetCustomStatusesDetailed(ctx)
+		names := listFilterConfig{customStatuses: customStatuses}.customStatusNames()
+		if err := applyStatusFilter(&filter, exportStatus, names); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+	}
+
+	if exportSince != "" {
+		since, err := parseTimeFlag(exportSince)
+		if err != nil {
+			return HandleErrorRespectJSON("invalid --since %q: %v", exportSince, err)
+		}
+		filter.UpdatedAfter = &since
+	}
+
 	// Determine output destination. File output uses atomic writes
 	// (temp file + rename) so concurrent exports and crashes never
 	// leave a truncated or interleaved JSONL file.
@@ -107,15 +172,7 @@ func runExport(cmd *cobra.Command, args []string) error {
 	} else {
 		w = os.Stdout
 	}
-
-	// Build filter for issues table. Export all statuses by default.
-	// Opt out of BEADS_MAX_ROWS (designer §4.1) — export is a data-integrity
-	// path and must never abort partway through an export run.
-	filter := types.IssueFilter{
-		Limit:         0,
-		MaxRows:       0,
-		MaxRowsSource: "",
-	}
+	rw := &exportRecordWriter{w: w, array: asArray}
 
 	// Exclude infra types by default (agents, roles, messages).
 	if !exportAll && !exportIncludeInfra {
@@ -170,7 +227,9 @@ func runExport(cmd *cobra.Command, args []string) error {
 		filteredOwnerCount = before - len(issues)
 	}
 
-	if len(issues) == 0 && exportNoMemories {
+	sortIssuesForExport(issues)
+
+	if len(issues) == 0 && exportNoMemories && !rw.array {
 		if exportOutput != "" {
 			fmt.Fprintln(os.Stderr, "No issues to export.")
 		}
@@ -219,15 +278,8 @@ func runExport(cmd *cobra.Command, args []string) error {
 			},
 		}
 
-		data, err := json.Marshal(record)
-		if err != nil {
-			return HandleErrorRespectJSON("failed to marshal issue %s: %v", issue.ID, err)
-		}
-		if _, err := w.Write(data); err != nil {
-			return HandleErrorRespectJSON("failed to write: %v", err)
-		}
-		if _, err := w.Write([]byte{'\n'}); err != nil {
-			return HandleErrorRespectJSON("failed to write newline: %v", err)
+		if err := rw.write(record); err != nil {
+			return HandleErrorRespectJSON("failed to write issue %s: %v", issue.ID, err)
 		}
 		count++
 	}
@@ -257,20 +309,17 @@ func runExport(cmd *cobra.Command, args []string) error {
 				"key":   userKey,
 				"value": v,
 			}
-			data, err := json.Marshal(record)
-			if err != nil {
-				return HandleErrorRespectJSON("failed to marshal memory %s: %v", userKey, err)
-			}
-			if _, err := w.Write(data); err != nil {
-				return HandleErrorRespectJSON("failed to write: %v", err)
-			}
-			if _, err := w.Write([]byte{'\n'}); err != nil {
-				return HandleErrorRespectJSON("failed to write newline: %v", err)
+			if err := rw.write(record); err != nil {
+				return HandleErrorRespectJSON("failed to write memory %s: %v", userKey, err)
 			}
 			memoryCount++
 		}
 	}
 
+	if err := rw.close(); err != nil {
+		return HandleErrorRespectJSON("failed to write: %v", err)
+	}
+
 	// Finalize atomic write if writing to file (fsync + rename).
 	if aw != nil {
 		if err := aw.Close(); err != nil {
@@ -301,6 +350,60 @@ type exportIssueRecord struct {
 	*types.IssueWithCounts
 }
 
+// exportRecordWriter writes export records as JSONL or, when array is set,
+// as a JSON array holding one record per line so exports stay diffable.
+type exportRecordWriter struct {
+	w     io.Writer
+	array bool
+	n     int
+}
+
+func (rw *exportRecordWriter) write(record interface{}) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if rw.array {
+		sep := ",\n"
+		if rw.n == 0 {
+			sep = "[\n"
+		}
+		data = append([]byte(sep), data...)
+	} else {
+		data = append(data, '\n')
+	}
+	if _, err := rw.w.Write(data); err != nil {
+		return err
+	}
+	rw.n++
+	return nil
+}
+
+// close terminates the JSON array; JSONL needs no trailer.
+func (rw *exportRecordWriter) close() error {
+	if !rw.array {
+		return nil
+	}
+	tail := "\n]\n"
+	if rw.n == 0 {
+		tail = "[]\n"
+	}
+	_, err := io.WriteString(rw.w, tail)
+	return err
+}
+
+// sortIssuesForExport orders issues by created_at, then ID, so repeated
+// exports of unchanged data are identical.
+func sortIssuesForExport(issues []*types.Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.ID < b.ID
+	})
+}
+
 // sanitizeZeroTime replaces Go zero-value time.Time fields with Unix epoch.
 // NULL datetime columns in Dolt scan as time.Time{} (year 0001-01-01), which
 // causes json.Marshal to fail with "year outside of range [0,9999]". (GH#2488)
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/atomicfile"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/configfile"
+	"github.com/steveyegge/beads/internal/storage"
+)
+
+// --- bd export --dolt-dump ---
+//
+// A Dolt dump is a physical snapshot: every table of the beads database,
+// schema and data, as SQL that 'dolt sql' replays into a fresh database. It
+// complements the logical JSONL export, which covers only issue records, for
+// disaster recovery. The dump holds the working set only; 'bd backup' is the
+// path that preserves commit history.
+
+const (
+	doltDumpFileName     = "beads.sql"
+	doltDumpManifestName = "manifest.json"
+	doltDumpFormat       = "dolt-dump"
+)
+
+// doltDumpManifest describes a dump directory. It is written next to the dump
+// as manifest.json so bd restore --dolt-dump can check what it is replaying.
+type doltDumpManifest struct {
+	Format        string    `json:"format"`
+	CreatedAt     time.Time `json:"created_at"`
+	BDVersion     string    `json:"bd_version"`
+	DoltVersion   string    `json:"dolt_version,omitempty"`
+	Database      string    `json:"database"`
+	ProjectID     string    `json:"project_id,omitempty"`
+	SchemaVersion int       `json:"schema_version"` // restore refuses versions newer than the binary's
+	Branch        string    `json:"branch,omitempty"`
+	Commit        string    `json:"commit,omitempty"`
+	IssueCount    int       `json:"issue_count"`
+	DumpFile      string    `json:"dump_file"`
+	SizeBytes     int64     `json:"size_bytes"`
+	SHA256        string    `json:"sha256"`
+	Restore       string    `json:"restore"`
+}
+
+// doltDumpExclusiveFlags are the logical-export filters. A dump always covers
+// the whole database, so none of them can apply to it.
+var doltDumpExclusiveFlags = []string{
+	"all", "include-infra", "scrub", "include-memories", "exclude-owner",
+	"jsonl", "status", "since",
+}
+
+// doltDumpBackendError refuses a workspace whose metadata names a backend
+// other than Dolt. A SQLite database is a single file, so copying that file
+// is its full snapshot.
+func doltDumpBackendError(backend string) error {
+	switch backend {
+	case "", configfile.BackendDolt:
+		return nil
+	case configfile.BackendSQLite:
+		return fmt.Errorf("--dolt-dump requires the Dolt backend; this workspace is configured for SQLite, whose database is a single file: back it up by copying the .db file from the .beads directory")
+	default:
+		return fmt.Errorf("--dolt-dump requires the Dolt backend (configured backend %q)", backend)
+	}
+}
+
+func runExportDoltDump(cmd *cobra.Command) error {
+	for _, name := range doltDumpExclusiveFlags {
+		if cmd.Flags().Changed(name) {
+			return HandleErrorRespectJSON("--%s filters the logical export and cannot be combined with --dolt-dump", name)
+		}
+	}
+	if exportOutput == "" {
+		return HandleErrorRespectJSON("--dolt-dump requires --output <dir>")
+	}
+	if beadsDir := beads.FindBeadsDir(); beadsDir != "" {
+		cfg, err := configfile.Load(beadsDir)
+		if err != nil {
+			return HandleErrorRespectJSON("loading config: %v", err)
+		}
+		if cfg != nil {
+			if err := doltDumpBackendError(cfg.Backend); err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
+		}
+	}
+	if store == nil {
+		return HandleErrorRespectJSON("no store available")
+	}
+
+	locator, ok := storage.UnwrapStore(store).(storage.StoreLocator)
+	if !ok {
+		return HandleErrorRespectJSON("storage backend does not expose its database directory")
+	}
+	cliDir := locator.CLIDir()
+	if cliDir == "" {
+		return HandleErrorRespectJSON("--dolt-dump needs the Dolt database files on this machine; this store has no local database directory (use 'bd backup' for a remote server)")
+	}
+	if _, err := os.Stat(filepath.Join(cliDir, ".dolt")); err != nil {
+		return HandleErrorRespectJSON("--dolt-dump needs the Dolt database files on this machine; no Dolt database found at %s (use 'bd backup' for a remote server)", cliDir)
+	}
+	doltBin, err := exec.LookPath("dolt")
+	if err != nil {
+		return HandleErrorWithHintRespectJSON("dolt command not found in PATH", "install Dolt from https://github.com/dolthub/dolt")
+	}
+
+	outDir, err := filepath.Abs(exportOutput)
+	if err != nil {
+		return HandleErrorRespectJSON("resolving --output: %v", err)
+	}
+	if err := os.MkdirAll(outDir, 0o750); err != nil {
+		return HandleErrorRespectJSON("creating output directory: %v", err)
+	}
+
+	ctx := rootCtx
+	dumpPath := filepath.Join(outDir, doltDumpFileName)
+	// -f replaces the dump left by an earlier run into the same directory.
+	dump := exec.CommandContext(ctx, doltBin, "dump", "-r", "sql", "-f", "-fn", dumpPath) // #nosec G204 -- fixed command, path from --output
+	dump.Dir = cliDir
+	if out, err := dump.CombinedOutput(); err != nil {
+		return HandleErrorRespectJSON("dolt dump failed: %v\n%s", err, strings.TrimSpace(string(out)))
+	}
+
+	manifest := doltDumpManifest{
+		Format:    doltDumpFormat,
+		CreatedAt: time.Now().UTC(),
+		BDVersion: Version,
+		Database:  filepath.Base(cliDir),
+		DumpFile:  doltDumpFileName,
+		Restore:   "bd restore --dolt-dump <dir>",
+	}
+	if out, err := exec.CommandContext(ctx, doltBin, "version").Output(); err == nil { // #nosec G204 -- fixed command
+		manifest.DoltVersion = strings.TrimSpace(strings.SplitN(string(out), "\n", 2)[0])
+	}
+	if branch, err := store.CurrentBranch(ctx); err == nil {
+		manifest.Branch = branch
+	}
+	if commit, err := store.GetCurrentCommit(ctx); err == nil {
+		manifest.Commit = commit
+	}
+	if reporter, ok := storage.UnwrapStore(store).(storage.BackendVersionReporter); ok {
+		if _, version, err := reporter.BackendVersions(ctx); err == nil {
+			manifest.SchemaVersion = version
+		}
+	}
+	if projectID, err := store.GetMetadata(ctx, "_project_id"); err == nil {
+		manifest.ProjectID = projectID
+	}
+	if stats, err := store.GetStatistics(ctx); err == nil {
+		manifest.IssueCount = stats.TotalIssues
+	}
+	manifest.SizeBytes, manifest.SHA256, err = fileSHA256(dumpPath)
+	if err != nil {
+		return HandleErrorRespectJSON("reading dump: %v", err)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return HandleErrorRespectJSON("encoding manifest: %v", err)
+	}
+	if err := atomicfile.WriteFile(filepath.Join(outDir, doltDumpManifestName), append(data, '\n'), 0o644); err != nil {
+		return HandleErrorRespectJSON("writing manifest: %v", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(manifest)
+	}
+	fmt.Fprintf(os.Stderr, "Dumped database %s (%d issues, %s) to %s\n",
+		manifest.Database, manifest.IssueCount, formatBytes(manifest.SizeBytes), outDir)
+	return nil
+}
+
+// fileSHA256 returns the size and hex SHA-256 digest of the file at path.
+func fileSHA256(path string) (int64, string, error) {
+	f, err := os.Open(path) // #nosec G304 -- path is the dump just written
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDoltDumpBackendError(t *testing.T) {
+	for _, backend := range []string{"", "dolt"} {
+		if err := doltDumpBackendError(backend); err != nil {
+			t.Errorf("backend %q: unexpected error %v", backend, err)
+		}
+	}
+	err := doltDumpBackendError("sqlite")
+	if err == nil || !strings.Contains(err.Error(), "copying the .db file") {
+		t.Errorf("sqlite should be refused with a pointer to the file copy, got %v", err)
+	}
+	if err := doltDumpBackendError("postgres"); err == nil {
+		t.Error("non-Dolt backends should be refused")
+	}
+}
+
+func TestFileSHA256(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dump.sql")
+	if err := os.WriteFile(path, []byte("abc"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	size, sum, err := fileSHA256(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != 3 || sum != "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad" {
+		t.Errorf("fileSHA256 = %d, %s", size, sum)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 )
 
 // bdExport runs "bd export" with extra args. Returns combined output.
@@ -154,12 +155,180 @@ func TestEmbeddedExport(t *testing.T) {
 		}
 	})
 
+	t.Run("json_array", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "exarr")
+		first := bdCreateSilent(t, bd, dir, "array issue 1")
+		second := bdCreateSilent(t, bd, dir, "array issue 2")
+
+		out := bdExport(t, bd, dir, "--format", "json")
+		var records []map[string]interface{}
+		if err := json.Unmarshal([]byte(out), &records); err != nil {
+			t.Fatalf("--format json should write a JSON array: %v\n%s", err, out)
+		}
+		if len(records) != 2 || !strings.Contains(out, first) || !strings.Contains(out, second) {
+			t.Errorf("records = %v, want %s and %s", records, first, second)
+		}
+
+		if jsonl := bdExport(t, bd, dir, "--format", "jsonl"); jsonl != bdExport(t, bd, dir) {
+			t.Errorf("--format jsonl should match the default output:\n%s", jsonl)
+		}
+		if jsonl := bdExport(t, bd, dir, "--jsonl"); jsonl != bdExport(t, bd, dir) {
+			t.Errorf("--jsonl should match the default output:\n%s", jsonl)
+		}
+		// The global --json flag only shapes error output; it does not
+		// switch the export format.
+		if withJSON := bdExport(t, bd, dir, "--json"); withJSON != bdExport(t, bd, dir) {
+			t.Errorf("--json should not change the export format:\n%s", withJSON)
+		}
+
+		bad := exec.Command(bd, "export", "--format", "yaml")
+		bad.Dir = dir
+		bad.Env = bdEnv(dir)
+		if out, err := bad.CombinedOutput(); err == nil || !strings.Contains(string(out), "invalid --format") {
+			t.Errorf("--format yaml should be rejected, got err=%v:\n%s", err, out)
+		}
+		both := exec.Command(bd, "export", "--jsonl", "--format", "json")
+		both.Dir = dir
+		both.Env = bdEnv(dir)
+		if out, err := both.CombinedOutput(); err == nil || !strings.Contains(string(out), "mutually exclusive") {
+			t.Errorf("--jsonl with --format json should be rejected, got err=%v:\n%s", err, out)
+		}
+
+		empty, _, _ := bdInit(t, bd, "--prefix", "exarre")
+		if got := strings.TrimSpace(bdExport(t, bd, empty, "--format", "json")); got != "[]" {
+			t.Errorf("empty --format json export = %q, want []", got)
+		}
+	})
+
+	t.Run("stable_order", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "exord")
+		for i := 0; i < 5; i++ {
+			bdCreateSilent(t, bd, dir, fmt.Sprintf("ordered issue %d", i), "-p", fmt.Sprint(4-i))
+		}
+
+		out := bdExport(t, bd, dir)
+		if again := bdExport(t, bd, dir); again != out {
+			t.Errorf("repeated exports differ:\n%s\n---\n%s", out, again)
+		}
+		// Priority order is the reverse of creation order, so only the
+		// explicit created_at/id sort puts these lines in order.
+		type row struct {
+			ID        string    `json:"id"`
+			CreatedAt time.Time `json:"created_at"`
+		}
+		var prev row
+		for i, line := range strings.Split(strings.TrimSpace(out), "\n") {
+			var record row
+			if err := json.Unmarshal([]byte(line), &record); err != nil {
+				t.Fatalf("invalid JSON line: %v\n%s", err, line)
+			}
+			if i > 0 && (record.CreatedAt.Before(prev.CreatedAt) ||
+				(record.CreatedAt.Equal(prev.CreatedAt) && record.ID < prev.ID)) {
+				t.Errorf("%s (%s) exported after %s (%s)", record.ID, record.CreatedAt, prev.ID, prev.CreatedAt)
+			}
+			prev = record
+		}
+	})
+
+	t.Run("status_and_since", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "exst")
+		open := bdCreateSilent(t, bd, dir, "still open")
+		closed := bdCreateSilent(t, bd, dir, "already closed")
+		if out, err := bdRunWithFlockRetry(t, bd, dir, "close", closed); err != nil {
+			t.Fatalf("bd close: %v\n%s", err, out)
+		}
+
+		all := bdExport(t, bd, dir)
+		if !strings.Contains(all, open) || !strings.Contains(all, closed) {
+			t.Errorf("default export should include closed issues:\n%s", all)
+		}
+		if out := bdExport(t, bd, dir, "--status", "all"); out != all {
+			t.Errorf("--status all should match the default:\n%s", out)
+		}
+		onlyOpen := bdExport(t, bd, dir, "--status", "open")
+		if !strings.Contains(onlyOpen, open) || strings.Contains(onlyOpen, closed) {
+			t.Errorf("--status open = %s, want only %s", onlyOpen, open)
+		}
+
+		if out := bdExport(t, bd, dir, "--since", "-1h"); out != all {
+			t.Errorf("--since -1h should include everything:\n%s", out)
+		}
+		if out := bdExport(t, bd, dir, "--since", "+1h"); strings.TrimSpace(out) != "" {
+			t.Errorf("--since +1h should export nothing, got:\n%s", out)
+		}
+
+		cmd := exec.Command(bd, "export", "--since", "not a time")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		if out, err := cmd.CombinedOutput(); err == nil || !strings.Contains(string(out), "invalid --since") {
+			t.Errorf("expected --since parse error, got err=%v\n%s", err, out)
+		}
+	})
+
 	t.Run("empty_db", func(t *testing.T) {
 		dir, _, _ := bdInit(t, bd, "--prefix", "exempty")
 
 		// Export with --no-memories on empty db should not error
 		bdExport(t, bd, dir, "--no-memories")
 	})
+
+	t.Run("dolt_dump_restores", func(t *testing.T) {
+		if _, err := exec.LookPath("dolt"); err != nil {
+			t.Skip("dolt CLI not in PATH")
+		}
+		dir, _, _ := bdInit(t, bd, "--prefix", "exdump")
+		bdCreateSilent(t, bd, dir, "dumped issue one")
+		bdCreateSilent(t, bd, dir, "dumped issue two")
+
+		outDir := filepath.Join(t.TempDir(), "snapshot")
+		out := bdExport(t, bd, dir, "--dolt-dump", "-o", outDir, "--json")
+		var manifest doltDumpManifest
+		if err := json.Unmarshal([]byte(out[max(strings.Index(out, "{"), 0):]), &manifest); err != nil {
+			t.Fatalf("parse manifest output: %v\n%s", err, out)
+		}
+		if manifest.Format != doltDumpFormat || manifest.IssueCount != 2 || manifest.SHA256 == "" {
+			t.Errorf("unexpected manifest: %+v", manifest)
+		}
+		onDisk, err := os.ReadFile(filepath.Join(outDir, doltDumpManifestName))
+		if err != nil {
+			t.Fatalf("manifest not written: %v", err)
+		}
+		if !strings.Contains(string(onDisk), manifest.SHA256) {
+			t.Errorf("manifest.json does not match reported manifest:\n%s", onDisk)
+		}
+
+		restoreDir := t.TempDir()
+		dump, err := os.Open(filepath.Join(outDir, manifest.DumpFile))
+		if err != nil {
+			t.Fatalf("open dump: %v", err)
+		}
+		defer dump.Close()
+		restore := exec.Command("dolt", "sql")
+		restore.Dir = restoreDir
+		restore.Env = bdEnv(dir)
+		restore.Stdin = dump
+		if out, err := restore.CombinedOutput(); err != nil {
+			t.Fatalf("replaying dump failed: %v\n%s", err, out)
+		}
+		query := exec.Command("dolt", "sql", "-r", "csv", "-q",
+			fmt.Sprintf("SELECT title FROM `%s`.issues ORDER BY title", manifest.Database))
+		query.Dir = restoreDir
+		query.Env = bdEnv(dir)
+		got, err := query.CombinedOutput()
+		if err != nil {
+			t.Fatalf("querying restored database failed: %v\n%s", err, got)
+		}
+		if !strings.Contains(string(got), "dumped issue one") || !strings.Contains(string(got), "dumped issue two") {
+			t.Errorf("restored database is missing issues:\n%s", got)
+		}
+
+		cmd := exec.Command(bd, "export", "--dolt-dump", "--status", "open", "-o", outDir)
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		if out, err := cmd.CombinedOutput(); err == nil || !strings.Contains(string(out), "cannot be combined with --dolt-dump") {
+			t.Errorf("expected --status to be rejected with --dolt-dump, got err=%v\n%s", err, out)
+		}
+	})
 }
 
 func TestEmbeddedExportConcurrent(t *testing.T) {
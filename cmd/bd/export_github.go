@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/github"
+	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/tracker"
+)
+
+// exportGitHubCmd pushes beads issues to GitHub without pulling anything back.
+var exportGitHubCmd = &cobra.Command{
+	Use:   "github",
+	Short: "Create or update GitHub issues from beads",
+	Long: `Push beads issues to a GitHub repository, the counterpart of pulling them
+with 'bd github sync --pull-only'.
+
+An issue whose external_ref already points at a GitHub issue (a github.com
+issue URL or github:<number>) updates that issue; any other issue creates a new
+GitHub issue, and its external_ref is set to the new issue's URL so the next
+export updates it instead of creating a duplicate. An update is skipped when
+the GitHub issue changed more recently than the beads issue.
+
+The target repository is --repo, or the configured github.owner/github.repo
+(see 'bd github --help'). Requests that hit the GitHub rate limit are retried
+with backoff; if the limit is exhausted the export stops and reports how many
+issues were left.
+
+EXAMPLES:
+  bd export github --repo acme/widgets             # Create and update
+  bd export github --repo acme/widgets --dry-run   # Preview only
+  bd export github --create-only                   # Only issues not on GitHub yet
+  bd export github --update-only --issues bd-1,bd-2`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE:          runExportGitHub,
+}
+
+var (
+	exportGitHubRepo       string
+	exportGitHubCreateOnly bool
+	exportGitHubUpdateOnly bool
+	exportGitHubDryRun     bool
+)
+
+// exportGitHubResult is the --json output of bd export github.
+type exportGitHubResult struct {
+	Repository string   `json:"repository,omitempty"`
+	DryRun     bool     `json:"dry_run"`
+	Created    int      `json:"created"`
+	Updated    int      `json:"updated"`
+	Skipped    int      `json:"skipped"`
+	Errors     int      `json:"errors"`
+	Warnings   []string `json:"warnings,omitempty"`
+}
+
+func init() {
+	exportGitHubCmd.Flags().StringVar(&exportGitHubRepo, "repo", "", "Target repository as owner/name (default: github.owner/github.repo config)")
+	exportGitHubCmd.Flags().BoolVar(&exportGitHubCreateOnly, "create-only", false, "Only create GitHub issues for beads issues not linked yet")
+	exportGitHubCmd.Flags().BoolVar(&exportGitHubUpdateOnly, "update-only", false, "Only update GitHub issues already linked via external_ref")
+	exportGitHubCmd.Flags().BoolVar(&exportGitHubDryRun, "dry-run", false, "Show what would be exported without making changes")
+	registerSelectiveSyncFlags(exportGitHubCmd)
+	exportCmd.AddCommand(exportGitHubCmd)
+}
+
+func runExportGitHub(cmd *cobra.Command, args []string) error {
+	if usesProxiedServer() {
+		return HandleErrorRespectJSON("export github is not supported in proxied-server mode")
+	}
+	evt := metrics.NewCommandEvent("export-github")
+	defer func() {
+		if c := metrics.Global(); c != nil {
+			c.CloseEventAndAdd(evt)
+		}
+	}()
+
+	if exportGitHubCreateOnly && exportGitHubUpdateOnly {
+		return HandleErrorRespectJSON("cannot use both --create-only and --update-only")
+	}
+
+	cfg := getGitHubConfig()
+	if exportGitHubRepo != "" {
+		owner, repo, ok := strings.Cut(exportGitHubRepo, "/")
+		if !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+			return HandleErrorRespectJSON("invalid --repo %q: want owner/name", exportGitHubRepo)
+		}
+		cfg.Owner, cfg.Repo = owner, repo
+	}
+	if err := validateGitHubConfig(cfg); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+
+	if !exportGitHubDryRun {
+		CheckReadonly("export github")
+	}
+
+	if err := ensureStoreActive(); err != nil {
+		return HandleErrorRespectJSON("database not available: %v", err)
+	}
+
+	out := cmd.OutOrStdout()
+	if jsonOutput {
+		// Keep stdout a single JSON document; progress goes to stderr.
+		out = os.Stderr
+	}
+	ctx := context.Background()
+
+	gt := &github.Tracker{Repository: exportGitHubRepo}
+	if err := gt.Init(ctx, store); err != nil {
+		return HandleErrorRespectJSON("initializing GitHub tracker: %v", err)
+	}
+
+	engine := tracker.NewEngine(gt, store, actor)
+	engine.OnMessage = func(msg string) { _, _ = fmt.Fprintln(out, "  "+msg) }
+	engine.OnWarning = func(msg string) { _, _ = fmt.Fprintf(os.Stderr, "Warning: %s\n", msg) }
+
+	opts := tracker.SyncOptions{
+		Push:             true,
+		DryRun:           exportGitHubDryRun,
+		CreateOnly:       exportGitHubCreateOnly,
+		UpdateOnly:       exportGitHubUpdateOnly,
+		ExcludeEphemeral: true,
+	}
+	if err := applySelectiveSyncFlags(cmd, &opts, true); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+
+	result, err := engine.Sync(ctx, opts)
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	if !exportGitHubDryRun && result.PushStats.Created > 0 {
+		// New issues were linked via external_ref.
+		commandDidWrite.Store(true)
+	}
+
+	res := exportGitHubResult{
+		Repository: cfg.Owner + "/" + cfg.Repo,
+		DryRun:     exportGitHubDryRun,
+		Created:    result.PushStats.Created,
+		Updated:    result.PushStats.Updated,
+		Skipped:    result.PushStats.Skipped,
+		Errors:     result.PushStats.Errors,
+		Warnings:   result.Warnings,
+	}
+	if jsonOutput {
+		return outputJSON(res)
+	}
+
+	verb := "Exported"
+	if res.DryRun {
+		verb = "Would export"
+	}
+	_, _ = fmt.Fprintf(out, "✓ %s to %s: %d created, %d updated, %d skipped\n",
+		verb, res.Repository, res.Created, res.Updated, res.Skipped)
+	if res.Errors > 0 {
+		_, _ = fmt.Fprintf(out, "✗ %d issue(s) failed (see warnings above)\n", res.Errors)
+	}
+	return nil
+}
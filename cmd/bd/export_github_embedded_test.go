@@ -0,0 +1,161 @@
+//go:build cgo
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeGitHubAPI serves the issue endpoints bd export github uses and records
+// every request it sees as "METHOD path".
+type fakeGitHubAPI struct {
+	mu       sync.Mutex
+	requests []string
+	bodies   map[string]map[string]interface{}
+}
+
+func (f *fakeGitHubAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	var fields map[string]interface{}
+	_ = json.Unmarshal(body, &fields)
+
+	f.mu.Lock()
+	call := r.Method + " " + r.URL.Path
+	f.requests = append(f.requests, call)
+	f.bodies[call] = fields
+	f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	const old = "2020-01-01T00:00:00Z"
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/repos/acme/widgets/issues":
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"id":1012,"number":12,"title":%q,"state":"open","updated_at":%q,"html_url":"https://github.com/acme/widgets/issues/12"}`,
+			fields["title"], old)
+	case strings.HasPrefix(r.URL.Path, "/repos/acme/widgets/issues/"):
+		number, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/repos/acme/widgets/issues/"))
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"id":%d,"number":%d,"title":"On GitHub","state":"open","updated_at":%q,"html_url":"https://github.com/acme/widgets/issues/%d"}`,
+			1000+number, number, old, number)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `{"message":"Not Found"}`)
+	}
+}
+
+func (f *fakeGitHubAPI) calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.requests...)
+}
+
+func TestEmbeddedExportGitHub(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+
+	// exportGitHub runs bd export github against a fresh fake API.
+	exportGitHub := func(t *testing.T, dir string, args ...string) (*fakeGitHubAPI, exportGitHubResult) {
+		t.Helper()
+		api := &fakeGitHubAPI{bodies: map[string]map[string]interface{}{}}
+		srv := httptest.NewServer(api)
+		t.Cleanup(srv.Close)
+
+		cmd := exec.Command(bd, append([]string{"export", "github", "--repo", "acme/widgets", "--json"}, args...)...)
+		cmd.Dir = dir
+		cmd.Env = append(bdEnv(dir), "GITHUB_TOKEN=test-token", "GITHUB_API_URL="+srv.URL)
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd export github %v: %v\nstdout: %s\nstderr: %s", args, err, stdout.String(), stderr.String())
+		}
+		var res exportGitHubResult
+		if err := json.Unmarshal(stdout.Bytes(), &res); err != nil {
+			t.Fatalf("parse bd export github --json: %v\n%s", err, stdout.String())
+		}
+		return api, res
+	}
+
+	t.Run("creates_unlinked_and_updates_linked", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "xg")
+		fresh := bdCreate(t, bd, dir, "Only in beads")
+		linked := bdCreate(t, bd, dir, "Already on GitHub", "--external-ref", "github:7")
+
+		api, res := exportGitHub(t, dir)
+		if res.Created != 1 || res.Updated != 1 || res.Errors != 0 || res.Repository != "acme/widgets" {
+			t.Errorf("result = %+v, want 1 created and 1 updated in acme/widgets", res)
+		}
+		calls := strings.Join(api.calls(), "\n")
+		if !strings.Contains(calls, "POST /repos/acme/widgets/issues") {
+			t.Errorf("%s was not created on GitHub; requests:\n%s", fresh.ID, calls)
+		}
+		if !strings.Contains(calls, "PATCH /repos/acme/widgets/issues/7") {
+			t.Errorf("%s did not update issue #7; requests:\n%s", linked.ID, calls)
+		}
+		if got := api.bodies["POST /repos/acme/widgets/issues"]["title"]; got != "Only in beads" {
+			t.Errorf("created title = %v, want %q", got, "Only in beads")
+		}
+
+		shown := bdShow(t, bd, dir, fresh.ID)
+		if shown.ExternalRef == nil || *shown.ExternalRef != "https://github.com/acme/widgets/issues/12" {
+			t.Errorf("%s external_ref = %v, want the created issue URL", fresh.ID, shown.ExternalRef)
+		}
+
+		// A second export updates the now-linked issue instead of creating a duplicate.
+		api, res = exportGitHub(t, dir)
+		if res.Created != 0 || res.Updated != 2 {
+			t.Errorf("second export = %+v, want 0 created and 2 updated", res)
+		}
+		if calls := strings.Join(api.calls(), "\n"); strings.Contains(calls, "POST ") {
+			t.Errorf("second export created an issue again; requests:\n%s", calls)
+		}
+	})
+
+	t.Run("create_only_and_update_only", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "xo")
+		bdCreate(t, bd, dir, "Only in beads")
+		bdCreate(t, bd, dir, "Already on GitHub", "--external-ref", "github:7")
+
+		api, res := exportGitHub(t, dir, "--update-only", "--dry-run")
+		if res.Created != 0 || res.Updated != 1 || !res.DryRun {
+			t.Errorf("--update-only --dry-run = %+v, want 0 created and 1 updated", res)
+		}
+		if calls := api.calls(); len(calls) != 0 {
+			t.Errorf("--dry-run sent requests: %v", calls)
+		}
+
+		api, res = exportGitHub(t, dir, "--create-only")
+		if res.Created != 1 || res.Updated != 0 {
+			t.Errorf("--create-only = %+v, want 1 created and 0 updated", res)
+		}
+		if calls := strings.Join(api.calls(), "\n"); strings.Contains(calls, "/issues/7") {
+			t.Errorf("--create-only touched the linked issue; requests:\n%s", calls)
+		}
+	})
+
+	t.Run("rejects_both_modes", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "xb")
+		cmd := exec.Command(bd, "export", "github", "--repo", "acme/widgets", "--create-only", "--update-only")
+		cmd.Dir = dir
+		cmd.Env = append(bdEnv(dir), "GITHUB_TOKEN=test-token")
+		out, err := cmd.CombinedOutput()
+		if err == nil || !strings.Contains(string(out), "cannot use both --create-only and --update-only") {
+			t.Errorf("expected mode conflict error, got err=%v\n%s", err, out)
+		}
+	})
+}
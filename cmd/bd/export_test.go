@@ -439,6 +439,59 @@ func TestFilterOutPollution(t *testing.T) {
 	}
 }
 
+func TestExportRecordWriter(t *testing.T) {
+	t.Parallel()
+
+	records := []map[string]string{{"id": "bd-1"}, {"id": "bd-2"}}
+	tests := []struct {
+		name    string
+		array   bool
+		records []map[string]string
+		want    string
+	}{
+		{"jsonl", false, records, "{\"id\":\"bd-1\"}\n{\"id\":\"bd-2\"}\n"},
+		{"jsonl_empty", false, nil, ""},
+		{"array", true, records, "[\n{\"id\":\"bd-1\"},\n{\"id\":\"bd-2\"}\n]\n"},
+		{"array_empty", true, nil, "[]\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			rw := &exportRecordWriter{w: &buf, array: tt.array}
+			for _, r := range tt.records {
+				if err := rw.write(r); err != nil {
+					t.Fatalf("write: %v", err)
+				}
+			}
+			if err := rw.close(); err != nil {
+				t.Fatalf("close: %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("output = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestSortIssuesForExport(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	issues := []*types.Issue{
+		{ID: "bd-c", CreatedAt: base.Add(time.Minute)},
+		{ID: "bd-b", CreatedAt: base},
+		{ID: "bd-a", CreatedAt: base},
+	}
+	sortIssuesForExport(issues)
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.ID)
+	}
+	if strings.Join(got, ",") != "bd-a,bd-b,bd-c" {
+		t.Errorf("order = %v, want bd-a, bd-b, bd-c", got)
+	}
+}
+
 func TestExportNoHistoryBeadRoundTrip(t *testing.T) {
 	// GH#2619: NoHistory beads are stored in the wisps table. The JSONL export
 	// must include them with no_history=true, and import must preserve the flag.
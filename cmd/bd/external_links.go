@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// externalLinksMetadataKey is the issue metadata key holding the links added
+// by bd create --link and bd link add. Keeping them in metadata makes them
+// queryable with bd list --has-metadata-key external_links and carries them
+// through export/import unchanged.
+const externalLinksMetadataKey = "external_links"
+
+// externalLink cross-references an issue in another tracker.
+type externalLink struct {
+	URL  string `json:"url"`
+	Type string `json:"type"`
+}
+
+// parseExternalLink validates an http(s) URL and classifies the tracker it
+// points at.
+func parseExternalLink(raw string) (externalLink, error) {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return externalLink{}, fmt.Errorf("invalid link %q: must be an http(s) URL", raw)
+	}
+	return externalLink{URL: raw, Type: externalLinkType(u)}, nil
+}
+
+// parseExternalLinks parses every value of a repeatable link flag.
+func parseExternalLinks(raw []string) ([]externalLink, error) {
+	links := make([]externalLink, 0, len(raw))
+	for _, r := range raw {
+		link, err := parseExternalLink(r)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return links, nil
+}
+
+// externalLinkType names the tracker a URL belongs to: github, gitlab, jira,
+// linear, or url for anything else.
+func externalLinkType(u *url.URL) string {
+	host := strings.ToLower(u.Hostname())
+	switch {
+	case host == "github.com" || strings.HasSuffix(host, ".github.com"):
+		return "github"
+	case strings.HasPrefix(host, "gitlab.") || strings.Contains(host, ".gitlab."):
+		return "gitlab"
+	case strings.HasSuffix(host, ".atlassian.net") || strings.HasPrefix(u.Path, "/browse/"):
+		return "jira"
+	case host == "linear.app":
+		return "linear"
+	default:
+		return "url"
+	}
+}
+
+// externalLinksOf returns the links stored in issue's metadata. Malformed
+// entries are ignored.
+func externalLinksOf(issue *types.Issue) []externalLink {
+	if issue == nil || len(issue.Metadata) == 0 {
+		return nil
+	}
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(issue.Metadata, &data); err != nil {
+		return nil
+	}
+	var links []externalLink
+	if err := json.Unmarshal(data[externalLinksMetadataKey], &links); err != nil {
+		return nil
+	}
+	out := links[:0]
+	for _, link := range links {
+		if link.URL != "" {
+			out = append(out, link)
+		}
+	}
+	return out
+}
+
+// addExternalLinks appends the links not already present (by URL) and
+// reports how many were added.
+func addExternalLinks(existing, add []externalLink) ([]externalLink, int) {
+	out := append([]externalLink{}, existing...)
+	added := 0
+	for _, link := range add {
+		if !containsExternalLink(out, link.URL) {
+			out = append(out, link)
+			added++
+		}
+	}
+	return out, added
+}
+
+// removeExternalLinks drops the links with the given URLs and reports how
+// many were removed.
+func removeExternalLinks(existing []externalLink, urls []string) ([]externalLink, int) {
+	out := make([]externalLink, 0, len(existing))
+	for _, link := range existing {
+		keep := true
+		for _, u := range urls {
+			if link.URL == strings.TrimSpace(u) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			out = append(out, link)
+		}
+	}
+	return out, len(existing) - len(out)
+}
+
+func containsExternalLink(links []externalLink, rawURL string) bool {
+	for _, link := range links {
+		if link.URL == rawURL {
+			return true
+		}
+	}
+	return false
+}
+
+// withExternalLinks returns metadata with the external_links key set to
+// links, or removed when links is empty. Other keys are preserved; metadata
+// that is not a JSON object is rejected.
+func withExternalLinks(metadata json.RawMessage, links []externalLink) (json.RawMessage, error) {
+	data := make(map[string]json.RawMessage)
+	if trimmed := strings.TrimSpace(string(metadata)); trimmed != "" && trimmed != "null" {
+		if err := json.Unmarshal(metadata, &data); err != nil {
+			return nil, fmt.Errorf("metadata must be a JSON object to hold links: %w", err)
+		}
+	}
+	if len(links) == 0 {
+		delete(data, externalLinksMetadataKey)
+	} else {
+		raw, err := json.Marshal(links)
+		if err != nil {
+			return nil, fmt.Errorf("encoding links: %w", err)
+		}
+		data[externalLinksMetadataKey] = raw
+	}
+	out, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("encoding metadata: %w", err)
+	}
+	return out, nil
+}
+
+// metadataWithLinkFlag adds the --link values of bd create to metadata,
+// keeping any links --metadata already supplied.
+func metadataWithLinkFlag(cmd *cobra.Command, metadata json.RawMessage) (json.RawMessage, error) {
+	raw, _ := cmd.Flags().GetStringArray("link")
+	add, err := parseExternalLinks(raw)
+	if err != nil {
+		return nil, err
+	}
+	links, _ := addExternalLinks(externalLinksOf(&types.Issue{Metadata: metadata}), add)
+	return withExternalLinks(metadata, links)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestParseExternalLink(t *testing.T) {
+	tests := []struct {
+		raw      string
+		wantType string
+		wantErr  bool
+	}{
+		{raw: "https://github.com/org/repo/issues/42", wantType: "github"},
+		{raw: "https://gitlab.example.com/g/p/-/issues/3", wantType: "gitlab"},
+		{raw: "https://acme.atlassian.net/browse/OPS-7", wantType: "jira"},
+		{raw: "https://jira.internal/browse/OPS-7", wantType: "jira"},
+		{raw: "https://linear.app/acme/issue/ENG-9", wantType: "linear"},
+		{raw: " https://example.com/ticket/1 ", wantType: "url"},
+		{raw: "github.com/org/repo/issues/42", wantErr: true},
+		{raw: "ftp://example.com/x", wantErr: true},
+		{raw: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		link, err := parseExternalLink(tt.raw)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseExternalLink(%q) = %+v, want error", tt.raw, link)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseExternalLink(%q): %v", tt.raw, err)
+			continue
+		}
+		if link.Type != tt.wantType {
+			t.Errorf("parseExternalLink(%q).Type = %q, want %q", tt.raw, link.Type, tt.wantType)
+		}
+	}
+}
+
+func TestExternalLinksMetadataRoundTrip(t *testing.T) {
+	gh := externalLink{URL: "https://github.com/o/r/issues/1", Type: "github"}
+	jira := externalLink{URL: "https://acme.atlassian.net/browse/A-1", Type: "jira"}
+
+	links, added := addExternalLinks(nil, []externalLink{gh, jira, gh})
+	if added != 2 || len(links) != 2 {
+		t.Fatalf("addExternalLinks added %d (%v), want 2 distinct links", added, links)
+	}
+
+	metadata, err := withExternalLinks(json.RawMessage(`{"team":"platform"}`), links)
+	if err != nil {
+		t.Fatalf("withExternalLinks: %v", err)
+	}
+	issue := &types.Issue{Metadata: metadata}
+	if got := externalLinksOf(issue); len(got) != 2 || got[0] != gh || got[1] != jira {
+		t.Errorf("externalLinksOf = %v, want [%v %v]", got, gh, jira)
+	}
+	var data map[string]any
+	if err := json.Unmarshal(metadata, &data); err != nil || data["team"] != "platform" {
+		t.Errorf("other metadata keys not preserved: %s", metadata)
+	}
+
+	links, removed := removeExternalLinks(links, []string{gh.URL, "https://nowhere.example/x"})
+	if removed != 1 || len(links) != 1 || links[0] != jira {
+		t.Errorf("removeExternalLinks removed %d, left %v", removed, links)
+	}
+
+	metadata, err = withExternalLinks(metadata, nil)
+	if err != nil {
+		t.Fatalf("withExternalLinks(nil): %v", err)
+	}
+	if string(metadata) != `{"team":"platform"}` {
+		t.Errorf("clearing links should drop the key, got %s", metadata)
+	}
+
+	if _, err := withExternalLinks(json.RawMessage(`[1,2]`), links); err == nil {
+		t.Error("withExternalLinks should reject non-object metadata")
+	}
+}
@@ -341,7 +341,7 @@ var groupReleaseCmd = &cobra.Command{
 					}
 				}
 				if !keepLabels && slices.Contains(labelMembers, m) {
-					if err := tx.RemoveLabel(ctx, m, parked, actor); err != nil {
+					if _, err := tx.RemoveLabel(ctx, m, parked, actor); err != nil {
 						return fmt.Errorf("label remove %s %s: %w", m, parked, err)
 					}
 				}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/cmd/bd/doctor"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// Overall health levels reported by bd health.
+const (
+	healthGreen  = "green"
+	healthYellow = "yellow"
+	healthRed    = "red"
+)
+
+// HealthOutput is the --json shape of bd health.
+type HealthOutput struct {
+	Path        string            `json:"path"`
+	Status      string            `json:"status"` // green, yellow, or red
+	Environment []doctorCheck     `json:"environment"`
+	Integrity   []doctorCheck     `json:"integrity"`
+	Stats       *types.Statistics `json:"stats"`
+}
+
+// healthSources supplies the store-backed parts of bd health so the direct
+// and proxied-server paths share one report.
+type healthSources struct {
+	detectCycles  func(context.Context) ([][]*types.Issue, error)
+	getStatistics func(context.Context) (*types.Statistics, error)
+}
+
+var healthCmd = &cobra.Command{
+	Use:     "health",
+	GroupID: "maint",
+	Short:   "One-shot workspace health summary (environment, integrity, stats)",
+	Long: `Summarize workspace health in one command, suitable for CI.
+
+Runs three sections and rolls them up into an overall status:
+  1. Environment: the checks from 'bd doctor --check=env'
+  2. Integrity: dependency cycles, plus the 'bd doctor --check=validate'
+     checks in server mode
+  3. Stats: issue counts from 'bd status' (informational)
+
+The overall status is red if any check fails, yellow if any check warns,
+and green otherwise. Exit 0 on green or yellow, exit 1 on red.
+
+Examples:
+  bd health          # Human-readable summary
+  bd health --json   # Structured output for automation`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		evt := metrics.NewCommandEvent("health")
+		defer func() {
+			if c := metrics.Global(); c != nil {
+				c.CloseEventAndAdd(evt)
+			}
+		}()
+
+		if usesProxiedServer() {
+			return runHealthProxiedServer(rootCtx)
+		}
+		if store == nil {
+			return HandleErrorRespectJSON("store not initialized")
+		}
+		return runHealth(rootCtx, healthSources{
+			detectCycles:  store.DetectCycles,
+			getStatistics: store.GetStatistics,
+		}, !isEmbeddedMode())
+	},
+}
+
+// healthWorkspacePath returns the repository root the environment checks
+// inspect: the parent of the discovered .beads directory, else ".".
+func healthWorkspacePath() (string, error) {
+	path := "."
+	if beadsDir := beads.FindBeadsDir(); beadsDir != "" {
+		path = filepath.Dir(beadsDir)
+	}
+	return filepath.Abs(path)
+}
+
+// runHealth collects every section, prints the report, and returns
+// SilentExit when the overall status is red. withValidate adds the
+// server-mode data-integrity checks, which open their own connection.
+func runHealth(ctx context.Context, src healthSources, withValidate bool) error {
+	path, err := healthWorkspacePath()
+	if err != nil {
+		return HandleErrorRespectJSON("failed to resolve path: %v", err)
+	}
+
+	env := collectEnvChecks(path)
+
+	cycles, err := src.detectCycles(ctx)
+	if err != nil {
+		return HandleErrorRespectJSON("detecting dependency cycles: %v", err)
+	}
+	integrity := []validateCheckResult{{check: healthCycleCheck(cycles)}}
+	if withValidate {
+		integrity = append(integrity, collectValidateChecks(path)...)
+	}
+
+	stats, err := src.getStatistics(ctx)
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+
+	status := healthStatus(env, integrity)
+
+	if jsonOutput {
+		out := HealthOutput{Path: path, Status: status, Stats: stats}
+		for _, cr := range env {
+			out.Environment = append(out.Environment, cr.check)
+		}
+		for _, cr := range integrity {
+			out.Integrity = append(out.Integrity, cr.check)
+		}
+		if err := outputJSON(out); err != nil {
+			return err
+		}
+	} else {
+		printHealthReport(status, env, integrity, stats)
+	}
+
+	if status == healthRed {
+		return SilentExit()
+	}
+	return nil
+}
+
+// healthCycleCheck reports dependency cycles as a failing integrity check;
+// a cycle deadlocks every issue on it, so there is no warning level.
+func healthCycleCheck(cycles [][]*types.Issue) doctorCheck {
+	check := doctorCheck{Name: "Dependency Cycles", Category: doctor.CategoryData}
+	if len(cycles) == 0 {
+		check.Status = statusOK
+		check.Message = "No dependency cycles"
+		return check
+	}
+	check.Status = statusError
+	check.Message = fmt.Sprintf("%d dependency cycle(s) found", len(cycles))
+	var parts []string
+	for _, cycle := range cycles {
+		ids := make([]string, 0, len(cycle))
+		for _, issue := range cycle {
+			ids = append(ids, issue.ID)
+		}
+		parts = append(parts, strings.Join(ids, " → "))
+	}
+	check.Detail = strings.Join(parts, "; ")
+	check.Fix = "Run 'bd dep cycles' and remove one edge from each cycle with 'bd dep remove'"
+	return check
+}
+
+// healthStatus rolls check sections up into green, yellow, or red.
+func healthStatus(sections ...[]validateCheckResult) string {
+	status := healthGreen
+	for _, checks := range sections {
+		for _, cr := range checks {
+			switch cr.check.Status {
+			case statusError:
+				return healthRed
+			case statusWarning:
+				status = healthYellow
+			}
+		}
+	}
+	return status
+}
+
+func printHealthReport(status string, env, integrity []validateCheckResult, stats *types.Statistics) {
+	printCheckResults("Environment", env)
+	printCheckResults("Data Integrity", integrity)
+
+	fmt.Println()
+	fmt.Println(ui.RenderCategory("Stats"))
+	fmt.Printf("  Total: %d  Open: %d  In Progress: %d  Blocked: %s  Ready: %s  Closed: %d\n",
+		stats.TotalIssues, stats.OpenIssues, stats.InProgressIssues,
+		healthCount(stats.BlockedIssues), healthCount(stats.ReadyIssues), stats.ClosedIssues)
+
+	fmt.Println()
+	switch status {
+	case healthGreen:
+		fmt.Println(ui.RenderPass("● Health: green"))
+	case healthYellow:
+		fmt.Println(ui.RenderWarn("● Health: yellow"))
+	default:
+		fmt.Println(ui.RenderFail("● Health: red"))
+	}
+}
+
+// healthCount formats an optional statistics count, "-" when it was skipped.
+func healthCount(n *int) string {
+	if n == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *n)
+}
+
+func init() {
+	rootCmd.AddCommand(healthCmd)
+}
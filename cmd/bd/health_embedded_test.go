@@ -0,0 +1,123 @@
+//go:build cgo
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/configfile"
+	"github.com/steveyegge/beads/internal/storage/embeddeddolt"
+)
+
+// healthTestEnv extends doctorTestEnv with a stub dolt binary on PATH that
+// answers the identity queries, so the environment section is green on
+// machines without a configured dolt install.
+func healthTestEnv(t *testing.T, dir string) []string {
+	t.Helper()
+	stubDir := t.TempDir()
+	stub := "#!/bin/sh\ncase \"$*\" in\n  *user.name*) echo tester ;;\n  *user.email*) echo tester@example.com ;;\nesac\n"
+	if err := os.WriteFile(filepath.Join(stubDir, "dolt"), []byte(stub), 0o755); err != nil {
+		t.Fatalf("write dolt stub: %v", err)
+	}
+	return append(doctorTestEnv(dir), "PATH="+stubDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func bdHealthJSON(t *testing.T, bd, dir string) (HealthOutput, error) {
+	t.Helper()
+	cmd := exec.Command(bd, "health", "--json")
+	cmd.Dir = dir
+	cmd.Env = healthTestEnv(t, dir)
+	var stdout, stderr strings.Builder
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+	var out HealthOutput
+	if err := json.Unmarshal([]byte(stdout.String()), &out); err != nil {
+		t.Fatalf("bd health --json did not emit valid JSON: %v\nstdout:\n%s\nstderr:\n%s", err, stdout.String(), stderr.String())
+	}
+	return out, runErr
+}
+
+// seedBlockingEdge writes a blocks edge straight into the dependencies
+// table, bypassing the cycle check bd dep add enforces.
+func seedBlockingEdge(t *testing.T, beadsDir, issueID, dependsOnID string) {
+	t.Helper()
+	database := ""
+	if cfg, _ := configfile.Load(beadsDir); cfg != nil {
+		database = cfg.GetDoltDatabase()
+	}
+	db, cleanup, err := embeddeddolt.OpenSQL(t.Context(), filepath.Join(beadsDir, "embeddeddolt"), database, "main")
+	if err != nil {
+		t.Fatalf("OpenSQL: %v", err)
+	}
+	defer cleanup()
+	if _, err := db.ExecContext(t.Context(),
+		"INSERT INTO dependencies (id, issue_id, depends_on_issue_id, type, created_by) VALUES (UUID(), ?, ?, 'blocks', 'test')",
+		issueID, dependsOnID); err != nil {
+		t.Fatalf("insert dependency %s -> %s: %v", issueID, dependsOnID, err)
+	}
+}
+
+func TestEmbeddedHealth(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
+	}
+	if runtime.GOOS == "windows" {
+		t.Skip("dolt stub is a shell script")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+
+	t.Run("clean_workspace_is_green", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "hg")
+		a := bdCreate(t, bd, dir, "Blocker", "--type", "task")
+		b := bdCreate(t, bd, dir, "Blocked", "--type", "task")
+		bdDepAdd(t, bd, dir, b.ID, a.ID)
+
+		out, err := bdHealthJSON(t, bd, dir)
+		if err != nil {
+			t.Fatalf("bd health on a clean workspace should exit 0: %v\n%+v", err, out)
+		}
+		if out.Status != healthGreen {
+			t.Errorf("status = %q, want %q\nenvironment: %+v\nintegrity: %+v", out.Status, healthGreen, out.Environment, out.Integrity)
+		}
+		if out.Stats == nil || out.Stats.TotalIssues != 2 {
+			t.Errorf("stats = %+v, want 2 total issues", out.Stats)
+		}
+	})
+
+	t.Run("seeded_cycle_is_red", func(t *testing.T) {
+		dir, beadsDir, _ := bdInit(t, bd, "--prefix", "hr")
+		a := bdCreate(t, bd, dir, "First", "--type", "task")
+		b := bdCreate(t, bd, dir, "Second", "--type", "task")
+		bdDepAdd(t, bd, dir, b.ID, a.ID)
+		seedBlockingEdge(t, beadsDir, a.ID, b.ID)
+
+		out, err := bdHealthJSON(t, bd, dir)
+		if err == nil {
+			t.Fatalf("bd health with a dependency cycle should exit non-zero\n%+v", out)
+		}
+		if out.Status != healthRed {
+			t.Errorf("status = %q, want %q", out.Status, healthRed)
+		}
+		var cycle *doctorCheck
+		for i := range out.Integrity {
+			if out.Integrity[i].Name == "Dependency Cycles" {
+				cycle = &out.Integrity[i]
+			}
+		}
+		if cycle == nil || cycle.Status != statusError {
+			t.Fatalf("expected a failing Dependency Cycles check, got %+v", out.Integrity)
+		}
+		if !strings.Contains(cycle.Detail, a.ID) || !strings.Contains(cycle.Detail, b.ID) {
+			t.Errorf("cycle detail %q should name %s and %s", cycle.Detail, a.ID, b.ID)
+		}
+	})
+}
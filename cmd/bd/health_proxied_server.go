@@ -0,0 +1,18 @@
+package main
+
+import "context"
+
+func runHealthProxiedServer(ctx context.Context) error {
+	uw, err := openProxiedListUOW(ctx)
+	if err != nil {
+		return HandleError("%v", err)
+	}
+	defer uw.Close(ctx)
+
+	// The validate checks open their own server-mode connection by path,
+	// which proxied-server mode doesn't provide, so integrity is cycles only.
+	return runHealth(ctx, healthSources{
+		detectCycles:  uw.DependencyUseCase().DetectCycles,
+		getStatistics: uw.IssueUseCase().GetStatistics,
+	}, false)
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestHealthStatus(t *testing.T) {
+	check := func(status string) validateCheckResult {
+		return validateCheckResult{check: doctorCheck{Name: status, Status: status}}
+	}
+	tests := []struct {
+		name     string
+		sections [][]validateCheckResult
+		want     string
+	}{
+		{"no checks", nil, healthGreen},
+		{"all ok", [][]validateCheckResult{{check(statusOK)}, {check(statusOK)}}, healthGreen},
+		{"warning", [][]validateCheckResult{{check(statusOK)}, {check(statusWarning)}}, healthYellow},
+		{"error beats warning", [][]validateCheckResult{{check(statusWarning)}, {check(statusOK), check(statusError)}}, healthRed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := healthStatus(tt.sections...); got != tt.want {
+				t.Errorf("healthStatus = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHealthCycleCheck(t *testing.T) {
+	if got := healthCycleCheck(nil); got.Status != statusOK {
+		t.Errorf("no cycles: status = %q, want %q", got.Status, statusOK)
+	}
+
+	cycles := [][]*types.Issue{{{ID: "bd-1"}, {ID: "bd-2"}}}
+	got := healthCycleCheck(cycles)
+	if got.Status != statusError {
+		t.Errorf("one cycle: status = %q, want %q", got.Status, statusError)
+	}
+	if !strings.Contains(got.Detail, "bd-1 → bd-2") {
+		t.Errorf("detail %q should list the cycle members", got.Detail)
+	}
+}
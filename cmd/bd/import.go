@@ -20,8 +20,10 @@ import (
 
 var importCmd = &cobra.Command{
 	Use:   "import [file|-]",
-	Short: "Import issues from a JSONL file or stdin into the database",
+	Short: "Import issues from a JSONL or JSON file or stdin into the database",
 	Long: `Import issues from a JSONL file (newline-delimited JSON) into the database.
+A JSON array of the same records, as written by 'bd export --format json', is also
+accepted.
 
 If no file is specified, imports from the configured import.path under .beads/
 (default: issues.jsonl). Use "-" to read from stdin. This is the incremental counterpart to
@@ -73,6 +75,19 @@ an import is visible. To deliberately restore an older snapshot, pass
 --allow-stale, which imports every row even when it overwrites newer
 local state.
 
+Issues keep their IDs, labels, comments, and dependencies. Dependencies are
+wired after every row in the file is written, so a dependency on an issue
+later in the file resolves regardless of order. A dependency whose target is
+neither in the file nor in the database is skipped, counted in "errors", and
+makes the command exit nonzero once the rest of the import has been applied.
+
+Two flags change how existing issues are handled:
+  --merge     Only add issues that don't exist yet; existing issues are
+              left untouched (reported as existing_skipped_ids).
+  --replace   Make existing issues match the file's rows: every stored
+              field (including owner, spec, due and defer dates) is
+              rewritten, even when the local issue is newer.
+
 Large imports are written in bounded transactions (a few hundred issues
 each, with a short pause between commits) with progress on stderr, so
 concurrent bd commands keep working while the import runs instead of
@@ -92,6 +107,9 @@ EXAMPLES:
   bd import --dry-run              # Show what would be imported
   bd import --dedup                # Skip issues with duplicate titles
   bd import --allow-stale old.jsonl # Restore an older snapshot (overwrites newer local rows)
+  bd import backup.json            # JSON array from 'bd export --format json'
+  bd import --merge backup.jsonl   # Add missing issues, keep existing ones as is
+  bd import --replace backup.jsonl # Make existing issues match the file
   bd import --json                 # Structured output with created and skipped IDs`,
 	GroupID:       "sync",
 	SilenceUsage:  true,
@@ -103,6 +121,8 @@ var (
 	importDryRun     bool
 	importDedup      bool
 	importAllowStale bool
+	importMerge      bool
+	importReplace    bool
 	importInput      string
 )
 
@@ -111,6 +131,8 @@ func init() {
 	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Show what would be imported without importing")
 	importCmd.Flags().BoolVar(&importDedup, "dedup", false, "Skip lines whose title matches an existing open issue")
 	importCmd.Flags().BoolVar(&importAllowStale, "allow-stale", false, "Import rows even when older than the local issue (required to restore an older snapshot)")
+	importCmd.Flags().BoolVar(&importMerge, "merge", false, "Only add new issues; skip issues that already exist")
+	importCmd.Flags().BoolVar(&importReplace, "replace", false, "Make existing issues match the imported rows, every field included (implies --allow-stale)")
 	rootCmd.AddCommand(importCmd)
 }
 
@@ -139,6 +161,9 @@ func runImportInner(args []string) error {
 	if importInput != "" && len(args) > 0 {
 		return fmt.Errorf("use either --input or a positional file, not both")
 	}
+	if importMerge && (importReplace || importAllowStale) {
+		return fmt.Errorf("--merge cannot be combined with --replace or --allow-stale")
+	}
 
 	fromStdin := importInput == "-" || (len(args) > 0 && args[0] == "-")
 
@@ -189,6 +214,7 @@ type importResultJSON struct {
 	Source              string         `json:"source"`
 	Created             int            `json:"created"`
 	Updated             int            `json:"updated,omitempty"`
+	Unchanged           int            `json:"unchanged,omitempty"`
 	Skipped             int            `json:"skipped"`
 	DedupHits           int            `json:"dedup_skipped,omitempty"`
 	Memories            int            `json:"memories,omitempty"`
@@ -196,7 +222,9 @@ type importResultJSON struct {
 	UpdatedIssues       []ImportChange `json:"updated_issues,omitempty"`
 	TieKeptLocalIDs     []string       `json:"tie_kept_local_ids,omitempty"`
 	StaleSkippedIDs     []string       `json:"stale_skipped_ids,omitempty"`
+	ExistingSkippedIDs  []string       `json:"existing_skipped_ids,omitempty"`
 	SkippedDependencies []string       `json:"skipped_dependencies,omitempty"`
+	Errors              int            `json:"errors"`
 	DryRun              bool           `json:"dry_run,omitempty"`
 }
 
@@ -205,67 +233,9 @@ func runImportFromReader(ctx context.Context, r io.Reader, source string) error
 		return fmt.Errorf("no database — run 'bd init' or 'bd bootstrap' first")
 	}
 
-	scanner := bufio.NewScanner(r)
-	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
-
-	var issues []*types.Issue
-	var memories []memoryRecord
-
-	for scanner.Scan() {
-		line := scanner.Text()
-		if line == "" {
-			continue
-		}
-
-		var peek map[string]json.RawMessage
-		if err := json.Unmarshal([]byte(line), &peek); err != nil {
-			return fmt.Errorf("failed to parse JSONL line: %w", err)
-		}
-
-		// Skip the optional beads-jsonl header record (§J1.3). A canonical
-		// export may prepend a provenance line, e.g.
-		// {"_schema":"beads-jsonl/1","_dolt_branch":"main","_sort":"stable-v1"}.
-		// It carries no _type and no issue fields; without this guard it falls
-		// through to the issue path, unmarshals into an empty Issue, and aborts
-		// the whole import with "title is required". parseJSONLFile (the
-		// bootstrap reader) has always skipped it; this loop — the one `bd
-		// import` and `bd import -` run through — did not.
-		if _, isHeader := peek["_schema"]; isHeader {
-			continue
-		}
-
-		if rawType, ok := peek["_type"]; ok {
-			var typeStr string
-			if err := json.Unmarshal(rawType, &typeStr); err == nil && typeStr == "memory" {
-				var mem memoryRecord
-				if err := json.Unmarshal([]byte(line), &mem); err != nil {
-					return fmt.Errorf("failed to parse memory record: %w", err)
-				}
-				if mem.Key != "" && mem.Value != "" {
-					memories = append(memories, mem)
-				}
-				continue
-			}
-		}
-
-		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err != nil {
-			return fmt.Errorf("failed to parse issue from JSONL: %w", err)
-		}
-		if issue.Status == "tombstone" {
-			continue
-		}
-		if _, hasWisp := peek["wisp"]; hasWisp && !issue.Ephemeral {
-			var wisp bool
-			if err := json.Unmarshal(peek["wisp"], &wisp); err == nil && wisp {
-				issue.Ephemeral = true
-			}
-		}
-		issue.SetDefaults()
-		issues = append(issues, &issue)
-	}
-	if err := scanner.Err(); err != nil {
-		return fmt.Errorf("failed to scan JSONL: %w", err)
+	issues, memories, err := readImportRecords(r)
+	if err != nil {
+		return err
 	}
 
 	// Dedup: skip issues whose title matches an existing open issue
@@ -274,16 +244,27 @@ func runImportFromReader(ctx context.Context, r io.Reader, source string) error
 		issues, dedupHits = filterDuplicatesByTitle(ctx, store, issues)
 	}
 
+	// --merge: leave issues that already exist locally untouched.
+	var existingSkipped []string
+	if importMerge && len(issues) > 0 {
+		issues, existingSkipped, err = filterExistingImportIssues(ctx, store, issues)
+		if err != nil {
+			return err
+		}
+	}
+
 	result := importResultJSON{
-		Source:    source,
-		DedupHits: dedupHits,
-		DryRun:    importDryRun,
+		Source:             source,
+		Skipped:            len(existingSkipped),
+		DedupHits:          dedupHits,
+		ExistingSkippedIDs: existingSkipped,
+		DryRun:             importDryRun,
 	}
 
 	if importDryRun {
 		result.Created = len(issues)
 		result.Memories = len(memories)
-		result.Skipped = dedupHits
+		result.Skipped += dedupHits
 		if jsonOutput {
 			return outputJSON(result)
 		}
@@ -291,6 +272,9 @@ func runImportFromReader(ctx context.Context, r io.Reader, source string) error
 		if dedupHits > 0 {
 			fmt.Fprintf(os.Stderr, " (%d duplicates skipped)", dedupHits)
 		}
+		if len(existingSkipped) > 0 {
+			fmt.Fprintf(os.Stderr, " (%d existing skipped)", len(existingSkipped))
+		}
 		fmt.Fprintln(os.Stderr)
 		return nil
 	}
@@ -306,23 +290,32 @@ func runImportFromReader(ctx context.Context, r io.Reader, source string) error
 
 	// Import issues
 	if len(issues) > 0 {
-		opts := ImportOptions{SkipPrefixValidation: true, AllowStale: importAllowStale}
+		opts := ImportOptions{
+			SkipPrefixValidation: true,
+			AllowStale:           importAllowStale || importReplace,
+			Replace:              importReplace,
+			// An issue created locally since the existence check above
+			// must still win under --merge.
+			ConflictSkip: importMerge,
+		}
 		importResult, err := importIssuesCore(ctx, "", store, issues, opts)
 		if err != nil {
 			return fmt.Errorf("import failed: %w", err)
 		}
 		result.Created = importResult.Created
 		result.Updated = importResult.Updated
+		result.Unchanged = importResult.Unchanged
 		result.Skipped += importResult.Skipped
 		result.SkippedDependencies = append(result.SkippedDependencies, importResult.SkippedDependencies...)
 		result.IDs = append(result.IDs, importResult.ImportedIDs...)
 		result.UpdatedIssues = append(result.UpdatedIssues, importResult.UpdatedIssues...)
 		result.TieKeptLocalIDs = append(result.TieKeptLocalIDs, importResult.TieKeptLocalIDs...)
 		result.StaleSkippedIDs = append(result.StaleSkippedIDs, importResult.StaleSkippedIDs...)
+		result.Errors = importResult.UnresolvedDependencies
 	}
 
-	if result.Created > 0 || result.Memories > 0 {
-		commitMsg := fmt.Sprintf("bd import: %d issues", result.Created)
+	if len(result.IDs) > 0 || result.Memories > 0 {
+		commitMsg := fmt.Sprintf("bd import: %d issues", len(result.IDs))
 		if result.Memories > 0 {
 			commitMsg += fmt.Sprintf(", %d memories", result.Memories)
 		}
@@ -352,7 +345,13 @@ func runImportFromReader(ctx context.Context, r io.Reader, source string) error
 	}
 
 	if jsonOutput {
-		return outputJSON(result)
+		if err := outputJSON(result); err != nil {
+			return err
+		}
+		if result.Errors > 0 {
+			return &exitError{Code: 1}
+		}
+		return nil
 	}
 
 	fmt.Fprintf(os.Stderr, "Imported %d issues", result.Created)
@@ -360,10 +359,16 @@ func runImportFromReader(ctx context.Context, r io.Reader, source string) error
 		fmt.Fprintf(os.Stderr, " and %d memories", result.Memories)
 	}
 	fmt.Fprintf(os.Stderr, " from %s", source)
+	if result.Updated > 0 {
+		fmt.Fprintf(os.Stderr, ", updated %d", result.Updated)
+	}
 	if dedupHits > 0 {
 		fmt.Fprintf(os.Stderr, " (%d duplicates skipped)", dedupHits)
 	}
-	if staleSkipped := result.Skipped - dedupHits; staleSkipped > 0 {
+	if len(existingSkipped) > 0 {
+		fmt.Fprintf(os.Stderr, " (%d existing skipped)", len(existingSkipped))
+	}
+	if staleSkipped := len(result.StaleSkippedIDs); staleSkipped > 0 {
 		fmt.Fprintf(os.Stderr, " (%d stale skipped; use --allow-stale to restore older rows)", staleSkipped)
 	}
 	fmt.Fprintln(os.Stderr)
@@ -380,9 +385,165 @@ func runImportFromReader(ctx context.Context, r io.Reader, source string) error
 	for _, skipped := range result.SkippedDependencies {
 		fmt.Fprintf(os.Stderr, "Skipped dependency: %s\n", skipped)
 	}
+	if result.Errors > 0 {
+		fmt.Fprintf(os.Stderr, "Error: %d dependency target(s) not found in the import or the database\n", result.Errors)
+		return &exitError{Code: 1}
+	}
 	return nil
 }
 
+// readImportRecords decodes bd export output: JSONL, or a JSON array of the
+// same records (bd export --format json). Tombstones and the header record are
+// dropped; memory records are returned separately.
+func readImportRecords(r io.Reader) ([]*types.Issue, []memoryRecord, error) {
+	var issues []*types.Issue
+	var memories []memoryRecord
+	add := func(raw []byte) error {
+		issue, mem, err := decodeImportRecord(raw)
+		if err != nil {
+			return err
+		}
+		if issue != nil {
+			issues = append(issues, issue)
+		}
+		if mem != nil {
+			memories = append(memories, *mem)
+		}
+		return nil
+	}
+
+	br := bufio.NewReader(r)
+	if first, err := peekFirstNonSpace(br); err == nil && first == '[' {
+		var records []json.RawMessage
+		if err := json.NewDecoder(br).Decode(&records); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse JSON array: %w", err)
+		}
+		for i, raw := range records {
+			if err := add(raw); err != nil {
+				return nil, nil, fmt.Errorf("record %d: %w", i+1, err)
+			}
+		}
+		return issues, memories, nil
+	}
+
+	scanner := bufio.NewScanner(br)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		if err := add(line); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to scan JSONL: %w", err)
+	}
+	return issues, memories, nil
+}
+
+// peekFirstNonSpace returns the first non-whitespace byte of br without
+// consuming it.
+func peekFirstNonSpace(br *bufio.Reader) (byte, error) {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return b, br.UnreadByte()
+	}
+}
+
+// decodeImportRecord decodes one exported record. It returns a nil issue and
+// memory for records the import ignores.
+func decodeImportRecord(raw []byte) (*types.Issue, *memoryRecord, error) {
+	var peek map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &peek); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse JSONL line: %w", err)
+	}
+
+	// Skip the optional beads-jsonl header record (§J1.3). A canonical
+	// export may prepend a provenance line, e.g.
+	// {"_schema":"beads-jsonl/1","_dolt_branch":"main","_sort":"stable-v1"}.
+	// It carries no _type and no issue fields; without this guard it falls
+	// through to the issue path, unmarshals into an empty Issue, and aborts
+	// the whole import with "title is required". parseJSONLFile (the
+	// bootstrap reader) has always skipped it; this loop — the one `bd
+	// import` and `bd import -` run through — did not.
+	if _, isHeader := peek["_schema"]; isHeader {
+		return nil, nil, nil
+	}
+
+	if rawType, ok := peek["_type"]; ok {
+		var typeStr string
+		if err := json.Unmarshal(rawType, &typeStr); err == nil && typeStr == "memory" {
+			var mem memoryRecord
+			if err := json.Unmarshal(raw, &mem); err != nil {
+				return nil, nil, fmt.Errorf("failed to parse memory record: %w", err)
+			}
+			if mem.Key == "" || mem.Value == "" {
+				return nil, nil, nil
+			}
+			return nil, &mem, nil
+		}
+	}
+
+	var issue types.Issue
+	if err := json.Unmarshal(raw, &issue); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse issue from JSONL: %w", err)
+	}
+	if issue.Status == "tombstone" {
+		return nil, nil, nil
+	}
+	if _, hasWisp := peek["wisp"]; hasWisp && !issue.Ephemeral {
+		var wisp bool
+		if err := json.Unmarshal(peek["wisp"], &wisp); err == nil && wisp {
+			issue.Ephemeral = true
+		}
+	}
+	issue.SetDefaults()
+	return &issue, nil, nil
+}
+
+// filterExistingImportIssues drops issues whose ID already exists locally,
+// returning the kept issues and the dropped IDs.
+func filterExistingImportIssues(ctx context.Context, st storage.DoltStorage, issues []*types.Issue) ([]*types.Issue, []string, error) {
+	ids := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		if issue.ID != "" {
+			ids = append(ids, issue.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return issues, nil, nil
+	}
+	local, err := st.GetIssuesByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("check existing issues before import: %w", err)
+	}
+	exists := make(map[string]bool, len(local))
+	for _, issue := range local {
+		if issue != nil {
+			exists[issue.ID] = true
+		}
+	}
+	kept := make([]*types.Issue, 0, len(issues))
+	var skipped []string
+	for _, issue := range issues {
+		if issue.ID != "" && exists[issue.ID] {
+			skipped = append(skipped, issue.ID)
+			continue
+		}
+		kept = append(kept, issue)
+	}
+	return kept, skipped, nil
+}
+
 // filterDuplicatesByTitle removes issues whose title matches an existing open issue.
 func filterDuplicatesByTitle(ctx context.Context, st storage.DoltStorage, issues []*types.Issue) ([]*types.Issue, int) {
 	existing, err := st.SearchIssues(ctx, "", types.IssueFilter{})
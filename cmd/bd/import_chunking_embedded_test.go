@@ -125,8 +125,8 @@ func TestImportChunkedRealStoreResumeConverges(t *testing.T) {
 	if err != nil {
 		t.Fatalf("re-run importIssuesCore: %v", err)
 	}
-	if result.Created != 12 {
-		t.Fatalf("re-run Created = %d, want all 12 rows accounted for", result.Created)
+	if result.Created != 7 || len(result.ImportedIDs) != 12 {
+		t.Fatalf("re-run Created = %d of %d imported, want the 7 missing rows created and all 12 accounted for", result.Created, len(result.ImportedIDs))
 	}
 	for i := 1; i <= 12; i++ {
 		id := fmt.Sprintf("bd-chunk%02d", i)
@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -209,6 +210,141 @@ func TestEmbeddedImport(t *testing.T) {
 		}
 	})
 
+	// importJSON runs bd import --json and decodes its summary; it does not
+	// fail on a nonzero exit so callers can assert on it.
+	importJSON := func(t *testing.T, dir string, args ...string) (importResultJSON, error) {
+		t.Helper()
+		cmd := exec.Command(bd, append([]string{"import", "--json"}, args...)...)
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		var res importResultJSON
+		if jerr := json.Unmarshal(stdout.Bytes(), &res); jerr != nil {
+			t.Fatalf("parse bd import --json: %v (exit err %v)\nstdout:\n%s\nstderr:\n%s", jerr, err, stdout.String(), stderr.String())
+		}
+		return res, err
+	}
+
+	t.Run("json_array_round_trip", func(t *testing.T) {
+		src, _, _ := bdInit(t, bd, "--prefix", "imrt")
+		parent := bdCreate(t, bd, src, "Parent", "--labels", "alpha,beta")
+		child := bdCreate(t, bd, src, "Child")
+		bdDepAdd(t, bd, src, child.ID, parent.ID)
+		bdCommand(t, bd, src, "comment", parent.ID, "carried over")
+
+		// Export as a JSON array, listing the dependent issue first so its
+		// edge points forward in the file.
+		var records []map[string]interface{}
+		if err := json.Unmarshal([]byte(bdExport(t, bd, src, "--format", "json")), &records); err != nil {
+			t.Fatalf("parse bd export --format json: %v", err)
+		}
+		slices.SortFunc(records, func(a, b map[string]interface{}) int {
+			return strings.Compare(fmt.Sprint(b["title"]), fmt.Sprint(a["title"]))
+		})
+		data, _ := json.Marshal(records)
+		path := filepath.Join(t.TempDir(), "export.json")
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		dst, _, _ := bdInit(t, bd, "--prefix", "imrt")
+		res, err := importJSON(t, dst, path)
+		if err != nil || res.Created != 2 || res.Errors != 0 {
+			t.Fatalf("first import = %+v, err %v; want 2 created, 0 errors", res, err)
+		}
+
+		var got []types.Issue
+		for _, line := range strings.Split(strings.TrimSpace(bdExport(t, bd, dst)), "\n") {
+			var issue types.Issue
+			if err := json.Unmarshal([]byte(line), &issue); err != nil {
+				t.Fatalf("parse export line %q: %v", line, err)
+			}
+			got = append(got, issue)
+		}
+		byID := make(map[string]types.Issue, len(got))
+		for _, issue := range got {
+			byID[issue.ID] = issue
+		}
+		if p := byID[parent.ID]; len(p.Labels) != 2 || len(p.Comments) != 1 || p.Comments[0].Text != "carried over" {
+			t.Errorf("%s labels/comments = %v/%v, want alpha,beta and the comment", parent.ID, p.Labels, p.Comments)
+		}
+		if c := byID[child.ID]; len(c.Dependencies) != 1 || c.Dependencies[0].DependsOnID != parent.ID {
+			t.Errorf("%s dependencies = %v, want one on %s", child.ID, c.Dependencies, parent.ID)
+		}
+
+		// Re-importing the same file adds nothing.
+		if _, err := importJSON(t, dst, path); err != nil {
+			t.Fatalf("re-import: %v", err)
+		}
+		if n := len(bdListJSON(t, bd, dst, "--all")); n != 2 {
+			t.Errorf("issues after re-import = %d, want 2", n)
+		}
+	})
+
+	t.Run("merge_and_replace", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "immr")
+		id := bdCreateSilent(t, bd, dir, "Local title")
+
+		// An older row, so the default stale guard would skip it too.
+		old := time.Now().UTC().Add(-time.Hour)
+		deferUntil := time.Now().UTC().Add(72 * time.Hour).Truncate(time.Second)
+		path := filepath.Join(t.TempDir(), "snapshot.jsonl")
+		writeJSONLFile(t, path, []types.Issue{
+			{ID: id, Title: "Snapshot title", Status: types.StatusOpen, IssueType: types.TypeTask, Owner: "snap@example.com", DeferUntil: &deferUntil, CreatedAt: old, UpdatedAt: old},
+			{ID: "immr-new", Title: "New in snapshot", Status: types.StatusOpen, IssueType: types.TypeTask, CreatedAt: old, UpdatedAt: old},
+		})
+
+		res, err := importJSON(t, dir, "--merge", path)
+		if err != nil || res.Created != 1 || res.Skipped != 1 || !slices.Equal(res.ExistingSkippedIDs, []string{id}) {
+			t.Fatalf("--merge = %+v, err %v; want immr-new created and %s skipped", res, err, id)
+		}
+		if got := bdShow(t, bd, dir, id).Title; got != "Local title" {
+			t.Errorf("--merge changed title to %q", got)
+		}
+
+		res, err = importJSON(t, dir, "--replace", path)
+		if err != nil || res.Created != 0 || res.Updated != 1 || res.Unchanged != 1 {
+			t.Fatalf("--replace = %+v, err %v; want %s updated, immr-new unchanged, nothing created", res, err, id)
+		}
+		got := bdShow(t, bd, dir, id)
+		if got.Title != "Snapshot title" {
+			t.Errorf("--replace title = %q, want %q", got.Title, "Snapshot title")
+		}
+		if got.Owner != "snap@example.com" || got.DeferUntil == nil || !got.DeferUntil.Equal(deferUntil) {
+			t.Errorf("--replace owner/defer_until = %q/%v, want snap@example.com/%v", got.Owner, got.DeferUntil, deferUntil)
+		}
+
+		cmd := exec.Command(bd, "import", "--merge", "--replace", path)
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		out, err := cmd.CombinedOutput()
+		if err == nil || !strings.Contains(string(out), "--merge cannot be combined") {
+			t.Errorf("--merge --replace: err=%v\n%s", err, out)
+		}
+	})
+
+	t.Run("unresolved_dependency_exits_nonzero", func(t *testing.T) {
+		dir, _, _ := bdInit(t, bd, "--prefix", "imud")
+		now := time.Now().UTC()
+		path := filepath.Join(t.TempDir(), "dangling.jsonl")
+		writeJSONLFile(t, path, []types.Issue{{
+			ID: "imud-a", Title: "Depends on a ghost", Status: types.StatusOpen, IssueType: types.TypeTask,
+			CreatedAt: now, UpdatedAt: now,
+			Dependencies: []*types.Dependency{{IssueID: "imud-a", DependsOnID: "imud-ghost", Type: types.DepBlocks}},
+		}})
+
+		res, err := importJSON(t, dir, path)
+		if err == nil {
+			t.Fatal("import with a missing dependency target exited zero")
+		}
+		if res.Created != 1 || res.Errors != 1 {
+			t.Errorf("result = %+v, want 1 created and 1 error", res)
+		}
+		if got := bdShow(t, bd, dir, "imud-a"); got.Title != "Depends on a ghost" {
+			t.Errorf("issue with the dangling dependency was not imported: %+v", got)
+		}
+	})
+
 	t.Run("prefix_sync", func(t *testing.T) {
 		// Simulate a stale DB: init with --prefix bd (DB has issue_prefix=bd),
 		// then overwrite config.yaml with issue-prefix: be. bd import must sync
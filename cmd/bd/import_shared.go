@@ -69,6 +69,11 @@ type ImportOptions struct {
 	// guard otherwise silently no-ops per row (bd-6dnrw.9). Only settable
 	// via explicit `bd import --allow-stale`; auto-import paths never set it.
 	AllowStale bool
+	// Replace makes the upsert rewrite every column of an existing issue
+	// (owner, spec_id, due_at, defer_until, …) so it matches the imported
+	// row, not just the core fields a regular import refreshes. Set only by
+	// `bd import --replace`, which also sets AllowStale.
+	Replace bool
 }
 
 // ImportResult describes what an import operation did.
@@ -97,6 +102,9 @@ type ImportResult struct {
 	// row for these (second-granularity timestamp ties, bd-hj85c); their
 	// aux data still merges.
 	TieKeptLocalIDs []string
+	// UnresolvedDependencies counts skipped edges whose target exists
+	// neither in the import nor in the database.
+	UnresolvedDependencies int
 }
 
 // ImportChange describes how an import row modified an existing local issue.
@@ -118,23 +126,19 @@ func importIssuesCore(ctx context.Context, _ string, store storage.DoltStorage,
 	// read, though, so a local update that commits between it and the batch
 	// write would slip through — RejectStaleUpserts below closes that race by
 	// re-checking updated_at inside the upsert itself.
-	var staleSkippedIDs []string
-	var changePlan importChangePlan
-	if !opts.AllowStale {
-		filtered, skipped, plan, err := filterStaleImportIssues(ctx, store, issues)
-		if err != nil {
-			return nil, err
-		}
-		issues = filtered
-		staleSkippedIDs = skipped
-		changePlan = plan
-		if len(issues) == 0 {
-			return &ImportResult{Skipped: len(staleSkippedIDs), StaleSkippedIDs: staleSkippedIDs}, nil
-		}
+	// Under --allow-stale/--replace nothing is filtered out, but the same
+	// read still tells created from updated rows.
+	issues, staleSkippedIDs, changePlan, err := filterStaleImportIssues(ctx, store, issues, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(issues) == 0 {
+		return &ImportResult{Skipped: len(staleSkippedIDs), StaleSkippedIDs: staleSkippedIDs}, nil
 	}
 
 	var skippedDependencies []string
 	skippedDependencySet := make(map[string]struct{})
+	unresolvedDependencies := 0
 	// In-txn half of the stale guard: rows the conditional upsert rejected
 	// (local update committed between the pre-filter read and the batch
 	// write). The transaction may retry, so dedup by ID.
@@ -145,6 +149,7 @@ func importIssuesCore(ctx context.Context, _ string, store storage.DoltStorage,
 		SkipPrefixValidation:           opts.SkipPrefixValidation,
 		ConflictSkip:                   opts.ConflictSkip,
 		RejectStaleUpserts:             !opts.AllowStale,
+		ReplaceAllColumns:              opts.Replace,
 		SkipDependencyValidationErrors: true,
 		OnSkippedDependency: func(issueID, dependsOnID, reason string) {
 			skipped := fmt.Sprintf("%s -> %s: %s", issueID, dependsOnID, reason)
@@ -153,12 +158,14 @@ func importIssuesCore(ctx context.Context, _ string, store storage.DoltStorage,
 			}
 			skippedDependencySet[skipped] = struct{}{}
 			skippedDependencies = append(skippedDependencies, skipped)
+			if reason == issueops.SkipReasonTargetNotFound {
+				unresolvedDependencies++
+			}
 		},
 		OnStaleRejected: func(issueID string) {
 			staleRejectedSet[issueID] = struct{}{}
 		},
 	}
-	var err error
 	if len(issues) <= importChunkSize {
 		// Small import: one transaction, dependencies inline — exactly the
 		// pre-chunking behavior.
@@ -171,12 +178,16 @@ func importIssuesCore(ctx context.Context, _ string, store storage.DoltStorage,
 	}
 
 	importedIDs := make([]string, 0, len(issues))
+	createdCount := 0
 	for _, issue := range issues {
 		if _, rejected := staleRejectedSet[issue.ID]; rejected {
 			staleSkippedIDs = append(staleSkippedIDs, issue.ID)
 			continue
 		}
 		importedIDs = append(importedIDs, issue.ID)
+		if _, existed := changePlan.Existing[issue.ID]; !existed {
+			createdCount++
+		}
 	}
 	// Drop planned updates the in-txn guard rejected (a local update raced
 	// in between the pre-filter read and the batch write).
@@ -190,14 +201,16 @@ func importIssuesCore(ctx context.Context, _ string, store storage.DoltStorage,
 		updatedCount++
 	}
 	return &ImportResult{
-		Created:             len(importedIDs),
-		Updated:             updatedCount,
-		Skipped:             len(staleSkippedIDs),
-		ImportedIDs:         importedIDs,
-		StaleSkippedIDs:     staleSkippedIDs,
-		SkippedDependencies: skippedDependencies,
-		UpdatedIssues:       updatedIssues,
-		TieKeptLocalIDs:     changePlan.TieKeptLocal,
+		Created:                createdCount,
+		Updated:                updatedCount,
+		Unchanged:              len(importedIDs) - createdCount - updatedCount,
+		Skipped:                len(staleSkippedIDs),
+		ImportedIDs:            importedIDs,
+		StaleSkippedIDs:        staleSkippedIDs,
+		SkippedDependencies:    skippedDependencies,
+		UpdatedIssues:          updatedIssues,
+		TieKeptLocalIDs:        changePlan.TieKeptLocal,
+		UnresolvedDependencies: unresolvedDependencies,
 	}, nil
 }
 
@@ -636,8 +649,11 @@ func (g importOrderGraph) cycleParticipant(start int, emitted []bool) int {
 // issues, so the import can surface what it changed instead of doing it
 // silently (bd-hj85c).
 type importChangePlan struct {
-	// Updates lists existing issues the batch will rewrite: incoming row
-	// strictly newer and row content differs.
+	// Existing holds the IDs of incoming rows already stored locally, so
+	// the result counts them as updated or unchanged rather than created.
+	Existing map[string]struct{}
+	// Updates lists existing issues the batch will rewrite: row content
+	// differs and the incoming row is strictly newer (or opts.AllowStale).
 	Updates []ImportChange
 	// TieKeptLocal lists incoming rows with the same updated_at as the
 	// local issue but different row content. The stale-guarded upsert keeps
@@ -646,7 +662,11 @@ type importChangePlan struct {
 	TieKeptLocal []string
 }
 
-func filterStaleImportIssues(ctx context.Context, store storage.DoltStorage, issues []*types.Issue) ([]*types.Issue, []string, importChangePlan, error) {
+// filterStaleImportIssues drops incoming rows older than the local issue
+// and plans how the rest relate to existing issues. With opts.AllowStale no
+// row is dropped and every differing existing row is planned as an update,
+// since the unguarded upsert rewrites it regardless of updated_at.
+func filterStaleImportIssues(ctx context.Context, store storage.DoltStorage, issues []*types.Issue, opts ImportOptions) ([]*types.Issue, []string, importChangePlan, error) {
 	var plan importChangePlan
 	ids := make([]string, 0, len(issues))
 	seen := make(map[string]struct{}, len(issues))
@@ -668,9 +688,14 @@ func filterStaleImportIssues(ctx context.Context, store storage.DoltStorage, iss
 	if err != nil {
 		return nil, nil, plan, fmt.Errorf("check existing issues before import: %w", err)
 	}
+	plan.Existing = make(map[string]struct{}, len(localIssues))
 	localByID := make(map[string]*types.Issue, len(localIssues))
 	for _, issue := range localIssues {
-		if issue != nil && issue.ID != "" && !issue.UpdatedAt.IsZero() {
+		if issue == nil || issue.ID == "" {
+			continue
+		}
+		plan.Existing[issue.ID] = struct{}{}
+		if !issue.UpdatedAt.IsZero() {
 			localByID[issue.ID] = issue
 		}
 	}
@@ -681,12 +706,19 @@ func filterStaleImportIssues(ctx context.Context, store storage.DoltStorage, iss
 	filtered := make([]*types.Issue, 0, len(issues))
 	skippedIDs := make([]string, 0)
 	for _, issue := range issues {
-		if issue == nil || issue.ID == "" || issue.UpdatedAt.IsZero() {
+		local, ok := localByID[issue.ID]
+		if !ok {
 			filtered = append(filtered, issue)
 			continue
 		}
-		local, ok := localByID[issue.ID]
-		if !ok {
+		if opts.AllowStale {
+			if summary := importRowChangeSummary(local, issue, opts.Replace); summary != "" {
+				plan.Updates = append(plan.Updates, ImportChange{ID: issue.ID, Changes: summary})
+			}
+			filtered = append(filtered, issue)
+			continue
+		}
+		if issue.UpdatedAt.IsZero() {
 			filtered = append(filtered, issue)
 			continue
 		}
@@ -699,7 +731,7 @@ func filterStaleImportIssues(ctx context.Context, store storage.DoltStorage, iss
 			skippedIDs = append(skippedIDs, issue.ID)
 			continue
 		}
-		if summary := importRowChangeSummary(local, issue); summary != "" {
+		if summary := importRowChangeSummary(local, issue, false); summary != "" {
 			if incomingAt.Equal(localAt) {
 				plan.TieKeptLocal = append(plan.TieKeptLocal, issue.ID)
 			} else {
@@ -713,10 +745,10 @@ func filterStaleImportIssues(ctx context.Context, store storage.DoltStorage, iss
 
 // importRowChangeSummary summarizes the differences between the local issue
 // row and the incoming import row, restricted to the columns the import
-// upsert rewrites. Returns "" when none of those fields differ. Status,
-// priority, and type transitions show old → new; long-form fields are listed
-// by name only.
-func importRowChangeSummary(local, incoming *types.Issue) string {
+// upsert rewrites (with replace, including the extra --replace columns).
+// Returns "" when none of those fields differ. Status, priority, and type
+// transitions show old → new; long-form fields are listed by name only.
+func importRowChangeSummary(local, incoming *types.Issue, replace bool) string {
 	var parts []string
 	if local.Status != incoming.Status {
 		parts = append(parts, fmt.Sprintf("status %s → %s", local.Status, incoming.Status))
@@ -761,6 +793,29 @@ func importRowChangeSummary(local, incoming *types.Issue) string {
 	if string(local.Metadata) != string(incoming.Metadata) {
 		parts = append(parts, "metadata")
 	}
+	if replace {
+		if local.Owner != incoming.Owner {
+			parts = append(parts, "owner")
+		}
+		if local.SpecID != incoming.SpecID {
+			parts = append(parts, "spec_id")
+		}
+		if !timePtrEqual(local.DueAt, incoming.DueAt) {
+			parts = append(parts, "due_at")
+		}
+		if !timePtrEqual(local.DeferUntil, incoming.DeferUntil) {
+			parts = append(parts, "defer_until")
+		}
+		if local.Pinned != incoming.Pinned {
+			parts = append(parts, "pinned")
+		}
+		if local.IsTemplate != incoming.IsTemplate {
+			parts = append(parts, "is_template")
+		}
+		if local.MolType != incoming.MolType || local.WorkType != incoming.WorkType {
+			parts = append(parts, "molecule type")
+		}
+	}
 	return strings.Join(parts, ", ")
 }
 
@@ -778,6 +833,15 @@ func intPtrEqual(a, b *int) bool {
 	return *a == *b
 }
 
+// timePtrEqual compares at second granularity, matching the DATETIME
+// columns the times round-trip through.
+func timePtrEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.UTC().Truncate(time.Second).Equal(b.UTC().Truncate(time.Second))
+}
+
 // importLocalResult holds counts from a local JSONL import.
 type importLocalResult struct {
 	Issues   int
@@ -947,7 +1011,7 @@ func importFromLocalJSONLWithOpts(ctx context.Context, store storage.DoltStorage
 		if err != nil {
 			return nil, err
 		}
-		result.Issues = importResult.Created
+		result.Issues = len(importResult.ImportedIDs)
 	}
 
 	return result, nil
@@ -48,7 +48,7 @@ func TestFilterStaleImportIssuesSkipsOlderIncomingRecords(t *testing.T) {
 		{ID: "bd-newer", Title: "old title", UpdatedAt: base.Add(time.Hour)},
 	}}
 
-	filtered, skippedIDs, plan, err := filterStaleImportIssues(context.Background(), store, incoming)
+	filtered, skippedIDs, plan, err := filterStaleImportIssues(context.Background(), store, incoming, ImportOptions{})
 	if err != nil {
 		t.Fatalf("filterStaleImportIssues: %v", err)
 	}
@@ -96,7 +96,7 @@ func TestFilterStaleImportIssuesReportsTieConflicts(t *testing.T) {
 		{ID: "bd-subsec", Title: "title", Notes: "local notes", UpdatedAt: base},
 	}}
 
-	filtered, skippedIDs, plan, err := filterStaleImportIssues(context.Background(), store, incoming)
+	filtered, skippedIDs, plan, err := filterStaleImportIssues(context.Background(), store, incoming, ImportOptions{})
 	if err != nil {
 		t.Fatalf("filterStaleImportIssues: %v", err)
 	}
@@ -126,12 +126,12 @@ func TestImportRowChangeSummary(t *testing.T) {
 		Title: "t", Status: types.StatusOpen, Priority: 2,
 		IssueType: types.TypeBug,
 	}
-	got := importRowChangeSummary(local, incoming)
+	got := importRowChangeSummary(local, incoming, false)
 	want := "status closed → open, priority 1 → 2, notes cleared"
 	if got != want {
 		t.Fatalf("importRowChangeSummary = %q, want %q", got, want)
 	}
-	if s := importRowChangeSummary(local, local); s != "" {
+	if s := importRowChangeSummary(local, local, false); s != "" {
 		t.Fatalf("importRowChangeSummary(identical) = %q, want empty", s)
 	}
 }
@@ -177,8 +177,8 @@ func TestImportIssuesCoreAllowStaleImportsOlderRows(t *testing.T) {
 	if err != nil {
 		t.Fatalf("importIssuesCore: %v", err)
 	}
-	if result.Created != 1 {
-		t.Fatalf("Created = %d, want 1", result.Created)
+	if result.Created != 0 || result.Updated != 1 {
+		t.Fatalf("Created = %d, Updated = %d, want the overwritten row counted as updated", result.Created, result.Updated)
 	}
 	if result.Skipped != 0 || len(result.StaleSkippedIDs) != 0 {
 		t.Fatalf("Skipped = %d, StaleSkippedIDs = %#v, want none", result.Skipped, result.StaleSkippedIDs)
@@ -188,6 +188,38 @@ func TestImportIssuesCoreAllowStaleImportsOlderRows(t *testing.T) {
 	}
 }
 
+// --replace must count overwritten rows as updated (not created), whatever
+// their updated_at, report changes in the replace-only columns, and ask the
+// upsert to rewrite those columns.
+func TestImportIssuesCoreReplaceCountsExistingRowsAsUpdated(t *testing.T) {
+	base := time.Date(2026, 5, 27, 12, 0, 0, 0, time.UTC)
+	deferred := base.Add(48 * time.Hour)
+	store := &fakeImportIssueLookupStore{issues: []*types.Issue{
+		{ID: "bd-newer", Title: "t", UpdatedAt: base.Add(time.Hour)},
+		{ID: "bd-defer", Title: "t", UpdatedAt: base},
+		{ID: "bd-same", Title: "t", UpdatedAt: base},
+	}}
+
+	result, err := importIssuesCore(context.Background(), "", store, []*types.Issue{
+		{ID: "bd-newer", Title: "snapshot", UpdatedAt: base},
+		{ID: "bd-defer", Title: "t", DeferUntil: &deferred, UpdatedAt: base},
+		{ID: "bd-same", Title: "t", UpdatedAt: base},
+		{ID: "bd-new", Title: "brand new", UpdatedAt: base},
+	}, ImportOptions{AllowStale: true, Replace: true})
+	if err != nil {
+		t.Fatalf("importIssuesCore: %v", err)
+	}
+	if result.Created != 1 || result.Updated != 2 || result.Unchanged != 1 {
+		t.Fatalf("Created/Updated/Unchanged = %d/%d/%d, want 1/2/1", result.Created, result.Updated, result.Unchanged)
+	}
+	if len(result.UpdatedIssues) != 2 || result.UpdatedIssues[1].ID != "bd-defer" || result.UpdatedIssues[1].Changes != "defer_until" {
+		t.Fatalf("UpdatedIssues = %#v, want bd-newer and bd-defer (defer_until)", result.UpdatedIssues)
+	}
+	if len(store.createOpts) != 1 || !store.createOpts[0].ReplaceAllColumns {
+		t.Fatalf("createOpts = %#v, want ReplaceAllColumns under --replace", store.createOpts)
+	}
+}
+
 // bd-hj85c: the import must report which existing local issues it changed
 // (field-level summary) and which same-timestamp conflicting rows kept local
 // state, so reverts are visible instead of silent. Updates rejected by the
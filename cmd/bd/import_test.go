@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestReadImportRecords(t *testing.T) {
+	const header = `{"_schema":"beads-jsonl/1","_sort":"stable-v1"}`
+	const issue1 = `{"id":"bd-1","title":"First","labels":["a"],"dependencies":[{"issue_id":"bd-1","depends_on_id":"bd-2","type":"blocks"}]}`
+	const issue2 = `{"id":"bd-2","title":"Second","comments":[{"author":"x","text":"hi"}]}`
+	const tombstone = `{"id":"bd-3","title":"Gone","status":"tombstone"}`
+	const memory = `{"_type":"memory","key":"k","value":"v"}`
+
+	inputs := map[string]string{
+		"jsonl":       strings.Join([]string{header, issue1, "", issue2, tombstone, memory}, "\n") + "\n",
+		"json_array":  "[\n" + strings.Join([]string{header, issue1, issue2, tombstone, memory}, ",\n") + "\n]\n",
+		"json_padded": "\n  [" + strings.Join([]string{issue1, issue2, tombstone, memory}, ",") + "]",
+	}
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			issues, memories, err := readImportRecords(strings.NewReader(input))
+			if err != nil {
+				t.Fatalf("readImportRecords: %v", err)
+			}
+			var ids []string
+			for _, issue := range issues {
+				ids = append(ids, issue.ID)
+			}
+			if !slices.Equal(ids, []string{"bd-1", "bd-2"}) {
+				t.Fatalf("ids = %v, want [bd-1 bd-2]", ids)
+			}
+			if got := issues[0]; len(got.Labels) != 1 || len(got.Dependencies) != 1 || got.Dependencies[0].DependsOnID != "bd-2" {
+				t.Errorf("bd-1 labels/dependencies = %v/%v, want them preserved", got.Labels, got.Dependencies)
+			}
+			if len(issues[1].Comments) != 1 {
+				t.Errorf("bd-2 comments = %v, want 1", issues[1].Comments)
+			}
+			if issues[1].Status != types.StatusOpen {
+				t.Errorf("bd-2 status = %q, want defaulted to open", issues[1].Status)
+			}
+			if len(memories) != 1 || memories[0].Key != "k" {
+				t.Errorf("memories = %v, want [k]", memories)
+			}
+		})
+	}
+
+	t.Run("bad_array_record", func(t *testing.T) {
+		_, _, err := readImportRecords(strings.NewReader(`[{"id":"bd-1","title":"ok"}, 42]`))
+		if err == nil || !strings.Contains(err.Error(), "record 2") {
+			t.Errorf("err = %v, want a record 2 parse error", err)
+		}
+	})
+}
+
+func TestFilterExistingImportIssues(t *testing.T) {
+	store := &fakeImportIssueLookupStore{issues: []*types.Issue{{ID: "bd-old"}}}
+	kept, skipped, err := filterExistingImportIssues(context.Background(), store, []*types.Issue{
+		{ID: "bd-old", Title: "exists"},
+		{ID: "bd-new", Title: "new"},
+		{Title: "no id"},
+	})
+	if err != nil {
+		t.Fatalf("filterExistingImportIssues: %v", err)
+	}
+	if len(kept) != 2 || kept[0].ID != "bd-new" || kept[1].Title != "no id" {
+		t.Errorf("kept = %v, want bd-new and the ID-less issue", kept)
+	}
+	if !slices.Equal(skipped, []string{"bd-old"}) {
+		t.Errorf("skipped = %v, want [bd-old]", skipped)
+	}
+}
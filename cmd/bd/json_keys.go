@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// legacyJSONKeys is --legacy-keys on list, search, show, ready and blocked:
+// emit the --json payload exactly as the Go types encode it instead of the
+// canonical key set. It exists for the transition period only.
+var legacyJSONKeys bool
+
+const legacyKeysFlagUsage = "Emit the legacy --json keys (parent, dependency type) instead of the canonical ones (transition period only)"
+
+func addLegacyKeysFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&legacyJSONKeys, "legacy-keys", false, legacyKeysFlagUsage)
+}
+
+// canonicalJSON re-encodes v with the canonical issue keys shared by list,
+// search, show, ready and blocked:
+//
+//   - an issue's parent is parent_id; the parent alias is dropped.
+//   - every dependency edge, whether a dependency record (list, ready,
+//     blocked) or an embedded issue (show dependencies/dependents), carries
+//     issue_id, depends_on_id and dependency_type; the type alias of
+//     dependency records is dropped.
+//
+// Objects are re-encoded as maps, so keys come out in sorted order whatever
+// struct produced them. With --legacy-keys v is returned unchanged.
+func canonicalJSON(v interface{}) (interface{}, error) {
+	if legacyJSONKeys {
+		return v, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encoding JSON: %v", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var out interface{}
+	if err := dec.Decode(&out); err != nil {
+		return nil, fmt.Errorf("encoding JSON: %v", err)
+	}
+	canonicalizeJSONKeys(out)
+	return out, nil
+}
+
+// outputCanonicalJSON is outputJSON for issue payloads: see canonicalJSON.
+func outputCanonicalJSON(v interface{}) error {
+	canonical, err := canonicalJSON(v)
+	if err != nil {
+		return err
+	}
+	return outputJSON(canonical)
+}
+
+// canonicalizeJSONKeys rewrites every issue object in a decoded JSON value.
+// User metadata is opaque and never rewritten.
+func canonicalizeJSONKeys(v interface{}) {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, elem := range v {
+			canonicalizeJSONKeys(elem)
+		}
+	case map[string]interface{}:
+		for key, elem := range v {
+			if key != "metadata" {
+				canonicalizeJSONKeys(elem)
+			}
+		}
+		if isIssueObject(v) {
+			canonicalizeIssueKeys(v)
+		}
+	}
+}
+
+// isIssueObject reports whether obj encodes an issue rather than a
+// dependency record or some other nested object.
+func isIssueObject(obj map[string]interface{}) bool {
+	_, hasID := obj["id"]
+	_, hasTitle := obj["title"]
+	return hasID && hasTitle
+}
+
+func canonicalizeIssueKeys(issue map[string]interface{}) {
+	if parent, ok := issue["parent"]; ok {
+		if _, has := issue["parent_id"]; !has {
+			issue["parent_id"] = parent
+		}
+		delete(issue, "parent")
+	}
+	id := issue["id"]
+	for _, edge := range jsonObjects(issue["dependencies"]) {
+		canonicalizeEdgeKeys(edge, id, edge["id"])
+	}
+	for _, edge := range jsonObjects(issue["dependents"]) {
+		canonicalizeEdgeKeys(edge, edge["id"], id)
+	}
+}
+
+// canonicalizeEdgeKeys names the endpoints and type of one dependency edge.
+// from and to are the issue_id and depends_on_id an embedded issue entry
+// stands for; a dependency record already carries both.
+func canonicalizeEdgeKeys(edge map[string]interface{}, from, to interface{}) {
+	if _, isRecord := edge["depends_on_id"]; isRecord {
+		if t, ok := edge["type"]; ok {
+			if _, has := edge["dependency_type"]; !has {
+				edge["dependency_type"] = t
+			}
+			delete(edge, "type")
+		}
+		return
+	}
+	if _, ok := edge["dependency_type"]; !ok {
+		return
+	}
+	edge["issue_id"] = from
+	edge["depends_on_id"] = to
+}
+
+// jsonObjects returns the object elements of a decoded JSON array.
+func jsonObjects(v interface{}) []map[string]interface{} {
+	arr, _ := v.([]interface{})
+	out := make([]map[string]interface{}, 0, len(arr))
+	for _, elem := range arr {
+		if obj, ok := elem.(map[string]interface{}); ok {
+			out = append(out, obj)
+		}
+	}
+	return out
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// canonicalObjects runs canonicalJSON and re-decodes the result for
+// inspection.
+func canonicalObjects(t *testing.T, v interface{}) []map[string]interface{} {
+	t.Helper()
+	out, err := canonicalJSON(v)
+	if err != nil {
+		t.Fatalf("canonicalJSON: %v", err)
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var items []map[string]interface{}
+	if err := json.Unmarshal(data, &items); err != nil {
+		t.Fatalf("unmarshal %s: %v", data, err)
+	}
+	return items
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	parent := "bd-1"
+
+	t.Run("dependency_records", func(t *testing.T) {
+		issue := &types.Issue{
+			ID:        "bd-1.1",
+			Title:     "child",
+			IssueType: types.TypeTask,
+			Metadata:  json.RawMessage(`{"id":"x","title":"y","parent":"kept","type":"kept"}`),
+			Dependencies: []*types.Dependency{
+				{IssueID: "bd-1.1", DependsOnID: parent, Type: types.DepParentChild},
+			},
+		}
+		items := canonicalObjects(t, []*types.IssueWithCounts{{Issue: issue, Parent: &parent}})
+		got := items[0]
+		if _, ok := got["parent"]; ok {
+			t.Errorf("legacy parent key present: %v", got)
+		}
+		if got["parent_id"] != parent {
+			t.Errorf("parent_id = %v, want %s", got["parent_id"], parent)
+		}
+		if got["issue_type"] != "task" {
+			t.Errorf("issue_type = %v, want task", got["issue_type"])
+		}
+		edge := got["dependencies"].([]interface{})[0].(map[string]interface{})
+		if _, ok := edge["type"]; ok {
+			t.Errorf("legacy dependency type key present: %v", edge)
+		}
+		if edge["issue_id"] != "bd-1.1" || edge["depends_on_id"] != parent || edge["dependency_type"] != "parent-child" {
+			t.Errorf("edge = %v, want bd-1.1 -> %s parent-child", edge, parent)
+		}
+		meta := got["metadata"].(map[string]interface{})
+		if meta["parent"] != "kept" || meta["type"] != "kept" {
+			t.Errorf("metadata was rewritten: %v", meta)
+		}
+	})
+
+	t.Run("embedded_edges", func(t *testing.T) {
+		details := &types.IssueDetails{
+			Issue: types.Issue{ID: "bd-2", Title: "middle"},
+			Dependencies: []*types.IssueWithDependencyMetadata{
+				{Issue: types.Issue{ID: "bd-3", Title: "blocker"}, DependencyType: types.DepBlocks},
+			},
+			Dependents: []*types.IssueWithDependencyMetadata{
+				{Issue: types.Issue{ID: "bd-4", Title: "waiter"}, DependencyType: types.DepBlocks},
+			},
+		}
+		got := canonicalObjects(t, []*types.IssueDetails{details})[0]
+		dep := got["dependencies"].([]interface{})[0].(map[string]interface{})
+		if dep["issue_id"] != "bd-2" || dep["depends_on_id"] != "bd-3" || dep["id"] != "bd-3" {
+			t.Errorf("dependency edge = %v, want bd-2 -> bd-3", dep)
+		}
+		rev := got["dependents"].([]interface{})[0].(map[string]interface{})
+		if rev["issue_id"] != "bd-4" || rev["depends_on_id"] != "bd-2" {
+			t.Errorf("dependent edge = %v, want bd-4 -> bd-2", rev)
+		}
+	})
+
+	t.Run("large_numbers_survive", func(t *testing.T) {
+		minutes := 1<<53 + 1
+		issue := &types.Issue{ID: "bd-5", Title: "big", EstimatedMinutes: &minutes}
+		out, err := canonicalJSON([]*types.Issue{issue})
+		if err != nil {
+			t.Fatalf("canonicalJSON: %v", err)
+		}
+		data, _ := json.Marshal(out)
+		var back []types.Issue
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if *back[0].EstimatedMinutes != minutes {
+			t.Errorf("estimated_minutes = %d, want %d", *back[0].EstimatedMinutes, minutes)
+		}
+	})
+
+	t.Run("legacy_keys", func(t *testing.T) {
+		legacyJSONKeys = true
+		t.Cleanup(func() { legacyJSONKeys = false })
+		issue := &types.Issue{ID: "bd-1.1", Title: "child"}
+		got := canonicalObjects(t, []*types.IssueWithCounts{{Issue: issue, Parent: &parent}})[0]
+		if got["parent"] != parent {
+			t.Errorf("--legacy-keys should keep parent, got %v", got)
+		}
+		if _, ok := got["parent_id"]; ok {
+			t.Errorf("--legacy-keys should not add parent_id: %v", got)
+		}
+	})
+}
@@ -4,6 +4,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -21,50 +22,101 @@ var labelCmd = &cobra.Command{
 	Short:   "Manage issue labels",
 }
 
+// processBatchLabelOperation applies every label to every issue in one
+// transaction. skip, when non-nil, leaves out pairs that need no change, such
+// as a label the issue already carries on add.
 func processBatchLabelOperation(issueIDs []string, labels []string, operation string, jsonOut bool,
+	skip func(issueID, label string) bool,
 	txFunc func(context.Context, storage.Transaction, string, string, string) error) error {
 	ctx := rootCtx
-	labelDesc := strings.Join(labels, "', '")
-	commitMsg := fmt.Sprintf("bd: label %s '%s' on %d issue(s)", operation, labelDesc, len(issueIDs))
-	err := transactHonoringAutoCommit(ctx, store, commitMsg, func(tx storage.Transaction) error {
-		for _, issueID := range issueIDs {
-			for _, label := range labels {
-				if err := txFunc(ctx, tx, issueID, label, actor); err != nil {
-					return fmt.Errorf("%s label '%s' on %s: %w", operation, label, issueID, err)
+	applied, skipped := splitLabelPairs(issueIDs, labels, skip)
+	var pending []string
+	for _, issueID := range issueIDs {
+		if len(applied[issueID]) > 0 {
+			pending = append(pending, issueID)
+		}
+	}
+	if len(pending) > 0 {
+		labelDesc := strings.Join(labels, "', '")
+		commitMsg := fmt.Sprintf("bd: label %s '%s' on %d issue(s)", operation, labelDesc, len(pending))
+		err := transactHonoringAutoCommit(ctx, store, commitMsg, func(tx storage.Transaction) error {
+			for _, issueID := range pending {
+				for _, label := range applied[issueID] {
+					if err := txFunc(ctx, tx, issueID, label, actor); err != nil {
+						return fmt.Errorf("%s label '%s' on %s: %w", operation, label, issueID, err)
+					}
 				}
 			}
+			return nil
+		})
+		if err != nil {
+			return HandleErrorRespectJSON("label %s: %v", operation, err)
+		}
+		commandDidWrite.Store(true)
+	}
+	return renderLabelOperation(issueIDs, applied, skipped, operation, jsonOut)
+}
+
+// splitLabelPairs sorts each issue's labels into those to apply and those
+// skip leaves out.
+func splitLabelPairs(issueIDs, labels []string, skip func(issueID, label string) bool) (applied, skipped map[string][]string) {
+	applied = make(map[string][]string, len(issueIDs))
+	skipped = make(map[string][]string)
+	for _, issueID := range issueIDs {
+		for _, label := range labels {
+			if skip != nil && skip(issueID, label) {
+				skipped[issueID] = append(skipped[issueID], label)
+			} else {
+				applied[issueID] = append(applied[issueID], label)
+			}
 		}
-		return nil
-	})
-	if err != nil {
-		return HandleErrorRespectJSON("label %s: %v", operation, err)
 	}
-	commandDidWrite.Store(true)
+	return applied, skipped
+}
+
+// renderLabelOperation reports the labels added to or removed from each
+// issue. A label an add skipped was already present and is reported as such
+// (status "exists" in JSON, like bd dep add). A remove passes only the labels
+// it removed; the rest have already been warned about by reportNothingToRemove.
+func renderLabelOperation(issueIDs []string, applied, skipped map[string][]string, operation string, jsonOut bool) error {
 	if jsonOut {
-		results := make([]map[string]interface{}, 0, len(issueIDs)*len(labels))
+		results := make([]map[string]interface{}, 0, len(issueIDs))
 		for _, issueID := range issueIDs {
-			for _, label := range labels {
+			for _, label := range applied[issueID] {
 				results = append(results, map[string]interface{}{
 					"status":   operation,
 					"issue_id": issueID,
 					"label":    label,
 				})
 			}
+			if operation != "added" {
+				continue
+			}
+			for _, label := range skipped[issueID] {
+				results = append(results, map[string]interface{}{
+					"status":   "exists",
+					"issue_id": issueID,
+					"label":    label,
+				})
+			}
 		}
 		return outputJSON(results)
 	}
-	verb := "Added"
-	prep := "to"
+	verb, prep := "Added", "to"
 	if operation == "removed" {
-		verb = "Removed"
-		prep = "from"
-	}
-	noun := "label"
-	if len(labels) > 1 {
-		noun = "labels"
+		verb, prep = "Removed", "from"
 	}
 	for _, issueID := range issueIDs {
-		fmt.Printf("%s %s %s '%s' %s %s\n", ui.RenderPass("✓"), verb, noun, labelDesc, prep, issueID)
+		if len(applied[issueID]) > 0 {
+			noun := "label"
+			if len(applied[issueID]) > 1 {
+				noun = "labels"
+			}
+			fmt.Printf("%s %s %s '%s' %s %s\n", ui.RenderPass("✓"), verb, noun, strings.Join(applied[issueID], "', '"), prep, issueID)
+		}
+		if operation == "added" && len(skipped[issueID]) > 0 {
+			fmt.Printf("%s Label already present: '%s' on %s; nothing changed\n", ui.RenderWarn("○"), strings.Join(skipped[issueID], "', '"), issueID)
+		}
 	}
 	return nil
 }
@@ -114,9 +166,12 @@ func resolveLabelIssueIDs(ctx context.Context, subcommand string, issueIDs []str
 
 //nolint:dupl // labelAddCmd and labelRemoveCmd are similar but serve different operations
 var labelAddCmd = &cobra.Command{
-	Use:           "add [issue-id...] [label[,label...]]",
-	Short:         "Add one or more labels to one or more issues",
-	Long:          "Add labels to issues. Issue IDs come first; the final argument is the label. Pass multiple labels comma-separated: bd label add bd-123 label1,label2",
+	Use:   "add [issue-id...] [label[,label...]]",
+	Short: "Add one or more labels to one or more issues",
+	Long: `Add labels to issues. Issue IDs come first; the final argument is the label. Pass multiple labels comma-separated: bd label add bd-123 label1,label2
+
+A label the issue already carries is reported as already present and left
+unchanged.`,
 	Args:          cobra.MinimumNArgs(2),
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -150,7 +205,12 @@ var labelAddCmd = &cobra.Command{
 			}
 		}
 
-		return processBatchLabelOperation(issueIDs, labels, "added", jsonOutput,
+		carried, err := store.GetLabelsForIssues(ctx, issueIDs)
+		if err != nil {
+			return HandleErrorRespectJSON("getting labels: %v", err)
+		}
+		hasLabel := func(issueID, label string) bool { return slices.Contains(carried[issueID], label) }
+		return processBatchLabelOperation(issueIDs, labels, "added", jsonOutput, hasLabel,
 			func(ctx context.Context, tx storage.Transaction, issueID, lbl, act string) error {
 				return tx.AddLabel(ctx, issueID, lbl, act)
 			})
@@ -159,9 +219,16 @@ var labelAddCmd = &cobra.Command{
 
 //nolint:dupl // labelRemoveCmd and labelAddCmd are similar but serve different operations
 var labelRemoveCmd = &cobra.Command{
-	Use:           "remove [issue-id...] [label[,label...]]",
-	Short:         "Remove one or more labels from one or more issues",
-	Long:          "Remove labels from issues. Issue IDs come first; the final argument is the label. Pass multiple labels comma-separated: bd label remove bd-123 label1,label2",
+	Use:   "remove [issue-id...] [label[,label...]]",
+	Short: "Remove one or more labels from one or more issues",
+	Long: `Remove labels from issues. Issue IDs come first; the final argument is the label. Pass multiple labels comma-separated: bd label remove bd-123 label1,label2
+
+Removing labels from more than one issue asks for confirmation. Pass --yes to
+skip the prompt; without a terminal, or with --json or --quiet, --yes is
+required. Use --dry-run to preview the removal.
+
+A label the issue does not carry is skipped with a warning; pass --strict to
+fail instead.`,
 	Args:          cobra.MinimumNArgs(2),
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -176,7 +243,7 @@ var labelRemoveCmd = &cobra.Command{
 		}()
 
 		if usesProxiedServer() {
-			return runLabelRemoveProxiedServer(rootCtx, args)
+			return runLabelRemoveProxiedServer(cmd, rootCtx, args)
 		}
 
 		issueIDs, labels := parseLabelArgs(args)
@@ -188,10 +255,52 @@ var labelRemoveCmd = &cobra.Command{
 		if err != nil {
 			return HandleErrorRespectJSON("%v", err)
 		}
-		return processBatchLabelOperation(issueIDs, labels, "removed", jsonOutput,
-			func(ctx context.Context, tx storage.Transaction, issueID, lbl, act string) error {
-				return tx.RemoveLabel(ctx, issueID, lbl, act)
-			})
+		issueIDs = uniqueStrings(issueIDs)
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			carried, err := store.GetLabelsForIssues(ctx, issueIDs)
+			if err != nil {
+				return HandleErrorRespectJSON("getting labels: %v", err)
+			}
+			if err := reportNothingToRemove(cmd, missingLabelRemovals(issueIDs, labels, carried)); err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
+			return previewLabelRemove(issueIDs, labels)
+		}
+		if err := confirmDestructive(cmd, labelRemoveAction(labels, len(issueIDs)), len(issueIDs)); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+
+		// A label whose DELETE removed no row was not on the issue.
+		var removed map[string][]string
+		var missing []string
+		commitMsg := fmt.Sprintf("bd: label removed '%s' on %d issue(s)", strings.Join(labels, "', '"), len(issueIDs))
+		err = transactHonoringAutoCommit(ctx, store, commitMsg, func(tx storage.Transaction) error {
+			removed, missing = make(map[string][]string, len(issueIDs)), nil
+			for _, issueID := range issueIDs {
+				for _, label := range labels {
+					n, err := tx.RemoveLabel(ctx, issueID, label, actor)
+					if err != nil {
+						return fmt.Errorf("removed label '%s' on %s: %w", label, issueID, err)
+					}
+					if n == 0 {
+						missing = append(missing, noSuchLabel(issueID, label))
+						continue
+					}
+					removed[issueID] = append(removed[issueID], label)
+				}
+			}
+			return strictNothingToRemove(cmd, missing)
+		})
+		if err != nil {
+			return HandleErrorRespectJSON("label removed: %v", err)
+		}
+		if err := reportNothingToRemove(cmd, missing); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		if len(removed) > 0 {
+			commandDidWrite.Store(true)
+		}
+		return renderLabelOperation(issueIDs, removed, nil, "removed", jsonOutput)
 	},
 }
 var labelListCmd = &cobra.Command{
@@ -398,7 +507,56 @@ var labelPropagateCmd = &cobra.Command{
 	},
 }
 
+// labelRemoveAction describes a bd label remove for confirmDestructive.
+func labelRemoveAction(labels []string, issueCount int) string {
+	noun := "label"
+	if len(labels) > 1 {
+		noun = "labels"
+	}
+	return fmt.Sprintf("remove %s '%s' from %d issues", noun, strings.Join(labels, "', '"), issueCount)
+}
+
+// missingLabelRemovals describes each label of a bd label remove --dry-run
+// that an issue does not carry. carried maps issue IDs to their current
+// labels. A real remove learns the same from the rows each DELETE removed.
+func missingLabelRemovals(issueIDs, labels []string, carried map[string][]string) []string {
+	var missing []string
+	for _, issueID := range issueIDs {
+		for _, label := range labels {
+			if !slices.Contains(carried[issueID], label) {
+				missing = append(missing, noSuchLabel(issueID, label))
+			}
+		}
+	}
+	return missing
+}
+
+// noSuchLabel describes a bd label remove of a label issueID does not carry.
+func noSuchLabel(issueID, label string) string {
+	return fmt.Sprintf("no such label to remove: %s does not have '%s'", issueID, label)
+}
+
+// previewLabelRemove prints what bd label remove would do without --dry-run.
+func previewLabelRemove(issueIDs, labels []string) error {
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{
+			"dry_run":   true,
+			"issue_ids": issueIDs,
+			"labels":    labels,
+		})
+	}
+	fmt.Printf("Would remove '%s' from %d issue(s):\n", strings.Join(labels, "', '"), len(issueIDs))
+	for _, issueID := range issueIDs {
+		fmt.Printf("  %s\n", issueID)
+	}
+	fmt.Printf("\n(Dry-run mode - no changes made)\n")
+	return nil
+}
+
 func init() {
+	registerDestructiveFlags(labelRemoveCmd)
+	registerStrictFlag(labelRemoveCmd)
+
 	// Issue ID completions
 	labelAddCmd.ValidArgsFunction = issueIDCompletion
 	labelRemoveCmd.ValidArgsFunction = issueIDCompletion
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -186,7 +187,14 @@ func TestEmbeddedLabel(t *testing.T) {
 	t.Run("label_add_duplicate_idempotent", func(t *testing.T) {
 		issue := bdCreate(t, bd, dir, "Dup label", "--type", "task")
 		bdLabel(t, bd, dir, "add", issue.ID, "dup")
-		bdLabel(t, bd, dir, "add", issue.ID, "dup")
+		out := bdLabel(t, bd, dir, "add", issue.ID, "dup")
+		if !strings.Contains(out, "already present") || strings.Contains(out, "Added") {
+			t.Errorf("expected the second add to report the label as already present: %s", out)
+		}
+		out = bdLabelJSONOutput(t, bd, dir, "add", issue.ID, "dup,dup-new", "--json")
+		if !strings.Contains(out, `"exists"`) || !strings.Contains(out, `"added"`) {
+			t.Errorf("expected dup as exists and dup-new as added: %s", out)
+		}
 		labels := bdLabelListJSON(t, bd, dir, issue.ID)
 		count := 0
 		for _, l := range labels {
@@ -215,7 +223,7 @@ func TestEmbeddedLabel(t *testing.T) {
 	t.Run("label_remove_batch", func(t *testing.T) {
 		issue1 := bdCreate(t, bd, dir, "Batch rm 1", "--type", "task", "--label", "batch-rm")
 		issue2 := bdCreate(t, bd, dir, "Batch rm 2", "--type", "task", "--label", "batch-rm")
-		bdLabel(t, bd, dir, "remove", issue1.ID, issue2.ID, "batch-rm")
+		bdLabel(t, bd, dir, "remove", issue1.ID, issue2.ID, "batch-rm", "--yes")
 		for _, id := range []string{issue1.ID, issue2.ID} {
 			labels := bdLabelListJSON(t, bd, dir, id)
 			for _, l := range labels {
@@ -226,6 +234,56 @@ func TestEmbeddedLabel(t *testing.T) {
 		}
 	})
 
+	t.Run("label_remove_batch_requires_yes", func(t *testing.T) {
+		issue1 := bdCreate(t, bd, dir, "Confirm rm 1", "--type", "task", "--label", "confirm-rm")
+		issue2 := bdCreate(t, bd, dir, "Confirm rm 2", "--type", "task", "--label", "confirm-rm")
+
+		// The test subprocess has no terminal, so there is no one to ask.
+		out := bdLabelFail(t, bd, dir, "remove", issue1.ID, issue2.ID, "confirm-rm")
+		if !strings.Contains(out, "--yes") {
+			t.Errorf("expected a hint to re-run with --yes: %s", out)
+		}
+		out = bdLabel(t, bd, dir, "remove", issue1.ID, issue2.ID, "confirm-rm", "--dry-run")
+		if !strings.Contains(out, "Would remove") {
+			t.Errorf("expected a dry-run preview: %s", out)
+		}
+		for _, id := range []string{issue1.ID, issue2.ID} {
+			if labels := bdLabelListJSON(t, bd, dir, id); !slices.Contains(labels, "confirm-rm") {
+				t.Errorf("label should survive on %s without --yes: %v", id, labels)
+			}
+		}
+	})
+
+	t.Run("label_remove_nonexistent_warns", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Remove missing label", "--type", "task", "--label", "real-label")
+
+		out := bdLabelFail(t, bd, dir, "remove", issue.ID, "never-existed", "--strict")
+		if !strings.Contains(out, "no such label to remove") {
+			t.Errorf("expected --strict to name the missing label: %s", out)
+		}
+		bdLabelFail(t, bd, dir, "remove", issue.ID, "real-label,never-existed", "--strict")
+		if labels := bdLabelListJSON(t, bd, dir, issue.ID); !slices.Contains(labels, "real-label") {
+			t.Fatalf("--strict should roll back the removals that matched: %v", labels)
+		}
+
+		cmd := exec.Command(bd, "label", "remove", issue.ID, "real-label,never-existed")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd label remove failed: %v\nstderr:\n%s", err, stderr.String())
+		}
+		if !strings.Contains(stderr.String(), "no such label to remove") {
+			t.Errorf("expected a warning for the missing label: %s", stderr.String())
+		}
+		if !strings.Contains(stdout.String(), "'real-label'") || strings.Contains(stdout.String(), "never-existed") {
+			t.Errorf("expected only the real label to be reported removed: %s", stdout.String())
+		}
+		if labels := bdLabelListJSON(t, bd, dir, issue.ID); len(labels) != 0 {
+			t.Errorf("real-label should have been removed: %v", labels)
+		}
+	})
+
 	t.Run("label_remove_json", func(t *testing.T) {
 		issue := bdCreate(t, bd, dir, "JSON rm label", "--type", "task", "--label", "jsonrm")
 		cmd := exec.Command(bd, "label", "remove", issue.ID, "jsonrm", "--json")
@@ -388,6 +446,57 @@ func TestEmbeddedLabel(t *testing.T) {
 			}
 		}
 	})
+
+	// ===== Label Merge =====
+
+	t.Run("label_merge", func(t *testing.T) {
+		issue1 := bdCreate(t, bd, dir, "Merge 1", "--type", "task", "--label", "merge-bug-fix")
+		issue2 := bdCreate(t, bd, dir, "Merge 2", "--type", "task", "--label", "merge-bugfix")
+		issue3 := bdCreate(t, bd, dir, "Merge 3", "--type", "task", "--label", "merge-bug-fix,merge-bugfix,keep")
+		untouched := bdCreate(t, bd, dir, "Merge 4", "--type", "task", "--label", "keep")
+
+		out := bdLabelFail(t, bd, dir, "merge", "merge-bug-fix", "merge-bugfix", "--into", "merge-bug")
+		if !strings.Contains(out, "--yes") {
+			t.Errorf("expected a hint to re-run with --yes: %s", out)
+		}
+		out = bdLabel(t, bd, dir, "merge", "merge-bug-fix", "merge-bugfix", "--into", "merge-bug", "--dry-run")
+		if !strings.Contains(out, "Would merge") {
+			t.Errorf("expected a dry-run preview: %s", out)
+		}
+		if labels := bdLabelListJSON(t, bd, dir, issue1.ID); !slices.Contains(labels, "merge-bug-fix") {
+			t.Fatalf("dry run should not change labels: %v", labels)
+		}
+
+		out = bdLabelJSONOutput(t, bd, dir, "merge", "merge-bug-fix", "merge-bugfix", "--into", "merge-bug", "--yes", "--json")
+		var result LabelMergeResult
+		if err := json.Unmarshal([]byte(out[strings.Index(out, "{"):]), &result); err != nil {
+			t.Fatalf("parse label merge JSON: %v\n%s", err, out)
+		}
+		if len(result.IssueIDs) != 3 || result.Merged["merge-bug-fix"] != 2 || result.Merged["merge-bugfix"] != 2 {
+			t.Errorf("unexpected merge counts: %+v", result)
+		}
+
+		for _, id := range []string{issue1.ID, issue2.ID, issue3.ID} {
+			labels := bdLabelListJSON(t, bd, dir, id)
+			if slices.Contains(labels, "merge-bug-fix") || slices.Contains(labels, "merge-bugfix") {
+				t.Errorf("source labels should be gone from %s: %v", id, labels)
+			}
+			if !slices.Contains(labels, "merge-bug") {
+				t.Errorf("%s should carry merge-bug: %v", id, labels)
+			}
+		}
+		if labels := bdLabelListJSON(t, bd, dir, issue3.ID); !slices.Contains(labels, "keep") {
+			t.Errorf("unrelated labels should survive: %v", labels)
+		}
+		if labels := bdLabelListJSON(t, bd, dir, untouched.ID); slices.Contains(labels, "merge-bug") {
+			t.Errorf("issue without a source label should not gain the target: %v", labels)
+		}
+	})
+
+	t.Run("label_merge_requires_into", func(t *testing.T) {
+		bdLabelFail(t, bd, dir, "merge", "some-label")
+		bdLabelFail(t, bd, dir, "merge", "some-label", "--into", "provides:thing")
+	})
 }
 
 // TestEmbeddedLabelConcurrent exercises label operations concurrently.
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// LabelMergeResult reports a bd label merge. Merged counts, per source label,
+// the issues that carried it; Added counts the issues that gained the target
+// label, which is less than the issue count when some already had it.
+type LabelMergeResult struct {
+	Into     string         `json:"into"`
+	From     []string       `json:"from"`
+	Merged   map[string]int `json:"merged"`
+	Added    int            `json:"added"`
+	IssueIDs []string       `json:"issue_ids"`
+	DryRun   bool           `json:"dry_run,omitempty"`
+}
+
+// labelMergeOp is the rewrite of one issue: the source labels to remove and
+// whether the target label still has to be added.
+type labelMergeOp struct {
+	issueID string
+	remove  []string
+	add     bool
+}
+
+var labelMergeCmd = &cobra.Command{
+	Use:   "merge <from-label>... --into <label>",
+	Short: "Merge labels into one label across all issues",
+	Long: `Rewrite every occurrence of the source labels to the target label, across
+all issues including closed ones. An issue that carries several of the labels
+ends up with the target label once. Use it to consolidate labels that have
+drifted apart, e.g. bug-fix and bugfix.
+
+Merging labels on more than one issue asks for confirmation. Pass --yes to
+skip the prompt; without a terminal, or with --json or --quiet, --yes is
+required. Use --dry-run to preview the merge.
+
+Examples:
+  bd label merge bug-fix bugfix --into bug
+  bd label merge frontend,ui --into web --dry-run`,
+	Args:          cobra.MinimumNArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		CheckReadonly("label merge")
+
+		evt := metrics.NewCommandEvent("label-merge")
+		defer func() {
+			if c := metrics.Global(); c != nil {
+				c.CloseEventAndAdd(evt)
+			}
+		}()
+
+		into, _ := cmd.Flags().GetString("into")
+		from, err := labelMergeSources(args, into)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		into = strings.TrimSpace(into)
+
+		if usesProxiedServer() {
+			return runLabelMergeProxiedServer(cmd, rootCtx, from, into)
+		}
+
+		ctx := rootCtx
+		issues, err := store.SearchIssues(ctx, "", types.IssueFilter{LabelsAny: from})
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		ids := make([]string, 0, len(issues))
+		for _, issue := range issues {
+			ids = append(ids, issue.ID)
+		}
+		labelsByIssue := map[string][]string{}
+		if len(ids) > 0 {
+			if labelsByIssue, err = store.GetLabelsForIssues(ctx, ids); err != nil {
+				return HandleErrorRespectJSON("getting labels: %v", err)
+			}
+		}
+
+		ops, result := planLabelMerge(labelsByIssue, from, into)
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			result.DryRun = true
+			return renderLabelMerge(result)
+		}
+		if err := confirmDestructive(cmd, labelMergeAction(from, into, len(ops)), len(ops)); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		if len(ops) == 0 {
+			return renderLabelMerge(result)
+		}
+
+		commitMsg := fmt.Sprintf("bd: merge labels '%s' into '%s' on %d issue(s)", strings.Join(from, "', '"), into, len(ops))
+		err = transactHonoringAutoCommit(ctx, store, commitMsg, func(tx storage.Transaction) error {
+			for _, op := range ops {
+				if op.add {
+					if err := tx.AddLabel(ctx, op.issueID, into, actor); err != nil {
+						return fmt.Errorf("add label '%s' on %s: %w", into, op.issueID, err)
+					}
+				}
+				for _, label := range op.remove {
+					if _, err := tx.RemoveLabel(ctx, op.issueID, label, actor); err != nil {
+						return fmt.Errorf("remove label '%s' on %s: %w", label, op.issueID, err)
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return HandleErrorRespectJSON("label merge: %v", err)
+		}
+		commandDidWrite.Store(true)
+		return renderLabelMerge(result)
+	},
+}
+
+// labelMergeSources validates the merge arguments and returns the source
+// labels, split on commas like the other label commands and deduplicated.
+// Naming the target among the sources is allowed and means "keep it".
+func labelMergeSources(args []string, into string) ([]string, error) {
+	into = strings.TrimSpace(into)
+	if into == "" {
+		return nil, fmt.Errorf("--into is required: name the label to merge into")
+	}
+	if strings.HasPrefix(into, "provides:") {
+		return nil, fmt.Errorf("'provides:' labels are reserved for cross-project capabilities. Hint: use 'bd ship %s' instead", strings.TrimPrefix(into, "provides:"))
+	}
+	var from []string
+	for _, arg := range args {
+		for _, label := range splitLabelArg(arg) {
+			if label != into && !slices.Contains(from, label) {
+				from = append(from, label)
+			}
+		}
+	}
+	if len(from) == 0 {
+		return nil, fmt.Errorf("no source labels to merge into '%s'", into)
+	}
+	return from, nil
+}
+
+// planLabelMerge works out the rewrite of every issue carrying a source
+// label. Issues come out in ID order so the output and the transaction are
+// deterministic.
+func planLabelMerge(labelsByIssue map[string][]string, from []string, into string) ([]labelMergeOp, *LabelMergeResult) {
+	result := &LabelMergeResult{Into: into, From: from, Merged: map[string]int{}, IssueIDs: []string{}}
+	for _, label := range from {
+		result.Merged[label] = 0
+	}
+
+	var ops []labelMergeOp
+	for issueID, labels := range labelsByIssue {
+		op := labelMergeOp{issueID: issueID, add: !slices.Contains(labels, into)}
+		for _, label := range labels {
+			if slices.Contains(from, label) {
+				op.remove = append(op.remove, label)
+				result.Merged[label]++
+			}
+		}
+		if len(op.remove) == 0 {
+			continue
+		}
+		slices.Sort(op.remove)
+		ops = append(ops, op)
+	}
+	slices.SortFunc(ops, func(a, b labelMergeOp) int { return strings.Compare(a.issueID, b.issueID) })
+	for _, op := range ops {
+		result.IssueIDs = append(result.IssueIDs, op.issueID)
+		if op.add {
+			result.Added++
+		}
+	}
+	return ops, result
+}
+
+// labelMergeAction describes a bd label merge for confirmDestructive.
+func labelMergeAction(from []string, into string, issueCount int) string {
+	return fmt.Sprintf("merge '%s' into '%s' on %d issues", strings.Join(from, "', '"), into, issueCount)
+}
+
+func renderLabelMerge(result *LabelMergeResult) error {
+	if jsonOutput {
+		return outputJSON(result)
+	}
+	from := strings.Join(result.From, "', '")
+	switch {
+	case len(result.IssueIDs) == 0:
+		fmt.Printf("No issues carry '%s'\n", from)
+		return nil
+	case result.DryRun:
+		fmt.Printf("Would merge '%s' into '%s' on %d issue(s):\n", from, result.Into, len(result.IssueIDs))
+	default:
+		fmt.Printf("%s Merged '%s' into '%s' on %d issue(s)\n", ui.RenderPass("✓"), from, result.Into, len(result.IssueIDs))
+	}
+	for _, label := range result.From {
+		fmt.Printf("  %s: %d issue(s)\n", label, result.Merged[label])
+	}
+	if result.DryRun {
+		fmt.Printf("\n(Dry-run mode - no changes made)\n")
+	}
+	return nil
+}
+
+func init() {
+	labelMergeCmd.Flags().String("into", "", "Label to merge the source labels into (required)")
+	registerDestructiveFlags(labelMergeCmd)
+	labelCmd.AddCommand(labelMergeCmd)
+}
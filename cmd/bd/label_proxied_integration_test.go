@@ -262,7 +262,7 @@ func TestProxiedServerLabel(t *testing.T) {
 		b := bdProxiedCreate(t, bd, p.dir, "Batch rm B")
 		bdProxiedLabel(t, bd, p.dir, "add", a.ID, b.ID, "batch-rm")
 
-		bdProxiedLabel(t, bd, p.dir, "remove", a.ID, b.ID, "batch-rm")
+		bdProxiedLabel(t, bd, p.dir, "remove", a.ID, b.ID, "batch-rm", "--yes")
 		for _, id := range []string{a.ID, b.ID} {
 			if got := bdProxiedLabelListJSON(t, bd, p.dir, id); len(got) != 0 {
 				t.Errorf("labels on %s after batch remove = %v, want none", id, got)
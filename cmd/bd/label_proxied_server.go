@@ -3,32 +3,24 @@ package main
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/storage/uow"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 )
 
 func runLabelAddProxiedServer(ctx context.Context, args []string) error {
-	return labelMutateProxied(ctx, args, "added")
-}
-
-func runLabelRemoveProxiedServer(ctx context.Context, args []string) error {
-	return labelMutateProxied(ctx, args, "removed")
-}
-
-func labelMutateProxied(ctx context.Context, args []string, operation string) error {
 	issueIDs, labels := parseLabelArgs(args)
 	if len(labels) == 0 {
 		return HandleErrorRespectJSON("label cannot be empty")
 	}
-	if operation == "added" {
-		for _, label := range labels {
-			if strings.HasPrefix(label, "provides:") {
-				return HandleErrorRespectJSON("'provides:' labels are reserved for cross-project capabilities. Hint: use 'bd ship %s' instead", strings.TrimPrefix(label, "provides:"))
-			}
+	for _, label := range labels {
+		if strings.HasPrefix(label, "provides:") {
+			return HandleErrorRespectJSON("'provides:' labels are reserved for cross-project capabilities. Hint: use 'bd ship %s' instead", strings.TrimPrefix(label, "provides:"))
 		}
 	}
 	if uowProvider == nil {
@@ -36,67 +28,173 @@ func labelMutateProxied(ctx context.Context, args []string, operation string) er
 	}
 
 	labelDesc := strings.Join(labels, "', '")
-	commitMsg := fmt.Sprintf("bd: label %s '%s' on %d issue(s)", operation, labelDesc, len(issueIDs))
+	commitMsg := fmt.Sprintf("bd: label added '%s' on %d issue(s)", labelDesc, len(issueIDs))
 
-	var resolvedIDs []string
+	var (
+		resolvedIDs      []string
+		applied, skipped map[string][]string
+	)
 	err := uow.RunTx(ctx, uowProvider, func(ctx context.Context, uw uow.UnitOfWork) (string, error) {
 		resolvedIDs = resolvedIDs[:0]
+		carried := make(map[string][]string, len(issueIDs))
+		wisps := make(map[string]bool)
 		for _, inputID := range issueIDs {
 			issue, isWisp := proxiedResolveIssueOrWisp(ctx, uw, inputID)
 			if issue == nil {
 				return "", fmt.Errorf("resolving issue ID %q: not found", inputID)
 			}
-			for _, label := range labels {
+			if slices.Contains(resolvedIDs, issue.ID) {
+				continue
+			}
+			var current []string
+			var err error
+			if isWisp {
+				current, err = uw.LabelUseCase().GetWispLabels(ctx, issue.ID)
+			} else {
+				current, err = uw.LabelUseCase().GetLabels(ctx, issue.ID)
+			}
+			if err != nil {
+				return "", fmt.Errorf("getting labels for %s: %w", issue.ID, err)
+			}
+			resolvedIDs = append(resolvedIDs, issue.ID)
+			carried[issue.ID] = current
+			wisps[issue.ID] = isWisp
+		}
+
+		applied, skipped = splitLabelPairs(resolvedIDs, labels, func(issueID, label string) bool {
+			return slices.Contains(carried[issueID], label)
+		})
+		wrote := false
+		for _, issueID := range resolvedIDs {
+			for _, label := range applied[issueID] {
 				var e error
-				switch {
-				case operation == "added" && isWisp:
-					e = uw.LabelUseCase().AddWispLabel(ctx, issue.ID, label, actor)
-				case operation == "added":
-					e = uw.LabelUseCase().AddLabel(ctx, issue.ID, label, actor)
-				case isWisp:
-					e = uw.LabelUseCase().RemoveWispLabel(ctx, issue.ID, label, actor)
-				default:
-					e = uw.LabelUseCase().RemoveLabel(ctx, issue.ID, label, actor)
+				if wisps[issueID] {
+					e = uw.LabelUseCase().AddWispLabel(ctx, issueID, label, actor)
+				} else {
+					e = uw.LabelUseCase().AddLabel(ctx, issueID, label, actor)
 				}
 				if e != nil {
-					return "", fmt.Errorf("%s label '%s' on %s: %w", operation, label, issue.ID, e)
+					return "", fmt.Errorf("added label '%s' on %s: %w", label, issueID, e)
 				}
+				wrote = true
 			}
-			resolvedIDs = append(resolvedIDs, issue.ID)
+		}
+		if !wrote {
+			return "", nil
 		}
 		return commitMsg, nil
 	})
 	if err != nil {
-		return HandleErrorRespectJSON("label %s: %v", operation, err)
+		return HandleErrorRespectJSON("label added: %v", err)
 	}
-	commandDidWrite.Store(true)
+	for _, issueID := range resolvedIDs {
+		if len(applied[issueID]) > 0 {
+			commandDidWrite.Store(true)
+			break
+		}
+	}
+	return renderLabelOperation(resolvedIDs, applied, skipped, "added", jsonOutput)
+}
 
-	if jsonOutput {
-		results := make([]map[string]interface{}, 0, len(resolvedIDs)*len(labels))
-		for _, issueID := range resolvedIDs {
-			for _, label := range labels {
-				results = append(results, map[string]interface{}{
-					"status":   operation,
-					"issue_id": issueID,
-					"label":    label,
-				})
+func runLabelRemoveProxiedServer(cmd *cobra.Command, ctx context.Context, args []string) error {
+	issueIDs, labels := parseLabelArgs(args)
+	if len(labels) == 0 {
+		return HandleErrorRespectJSON("label cannot be empty")
+	}
+	issueIDs = uniqueStrings(issueIDs)
+	if uowProvider == nil {
+		return HandleError("proxied-server UOW provider not initialized")
+	}
+
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		uw, err := proxiedOpenReadUOW(ctx)
+		if err != nil {
+			return err
+		}
+		defer uw.Close(ctx)
+		resolved := make([]string, 0, len(issueIDs))
+		carried := make(map[string][]string, len(issueIDs))
+		for _, inputID := range issueIDs {
+			issue, isWisp := proxiedResolveIssueOrWisp(ctx, uw, inputID)
+			if issue == nil {
+				return HandleErrorRespectJSON("resolving issue ID %q: not found", inputID)
 			}
+			var current []string
+			if isWisp {
+				current, err = uw.LabelUseCase().GetWispLabels(ctx, issue.ID)
+			} else {
+				current, err = uw.LabelUseCase().GetLabels(ctx, issue.ID)
+			}
+			if err != nil {
+				return HandleErrorRespectJSON("getting labels for %s: %v", issue.ID, err)
+			}
+			resolved = append(resolved, issue.ID)
+			carried[issue.ID] = current
 		}
-		return outputJSON(results)
+		resolved = uniqueStrings(resolved)
+		if err := reportNothingToRemove(cmd, missingLabelRemovals(resolved, labels, carried)); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		return previewLabelRemove(resolved, labels)
+	}
+	if err := confirmDestructive(cmd, labelRemoveAction(labels, len(issueIDs)), len(issueIDs)); err != nil {
+		return HandleErrorRespectJSON("%v", err)
 	}
 
-	verb, prep := "Added", "to"
-	if operation == "removed" {
-		verb, prep = "Removed", "from"
+	// A label whose DELETE removed no row was not on the issue.
+	commitMsg := fmt.Sprintf("bd: label removed '%s' on %d issue(s)", strings.Join(labels, "', '"), len(issueIDs))
+	var (
+		resolvedIDs []string
+		removed     map[string][]string
+		missing     []string
+	)
+	err := uow.RunTx(ctx, uowProvider, func(ctx context.Context, uw uow.UnitOfWork) (string, error) {
+		resolvedIDs, removed, missing = nil, make(map[string][]string, len(issueIDs)), nil
+		for _, inputID := range issueIDs {
+			issue, isWisp := proxiedResolveIssueOrWisp(ctx, uw, inputID)
+			if issue == nil {
+				return "", fmt.Errorf("resolving issue ID %q: not found", inputID)
+			}
+			if slices.Contains(resolvedIDs, issue.ID) {
+				continue
+			}
+			resolvedIDs = append(resolvedIDs, issue.ID)
+			for _, label := range labels {
+				var n int64
+				var e error
+				if isWisp {
+					n, e = uw.LabelUseCase().RemoveWispLabel(ctx, issue.ID, label, actor)
+				} else {
+					n, e = uw.LabelUseCase().RemoveLabel(ctx, issue.ID, label, actor)
+				}
+				if e != nil {
+					return "", fmt.Errorf("removed label '%s' on %s: %w", label, issue.ID, e)
+				}
+				if n == 0 {
+					missing = append(missing, noSuchLabel(issue.ID, label))
+					continue
+				}
+				removed[issue.ID] = append(removed[issue.ID], label)
+			}
+		}
+		if err := strictNothingToRemove(cmd, missing); err != nil {
+			return "", err
+		}
+		if len(removed) == 0 {
+			return "", nil
+		}
+		return commitMsg, nil
+	})
+	if err != nil {
+		return HandleErrorRespectJSON("label removed: %v", err)
 	}
-	noun := "label"
-	if len(labels) > 1 {
-		noun = "labels"
+	if err := reportNothingToRemove(cmd, missing); err != nil {
+		return HandleErrorRespectJSON("%v", err)
 	}
-	for _, issueID := range resolvedIDs {
-		fmt.Printf("%s %s %s '%s' %s %s\n", ui.RenderPass("✓"), verb, noun, labelDesc, prep, issueID)
+	if len(removed) > 0 {
+		commandDidWrite.Store(true)
 	}
-	return nil
+	return renderLabelOperation(resolvedIDs, removed, nil, "removed", jsonOutput)
 }
 
 func runLabelListProxiedServer(ctx context.Context, args []string) error {
@@ -300,3 +398,97 @@ func runLabelPropagateProxiedServer(ctx context.Context, args []string) error {
 	}
 	return nil
 }
+
+func runLabelMergeProxiedServer(cmd *cobra.Command, ctx context.Context, from []string, into string) error {
+	if uowProvider == nil {
+		return HandleError("proxied-server UOW provider not initialized")
+	}
+
+	uw, err := proxiedOpenReadUOW(ctx)
+	if err != nil {
+		return err
+	}
+	page, err := uw.IssueUseCase().SearchIssues(ctx, "", types.IssueFilter{LabelsAny: from})
+	if err != nil {
+		uw.Close(ctx)
+		return HandleErrorRespectJSON("%v", err)
+	}
+	var permIDs, wispIDs []string
+	wisps := make(map[string]bool)
+	for _, issue := range page.Items {
+		if issue.Ephemeral {
+			wispIDs = append(wispIDs, issue.ID)
+			wisps[issue.ID] = true
+		} else {
+			permIDs = append(permIDs, issue.ID)
+		}
+	}
+	labelsByIssue := make(map[string][]string)
+	if len(permIDs) > 0 {
+		byIssue, err := uw.LabelUseCase().GetLabelsForIssues(ctx, permIDs)
+		if err != nil {
+			uw.Close(ctx)
+			return HandleErrorRespectJSON("getting labels: %v", err)
+		}
+		for id, labels := range byIssue {
+			labelsByIssue[id] = labels
+		}
+	}
+	if len(wispIDs) > 0 {
+		byWisp, err := uw.LabelUseCase().GetLabelsForWisps(ctx, wispIDs)
+		if err != nil {
+			uw.Close(ctx)
+			return HandleErrorRespectJSON("getting labels: %v", err)
+		}
+		for id, labels := range byWisp {
+			labelsByIssue[id] = labels
+		}
+	}
+	uw.Close(ctx)
+
+	ops, result := planLabelMerge(labelsByIssue, from, into)
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		result.DryRun = true
+		return renderLabelMerge(result)
+	}
+	if err := confirmDestructive(cmd, labelMergeAction(from, into, len(ops)), len(ops)); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	if len(ops) == 0 {
+		return renderLabelMerge(result)
+	}
+
+	commitMsg := fmt.Sprintf("bd: merge labels '%s' into '%s' on %d issue(s)", strings.Join(from, "', '"), into, len(ops))
+	err = uow.RunTx(ctx, uowProvider, func(ctx context.Context, uw uow.UnitOfWork) (string, error) {
+		for _, op := range ops {
+			if op.add {
+				var e error
+				if wisps[op.issueID] {
+					e = uw.LabelUseCase().AddWispLabel(ctx, op.issueID, into, actor)
+				} else {
+					e = uw.LabelUseCase().AddLabel(ctx, op.issueID, into, actor)
+				}
+				if e != nil {
+					return "", fmt.Errorf("add label '%s' on %s: %w", into, op.issueID, e)
+				}
+			}
+			for _, label := range op.remove {
+				var e error
+				if wisps[op.issueID] {
+					_, e = uw.LabelUseCase().RemoveWispLabel(ctx, op.issueID, label, actor)
+				} else {
+					_, e = uw.LabelUseCase().RemoveLabel(ctx, op.issueID, label, actor)
+				}
+				if e != nil {
+					return "", fmt.Errorf("remove label '%s' on %s: %w", label, op.issueID, e)
+				}
+			}
+		}
+		return commitMsg, nil
+	})
+	if err != nil {
+		return HandleErrorRespectJSON("label merge: %v", err)
+	}
+	commandDidWrite.Store(true)
+	return renderLabelMerge(result)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/steveyegge/beads/internal/storage/dolt"
@@ -45,7 +46,7 @@ func (h *labelTestHelper) addLabels(issueID string, labels []string) {
 }
 
 func (h *labelTestHelper) removeLabel(issueID, label string) {
-	if err := h.s.RemoveLabel(h.ctx, issueID, label, "test-user"); err != nil {
+	if _, err := h.s.RemoveLabel(h.ctx, issueID, label, "test-user"); err != nil {
 		h.t.Fatalf("Failed to remove label '%s': %v", label, err)
 	}
 }
@@ -363,3 +364,74 @@ func TestLabelCommands(t *testing.T) {
 		}
 	})
 }
+
+func TestPlanLabelMerge(t *testing.T) {
+	labelsByIssue := map[string][]string{
+		"bd-1": {"bug-fix"},
+		"bd-2": {"bugfix", "ui"},
+		"bd-3": {"bug-fix", "bugfix"},
+		"bd-4": {"bug", "bugfix"},
+		"bd-5": {"ui"},
+	}
+	ops, result := planLabelMerge(labelsByIssue, []string{"bug-fix", "bugfix"}, "bug")
+
+	wantIDs := []string{"bd-1", "bd-2", "bd-3", "bd-4"}
+	if fmt.Sprint(result.IssueIDs) != fmt.Sprint(wantIDs) {
+		t.Errorf("IssueIDs = %v, want %v", result.IssueIDs, wantIDs)
+	}
+	if result.Merged["bug-fix"] != 2 || result.Merged["bugfix"] != 3 {
+		t.Errorf("Merged = %v, want bug-fix:2 bugfix:3", result.Merged)
+	}
+	// bd-4 already carries the target, so only three issues gain it.
+	if result.Added != 3 {
+		t.Errorf("Added = %d, want 3", result.Added)
+	}
+	if len(ops) != 4 {
+		t.Fatalf("got %d ops, want 4", len(ops))
+	}
+	if op := ops[2]; op.issueID != "bd-3" || !op.add || fmt.Sprint(op.remove) != "[bug-fix bugfix]" {
+		t.Errorf("bd-3 op = %+v, want both sources removed and target added once", op)
+	}
+	if op := ops[3]; op.issueID != "bd-4" || op.add {
+		t.Errorf("bd-4 op = %+v, want no re-add of the target", op)
+	}
+}
+
+func TestLabelMergeSources(t *testing.T) {
+	from, err := labelMergeSources([]string{"bug-fix,bugfix", "bugfix", "bug"}, " bug ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fmt.Sprint(from) != "[bug-fix bugfix]" {
+		t.Errorf("from = %v, want deduped sources without the target", from)
+	}
+	for _, tc := range []struct {
+		args []string
+		into string
+	}{
+		{[]string{"bugfix"}, ""},
+		{[]string{"bug"}, "bug"},
+		{[]string{"bugfix"}, "provides:bug"},
+	} {
+		if _, err := labelMergeSources(tc.args, tc.into); err == nil {
+			t.Errorf("labelMergeSources(%v, %q) should fail", tc.args, tc.into)
+		}
+	}
+}
+
+func TestSplitLabelPairs(t *testing.T) {
+	carried := map[string][]string{"bd-1": {"a"}, "bd-2": {}}
+	hasLabel := func(issueID, label string) bool { return slices.Contains(carried[issueID], label) }
+	applied, skipped := splitLabelPairs([]string{"bd-1", "bd-2"}, []string{"a", "b"}, hasLabel)
+	if fmt.Sprint(applied["bd-1"]) != "[b]" || fmt.Sprint(applied["bd-2"]) != "[a b]" {
+		t.Errorf("applied = %v, want bd-1:[b] bd-2:[a b]", applied)
+	}
+	if fmt.Sprint(skipped["bd-1"]) != "[a]" || len(skipped["bd-2"]) != 0 {
+		t.Errorf("skipped = %v, want only bd-1:[a]", skipped)
+	}
+
+	applied, skipped = splitLabelPairs([]string{"bd-1"}, []string{"a"}, nil)
+	if fmt.Sprint(applied["bd-1"]) != "[a]" || len(skipped) != 0 {
+		t.Errorf("nil skip should apply everything: applied=%v skipped=%v", applied, skipped)
+	}
+}
@@ -19,10 +19,14 @@ var linkCmd = &cobra.Command{
 Shorthand for 'bd dep add <id1> <id2>'. By default creates a "blocks"
 dependency (id2 blocks id1). Use --type to specify a different relationship.
 
+To cross-reference an issue in an external tracker (GitHub, Jira, ...), use
+'bd link add <id> <url>' and 'bd link remove <id> <url>'.
+
 Examples:
   bd link bd-123 bd-456                    # bd-456 blocks bd-123
   bd link bd-123 bd-456 --type related     # bd-123 related to bd-456
-  bd link bd-123 bd-456 --type parent-child`,
+  bd link bd-123 bd-456 --type parent-child
+  bd link add bd-123 https://github.com/org/repo/issues/42`,
 	Args:          cobra.ExactArgs(2),
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/uow"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var linkAddCmd = &cobra.Command{
+	Use:   "add <id> <url>...",
+	Short: "Cross-reference an issue in an external tracker",
+	Long: `Attach external tracker URLs (GitHub, GitLab, Jira, Linear, or any http(s)
+URL) to an issue. Links are stored in metadata.external_links with a type
+inferred from the URL, appear as external_links in bd show --json, and can be
+queried with bd list --has-metadata-key external_links. A URL already linked
+is left as is.
+
+Examples:
+  bd link add bd-123 https://github.com/org/repo/issues/42
+  bd link add bd-123 https://acme.atlassian.net/browse/OPS-7 https://linear.app/acme/issue/ENG-9`,
+	Args:          cobra.MinimumNArgs(2),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		CheckReadonly("link add")
+		add, err := parseExternalLinks(args[1:])
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		return runExternalLinkEdit("link add", args[0], func(links []externalLink) ([]externalLink, int, error) {
+			out, added := addExternalLinks(links, add)
+			return out, added, nil
+		})
+	},
+}
+
+var linkRemoveCmd = &cobra.Command{
+	Use:   "remove <id> <url>...",
+	Short: "Remove external tracker links from an issue",
+	Long: `Remove external tracker URLs previously attached with bd create --link or
+bd link add. It is an error if none of the URLs is linked to the issue.
+
+Example:
+  bd link remove bd-123 https://github.com/org/repo/issues/42`,
+	Args:          cobra.MinimumNArgs(2),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		CheckReadonly("link remove")
+		id, urls := args[0], args[1:]
+		return runExternalLinkEdit("link remove", id, func(links []externalLink) ([]externalLink, int, error) {
+			out, removed := removeExternalLinks(links, urls)
+			if removed == 0 {
+				return nil, 0, fmt.Errorf("no link %s on %s", strings.Join(urls, ", "), id)
+			}
+			return out, removed, nil
+		})
+	},
+}
+
+// externalLinkEdit rewrites an issue's links, returning the new list and how
+// many links changed.
+type externalLinkEdit func(links []externalLink) ([]externalLink, int, error)
+
+// externalLinkResult is the --json output of bd link add/remove.
+type externalLinkResult struct {
+	ID            string         `json:"id"`
+	Changed       int            `json:"changed"`
+	ExternalLinks []externalLink `json:"external_links"`
+}
+
+func runExternalLinkEdit(command, id string, edit externalLinkEdit) error {
+	evt := metrics.NewCommandEvent(strings.ReplaceAll(command, " ", "-"))
+	defer func() {
+		if c := metrics.Global(); c != nil {
+			c.CloseEventAndAdd(evt)
+		}
+	}()
+
+	if usesProxiedServer() {
+		return runExternalLinkEditProxiedServer(rootCtx, command, id, edit)
+	}
+
+	ctx := rootCtx
+	fullID, st, cleanup, err := resolveIDForMutation(ctx, store, id)
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	defer cleanup()
+
+	var res externalLinkResult
+	commitMsg := fmt.Sprintf("bd: %s %s", command, fullID)
+	err = transactHonoringAutoCommit(ctx, st, commitMsg, func(tx storage.Transaction) error {
+		issue, err := tx.GetIssue(ctx, fullID)
+		if err != nil {
+			return err
+		}
+		res, err = applyExternalLinkEdit(ctx, issue, edit, tx.UpdateIssue)
+		return err
+	})
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	if res.Changed > 0 {
+		commandDidWrite.Store(true)
+	}
+	SetLastTouchedID(fullID)
+	return renderExternalLinkResult(command, res)
+}
+
+// applyExternalLinkEdit runs edit against issue's links and writes the new
+// metadata through update when anything changed.
+func applyExternalLinkEdit(ctx context.Context, issue *types.Issue, edit externalLinkEdit,
+	update func(ctx context.Context, id string, updates map[string]interface{}, actor string) error) (externalLinkResult, error) {
+	if issue == nil {
+		return externalLinkResult{}, fmt.Errorf("issue not found")
+	}
+	links, changed, err := edit(externalLinksOf(issue))
+	if err != nil {
+		return externalLinkResult{}, err
+	}
+	res := externalLinkResult{ID: issue.ID, Changed: changed, ExternalLinks: links}
+	if changed == 0 {
+		return res, nil
+	}
+	metadata, err := withExternalLinks(issue.Metadata, links)
+	if err != nil {
+		return externalLinkResult{}, err
+	}
+	if err := update(ctx, issue.ID, map[string]interface{}{"metadata": string(metadata)}, actor); err != nil {
+		return externalLinkResult{}, err
+	}
+	return res, nil
+}
+
+func runExternalLinkEditProxiedServer(ctx context.Context, command, id string, edit externalLinkEdit) error {
+	if uowProvider == nil {
+		return HandleErrorRespectJSON("proxied-server UOW provider not initialized")
+	}
+	res, err := uow.RunTxResult(ctx, uowProvider, func(ctx context.Context, uw uow.UnitOfWork) (externalLinkResult, string, error) {
+		issueUC := uw.IssueUseCase()
+		issue, err := issueUC.GetIssue(ctx, id)
+		if err != nil {
+			return externalLinkResult{}, "", err
+		}
+		res, err := applyExternalLinkEdit(ctx, issue, edit, func(ctx context.Context, id string, updates map[string]interface{}, actor string) error {
+			return issueUC.UpdateIssue(ctx, id, updates, actor)
+		})
+		return res, fmt.Sprintf("bd: %s %s", command, id), err
+	})
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	SetLastTouchedID(res.ID)
+	return renderExternalLinkResult(command, res)
+}
+
+func renderExternalLinkResult(command string, res externalLinkResult) error {
+	if res.ExternalLinks == nil {
+		res.ExternalLinks = []externalLink{}
+	}
+	if jsonOutput {
+		return outputJSON(res)
+	}
+	verb := "Added"
+	if command == "link remove" {
+		verb = "Removed"
+	}
+	fmt.Printf("%s %s %d link(s) on %s\n", ui.RenderPass("✓"), verb, res.Changed, res.ID)
+	for _, link := range res.ExternalLinks {
+		fmt.Printf("  [%s] %s\n", link.Type, link.URL)
+	}
+	return nil
+}
+
+func init() {
+	linkAddCmd.ValidArgsFunction = issueIDCompletion
+	linkRemoveCmd.ValidArgsFunction = issueIDCompletion
+	linkCmd.AddCommand(linkAddCmd, linkRemoveCmd)
+}
@@ -0,0 +1,83 @@
+//go:build cgo
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestEmbeddedExternalLinks(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+	dir, _, _ := bdInit(t, bd, "--prefix", "xl")
+
+	const ghURL = "https://github.com/org/repo/issues/42"
+	const jiraURL = "https://acme.atlassian.net/browse/OPS-7"
+
+	showLinks := func(t *testing.T, id string) []externalLink {
+		t.Helper()
+		var items []struct {
+			ExternalLinks []externalLink `json:"external_links"`
+		}
+		out := bdShowRaw(t, bd, dir, id, "--json")
+		if err := json.Unmarshal([]byte(out), &items); err != nil || len(items) != 1 {
+			t.Fatalf("parse bd show --json: %v\n%s", err, out)
+		}
+		return items[0].ExternalLinks
+	}
+
+	t.Run("create_link_round_trip", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Linked at create", "--link", ghURL, "--link", jiraURL)
+		links := showLinks(t, issue.ID)
+		if len(links) != 2 || links[0].URL != ghURL || links[0].Type != "github" ||
+			links[1].URL != jiraURL || links[1].Type != "jira" {
+			t.Errorf("external_links = %+v, want github %s and jira %s", links, ghURL, jiraURL)
+		}
+
+		listed := bdList(t, bd, dir, "--has-metadata-key", "external_links", "--json")
+		if !strings.Contains(listed, issue.ID) {
+			t.Errorf("--has-metadata-key external_links should find %s:\n%s", issue.ID, listed)
+		}
+	})
+
+	t.Run("link_add_remove", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Linked later")
+		if out, err := bdRunWithFlockRetry(t, bd, dir, "link", "add", issue.ID, ghURL, jiraURL); err != nil {
+			t.Fatalf("bd link add: %v\n%s", err, out)
+		}
+		// Adding an already linked URL is a no-op.
+		if out, err := bdRunWithFlockRetry(t, bd, dir, "link", "add", issue.ID, ghURL); err != nil {
+			t.Fatalf("bd link add (again): %v\n%s", err, out)
+		}
+		if links := showLinks(t, issue.ID); len(links) != 2 {
+			t.Fatalf("after add: external_links = %+v, want 2 links", links)
+		}
+
+		if out, err := bdRunWithFlockRetry(t, bd, dir, "link", "remove", issue.ID, ghURL); err != nil {
+			t.Fatalf("bd link remove: %v\n%s", err, out)
+		}
+		links := showLinks(t, issue.ID)
+		if len(links) != 1 || links[0].URL != jiraURL {
+			t.Errorf("after remove: external_links = %+v, want only %s", links, jiraURL)
+		}
+
+		if out, err := bdRunWithFlockRetry(t, bd, dir, "link", "remove", issue.ID, ghURL); err == nil {
+			t.Errorf("removing an unlinked URL should fail:\n%s", out)
+		}
+	})
+
+	t.Run("invalid_url_rejected", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Bad link target")
+		out, err := bdRunWithFlockRetry(t, bd, dir, "link", "add", issue.ID, "not-a-url")
+		if err == nil || !strings.Contains(string(out), "http(s) URL") {
+			t.Errorf("bd link add with a bad URL: err=%v\n%s", err, out)
+		}
+	})
+}
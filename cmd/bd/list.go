@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/steveyegge/beads/internal/metrics"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
@@ -65,18 +66,30 @@ func withStorage(ctx context.Context, store storage.DoltStorage, dbPath string,
 // tighter-cap violation still fires with the correct (unbumped) Cap value
 // in the error message.
 func withFetchOneExtra(filter types.IssueFilter) types.IssueFilter {
-	if filter.Limit > 0 {
-		if filter.MaxRows > 0 && filter.Limit == filter.MaxRows {
-			filter.MaxRows++
+	filter.Limit, filter.MaxRows = fetchOneExtra(filter.Limit, filter.MaxRows)
+	return filter
+}
+
+// fetchOneExtra is the limit arithmetic behind withFetchOneExtra, for
+// callers holding a types.WorkFilter (bd ready) rather than an IssueFilter.
+func fetchOneExtra(limit, maxRows int) (int, int) {
+	if limit > 0 {
+		if maxRows > 0 && limit == maxRows {
+			maxRows++
 		}
-		filter.Limit++
+		limit++
 	}
-	return filter
+	return limit, maxRows
 }
 
+// readyWorkFilterFromIssueFilter maps bd list's filter onto the WorkFilter
+// bd ready builds, so list --ready reads the same ready set. The sort policy
+// matters even though list re-sorts afterwards: it decides which rows a
+// limited page keeps, so it must be bd ready's default.
 func readyWorkFilterFromIssueFilter(filter types.IssueFilter) types.WorkFilter {
 	wf := types.WorkFilter{
 		Status:         types.StatusOpen,
+		SortPolicy:     types.SortPolicyPriority,
 		Limit:          filter.Limit,
 		Offset:         filter.Offset,
 		Labels:         filter.Labels,
@@ -187,7 +200,27 @@ func findAllDescendants(ctx context.Context, store storage.DoltStorage, dbPath s
 // Uses polling instead of fsnotify because Dolt stores data in a server-side
 // database, not files — file watchers never fire.
 type watchListDependencyStore interface {
-	GetAllDependencyRecords(ctx context.Context) (map[string][]*types.Dependency, error)
+	GetDependencyRecordsForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Dependency, error)
+}
+
+// loadListDependencyRecords fetches the dependency records of the listed
+// issues in one batched read keyed by issue ID, so the tree, --format and
+// watch renderers cost the same query however many issues are listed and
+// never load edges of issues that are not shown. Errors yield nil, which
+// the renderers treat as "no edges".
+func loadListDependencyRecords(ctx context.Context, store watchListDependencyStore, issues []*types.Issue) map[string][]*types.Dependency {
+	if store == nil || len(issues) == 0 {
+		return nil
+	}
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.ID
+	}
+	deps, err := store.GetDependencyRecordsForIssues(ctx, ids)
+	if err != nil {
+		return nil
+	}
+	return deps
 }
 
 func loadWatchedIssues(ctx context.Context, store storage.DoltStorage, filter types.IssueFilter, ready bool, parentID string, sortBy string, reverse bool) ([]*types.Issue, error) {
@@ -220,14 +253,7 @@ func loadWatchedIssues(ctx context.Context, store storage.DoltStorage, filter ty
 }
 
 func displayWatchedIssueList(ctx context.Context, store watchListDependencyStore, issues []*types.Issue) {
-	var allDeps map[string][]*types.Dependency
-	if store != nil {
-		deps, err := store.GetAllDependencyRecords(ctx)
-		if err == nil {
-			allDeps = deps
-		}
-	}
-	displayPrettyListWithDeps(issues, true, allDeps)
+	displayPrettyListWithDeps(issues, true, loadListDependencyRecords(ctx, store, issues))
 }
 
 // watchIssues returns an error only for the initial query — a failure there
@@ -315,6 +341,16 @@ func compareIssuesBy(a, b *types.Issue, sortBy string) int {
 			return -1
 		}
 		return b.ClosedAt.Compare(*a.ClosedAt)
+	case "due":
+		// Soonest first; issues without a due date last.
+		if a.DueAt == nil && b.DueAt == nil {
+			return 0
+		} else if a.DueAt == nil {
+			return 1
+		} else if b.DueAt == nil {
+			return -1
+		}
+		return a.DueAt.Compare(*b.DueAt)
 	case "status":
 		return cmp.Compare(a.Status, b.Status)
 	case "id":
@@ -333,8 +369,9 @@ func sortIssues(issues []*types.Issue, sortBy string, reverse bool) {
 	if sortBy == "" {
 		return
 	}
+	compare := issueComparator(sortBy)
 	slices.SortFunc(issues, func(a, b *types.Issue) int {
-		r := compareIssuesBy(a, b, sortBy)
+		r := compare(a, b)
 		if reverse {
 			return -r
 		}
@@ -346,6 +383,7 @@ func sortIssuesWithCounts(items []*types.IssueWithCounts, sortBy string, reverse
 	if sortBy == "" {
 		return
 	}
+	compare := issueComparator(sortBy)
 	slices.SortFunc(items, func(a, b *types.IssueWithCounts) int {
 		ai, bi := issueOrNil(a), issueOrNil(b)
 		if ai == nil {
@@ -357,7 +395,7 @@ func sortIssuesWithCounts(items []*types.IssueWithCounts, sortBy string, reverse
 		if bi == nil {
 			return -1
 		}
-		r := compareIssuesBy(ai, bi, sortBy)
+		r := compare(ai, bi)
 		if reverse {
 			return -r
 		}
@@ -377,7 +415,12 @@ func issueOrNil(iwc *types.IssueWithCounts) *types.Issue {
 // with --skip-labels, every issue's labels field is present and empty.
 type skipLabelsIssueView struct {
 	*types.IssueWithCounts
-	Labels []string `json:"labels"`
+	Labels   []string `json:"labels"`
+	ParentID *string  `json:"parent_id"`
+
+	// Comments shadows Issue.Comments and is never set: list reports
+	// comment_count, and bd show is where comment bodies are read.
+	Comments []*types.Comment `json:"comments,omitempty"`
 }
 
 type skipLabelsListJSONResponse struct {
@@ -396,6 +439,7 @@ func newSkipLabelsListJSONResponse(issues []*types.IssueWithCounts) skipLabelsLi
 		views[i] = skipLabelsIssueView{
 			IssueWithCounts: issue,
 			Labels:          []string{},
+			ParentID:        issue.Parent,
 		}
 	}
 	return skipLabelsListJSONResponse{
@@ -548,7 +592,6 @@ func runListCore(cmd *cobra.Command, _ []string) error {
 	filter.MaxRowsSource = maxRowsSource
 
 	ctx := rootCtx
-
 	activeStore := store
 	routedStore, routed, err := openRoutedReadStore(ctx, activeStore)
 	if err != nil {
@@ -558,6 +601,13 @@ func runListCore(cmd *cobra.Command, _ []string) error {
 		defer func() { _ = routedStore.Close() }()
 		activeStore = routedStore
 	}
+	if err := applyBlockedStatusIDs(ctx, in, &filter, activeStore.GetBlockedIssues); err != nil {
+		return HandleError("%v", err)
+	}
+
+	if in.countOnly {
+		return runListCount(ctx, activeStore, filter, in.readyFlag)
+	}
 
 	if in.watchMode {
 		if err := watchIssues(ctx, activeStore, filter, in.readyFlag, in.parentID, in.sortBy, in.reverse, in.effectiveLimit); err != nil {
@@ -569,36 +619,30 @@ func runListCore(cmd *cobra.Command, _ []string) error {
 		return nil
 	}
 
+	if jsonOutput && len(in.fields) > 0 && !in.readyFlag && !listFieldsNeedCounts(in.fields) {
+		return runListFieldsJSON(ctx, activeStore, filter, in)
+	}
+
 	if jsonOutput {
-		var iwc []*types.IssueWithCounts
-		var err error
-		if in.readyFlag {
-			iwc, err = activeStore.GetReadyWorkWithCounts(ctx, readyWorkFilterFromIssueFilter(withFetchOneExtra(filter)))
-		} else {
-			iwc, err = activeStore.SearchIssuesWithCounts(ctx, "", withFetchOneExtra(filter))
-		}
+		iwc, truncated, err := queryListIssuesWithCounts(ctx, activeStore, filter, in)
 		if err != nil {
 			if capErr := handleMaxRowsError(err); capErr != nil {
 				return capErr
 			}
 			return HandleError("%v", err)
 		}
-		sortIssuesWithCounts(iwc, in.sortBy, in.reverse)
-		truncated := in.effectiveLimit > 0 && len(iwc) > in.effectiveLimit
-		if truncated {
-			iwc = iwc[:in.effectiveLimit]
-		}
-		if iwc == nil {
-			iwc = []*types.IssueWithCounts{}
-		}
-		if in.skipLabels {
-			if err := outputJSON(newSkipLabelsListJSONResponse(iwc)); err != nil {
+		if in.skipLabels && len(in.fields) == 0 {
+			if err := outputCanonicalJSON(newSkipLabelsListJSONResponse(iwc)); err != nil {
 				return err
 			}
 			printTruncationHint(truncated, in.effectiveLimit)
 			return nil
 		}
-		if err := outputJSON(iwc); err != nil {
+		payload, err := storeListJSONPayload(ctx, activeStore, iwc, listJSONOptions(in), in.fields)
+		if err != nil {
+			return err
+		}
+		if err := outputJSON(payload); err != nil {
 			return err
 		}
 		printTruncationHint(truncated, in.effectiveLimit)
@@ -634,6 +678,9 @@ func runListCore(cmd *cobra.Command, _ []string) error {
 		issues = issues[:in.effectiveLimit]
 	}
 
+	localizeIssueTimes(in.tz, issues...)
+
+	beginTimingPhase(timingPhaseRender)
 	if in.prettyFormat && !jsonOutput {
 		if in.parentID != "" && !in.readyFlag {
 			treeIssues, err := getHierarchicalChildren(ctx, activeStore, "", in.parentID, filter)
@@ -646,22 +693,35 @@ func runListCore(cmd *cobra.Command, _ []string) error {
 				return nil
 			}
 
-			allDeps, _ := activeStore.GetAllDependencyRecords(ctx)
-			displayPrettyListWithDeps(treeIssues, false, allDeps)
+			displayPrettyListWithDeps(treeIssues, false, loadListDependencyRecords(ctx, activeStore, treeIssues))
 			printSkipLabelsFooter(in.skipLabels)
 			return nil
 		}
 
-		allDeps, _ := activeStore.GetAllDependencyRecords(ctx)
-		displayPrettyListWithDeps(issues, false, allDeps)
+		displayPrettyListWithDeps(issues, false, loadListDependencyRecords(ctx, activeStore, issues))
 		printTruncationHint(truncated, in.effectiveLimit)
 		printSkipLabelsFooter(in.skipLabels)
 		return nil
 	}
 
+	if in.csv != nil {
+		if err := outputIssuesCSV(ctx, issues, in.csv, activeStore.GetLabelsForIssues); err != nil {
+			return HandleError("%v", err)
+		}
+		printTruncationHint(truncated, in.effectiveLimit)
+		return nil
+	}
+
+	if in.md != nil {
+		if err := writeIssuesMarkdown(os.Stdout, issues, in.md); err != nil {
+			return HandleError("%v", err)
+		}
+		printTruncationHint(truncated, in.effectiveLimit)
+		return nil
+	}
+
 	if in.formatStr != "" {
-		depsByIssueID, _ := activeStore.GetAllDependencyRecords(ctx)
-		if err := outputFormattedList(issues, depsByIssueID, in.formatStr); err != nil {
+		if err := outputFormattedList(issues, loadListDependencyRecords(ctx, activeStore, issues), in.formatStr); err != nil {
 			return HandleError("%v", err)
 		}
 		printTruncationHint(truncated, in.effectiveLimit)
@@ -718,27 +778,103 @@ func runListCore(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+// runListCount serves `bd list --count-only`: the same store query that
+// `bd list --json -n 0` runs, so the count always agrees with that list,
+// unlike bd count, which applies its own defaults.
+func runListCount(ctx context.Context, s storage.DoltStorage, filter types.IssueFilter, ready bool) error {
+	var issues []*types.Issue
+	var err error
+	if ready {
+		issues, err = s.GetReadyWork(ctx, readyWorkFilterFromIssueFilter(filter))
+	} else {
+		issues, err = s.SearchIssues(ctx, "", filter)
+	}
+	if err != nil {
+		if capErr := handleMaxRowsError(err); capErr != nil {
+			return capErr
+		}
+		return HandleError("%v", err)
+	}
+	return printListCount(len(issues))
+}
+
+// printListCount prints a --count-only result in bd count's shape.
+func printListCount(n int) error {
+	if jsonOutput {
+		return outputJSON(struct {
+			Count int `json:"count"`
+		}{Count: n})
+	}
+	fmt.Println(n)
+	return nil
+}
+
+// runListFieldsJSON serves `bd list --json --fields` when every field is an
+// issue column (or labels): the projection is pushed into the search, so only
+// those columns are read instead of full rows trimmed after the fact.
+func runListFieldsJSON(ctx context.Context, s storage.DoltStorage, filter types.IssueFilter, in listInput) error {
+	filter = withFetchOneExtra(filter)
+	filter.Fields = in.fields
+	issues, err := s.SearchIssues(ctx, "", filter)
+	if err != nil {
+		if capErr := handleMaxRowsError(err); capErr != nil {
+			return capErr
+		}
+		return HandleError("%v", err)
+	}
+	sortIssues(issues, in.sortBy, in.reverse)
+	truncated := in.effectiveLimit > 0 && len(issues) > in.effectiveLimit
+	if truncated {
+		issues = issues[:in.effectiveLimit]
+	}
+	for _, issue := range issues {
+		issue.NormalizeOrder()
+	}
+	localizeIssueTimes(in.tz, issues...)
+	projected, err := finishListJSON(issues, in.fields)
+	if err != nil {
+		return err
+	}
+	if err := outputJSON(projected); err != nil {
+		return err
+	}
+	printTruncationHint(truncated, in.effectiveLimit)
+	return nil
+}
+
 func init() {
-	listCmd.Flags().StringP("status", "s", "", "Filter by stored status (open, in_progress, blocked, deferred, closed). Comma-separated for multiple: --status open,in_progress. Note: repeating -s/--status silently overwrites the previous value — always use the comma-separated form for multi-status filters.")
+	listCmd.Flags().StringSliceP("status", "s", nil, "Filter by status (open, in_progress, blocked, deferred, closed). ready is derived (same as bd ready); blocked matches the stored status plus everything bd blocked lists. Comma-separated or repeatable for multiple: --status open,in_progress")
 	listCmd.Flags().String("state", "", "Alias for --status")
 	_ = listCmd.Flags().MarkHidden("state")
 	registerPriorityFlag(listCmd, "")
-	listCmd.Flags().StringP("assignee", "a", "", "Filter by assignee")
-	listCmd.Flags().StringP("type", "t", "", "Filter by type (bug, feature, task, epic, chore, decision, merge-request, molecule, gate, convoy). Aliases: mr→merge-request, feat→feature, mol→molecule, dec/adr→decision")
+	listCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (an empty value means unassigned, as --no-assignee)")
+	listCmd.Flags().String("assignee-pattern", "", "Filter by assignee glob pattern (e.g., 'team-a/*' matches team-a/crew/max)")
+	listCmd.Flags().String("created-by", "", "Filter by the actor that created the issue (exact match on created_by)")
+	listCmd.Flags().StringSliceP("type", "t", nil, "Filter by type (bug, feature, task, epic, chore, decision, merge-request, molecule, gate, convoy). Comma-separated or repeatable for multiple: --type bug,feature. Aliases: mr→merge-request, feat→feature, mol→molecule, dec/adr→decision")
 	listCmd.Flags().StringSliceP("label", "l", []string{}, "Filter by labels (AND: must have ALL). Can combine with --label-any")
 	listCmd.Flags().StringSlice("label-any", []string{}, "Filter by labels (OR: must have AT LEAST ONE). Can combine with --label")
 	listCmd.Flags().StringSlice("exclude-label", []string{}, "Exclude issues that have ANY of these labels")
-	listCmd.Flags().String("label-pattern", "", "Filter by label glob pattern (e.g., 'tech-*' matches tech-debt, tech-legacy)")
+	listCmd.Flags().String("label-pattern", "", "Filter by label glob pattern (e.g., 'tech-*' matches tech-debt, tech-legacy; '!wip-*' excludes issues with a matching label)")
 	listCmd.Flags().String("label-regex", "", "Filter by label regex pattern (e.g., 'tech-(debt|legacy)')")
 	listCmd.Flags().String("title", "", "Filter by title text (case-insensitive substring match)")
 	listCmd.Flags().String("spec", "", "Filter by spec_id prefix")
+	listCmd.Flags().String("spec-id", "", "Filter by exact spec_id (e.g., RFC-007)")
+	listCmd.Flags().String("spec-id-prefix", "", "Filter by spec_id prefix (same as --spec)")
 	listCmd.Flags().String("id", "", "Filter by specific issue IDs (comma-separated, e.g., bd-1,bd-5,bd-10)")
-	listCmd.Flags().IntP("limit", "n", 50, "Limit results (default 50, use 0 for unlimited)")
+	listCmd.Flags().IntP("limit", "n", 50, "Limit results (default 50; 0 means unlimited and returns every match)")
+	listCmd.Flags().Bool("count-only", false, "Print only the number of matching issues (with --json: {\"count\": N}); counts every match under the same filters and defaults as bd list")
 	listCmd.Flags().Int("offset", 0, "Skip the first N matching results (0-based). Only supported under --proxied-server.")
-	listCmd.Flags().String("format", "", "Output format: 'digraph' (for golang.org/x/tools/cmd/digraph), 'dot' (Graphviz), or Go template")
+	listCmd.Flags().Bool("annotate", false, "With --json, add each issue's age (seconds since created), idle (seconds since last update), due_in and overdue_by (seconds until or past due_at), blockers (open issues blocking it) and blocking (issues it blocks)")
+	listCmd.Flags().Bool("annotate-hierarchy", false, "With --json, add each issue's depth (hops from its root) and path (ancestor IDs, root first)")
+	listCmd.Flags().Bool("rollup", false, rollupFlagUsage)
+	listCmd.Flags().StringSlice("fields", nil, "With --json, emit only these fields (comma-separated, e.g. --fields id,title). Column fields are read from the database as a projection")
+	listCmd.Flags().String("format", "", "Output format: 'csv' (see --columns), 'md' (Markdown table, see --md-links), 'digraph' (for golang.org/x/tools/cmd/digraph), 'dot' (Graphviz), or Go template")
+	registerCSVFlags(listCmd)
+	registerMarkdownFlags(listCmd)
+	registerTimezoneFlag(listCmd)
 	listCmd.Flags().Bool("all", false, "Show all issues including closed (overrides default filter)")
 	listCmd.Flags().Bool("long", false, "Show detailed multi-line output for each issue")
-	listCmd.Flags().String("sort", "", "Sort by field: priority, created, updated, closed, status, id, title, type, assignee")
+	listCmd.Flags().String("sort", "", "Sort by field: "+listSortFieldsHelp+"; add :asc or :desc for a direction, and separate keys with commas (e.g. priority,created:asc)")
 	listCmd.Flags().BoolP("reverse", "r", false, "Reverse sort order")
 
 	// Pattern matching
@@ -746,15 +882,16 @@ func init() {
 	listCmd.Flags().String("desc-contains", "", "Filter by description substring (case-insensitive)")
 	listCmd.Flags().String("notes-contains", "", "Filter by notes substring (case-insensitive)")
 	listCmd.Flags().String("external-contains", "", "Filter by external ref substring (case-insensitive)")
+	listCmd.Flags().String("close-reason-contains", "", "Filter by close reason substring (case-insensitive); pair with --status closed or --all")
 	listCmd.Flags().String("external-ref", "", "Filter by exact external_ref value")
 
 	// Date ranges
-	listCmd.Flags().String("created-after", "", "Filter issues created after date (YYYY-MM-DD or RFC3339)")
-	listCmd.Flags().String("created-before", "", "Filter issues created before date (YYYY-MM-DD or RFC3339)")
-	listCmd.Flags().String("updated-after", "", "Filter issues updated after date (YYYY-MM-DD or RFC3339)")
-	listCmd.Flags().String("updated-before", "", "Filter issues updated before date (YYYY-MM-DD or RFC3339)")
-	listCmd.Flags().String("closed-after", "", "Filter issues closed after date (YYYY-MM-DD or RFC3339)")
-	listCmd.Flags().String("closed-before", "", "Filter issues closed before date (YYYY-MM-DD or RFC3339)")
+	listCmd.Flags().String("created-after", "", "Filter issues created after date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	listCmd.Flags().String("created-before", "", "Filter issues created before date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	listCmd.Flags().String("updated-after", "", "Filter issues updated after date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	listCmd.Flags().String("updated-before", "", "Filter issues updated before date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	listCmd.Flags().String("closed-after", "", "Filter issues closed after date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	listCmd.Flags().String("closed-before", "", "Filter issues closed before date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
 
 	// Empty/null checks
 	listCmd.Flags().Bool("empty-description", false, "Filter issues with empty or missing description")
@@ -815,17 +952,24 @@ func init() {
 	listCmd.Flags().BoolP("watch", "w", false, "Watch for changes and auto-update display (implies --pretty)")
 
 	// Metadata filtering (GH#1406)
-	listCmd.Flags().StringArray("metadata-field", nil, "Filter by metadata field (key=value, repeatable)")
+	listCmd.Flags().StringArray("metadata-field", nil, "Filter by metadata field (key=value, repeatable; alias --metadata)")
+	listCmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == "metadata" {
+			name = "metadata-field"
+		}
+		return pflag.NormalizedName(name)
+	})
 	listCmd.Flags().String("has-metadata-key", "", "Filter issues that have this metadata key set")
 
 	// Pager control (bd-jdz3)
 	listCmd.Flags().Bool("no-pager", false, "Disable pager output")
 
 	// Ready filter: show only issues ready to be worked on (bd-ihu31)
-	listCmd.Flags().Bool("ready", false, "Show only ready issues (no active blockers, same semantics as bd ready)")
+	listCmd.Flags().Bool("ready", false, "Show only ready issues (no active blockers, same semantics as bd ready). Conflicts with any --status but open")
 
 	// Defensive row cap (be-x42v): exits 2 on overage, default disabled.
 	addMaxRowsFlag(listCmd)
+	addLegacyKeysFlag(listCmd)
 
 	// Note: --json flag is defined as a persistent flag in main.go, not here
 	rootCmd.AddCommand(listCmd)
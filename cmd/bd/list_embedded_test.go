@@ -3,16 +3,20 @@
 package main
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/steveyegge/beads/internal/listjson"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -223,6 +227,21 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("created_by", func(t *testing.T) {
+		byCarol := bdCreate(t, bd, dir, "Filed by carol", "--type", "task", "--actor", "carol")
+		byDave := bdCreate(t, bd, dir, "Filed by dave", "--type", "task", "--actor", "dave")
+		issues := bdListJSON(t, bd, dir, "--created-by", "carol", "--all")
+		if len(issues) != 1 || issues[0].ID != byCarol.ID {
+			t.Errorf("--created-by carol = %v, want only %s", listIssueIDs(issues), byCarol.ID)
+		}
+		if issues := bdListJSON(t, bd, dir, "--created-by", "dave", "--all"); !containsID(issues, byDave.ID) || containsID(issues, byCarol.ID) {
+			t.Errorf("--created-by dave = %v, want %s without %s", listIssueIDs(issues), byDave.ID, byCarol.ID)
+		}
+		if issues := bdListJSON(t, bd, dir, "--created-by", "nobody", "--all"); len(issues) != 0 {
+			t.Errorf("--created-by nobody = %v, want none", listIssueIDs(issues))
+		}
+	})
+
 	t.Run("type_bug", func(t *testing.T) {
 		issues := bdListJSON(t, bd, dir, "--type", "bug")
 		for _, issue := range issues {
@@ -232,6 +251,27 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("type_multi", func(t *testing.T) {
+		tmDir, _, _ := bdInit(t, bd, "--prefix", "ttm")
+		bug := bdCreate(t, bd, tmDir, "Multi-type bug", "--type", "bug")
+		feature := bdCreate(t, bd, tmDir, "Multi-type feature", "--type", "feature")
+		task := bdCreate(t, bd, tmDir, "Multi-type task", "--type", "task")
+
+		for _, args := range [][]string{{"--type", "bug,feature"}, {"--type", "bug", "--type", "feat"}} {
+			issues := bdListJSON(t, bd, tmDir, args...)
+			if !containsID(issues, bug.ID) || !containsID(issues, feature.ID) || containsID(issues, task.ID) {
+				t.Errorf("list %v = %v, want %s and %s without %s", args, listIssueIDs(issues), bug.ID, feature.ID, task.ID)
+			}
+		}
+
+		cmd := exec.Command(bd, "list", "--type", "bug,nonsense")
+		cmd.Dir = tmDir
+		cmd.Env = bdEnv(tmDir)
+		if out, err := cmd.CombinedOutput(); err == nil || !strings.Contains(string(out), `invalid issue type "nonsense"`) {
+			t.Errorf("expected invalid type error, got err=%v\n%s", err, out)
+		}
+	})
+
 	t.Run("priority", func(t *testing.T) {
 		issues := bdListJSON(t, bd, dir, "--priority", "0")
 		for _, issue := range issues {
@@ -254,6 +294,17 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("limit_negative_rejected", func(t *testing.T) {
+		out := bdListFail(t, bd, dir, "-n", "-1")
+		if !strings.Contains(out, "--limit must be >= 0") {
+			t.Errorf("expected a negative limit error, got: %s", out)
+		}
+		// -n 0 keeps its documented unlimited meaning.
+		if all, limited := bdListJSON(t, bd, dir, "-n", "0"), bdListJSON(t, bd, dir, "--limit", "2"); len(all) <= len(limited) {
+			t.Errorf("-n 0 should list everything, got %d issues (vs %d with --limit 2)", len(all), len(limited))
+		}
+	})
+
 	t.Run("limit_truncation_hint", func(t *testing.T) {
 		// GH#4094: hint is suppressed when stderr is not a terminal (piped).
 		// bdListCapture always runs in piped mode, so no hint expected even when truncated.
@@ -336,6 +387,35 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("spec_id", func(t *testing.T) {
+		rfc7 := bdCreate(t, bd, dir, "Implements RFC-007", "--type", "task", "--spec-id", "RFC-007")
+		rfc7b := bdCreate(t, bd, dir, "Also implements RFC-007", "--type", "bug", "--spec-id", "RFC-007")
+		rfc70 := bdCreate(t, bd, dir, "Implements RFC-0070", "--type", "task", "--spec-id", "RFC-0070")
+		adr := bdCreate(t, bd, dir, "Implements ADR-001", "--type", "task", "--spec-id", "ADR-001")
+
+		issues := bdListJSON(t, bd, dir, "--spec-id", "RFC-007", "--all")
+		got := listIssueIDs(issues)
+		slices.Sort(got)
+		want := []string{rfc7.ID, rfc7b.ID}
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("--spec-id RFC-007 = %v, want exactly %v", got, want)
+		}
+		for _, issue := range issues {
+			if issue.SpecID != "RFC-007" {
+				t.Errorf("%s has spec_id %q, want RFC-007", issue.ID, issue.SpecID)
+			}
+		}
+
+		issues = bdListJSON(t, bd, dir, "--spec-id-prefix", "RFC-", "--all")
+		if len(issues) != 3 || !containsID(issues, rfc70.ID) || containsID(issues, adr.ID) {
+			t.Errorf("--spec-id-prefix RFC- = %v, want %s, %s and %s", listIssueIDs(issues), rfc7.ID, rfc7b.ID, rfc70.ID)
+		}
+		if issues := bdListJSON(t, bd, dir, "--spec-id", "RFC-00", "--all"); len(issues) != 0 {
+			t.Errorf("--spec-id RFC-00 = %v, want none (exact match only)", listIssueIDs(issues))
+		}
+	})
+
 	// --- B. Label filtering ---
 
 	t.Run("label_and", func(t *testing.T) {
@@ -469,6 +549,46 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("ready_with_status", func(t *testing.T) {
+		// --status open agrees with --ready and changes nothing.
+		ready := bdListJSON(t, bd, dir, "--ready", "--limit", "0")
+		withOpen := bdListJSON(t, bd, dir, "--ready", "--status", "open", "--limit", "0")
+		if !slices.Equal(listIssueIDs(ready), listIssueIDs(withOpen)) {
+			t.Errorf("--ready --status open = %v, want the --ready set %v", listIssueIDs(withOpen), listIssueIDs(ready))
+		}
+
+		for _, status := range []string{"closed", "blocked", "open,in_progress"} {
+			out := bdListFail(t, bd, dir, "--ready", "--status", status)
+			if !strings.Contains(out, "conflicts with --status") {
+				t.Errorf("--ready --status %s: expected a conflict error, got: %s", status, out)
+			}
+		}
+	})
+
+	t.Run("count_only_matches_unlimited_list", func(t *testing.T) {
+		for _, filter := range [][]string{
+			{},
+			{"--all"},
+			{"--ready"},
+			{"--type", "bug"},
+			{"--assignee", "alice", "--all"},
+			{"--priority-max", "1"},
+		} {
+			want := len(bdListJSON(t, bd, dir, append([]string{"-n", "0"}, filter...)...))
+			got := strings.TrimSpace(bdList(t, bd, dir, append([]string{"--count-only"}, filter...)...))
+			if got != fmt.Sprint(want) {
+				t.Errorf("list --count-only %v = %s, want len(list --json -n 0) = %d", filter, got, want)
+			}
+		}
+
+		for _, args := range [][]string{{"--limit", "5"}, {"--fields", "id", "--json"}, {"--watch"}} {
+			out := bdListFail(t, bd, dir, append([]string{"--count-only"}, args...)...)
+			if !strings.Contains(out, "conflicts with") {
+				t.Errorf("--count-only %v: expected a conflict error, got: %s", args, out)
+			}
+		}
+	})
+
 	t.Run("ready_exclude_type", func(t *testing.T) {
 		issues := bdListJSON(t, bd, dir, "--ready", "--exclude-type", "epic", "--limit", "0")
 		if containsID(issues, seed.epic) {
@@ -514,6 +634,139 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("parent_id_json", func(t *testing.T) {
+		// parent_id is always present: the parent's ID for a child, null for a root.
+		parentIDs := func(args ...string) map[string]json.RawMessage {
+			t.Helper()
+			cmd := exec.Command(bd, args...)
+			cmd.Dir = dir
+			cmd.Env = bdEnv(dir)
+			stdout, stderr, err := runCommandBuffers(t, cmd)
+			if err != nil {
+				t.Fatalf("bd %s failed: %v\nstderr:\n%s", strings.Join(args, " "), err, stderr.String())
+			}
+			var items []map[string]json.RawMessage
+			if err := json.Unmarshal(stdout.Bytes(), &items); err != nil {
+				t.Fatalf("parse bd %s: %v\nraw: %s", strings.Join(args, " "), err, stdout.String())
+			}
+			out := make(map[string]json.RawMessage, len(items))
+			for _, item := range items {
+				var id string
+				_ = json.Unmarshal(item["id"], &id)
+				raw, ok := item["parent_id"]
+				if !ok {
+					t.Fatalf("bd %s: %s has no parent_id key", strings.Join(args, " "), id)
+				}
+				out[id] = raw
+			}
+			return out
+		}
+		wantParent := fmt.Sprintf("%q", seed.epic)
+
+		listed := parentIDs("list", "--json", "--all", "--limit", "0")
+		if got := string(listed[seed.childTaskA]); got != wantParent {
+			t.Errorf("list: child parent_id = %s, want %s", got, wantParent)
+		}
+		if got := string(listed[seed.epic]); got != "null" {
+			t.Errorf("list: root parent_id = %s, want null", got)
+		}
+
+		shown := parentIDs("show", seed.childTaskA, seed.epic, "--json")
+		if got := string(shown[seed.childTaskA]); got != wantParent {
+			t.Errorf("show: child parent_id = %s, want %s", got, wantParent)
+		}
+		if got := string(shown[seed.epic]); got != "null" {
+			t.Errorf("show: root parent_id = %s, want null", got)
+		}
+	})
+
+	t.Run("canonical_json_keys", func(t *testing.T) {
+		// list, show, ready and blocked share one key set: parent_id, and
+		// issue_id/depends_on_id/dependency_type on every dependency edge.
+		items := func(args ...string) map[string]map[string]any {
+			t.Helper()
+			cmd := exec.Command(bd, args...)
+			cmd.Dir = dir
+			cmd.Env = bdEnv(dir)
+			stdout, stderr, err := runCommandBuffers(t, cmd)
+			if err != nil {
+				t.Fatalf("bd %s failed: %v\nstderr:\n%s", strings.Join(args, " "), err, stderr.String())
+			}
+			var list []map[string]any
+			if err := json.Unmarshal(stdout.Bytes(), &list); err != nil {
+				t.Fatalf("parse bd %s: %v\nraw: %s", strings.Join(args, " "), err, stdout.String())
+			}
+			out := make(map[string]map[string]any, len(list))
+			for _, item := range list {
+				id, _ := item["id"].(string)
+				out[id] = item
+			}
+			return out
+		}
+		assertCanonical := func(command string, byID map[string]map[string]any) {
+			t.Helper()
+			for id, item := range byID {
+				if _, ok := item["parent"]; ok {
+					t.Errorf("%s: %s carries the legacy parent key", command, id)
+				}
+				for _, key := range []string{"dependencies", "dependents"} {
+					edges, _ := item[key].([]any)
+					for _, e := range edges {
+						edge, _ := e.(map[string]any)
+						if _, ok := edge["type"]; ok {
+							t.Errorf("%s: %s %s entry carries the legacy type key: %v", command, id, key, edge)
+						}
+						for _, want := range []string{"issue_id", "depends_on_id", "dependency_type"} {
+							if _, ok := edge[want]; !ok {
+								t.Errorf("%s: %s %s entry lacks %s: %v", command, id, key, want, edge)
+							}
+						}
+					}
+				}
+			}
+		}
+
+		listed := items("list", "--json", "--all", "--limit", "0")
+		assertCanonical("list", listed)
+		if got, _ := listed[seed.childTaskA]["parent_id"].(string); got != seed.epic {
+			t.Errorf("list: child parent_id = %q, want %q", got, seed.epic)
+		}
+
+		ready := items("ready", "--json", "--limit", "0")
+		assertCanonical("ready", ready)
+		if child, ok := ready[seed.childTaskA]; ok {
+			if got, _ := child["parent_id"].(string); got != seed.epic {
+				t.Errorf("ready: child parent_id = %q, want %q", got, seed.epic)
+			}
+		}
+
+		assertCanonical("blocked", items("blocked", "--json"))
+
+		shown := items("show", seed.childTaskA, "--json")
+		assertCanonical("show", shown)
+		deps, _ := shown[seed.childTaskA]["dependencies"].([]any)
+		found := false
+		for _, e := range deps {
+			edge, _ := e.(map[string]any)
+			if edge["issue_id"] == seed.childTaskA && edge["depends_on_id"] == seed.epic && edge["dependency_type"] == "parent-child" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("show: no canonical parent-child edge %s -> %s in %v", seed.childTaskA, seed.epic, deps)
+		}
+
+		legacy := items("list", "--json", "--all", "--limit", "0", "--legacy-keys")
+		if got, _ := legacy[seed.childTaskA]["parent"].(string); got != seed.epic {
+			t.Errorf("list --legacy-keys: child parent = %q, want %q", got, seed.epic)
+		}
+
+		out := bdListFail(t, bd, dir, "--json", "--fields", "id,parent")
+		if !strings.Contains(out, "parent_id") {
+			t.Errorf("--fields parent should point at parent_id, got: %s", out)
+		}
+	})
+
 	t.Run("tree_parent", func(t *testing.T) {
 		// --tree --parent shows hierarchical display
 		out := bdList(t, bd, dir, "--tree", "--parent", seed.epic)
@@ -600,6 +853,29 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("close_reason_contains", func(t *testing.T) {
+		// Own workspace: closing issues would disturb the shared seed.
+		crDir, _, _ := bdInit(t, bd, "--prefix", "tcr")
+		fixed := bdCreate(t, bd, crDir, "Fixed in commit", "--type", "bug")
+		wontfix := bdCreate(t, bd, crDir, "Won't fix", "--type", "bug")
+		stillOpen := bdCreate(t, bd, crDir, "Still open", "--type", "bug")
+		bdClose(t, bd, crDir, fixed.ID, "--reason", "Fixed by Commit abc123")
+		bdClose(t, bd, crDir, wontfix.ID, "--reason", "working as intended")
+
+		issues := bdListJSON(t, bd, crDir, "--status", "closed", "--close-reason-contains", "commit")
+		if len(issues) != 1 || issues[0].ID != fixed.ID {
+			t.Errorf("--close-reason-contains commit = %v, want only %s", listIssueIDs(issues), fixed.ID)
+		}
+		if containsID(bdListJSON(t, bd, crDir, "--all", "--close-reason-contains", "commit"), stillOpen.ID) {
+			t.Error("an open issue without a close reason must not match")
+		}
+
+		out := bdList(t, bd, crDir, "--all", "--json", "--fields", "id,close_reason")
+		if !strings.Contains(out, `"close_reason": "working as intended"`) {
+			t.Errorf("--fields close_reason should emit the reason:\n%s", out)
+		}
+	})
+
 	t.Run("empty_description", func(t *testing.T) {
 		issues := bdListJSON(t, bd, dir, "--empty-description")
 		if !containsID(issues, seed.noDescBug) {
@@ -616,6 +892,504 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("assignee_flag_combinations", func(t *testing.T) {
+		acDir, _, _ := bdInit(t, bd, "--prefix", "tac")
+		alice := bdCreate(t, bd, acDir, "Alice's", "--assignee", "alice")
+		unassigned := bdCreate(t, bd, acDir, "Nobody's")
+
+		// An empty --assignee asks for unassigned issues, like --no-assignee.
+		for _, args := range [][]string{{"--assignee", ""}, {"--assignee", "", "--no-assignee"}} {
+			issues := bdListJSON(t, bd, acDir, args...)
+			if containsID(issues, alice.ID) || !containsID(issues, unassigned.ID) {
+				t.Errorf("list %v: want only the unassigned issue, got %d issues", args, len(issues))
+			}
+		}
+
+		out := bdListFail(t, bd, acDir, "--assignee", "alice", "--no-assignee")
+		if !strings.Contains(out, "conflicts with --no-assignee") {
+			t.Errorf("expected a conflict error, got: %s", out)
+		}
+	})
+
+	t.Run("assignee_pattern", func(t *testing.T) {
+		apDir, _, _ := bdInit(t, bd, "--prefix", "tap")
+		crewMax := bdCreate(t, bd, apDir, "Crew max", "--assignee", "team-a/crew/max")
+		polecat := bdCreate(t, bd, apDir, "Polecat", "--assignee", "team-a/polecats/nux")
+		otherRig := bdCreate(t, bd, apDir, "Other rig", "--assignee", "team-b/crew/max")
+		lookalike := bdCreate(t, bd, apDir, "Lookalike", "--assignee", "team-ab/crew/joe")
+		unassigned := bdCreate(t, bd, apDir, "Unassigned")
+
+		got := listIssueIDs(bdListJSON(t, bd, apDir, "--assignee-pattern", "team-a/*"))
+		slices.Sort(got)
+		want := []string{crewMax.ID, polecat.ID}
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("--assignee-pattern team-a/* = %v, want %v", got, want)
+		}
+
+		crew := bdListJSON(t, bd, apDir, "--assignee-pattern", "*/crew/*")
+		if !containsID(crew, crewMax.ID) || !containsID(crew, otherRig.ID) || !containsID(crew, lookalike.ID) ||
+			containsID(crew, polecat.ID) || containsID(crew, unassigned.ID) {
+			t.Errorf("--assignee-pattern */crew/* = %v", listIssueIDs(crew))
+		}
+	})
+
+	t.Run("labels_sorted", func(t *testing.T) {
+		lsDir, _, _ := bdInit(t, bd, "--prefix", "tls")
+		created := bdCreate(t, bd, lsDir, "Unordered labels", "-l", "zeta,alpha,mid,alpha")
+		want := []string{"alpha", "mid", "zeta"}
+		if !slices.Equal(created.Labels, want) {
+			t.Errorf("create --json labels = %v, want %v", created.Labels, want)
+		}
+		cmd := exec.Command(bd, "label", "add", created.ID, "beta")
+		cmd.Dir = lsDir
+		cmd.Env = bdEnv(lsDir)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("bd label add failed: %v\n%s", err, out)
+		}
+
+		want = []string{"alpha", "beta", "mid", "zeta"}
+		issues := bdListJSON(t, bd, lsDir)
+		if len(issues) != 1 || !slices.Equal(issues[0].Labels, want) {
+			t.Fatalf("list --json labels = %v, want %v", issues, want)
+		}
+		shown := bdShow(t, bd, lsDir, created.ID)
+		if !slices.Equal(shown.Labels, want) {
+			t.Errorf("show --json labels = %v, want %v", shown.Labels, want)
+		}
+	})
+
+	t.Run("annotate_age_idle", func(t *testing.T) {
+		anDir, _, _ := bdInit(t, bd, "--prefix", "tan")
+		fresh := bdCreate(t, bd, anDir, "Fresh issue")
+		ages := func() (age, idle int64) {
+			t.Helper()
+			var items []struct {
+				ID   string `json:"id"`
+				Age  *int64 `json:"age"`
+				Idle *int64 `json:"idle"`
+			}
+			out := bdList(t, bd, anDir, "--json", "--annotate")
+			if err := json.Unmarshal([]byte(out), &items); err != nil {
+				t.Fatalf("parse --annotate output: %v\n%s", err, out)
+			}
+			if len(items) != 1 || items[0].ID != fresh.ID || items[0].Age == nil || items[0].Idle == nil {
+				t.Fatalf("--annotate should list %s with age and idle:\n%s", fresh.ID, out)
+			}
+			return *items[0].Age, *items[0].Idle
+		}
+
+		age, idle := ages()
+		if age < 0 || age > 60 || idle < 0 || idle > 60 {
+			t.Errorf("fresh issue: age=%d idle=%d, want near zero", age, idle)
+		}
+		time.Sleep(2 * time.Second)
+		laterAge, laterIdle := ages()
+		if laterAge < age+1 || laterIdle < idle+1 {
+			t.Errorf("after 2s: age %d -> %d, idle %d -> %d, want both to grow", age, laterAge, idle, laterIdle)
+		}
+
+		if out := bdList(t, bd, anDir, "--json"); strings.Contains(out, `"age"`) {
+			t.Errorf("age must only appear with --annotate:\n%s", out)
+		}
+		if out := bdList(t, bd, anDir, "--json", "--fields", "id,idle"); !strings.Contains(out, `"idle"`) {
+			t.Errorf("--fields idle should imply --annotate:\n%s", out)
+		}
+	})
+
+	t.Run("annotate_due_in_overdue_by", func(t *testing.T) {
+		adDir, _, _ := bdInit(t, bd, "--prefix", "tad")
+		future := bdCreate(t, bd, adDir, "Due next week", "--due", "+7d")
+		past := bdCreate(t, bd, adDir, "Due yesterday", "--due", "-1d")
+		none := bdCreate(t, bd, adDir, "No due date")
+
+		var items []struct {
+			ID        string `json:"id"`
+			DueIn     *int64 `json:"due_in"`
+			OverdueBy *int64 `json:"overdue_by"`
+		}
+		out := bdList(t, bd, adDir, "--json", "--annotate")
+		if err := json.Unmarshal([]byte(out), &items); err != nil {
+			t.Fatalf("parse --annotate output: %v\n%s", err, out)
+		}
+		for _, item := range items {
+			switch item.ID {
+			case future.ID:
+				if item.DueIn == nil || *item.DueIn <= 0 || item.OverdueBy != nil {
+					t.Errorf("future-due issue: due_in=%v overdue_by=%v, want a positive due_in only", item.DueIn, item.OverdueBy)
+				}
+			case past.ID:
+				if item.OverdueBy == nil || *item.OverdueBy <= 0 || item.DueIn != nil {
+					t.Errorf("past-due issue: due_in=%v overdue_by=%v, want a positive overdue_by only", item.DueIn, item.OverdueBy)
+				}
+			case none.ID:
+				if item.DueIn != nil || item.OverdueBy != nil {
+					t.Errorf("issue without a due date: due_in=%v overdue_by=%v, want both null", item.DueIn, item.OverdueBy)
+				}
+			}
+		}
+		if !strings.Contains(out, `"overdue_by": null`) && !strings.Contains(out, `"overdue_by":null`) {
+			t.Errorf("overdue_by should be null, not omitted, when annotated:\n%s", out)
+		}
+		if out := bdList(t, bd, adDir, "--json", "--fields", "id,overdue_by"); !strings.Contains(out, `"overdue_by"`) {
+			t.Errorf("--fields overdue_by should imply --annotate:\n%s", out)
+		}
+	})
+
+	t.Run("annotate_blockers_blocking_chain", func(t *testing.T) {
+		chDir, _, _ := bdInit(t, bd, "--prefix", "tbc")
+		a := bdCreate(t, bd, chDir, "Chain A")
+		b := bdCreate(t, bd, chDir, "Chain B")
+		c := bdCreate(t, bd, chDir, "Chain C")
+		// a blocks b, b blocks c.
+		bdDepAdd(t, bd, chDir, b.ID, a.ID)
+		bdDepAdd(t, bd, chDir, c.ID, b.ID)
+
+		type edges struct{ blockers, blocking []string }
+		annotated := func(args ...string) map[string]edges {
+			t.Helper()
+			var items []struct {
+				ID       string   `json:"id"`
+				Blockers []string `json:"blockers"`
+				Blocking []string `json:"blocking"`
+			}
+			out := bdList(t, bd, chDir, append([]string{"--json", "--annotate", "--all"}, args...)...)
+			if err := json.Unmarshal([]byte(out), &items); err != nil {
+				t.Fatalf("parse --annotate output: %v\n%s", err, out)
+			}
+			got := make(map[string]edges, len(items))
+			for _, item := range items {
+				if item.Blockers == nil || item.Blocking == nil {
+					t.Errorf("%s: blockers and blocking must be arrays, got %v / %v\n%s", item.ID, item.Blockers, item.Blocking, out)
+				}
+				got[item.ID] = edges{item.Blockers, item.Blocking}
+			}
+			return got
+		}
+		check := func(got map[string]edges, want map[string]edges) {
+			t.Helper()
+			for id, w := range want {
+				g := got[id]
+				if !slices.Equal(g.blockers, w.blockers) || !slices.Equal(g.blocking, w.blocking) {
+					t.Errorf("%s: blockers=%v blocking=%v, want blockers=%v blocking=%v", id, g.blockers, g.blocking, w.blockers, w.blocking)
+				}
+			}
+		}
+
+		check(annotated(), map[string]edges{
+			a.ID: {[]string{}, []string{b.ID}},
+			b.ID: {[]string{a.ID}, []string{c.ID}},
+			c.ID: {[]string{b.ID}, []string{}},
+		})
+
+		// A closed blocker no longer blocks anything.
+		bdClose(t, bd, chDir, a.ID)
+		check(annotated(), map[string]edges{
+			a.ID: {[]string{}, []string{}},
+			b.ID: {[]string{}, []string{c.ID}},
+			c.ID: {[]string{b.ID}, []string{}},
+		})
+
+		if out := bdList(t, bd, chDir, "--json"); strings.Contains(out, `"blockers"`) {
+			t.Errorf("blockers must only appear with --annotate:\n%s", out)
+		}
+	})
+
+	t.Run("format_csv_respects_filters", func(t *testing.T) {
+		csvRows := func(args ...string) [][]string {
+			t.Helper()
+			out := bdList(t, bd, dir, append([]string{"--format", "csv"}, args...)...)
+			records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+			if err != nil {
+				t.Fatalf("parse CSV: %v\n%s", err, out)
+			}
+			return records
+		}
+
+		records := csvRows("--status", "open", "--limit", "0")
+		if !slices.Equal(records[0], []string{"id", "title", "status", "priority", "assignee", "due_at"}) {
+			t.Errorf("default header = %q", records[0])
+		}
+		want := bdListJSON(t, bd, dir, "--status", "open", "--limit", "0")
+		if len(records)-1 != len(want) {
+			t.Errorf("CSV has %d rows, --json lists %d issues", len(records)-1, len(want))
+		}
+		for _, record := range records[1:] {
+			if record[2] != "open" {
+				t.Errorf("--status open row %q has status %q", record[0], record[2])
+			}
+		}
+
+		records = csvRows("--status", "open", "--limit", "0", "--priority-min", "0", "--priority-max", "1", "--columns", "id,priority")
+		for _, record := range records[1:] {
+			if record[1] != "0" && record[1] != "1" {
+				t.Errorf("--priority-max 1 row %q has priority %q", record[0], record[1])
+			}
+		}
+
+		out, err := bdRunWithFlockRetry(t, bd, dir, "list", "--columns", "id")
+		if err == nil || !strings.Contains(string(out), "--columns requires --format csv") {
+			t.Errorf("--columns without --format csv: err=%v\n%s", err, out)
+		}
+	})
+
+	t.Run("format_md_matches_text_filters", func(t *testing.T) {
+		out := bdList(t, bd, dir, "--format", "md", "--status", "open", "--limit", "0")
+		lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+		if len(lines) < 2 || lines[0] != "| ID | Title | Status | Priority | Assignee |" || lines[1] != "| --- | --- | --- | --- | --- |" {
+			t.Fatalf("expected a Markdown table header:\n%s", out)
+		}
+		want := bdListJSON(t, bd, dir, "--status", "open", "--limit", "0")
+		if len(lines)-2 != len(want) {
+			t.Errorf("table has %d rows, --json lists %d issues", len(lines)-2, len(want))
+		}
+		for _, issue := range want {
+			if !strings.Contains(out, "| `"+issue.ID+"` |") {
+				t.Errorf("issue %s missing from table:\n%s", issue.ID, out)
+			}
+		}
+
+		out2, err := bdRunWithFlockRetry(t, bd, dir, "list", "--md-links", "https://example.com")
+		if err == nil || !strings.Contains(string(out2), "--md-links requires --format md") {
+			t.Errorf("--md-links without --format md: err=%v\n%s", err, out2)
+		}
+	})
+
+	t.Run("tz_renders_same_instant", func(t *testing.T) {
+		tzDir, _, _ := bdInit(t, bd, "--prefix", "ttz")
+		issue := bdCreate(t, bd, tzDir, "Timezone issue")
+
+		createdIn := func(tz string) string {
+			var items []struct {
+				ID        string `json:"id"`
+				CreatedAt string `json:"created_at"`
+			}
+			out := bdList(t, bd, tzDir, "--json", "--tz", tz)
+			if err := json.Unmarshal([]byte(out), &items); err != nil {
+				t.Fatalf("parse --tz %s output: %v\n%s", tz, err, out)
+			}
+			if len(items) != 1 || items[0].ID != issue.ID {
+				t.Fatalf("--tz %s: expected only %s: %s", tz, issue.ID, out)
+			}
+			return items[0].CreatedAt
+		}
+		ny, tokyo := createdIn("America/New_York"), createdIn("Asia/Tokyo")
+		if ny == tokyo {
+			t.Fatalf("expected different local renderings, both %s", ny)
+		}
+		nyTime, err := time.Parse(time.RFC3339Nano, ny)
+		if err != nil {
+			t.Fatalf("parse %s: %v", ny, err)
+		}
+		tokyoTime, err := time.Parse(time.RFC3339Nano, tokyo)
+		if err != nil {
+			t.Fatalf("parse %s: %v", tokyo, err)
+		}
+		if !nyTime.Equal(tokyoTime) || !nyTime.Equal(issue.CreatedAt) {
+			t.Errorf("--tz changed the instant: %s vs %s (stored %s)", ny, tokyo, issue.CreatedAt)
+		}
+		if _, offset := tokyoTime.Zone(); offset != 9*3600 {
+			t.Errorf("Tokyo rendering %s should carry +09:00", tokyo)
+		}
+
+		tmpl := bdList(t, bd, tzDir, "--format", "{{.CreatedAt.Format \"-07:00\"}}", "--tz", "Asia/Tokyo")
+		if strings.TrimSpace(tmpl) != "+09:00" {
+			t.Errorf("--format template should see the --tz zone, got %q", tmpl)
+		}
+
+		out, err := bdRunWithFlockRetry(t, bd, tzDir, "list", "--tz", "Mars/Olympus_Mons")
+		if err == nil || !strings.Contains(string(out), "invalid --tz") {
+			t.Errorf("unknown zone should be rejected: err=%v\n%s", err, out)
+		}
+	})
+
+	t.Run("status_ready_and_blocked_match_ready_and_blocked", func(t *testing.T) {
+		psDir, _, _ := bdInit(t, bd, "--prefix", "tps")
+		head := bdCreate(t, bd, psDir, "Chain head")
+		mid := bdCreate(t, bd, psDir, "Chain mid")
+		tail := bdCreate(t, bd, psDir, "Chain tail")
+		bdDep(t, bd, psDir, "add", mid.ID, head.ID)
+		bdDep(t, bd, psDir, "add", tail.ID, mid.ID)
+		epic := bdCreate(t, bd, psDir, "Blocked epic", "--type", "epic")
+		child := bdCreate(t, bd, psDir, "Child of blocked epic", "--type", "epic", "--parent", epic.ID)
+		grandchild := bdCreate(t, bd, psDir, "Grandchild of blocked epic", "--parent", child.ID)
+		bdDep(t, bd, psDir, "add", epic.ID, head.ID)
+		free := bdCreate(t, bd, psDir, "Free")
+		// Stored status blocked with no open blocker is not in bd blocked,
+		// but --status blocked still matches the stored status.
+		marked := bdCreate(t, bd, psDir, "Marked blocked", "--status", "blocked")
+
+		commandIDs := func(args ...string) []string {
+			cmd := exec.Command(bd, append(args, "--json")...)
+			cmd.Dir = psDir
+			cmd.Env = bdEnv(psDir)
+			stdout, stderr, err := runCommandBuffers(t, cmd)
+			if err != nil {
+				t.Fatalf("bd %v failed: %v\nstderr:\n%s", args, err, stderr.String())
+			}
+			out := stdout.String()
+			var items []struct {
+				ID string `json:"id"`
+			}
+			if err := json.Unmarshal([]byte(out[max(strings.Index(out, "["), 0):]), &items); err != nil {
+				t.Fatalf("parse bd %v output: %v\n%s", args, err, out)
+			}
+			ids := make([]string, 0, len(items))
+			for _, item := range items {
+				ids = append(ids, item.ID)
+			}
+			slices.Sort(ids)
+			return ids
+		}
+
+		ready := commandIDs("list", "--status", "ready", "-n", "0")
+		if want := commandIDs("ready", "-n", "0"); !slices.Equal(ready, want) {
+			t.Errorf("list --status ready = %v, bd ready = %v", ready, want)
+		}
+		if !slices.Contains(ready, free.ID) || slices.Contains(ready, mid.ID) {
+			t.Errorf("list --status ready = %v, want %s and not %s", ready, free.ID, mid.ID)
+		}
+
+		// bd blocked lists direct blockers only; the epic's child and
+		// grandchild are blocked through it and show up with --transitive.
+		derivedBlocked := commandIDs("blocked")
+		wantDerived := []string{mid.ID, tail.ID, epic.ID}
+		slices.Sort(wantDerived)
+		if !slices.Equal(derivedBlocked, wantDerived) {
+			t.Errorf("bd blocked = %v, want %v", derivedBlocked, wantDerived)
+		}
+		if transitive := commandIDs("blocked", "--transitive"); !slices.Contains(transitive, grandchild.ID) {
+			t.Errorf("bd blocked --transitive = %v, want it to include %s", transitive, grandchild.ID)
+		}
+		blocked := commandIDs("list", "--status", "blocked", "-n", "0")
+		wantBlocked := append(slices.Clone(derivedBlocked), marked.ID)
+		slices.Sort(wantBlocked)
+		if !slices.Equal(blocked, wantBlocked) {
+			t.Errorf("list --status blocked = %v, want bd blocked %v plus stored %s", blocked, derivedBlocked, marked.ID)
+		}
+
+		openOrBlocked := commandIDs("list", "--status", "open,blocked", "-n", "0")
+		for _, id := range append(slices.Clone(wantBlocked), head.ID, free.ID) {
+			if !slices.Contains(openOrBlocked, id) {
+				t.Errorf("list --status open,blocked = %v, missing %s", openOrBlocked, id)
+			}
+		}
+	})
+
+	t.Run("status_comma_and_repeated", func(t *testing.T) {
+		msDir, _, _ := bdInit(t, bd, "--prefix", "tms")
+		open := bdCreate(t, bd, msDir, "Open one")
+		closed := bdCreate(t, bd, msDir, "Closed one")
+		bdClose(t, bd, msDir, closed.ID)
+		deferred := bdCreate(t, bd, msDir, "Deferred one", "--status", "deferred")
+
+		ids := func(args ...string) []string {
+			var out []string
+			for _, issue := range bdListJSON(t, bd, msDir, append(args, "-n", "0")...) {
+				out = append(out, issue.ID)
+			}
+			slices.Sort(out)
+			return out
+		}
+		want := []string{open.ID, closed.ID}
+		slices.Sort(want)
+		if got := ids("--status", "open,closed"); !slices.Equal(got, want) {
+			t.Errorf("--status open,closed = %v, want %v", got, want)
+		}
+		if got := ids("--status", "open", "--status", "closed"); !slices.Equal(got, want) {
+			t.Errorf("--status open --status closed = %v, want %v", got, want)
+		}
+		if got := ids("--status", "open,closed"); slices.Contains(got, deferred.ID) {
+			t.Errorf("--status open,closed should not include deferred %s", deferred.ID)
+		}
+
+		out, err := bdRunWithFlockRetry(t, bd, msDir, "list", "--status", "open,bogus")
+		if err == nil || !strings.Contains(string(out), `"bogus"`) {
+			t.Errorf("an invalid member should be named in the error: err=%v\n%s", err, out)
+		}
+	})
+
+	t.Run("comment_count_without_bodies", func(t *testing.T) {
+		ccDir, _, _ := bdInit(t, bd, "--prefix", "tcc")
+		issue := bdCreate(t, bd, ccDir, "Commented")
+		bdComment(t, bd, ccDir, issue.ID, "first remark")
+		bdComment(t, bd, ccDir, issue.ID, "second remark")
+
+		listed := bdListJSON(t, bd, ccDir)
+		if len(listed) != 1 {
+			t.Fatalf("expected 1 issue, got %d", len(listed))
+		}
+		if listed[0].CommentCount != 2 {
+			t.Errorf("list comment_count = %d, want 2", listed[0].CommentCount)
+		}
+		if len(listed[0].Comments) != 0 {
+			t.Errorf("list should not carry comment bodies, got %d comments", len(listed[0].Comments))
+		}
+
+		shown := bdShow(t, bd, ccDir, issue.ID)
+		if len(shown.Comments) != 2 || shown.Comments[0].Text != "first remark" {
+			t.Errorf("show should include comment bodies, got %+v", shown.Comments)
+		}
+	})
+
+	t.Run("rollup_epic_totals", func(t *testing.T) {
+		roDir, _, _ := bdInit(t, bd, "--prefix", "tro")
+		epic := bdCreate(t, bd, roDir, "Rollup epic", "--type", "epic")
+		bdCreate(t, bd, roDir, "Child A", "--parent", epic.ID, "--estimate", "2h")
+		done := bdCreate(t, bd, roDir, "Child B", "--parent", epic.ID, "--estimate", "30m")
+		sub := bdCreate(t, bd, roDir, "Sub epic", "--type", "epic", "--parent", epic.ID, "--estimate", "8h")
+		bdCreate(t, bd, roDir, "Grandchild", "--parent", sub.ID, "--estimate", "15")
+		bdCreate(t, bd, roDir, "Unestimated", "--parent", epic.ID)
+		bdClose(t, bd, roDir, done.ID)
+
+		want := listjson.EpicRollup{EstimateTotal: 165, ActualTotal: 30, Remaining: 135, Descendants: 4, Unestimated: 1}
+		var items []struct {
+			ID     string               `json:"id"`
+			Rollup *listjson.EpicRollup `json:"rollup"`
+		}
+		out := bdList(t, bd, roDir, "--json", "--rollup", "--all")
+		if err := json.Unmarshal([]byte(out), &items); err != nil {
+			t.Fatalf("parse --rollup output: %v\n%s", err, out)
+		}
+		for _, item := range items {
+			switch item.ID {
+			case epic.ID:
+				if item.Rollup == nil || *item.Rollup != want {
+					t.Errorf("epic rollup = %+v, want %+v", item.Rollup, want)
+				}
+			case sub.ID:
+				if item.Rollup == nil || item.Rollup.EstimateTotal != 15 {
+					t.Errorf("sub-epic rollup = %+v, want estimate_total 15", item.Rollup)
+				}
+			default:
+				if item.Rollup != nil {
+					t.Errorf("non-epic %s should have no rollup: %+v", item.ID, item.Rollup)
+				}
+			}
+		}
+
+		cmd := exec.Command(bd, "show", epic.ID, "--json", "--rollup")
+		cmd.Dir = roDir
+		cmd.Env = bdEnv(roDir)
+		showOut, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("bd show --rollup failed: %v\n%s", err, showOut)
+		}
+		var shown []struct {
+			Rollup *listjson.EpicRollup `json:"rollup"`
+		}
+		if err := json.Unmarshal(showOut, &shown); err != nil || len(shown) != 1 {
+			t.Fatalf("parse show --rollup output: %v\n%s", err, showOut)
+		}
+		if shown[0].Rollup == nil || *shown[0].Rollup != want {
+			t.Errorf("show rollup = %+v, want %+v", shown[0].Rollup, want)
+		}
+
+		if out := bdListFail(t, bd, roDir, "--rollup"); !strings.Contains(out, "--rollup requires --json") {
+			t.Errorf("--rollup without --json should fail:\n%s", out)
+		}
+	})
+
 	// --- F. Date range filtering ---
 
 	t.Run("created_after_yesterday", func(t *testing.T) {
@@ -634,6 +1408,22 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("date_range_reversed", func(t *testing.T) {
+		out := bdListFail(t, bd, dir, "--created-after", "2099-01-01", "--created-before", "2020-01-01")
+		if !strings.Contains(out, "--created-after 2099-01-01") || !strings.Contains(out, "is not earlier than --created-before 2020-01-01") {
+			t.Errorf("expected the reversed range error showing both dates, got: %s", out)
+		}
+		out = bdListFail(t, bd, dir, "--updated-after", "2099-01-01", "--updated-before", "2020-01-01")
+		if !strings.Contains(out, "--updated-after") {
+			t.Errorf("expected the reversed updated range to be rejected, got: %s", out)
+		}
+		// A window around now still matches.
+		issues := bdListJSON(t, bd, dir, "--created-after", "2020-01-01", "--created-before", "2099-01-01")
+		if len(issues) == 0 {
+			t.Error("issues created today should match a 2020-2099 window")
+		}
+	})
+
 	// --- G. Priority range ---
 
 	t.Run("priority_range", func(t *testing.T) {
@@ -645,6 +1435,19 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("priority_range_reversed", func(t *testing.T) {
+		out := bdListFail(t, bd, dir, "--priority-min", "4", "--priority-max", "0")
+		if !strings.Contains(out, "--priority-min 4 is greater than --priority-max 0") {
+			t.Errorf("expected the reversed range error showing both bounds, got: %s", out)
+		}
+		// Equal bounds are a valid single-priority range.
+		for _, issue := range bdListJSON(t, bd, dir, "--priority-min", "P1", "--priority-max", "P1", "--all") {
+			if issue.Priority != 1 {
+				t.Errorf("expected priority 1, got %d for %s", issue.Priority, issue.ID)
+			}
+		}
+	})
+
 	t.Run("priority_min", func(t *testing.T) {
 		issues := bdListJSON(t, bd, dir, "--priority-min", "3", "--all")
 		for _, issue := range issues {
@@ -686,6 +1489,38 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("sort_unknown_field", func(t *testing.T) {
+		out := bdListFail(t, bd, dir, "--sort", "nonexistent_field")
+		if !strings.Contains(out, `invalid sort field "nonexistent_field"`) || !strings.Contains(out, "priority, created") {
+			t.Errorf("expected an error naming the valid sort fields, got: %s", out)
+		}
+		out = bdListFail(t, bd, dir, "--sort", "priority:sideways")
+		if !strings.Contains(out, "invalid sort direction") {
+			t.Errorf("expected an invalid direction error, got: %s", out)
+		}
+	})
+
+	t.Run("sort_direction_suffix", func(t *testing.T) {
+		got := listIssueIDs(bdListJSON(t, bd, dir, "--all", "--flat", "--sort", "priority:desc"))
+		want := listIssueIDs(bdListJSON(t, bd, dir, "--all", "--flat", "--sort", "priority", "--reverse"))
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("--sort priority:desc should match --sort priority --reverse\n got:  %v\n want: %v", got, want)
+		}
+	})
+
+	t.Run("sort_multi_key", func(t *testing.T) {
+		issues := bdListJSON(t, bd, dir, "--all", "--flat", "--sort", "priority,title:desc")
+		for i := 1; i < len(issues); i++ {
+			a, b := issues[i-1], issues[i]
+			if a.Priority > b.Priority {
+				t.Fatalf("priority should be the primary key, got P%d (%s) before P%d (%s)", a.Priority, a.ID, b.Priority, b.ID)
+			}
+			if a.Priority == b.Priority && strings.ToLower(a.Title) < strings.ToLower(b.Title) {
+				t.Fatalf("title:desc should break priority ties, got %q before %q", a.Title, b.Title)
+			}
+		}
+	})
+
 	// --- I. Output formats ---
 
 	t.Run("json_output", func(t *testing.T) {
@@ -702,8 +1537,10 @@ func TestEmbeddedList(t *testing.T) {
 				t.Error("issue title should not be empty in JSON output")
 			}
 		}
-		// Verify child has parent field
-		for _, issue := range issues {
+		// Consumers decoding into types.IssueWithCounts read the legacy parent
+		// key, which --legacy-keys keeps for the transition period.
+		legacy := bdListJSON(t, bd, dir, "--all", "--legacy-keys")
+		for _, issue := range legacy {
 			if issue.ID == seed.childTaskA {
 				if issue.Parent == nil || *issue.Parent != seed.epic {
 					t.Errorf("child A should have parent=%s, got %v", seed.epic, issue.Parent)
@@ -768,6 +1605,48 @@ func TestEmbeddedList(t *testing.T) {
 		}
 	})
 
+	t.Run("metadata_pairs_anded", func(t *testing.T) {
+		// Own workspace so the extra issues don't shift the seed's counts.
+		mdDir, _, _ := bdInit(t, bd, "--prefix", "md")
+		authProd := bdCreate(t, bd, mdDir, "Auth prod", "--metadata", `{"component":"auth","env":"prod"}`)
+		authDev := bdCreate(t, bd, mdDir, "Auth dev", "--metadata", `{"component":"auth","env":"dev"}`)
+		billing := bdCreate(t, bd, mdDir, "Billing prod", "--metadata", `{"component":"billing","env":"prod"}`)
+		plain := bdCreate(t, bd, mdDir, "No metadata")
+
+		issues := bdListJSON(t, bd, mdDir, "--metadata", "component=auth")
+		if !containsID(issues, authProd.ID) || !containsID(issues, authDev.ID) {
+			t.Errorf("--metadata component=auth should match both auth issues, got %d issues", len(issues))
+		}
+		if containsID(issues, billing.ID) || containsID(issues, plain.ID) {
+			t.Error("--metadata component=auth should not match billing or metadata-less issues")
+		}
+
+		issues = bdListJSON(t, bd, mdDir, "--metadata", "component=auth", "--metadata", "env=prod")
+		if len(issues) != 1 || !containsID(issues, authProd.ID) {
+			t.Errorf("--metadata component=auth --metadata env=prod should match only %s, got %d issues", authProd.ID, len(issues))
+		}
+	})
+
+	t.Run("metadata_invalid_pair", func(t *testing.T) {
+		out := bdListFail(t, bd, dir, "--metadata", "component")
+		if !strings.Contains(out, "invalid --metadata-field: expected key=value") {
+			t.Errorf("expected the key=value error, got: %s", out)
+		}
+	})
+
+	t.Run("metadata_repeated_key_warns", func(t *testing.T) {
+		cmd := exec.Command(bd, "list", "--json", "--metadata", "env=prod", "--metadata-field", "env=dev")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		_, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd list failed: %v\n%s", err, stderr.String())
+		}
+		if !strings.Contains(stderr.String(), `--metadata-field env given more than once; filtering on the last value "dev"`) {
+			t.Errorf("expected a repeated-key warning, got: %s", stderr.String())
+		}
+	})
+
 	t.Run("has_metadata_key", func(t *testing.T) {
 		issues := bdListJSON(t, bd, dir, "--has-metadata-key", "env")
 		if !containsID(issues, seed.metadataIssue) {
@@ -1046,3 +1925,91 @@ func TestEmbeddedListConcurrent(t *testing.T) {
 	t.Logf("concurrency test: %d/%d workers succeeded, %d IDs created, %d in final list",
 		successes, numWorkers, len(allIDs), len(finalIssues))
 }
+
+// TestEmbeddedListJSONItemsMatchCLI verifies that listjson.Items, the
+// struct-returning core bd list, search and query --json render through,
+// yields exactly what the CLI prints for the same query.
+func TestEmbeddedListJSONItemsMatchCLI(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+	dir, beadsDir, _ := bdInit(t, bd, "--prefix", "lq")
+	epic := bdCreate(t, bd, dir, "Core epic", "--type", "epic")
+	child := bdCreate(t, bd, dir, "Core child", "--parent", epic.ID, "--estimate", "90")
+	blocker := bdCreate(t, bd, dir, "Core blocker", "--type", "bug", "--priority", "0")
+	bdDepAdd(t, bd, dir, child.ID, blocker.ID)
+	bdCreate(t, bd, dir, "Core labeled", "--labels", "api")
+	closed := bdCreate(t, bd, dir, "Core closed")
+	bdClose(t, bd, dir, closed.ID)
+
+	cases := []struct {
+		name string
+		args []string
+		in   listInput
+	}{
+		{"default", nil, listInput{}},
+		{"all_rollup", []string{"--all", "--rollup"}, listInput{allFlag: true, rollup: true}},
+		{"ready", []string{"--ready"}, listInput{readyFlag: true}},
+		{"hierarchy", []string{"--annotate-hierarchy"}, listInput{annotateHierarchy: true}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cli []map[string]interface{}
+			out := bdList(t, bd, dir, append([]string{"--json", "--limit", "0"}, tc.args...)...)
+			if err := json.Unmarshal([]byte(out), &cli); err != nil {
+				t.Fatalf("parse bd list --json: %v\n%s", err, out)
+			}
+
+			st := openStore(t, beadsDir, "lq")
+			ctx := t.Context()
+			in := tc.in
+			var err error
+			if in.sortBy, in.reverse, err = resolveSortFlag("", false); err != nil {
+				t.Fatalf("resolveSortFlag: %v", err)
+			}
+			cfg, err := loadDirectListFilterConfig(ctx, st)
+			if err != nil {
+				t.Fatalf("loadDirectListFilterConfig: %v", err)
+			}
+			filter, err := buildListFilter(in, cfg)
+			if err != nil {
+				t.Fatalf("buildListFilter: %v", err)
+			}
+			iwc, truncated, err := queryListIssuesWithCounts(ctx, st, filter, in)
+			if err != nil {
+				t.Fatalf("queryListIssuesWithCounts: %v", err)
+			}
+			items, err := listjson.Items(ctx, iwc, listJSONOptions(in), storeListLookups(st))
+			if err != nil {
+				t.Fatalf("listjson.Items: %v", err)
+			}
+			// Release the embedded lock before the next subtest runs bd.
+			_ = st.Close()
+			if truncated {
+				t.Errorf("unlimited query reported truncation")
+			}
+			payload, err := finishListJSON(items, nil)
+			if err != nil {
+				t.Fatalf("finishListJSON: %v", err)
+			}
+			raw, err := json.Marshal(payload)
+			if err != nil {
+				t.Fatalf("marshal core items: %v", err)
+			}
+			var core []map[string]interface{}
+			if err := json.Unmarshal(raw, &core); err != nil {
+				t.Fatalf("parse core items: %v", err)
+			}
+
+			if len(core) == 0 {
+				t.Fatal("core returned no issues")
+			}
+			if !reflect.DeepEqual(core, cli) {
+				t.Errorf("core items differ from bd list --json %s\ncore: %s\ncli:  %s", strings.Join(tc.args, " "), raw, out)
+			}
+		})
+	}
+}
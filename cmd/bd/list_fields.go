@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/storage/sqlbuild"
+)
+
+// listCountFields are `bd list --json` fields computed from relations rather
+// than stored on the issue row. Requesting one keeps the counts query; any
+// other combination of fields is pushed down as a column projection. depth
+// and path imply --annotate-hierarchy; age, idle, due_in, overdue_by, blockers
+// and blocking imply --annotate; rollup implies --rollup.
+var listCountFields = map[string]bool{
+	"dependency_count": true,
+	"dependent_count":  true,
+	"comment_count":    true,
+	"parent":           true,
+	"parent_id":        true,
+	"depth":            true,
+	"path":             true,
+	"age":              true,
+	"idle":             true,
+	"due_in":           true,
+	"overdue_by":       true,
+	"blockers":         true,
+	"blocking":         true,
+	"rollup":           true,
+}
+
+// parseListFields validates --fields values (comma-separated or repeated)
+// against the fields bd list --json can emit, dropping blanks and duplicates
+// while keeping the caller's order.
+func parseListFields(raw []string) ([]string, error) {
+	var fields []string
+	seen := make(map[string]bool)
+	for _, entry := range raw {
+		for _, field := range strings.Split(entry, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" || seen[field] {
+				continue
+			}
+			if !sqlbuild.IsProjectableIssueField(field) && field != "labels" && !listCountFields[field] {
+				return nil, fmt.Errorf("unknown --fields value %q (valid: %s)", field, strings.Join(listFieldNames(), ", "))
+			}
+			seen[field] = true
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+// listFieldNames returns every value --fields accepts.
+func listFieldNames() []string {
+	names := append(sqlbuild.ProjectableIssueFields(), "labels")
+	for field := range listCountFields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// listFieldsNeedCounts reports whether any field comes from the counts query.
+func listFieldsNeedCounts(fields []string) bool {
+	for _, field := range fields {
+		if listCountFields[field] {
+			return true
+		}
+	}
+	return false
+}
+
+// projectJSONFields renders each item with only the requested JSON fields.
+// A requested field the item omits (omitempty on a zero value) is emitted
+// as null, so every object carries the same keys.
+func projectJSONFields[T any](items []T, fields []string) ([]map[string]json.RawMessage, error) {
+	out := make([]map[string]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, fmt.Errorf("encoding JSON: %v", err)
+		}
+		var all map[string]json.RawMessage
+		if err := json.Unmarshal(data, &all); err != nil {
+			return nil, fmt.Errorf("encoding JSON: %v", err)
+		}
+		projected := make(map[string]json.RawMessage, len(fields))
+		for _, field := range fields {
+			if v, ok := all[field]; ok {
+				projected[field] = v
+			} else {
+				projected[field] = json.RawMessage("null")
+			}
+		}
+		out = append(out, projected)
+	}
+	return out, nil
+}
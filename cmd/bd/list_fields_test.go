@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestParseListFields(t *testing.T) {
+	got, err := parseListFields([]string{"id, title", "title", "", "comment_count,labels"})
+	if err != nil {
+		t.Fatalf("parseListFields: %v", err)
+	}
+	if strings.Join(got, ",") != "id,title,comment_count,labels" {
+		t.Errorf("parseListFields = %v", got)
+	}
+	if !listFieldsNeedCounts(got) {
+		t.Error("comment_count should need the counts query")
+	}
+	if listFieldsNeedCounts([]string{"id", "labels", "assignee"}) {
+		t.Error("column fields should not need the counts query")
+	}
+
+	_, err = parseListFields([]string{"id,bogus"})
+	if err == nil || !strings.Contains(err.Error(), `"bogus"`) {
+		t.Fatalf("parseListFields(bogus) error = %v", err)
+	}
+}
+
+func TestProjectJSONFields(t *testing.T) {
+	items := []*types.IssueWithCounts{{
+		Issue:        &types.Issue{ID: "bd-1", Title: "One", Description: "long text", Priority: 0},
+		CommentCount: 3,
+	}}
+	got, err := projectJSONFields(items, []string{"id", "priority", "comment_count", "assignee"})
+	if err != nil {
+		t.Fatalf("projectJSONFields: %v", err)
+	}
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"assignee":null,"comment_count":3,"id":"bd-1","priority":0}]`
+	if string(data) != want {
+		t.Errorf("projected JSON = %s, want %s", data, want)
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/steveyegge/beads/internal/config"
@@ -116,6 +117,19 @@ func loadProxiedListFilterConfig(ctx context.Context, uw uow.UnitOfWork) (listFi
 	return loadListFilterConfig(ctx, proxiedConfigSource{uw: uw})
 }
 
+// defaultExcludeStatuses is the status filter bd list applies when no status
+// selection is given: closed and pinned issues, plus custom statuses in the
+// done or frozen category. bd count applies it too, so the two agree.
+func defaultExcludeStatuses(cfg listFilterConfig) []types.Status {
+	excludeStatuses := []types.Status{types.StatusClosed, types.StatusPinned}
+	for _, cs := range cfg.customStatuses {
+		if cs.Category == types.CategoryDone || cs.Category == types.CategoryFrozen {
+			excludeStatuses = append(excludeStatuses, types.Status(cs.Name))
+		}
+	}
+	return excludeStatuses
+}
+
 func buildListFilter(in listInput, cfg listFilterConfig) (types.IssueFilter, error) {
 	filter := types.IssueFilter{
 		Limit:    in.sqlLimit,
@@ -134,13 +148,7 @@ func buildListFilter(in listInput, cfg listFilterConfig) (types.IssueFilter, err
 	}
 
 	if in.status == "" && !in.allFlag && !in.readyFlag && !in.pinnedFlag {
-		excludeStatuses := []types.Status{types.StatusClosed, types.StatusPinned}
-		for _, cs := range cfg.customStatuses {
-			if cs.Category == types.CategoryDone || cs.Category == types.CategoryFrozen {
-				excludeStatuses = append(excludeStatuses, types.Status(cs.Name))
-			}
-		}
-		filter.ExcludeStatus = excludeStatuses
+		filter.ExcludeStatus = defaultExcludeStatuses(cfg)
 	}
 
 	if in.prioritySet {
@@ -151,17 +159,42 @@ func buildListFilter(in listInput, cfg listFilterConfig) (types.IssueFilter, err
 		a := in.assignee
 		filter.Assignee = &a
 	}
+	if in.assigneePat != "" {
+		filter.AssigneePattern = in.assigneePat
+	}
+	if in.createdBy != "" {
+		createdBy := in.createdBy
+		filter.CreatedBy = &createdBy
+	}
+	requestedTypes := in.issueTypes
 	if in.issueType != "" {
-		t := types.IssueType(in.issueType)
+		requestedTypes = []string{in.issueType}
+	}
+	infraCount := 0
+	for _, name := range requestedTypes {
+		t := types.IssueType(name)
 		if !t.IsValidWithCustom(cfg.customTypes) {
 			validTypes := "bug, feature, task, epic, chore, decision"
 			if len(cfg.customTypes) > 0 {
 				validTypes += ", " + joinStrings(cfg.customTypes, ", ")
 			}
-			return filter, fmt.Errorf("invalid issue type %q (valid: %s)", in.issueType, validTypes)
+			return filter, fmt.Errorf("invalid issue type %q (valid: %s)", name, validTypes)
+		}
+		if cfg.isInfra(name) {
+			infraCount++
 		}
-		filter.IssueType = &t
+		if len(requestedTypes) == 1 {
+			filter.IssueType = &t
+		} else {
+			filter.Types = append(filter.Types, t)
+		}
+	}
+	// Infra types live in the wisps table, so a --type list is either all
+	// infra or all regular types.
+	if infraCount > 0 && infraCount < len(requestedTypes) {
+		return filter, fmt.Errorf("--type cannot mix infrastructure types with regular types: %s", strings.Join(requestedTypes, ","))
 	}
+	infraTypesRequested := infraCount > 0
 
 	if len(in.labels) > 0 {
 		filter.Labels = in.labels
@@ -187,6 +220,9 @@ func buildListFilter(in listInput, cfg listFilterConfig) (types.IssueFilter, err
 			filter.IDs = ids
 		}
 	}
+	if in.specID != "" {
+		filter.SpecID = in.specID
+	}
 	if in.specPrefix != "" {
 		filter.SpecIDPrefix = in.specPrefix
 	}
@@ -203,6 +239,9 @@ func buildListFilter(in listInput, cfg listFilterConfig) (types.IssueFilter, err
 	if in.externalContains != "" {
 		filter.ExternalRefContains = in.externalContains
 	}
+	if in.closeReasonContains != "" {
+		filter.CloseReasonContains = in.closeReasonContains
+	}
 	if in.externalRef != "" {
 		filter.ExternalRef = &in.externalRef
 	}
@@ -249,11 +288,11 @@ func buildListFilter(in listInput, cfg listFilterConfig) (types.IssueFilter, err
 		filter.IsTemplate = &isTemplate
 	}
 
-	if !in.includeGates && in.issueType != "gate" {
+	if !in.includeGates && !slices.Contains(requestedTypes, "gate") {
 		filter.ExcludeTypes = append(filter.ExcludeTypes, "gate")
 	}
 
-	if !in.includeInfra && !cfg.isInfra(in.issueType) {
+	if !in.includeInfra && !infraTypesRequested {
 		for _, t := range cfg.infraTypes() {
 			filter.ExcludeTypes = append(filter.ExcludeTypes, types.IssueType(t))
 		}
@@ -268,7 +307,7 @@ func buildListFilter(in listInput, cfg listFilterConfig) (types.IssueFilter, err
 		}
 	}
 
-	if cfg.isInfra(in.issueType) {
+	if infraTypesRequested {
 		ephemeral := true
 		filter.Ephemeral = &ephemeral
 	}
@@ -306,7 +345,7 @@ func buildListFilter(in listInput, cfg listFilterConfig) (types.IssueFilter, err
 		filter.HasMetadataKey = in.hasMetadataKey
 	}
 
-	if !in.includeInfra && (in.issueType == "" || !cfg.isInfra(in.issueType)) {
+	if !in.includeInfra && !infraTypesRequested {
 		filter.SkipWisps = true
 	}
 
@@ -321,6 +360,9 @@ func validStatusList(customStatusNames []string) string {
 	return validList
 }
 
+// applyStatusFilter sets filter's status predicate from a --status value: a
+// single status, or a comma-separated OR list whose members each name a known
+// status. An invalid member is reported by name.
 func applyStatusFilter(filter *types.IssueFilter, status string, customStatusNames []string) error {
 	statusParts := strings.Split(status, ",")
 	if len(statusParts) == 1 {
@@ -334,10 +376,77 @@ func applyStatusFilter(filter *types.IssueFilter, status string, customStatusNam
 
 	for _, part := range statusParts {
 		s := types.Status(strings.TrimSpace(part))
-		if !s.IsValidWithCustom(customStatusNames) {
+		switch {
+		case s == "":
+			return fmt.Errorf("empty status in multi-status filter %q", status)
+		case s == "all":
+			return fmt.Errorf("--status all cannot be combined with other statuses")
+		case !s.IsValidWithCustom(customStatusNames):
 			return fmt.Errorf("invalid status %q in multi-status filter (valid: %s)", strings.TrimSpace(part), validStatusList(customStatusNames))
+		case slices.Contains(filter.Statuses, s):
+			continue
 		}
 		filter.Statuses = append(filter.Statuses, s)
 	}
 	return nil
 }
+
+// readyStatusConflict rejects a --status that --ready would contradict.
+// --ready lists open issues with no active blockers, so only --status open or
+// --status ready agree with it; anything else used to be silently replaced.
+func readyStatusConflict(status string) error {
+	for _, part := range strings.Split(status, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" || part == "ready" || part == string(types.StatusOpen) {
+			continue
+		}
+		return fmt.Errorf("--ready lists only open, unblocked issues and conflicts with --status %s; drop one of them", status)
+	}
+	return nil
+}
+
+// derivedListStatus returns "ready" when a bd list --status value names the
+// derived ready pseudo-status, or "" otherwise. Ready is computed from the
+// dependency graph, so it cannot share an OR list with stored statuses.
+func derivedListStatus(status string) (string, error) {
+	parts := strings.Split(status, ",")
+	for _, part := range parts {
+		if strings.TrimSpace(part) != "ready" {
+			continue
+		}
+		if len(parts) > 1 {
+			return "", fmt.Errorf("--status ready is derived from dependencies and cannot be combined with other statuses")
+		}
+		return "ready", nil
+	}
+	return "", nil
+}
+
+// listStatusIncludesBlocked reports whether a bd list --status value names
+// blocked, which matches the stored status and also bd blocked's set.
+func listStatusIncludesBlocked(status string) bool {
+	for _, part := range strings.Split(status, ",") {
+		if strings.TrimSpace(part) == string(types.StatusBlocked) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyBlockedStatusIDs adds bd blocked's set to filter's status predicate
+// when --status names blocked, so bd list --status blocked lists exactly
+// what bd blocked does (same predicate, same pruning) plus any row whose
+// stored status is blocked.
+func applyBlockedStatusIDs(ctx context.Context, in listInput, filter *types.IssueFilter, getBlocked func(context.Context, types.WorkFilter) ([]*types.BlockedIssue, error)) error {
+	if !in.blockedFlag {
+		return nil
+	}
+	blocked, err := getBlocked(ctx, types.WorkFilter{DirectBlockedOnly: true})
+	if err != nil {
+		return fmt.Errorf("load blocked issues: %w", err)
+	}
+	for _, b := range blocked {
+		filter.StatusOrIDs = append(filter.StatusOrIDs, b.ID)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -40,6 +41,31 @@ func TestApplyStatusFilter(t *testing.T) {
 		}
 	})
 
+	t.Run("blocked is a stored status in an OR list", func(t *testing.T) {
+		var filter types.IssueFilter
+		if err := applyStatusFilter(&filter, "open,blocked,open", nil); err != nil {
+			t.Fatalf("applyStatusFilter: %v", err)
+		}
+		want := []types.Status{types.StatusOpen, types.StatusBlocked}
+		if !reflect.DeepEqual(filter.Statuses, want) {
+			t.Fatalf("Statuses = %v, want %v (deduplicated)", filter.Statuses, want)
+		}
+	})
+
+	t.Run("empty and all members error", func(t *testing.T) {
+		for status, want := range map[string]string{
+			"open,":      "empty status",
+			"open,,done": "empty status",
+			"all,closed": "cannot be combined",
+		} {
+			var filter types.IssueFilter
+			err := applyStatusFilter(&filter, status, nil)
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("applyStatusFilter(%q) = %v, want error containing %q", status, err, want)
+			}
+		}
+	})
+
 	t.Run("invalid status errors", func(t *testing.T) {
 		var filter types.IssueFilter
 		err := applyStatusFilter(&filter, "open,not-a-status", nil)
@@ -51,3 +77,150 @@ func TestApplyStatusFilter(t *testing.T) {
 		}
 	})
 }
+
+func TestDerivedListStatus(t *testing.T) {
+	for status, want := range map[string]string{
+		"ready":        "ready",
+		" ready ":      "ready",
+		"blocked":      "",
+		"open,blocked": "",
+		"open":         "",
+		"open,closed":  "",
+		"":             "",
+	} {
+		got, err := derivedListStatus(status)
+		if err != nil || got != want {
+			t.Errorf("derivedListStatus(%q) = %q, %v; want %q", status, got, err, want)
+		}
+	}
+	for _, status := range []string{"open,ready", "ready, in_progress"} {
+		if _, err := derivedListStatus(status); err == nil || !strings.Contains(err.Error(), "derived") {
+			t.Errorf("derivedListStatus(%q) = %v, want derived-status error", status, err)
+		}
+	}
+}
+
+func TestListStatusIncludesBlocked(t *testing.T) {
+	for status, want := range map[string]bool{
+		"blocked":            true,
+		"open, blocked":      true,
+		"open,in_progress":   false,
+		"blocked_on_purpose": false,
+		"":                   false,
+	} {
+		if got := listStatusIncludesBlocked(status); got != want {
+			t.Errorf("listStatusIncludesBlocked(%q) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestReadyStatusConflict(t *testing.T) {
+	for _, status := range []string{"", "open", " open ", "ready"} {
+		if err := readyStatusConflict(status); err != nil {
+			t.Errorf("readyStatusConflict(%q) = %v, want nil", status, err)
+		}
+	}
+	for _, status := range []string{"closed", "blocked", "in_progress", "all", "open,closed"} {
+		if err := readyStatusConflict(status); err == nil || !strings.Contains(err.Error(), "conflicts with --status") {
+			t.Errorf("readyStatusConflict(%q) = %v, want a conflict error", status, err)
+		}
+	}
+}
+
+func TestPriorityRangeError(t *testing.T) {
+	for _, r := range [][2]int{{0, 4}, {2, 2}, {0, 0}} {
+		if err := priorityRangeError(r[0], r[1]); err != nil {
+			t.Errorf("priorityRangeError(%d, %d) = %v, want nil", r[0], r[1], err)
+		}
+	}
+	err := priorityRangeError(4, 0)
+	if err == nil || !strings.Contains(err.Error(), "--priority-min 4 is greater than --priority-max 0") {
+		t.Errorf("reversed range: got %v", err)
+	}
+}
+
+func TestLimitFlagError(t *testing.T) {
+	for _, limit := range []int{0, 1, 50} {
+		if err := limitFlagError(limit); err != nil {
+			t.Errorf("limitFlagError(%d) = %v, want nil", limit, err)
+		}
+	}
+	if err := limitFlagError(-1); err == nil || !strings.Contains(err.Error(), "--limit must be >= 0") {
+		t.Errorf("limitFlagError(-1) = %v, want a >= 0 error", err)
+	}
+}
+
+func TestPriorityFlagError(t *testing.T) {
+	for _, priority := range []int{0, 2, 4} {
+		if err := priorityFlagError(priority); err != nil {
+			t.Errorf("priorityFlagError(%d) = %v, want nil", priority, err)
+		}
+	}
+	for _, priority := range []int{-1, 5} {
+		if err := priorityFlagError(priority); err == nil || !strings.Contains(err.Error(), "between 0 and 4") {
+			t.Errorf("priorityFlagError(%d) = %v, want a 0-4 range error", priority, err)
+		}
+	}
+}
+
+func TestResolveNoAssignee(t *testing.T) {
+	tests := []struct {
+		name        string
+		assignee    string
+		assigneeSet bool
+		noAssignee  bool
+		want        bool
+		wantErr     bool
+	}{
+		{name: "neither flag", want: false},
+		{name: "named assignee", assignee: "alice", assigneeSet: true, want: false},
+		{name: "no-assignee alone", noAssignee: true, want: true},
+		{name: "empty assignee means unassigned", assignee: "", assigneeSet: true, want: true},
+		{name: "blank assignee means unassigned", assignee: "  ", assigneeSet: true, want: true},
+		{name: "empty assignee with no-assignee", assignee: "", assigneeSet: true, noAssignee: true, want: true},
+		{name: "named assignee with no-assignee", assignee: "alice", assigneeSet: true, noAssignee: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveNoAssignee(tt.assignee, tt.assigneeSet, tt.noAssignee)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "conflicts with --no-assignee") {
+					t.Fatalf("resolveNoAssignee() error = %v, want a conflict error", err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("resolveNoAssignee() = (%v, %v), want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeRangeErr(t *testing.T) {
+	early := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, r := range []timeRange{
+		{"created", &early, &late},
+		{"created", &late, nil},
+		{"created", nil, &early},
+	} {
+		if err := r.err(); err != nil {
+			t.Errorf("%+v: unexpected error %v", r, err)
+		}
+	}
+	err := timeRange{"created", &late, &early}.err()
+	want := "--created-after 2099-01-01T00:00:00Z is not earlier than --created-before 2020-01-01T00:00:00Z"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("reversed range: got %v, want %q", err, want)
+	}
+	// Both bounds are exclusive, so an empty window is rejected too.
+	if err := (timeRange{"due", &early, &early}).err(); err == nil {
+		t.Error("equal bounds should be rejected")
+	}
+
+	filter := types.IssueFilter{UpdatedAfter: &late, UpdatedBefore: &early}
+	if err := filterTimeRangeError(filter); err == nil || !strings.Contains(err.Error(), "--updated-after") {
+		t.Errorf("filterTimeRangeError: got %v", err)
+	}
+}
@@ -13,11 +13,26 @@ import (
 	"github.com/steveyegge/beads/internal/ui"
 )
 
+// timeFlagExamples lists sample date inputs for flag error messages.
+const timeFlagExamples = "today, tomorrow, +3d, next friday, 2025-01-15"
+
 // parseTimeFlag parses time strings using the layered time parsing architecture.
-// Supports compact durations (+6h, -1d), natural language (tomorrow, next monday),
-// and absolute formats (2006-01-02, RFC3339).
+// Supports compact durations (+6h, -1d), natural language (today, tomorrow,
+// next friday), and absolute formats (2006-01-02, RFC3339). It is the one
+// parser behind --due, --defer, --until and the date range filters, and its
+// result is always in UTC.
 func parseTimeFlag(s string) (time.Time, error) {
-	return timeparsing.ParseRelativeTime(s, time.Now())
+	return parseTimeFlagAt(s, time.Now())
+}
+
+// parseTimeFlagAt is parseTimeFlag with relative forms resolved against now.
+// Dates without a zone are read in now's location before conversion to UTC.
+func parseTimeFlagAt(s string, now time.Time) (time.Time, error) {
+	t, err := timeparsing.ParseRelativeTime(strings.TrimSpace(s), now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse time expression: %q (examples: %s)", s, timeFlagExamples)
+	}
+	return t.UTC(), nil
 }
 
 // pinIndicator returns a pushpin emoji prefix for pinned issues
@@ -103,6 +118,9 @@ func formatIssueLong(buf *strings.Builder, issue *types.Issue, labels []string,
 	if issue.Assignee != "" {
 		buf.WriteString(fmt.Sprintf("  Assignee: %s\n", issue.Assignee))
 	}
+	if issue.CloseReason != "" {
+		buf.WriteString(fmt.Sprintf("  Close reason: %s\n", issue.CloseReason))
+	}
 	if desc := strings.TrimSpace(issue.Description); desc != "" {
 		buf.WriteString("  Description:\n")
 		for _, line := range strings.Split(desc, "\n") {
@@ -4,6 +4,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -18,7 +19,7 @@ type watchListDependencyStoreStub struct {
 	err     error
 }
 
-func (s watchListDependencyStoreStub) GetAllDependencyRecords(_ context.Context) (map[string][]*types.Dependency, error) {
+func (s watchListDependencyStoreStub) GetDependencyRecordsForIssues(_ context.Context, _ []string) (map[string][]*types.Dependency, error) {
 	return s.allDeps, s.err
 }
 
@@ -511,3 +512,38 @@ func TestGetHierarchicalChildrenIncludesDescendantsBeyondDepthTen(t *testing.T)
 		t.Fatalf("expected descendant at depth %d (%s), got %v", depth, leaf.ID, ids)
 	}
 }
+
+func routedIssues(results []*RoutedResult) []*types.Issue {
+	issues := make([]*types.Issue, len(results))
+	for i, r := range results {
+		issues[i] = r.Issue
+	}
+	return issues
+}
+
+func TestLoadListDependencyRecords_QueryCountBounded(t *testing.T) {
+	for _, n := range []int{1, 10, 200} {
+		s, results := newShowBatchCountingStore(n)
+		deps := loadListDependencyRecords(context.Background(), s, routedIssues(results))
+		if len(deps) != n {
+			t.Fatalf("n=%d: got deps for %d issues", n, len(deps))
+		}
+		if s.queries != 1 {
+			t.Errorf("n=%d: %d queries, want 1 (must not grow with result size)", n, s.queries)
+		}
+	}
+}
+
+func BenchmarkLoadListDependencyRecords(b *testing.B) {
+	for _, n := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("issues=%d", n), func(b *testing.B) {
+			s, results := newShowBatchCountingStore(n)
+			issues := routedIssues(results)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				loadListDependencyRecords(context.Background(), s, issues)
+			}
+			b.ReportMetric(float64(s.queries)/float64(b.N), "queries/op")
+		})
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,8 +19,12 @@ import (
 type listInput struct {
 	status      string
 	issueType   string
+	issueTypes  []string // two or more --type values; issueType holds a single one
 	assignee    string
+	assigneePat string
+	createdBy   string
 	titleSearch string
+	specID      string
 	specPrefix  string
 	idFilter    string
 
@@ -29,11 +34,12 @@ type listInput struct {
 	labelPattern  string
 	labelRegex    string
 
-	titleContains    string
-	descContains     string
-	notesContains    string
-	externalContains string
-	externalRef      string
+	titleContains       string
+	descContains        string
+	notesContains       string
+	externalContains    string
+	closeReasonContains string
+	externalRef         string
 
 	createdBefore *time.Time
 	createdAfter  *time.Time
@@ -78,16 +84,26 @@ type listInput struct {
 
 	allFlag      bool
 	readyFlag    bool
+	blockedFlag  bool // --status names blocked: stored status plus bd blocked's set
 	longFormat   bool
 	prettyFormat bool
 	flatFormat   bool
 	watchMode    bool
+	countOnly    bool // --count-only: print the match count instead of the issues
 	noPager      bool
 	formatStr    string
+	csv          *csvOptions      // --format csv; nil for every other format
+	md           *markdownOptions // --format md; nil for every other format
+	tz           *time.Location   // --tz display zone; nil keeps UTC
 	jsonOutput   bool
+	fields       []string // --fields projection for --json; nil = every field
 	sortBy       string
 	reverse      bool
 
+	annotate          bool // --annotate: add age, idle, blockers and blocking to --json
+	annotateHierarchy bool // --annotate-hierarchy: add depth and path to --json
+	rollup            bool // --rollup: add estimate rollups to epics in --json
+
 	limitChanged   bool
 	effectiveLimit int
 	sqlLimit       int
@@ -101,17 +117,33 @@ type listInput struct {
 func gatherListInput(cmd *cobra.Command) (listInput, error) {
 	in := listInput{}
 
-	in.status, _ = cmd.Flags().GetString("status")
+	statuses, _ := cmd.Flags().GetStringSlice("status")
+	in.status = strings.Join(statuses, ",")
 	if in.status == "" {
 		in.status, _ = cmd.Flags().GetString("state")
 	}
 
 	in.assignee, _ = cmd.Flags().GetString("assignee")
-	rawType, _ := cmd.Flags().GetString("type")
-	in.issueType = utils.NormalizeIssueType(rawType)
+	in.assigneePat, _ = cmd.Flags().GetString("assignee-pattern")
+	in.createdBy, _ = cmd.Flags().GetString("created-by")
+	rawTypes, _ := cmd.Flags().GetStringSlice("type")
+	for _, raw := range rawTypes {
+		t := utils.NormalizeIssueType(strings.TrimSpace(raw))
+		if t != "" && !slices.Contains(in.issueTypes, t) {
+			in.issueTypes = append(in.issueTypes, t)
+		}
+	}
+	if len(in.issueTypes) == 1 {
+		in.issueType, in.issueTypes = in.issueTypes[0], nil
+	}
 
 	limit, _ := cmd.Flags().GetInt("limit")
 	in.limitChanged = cmd.Flags().Changed("limit")
+	if in.limitChanged {
+		if err := limitFlagError(limit); err != nil {
+			return in, HandleError("%v", err)
+		}
+	}
 	listLimitConfigured := false
 	if !in.limitChanged {
 		listLimitConfigured = config.GetValueSource("list.limit") != config.SourceDefault
@@ -126,13 +158,54 @@ func gatherListInput(cmd *cobra.Command) (listInput, error) {
 	}
 	in.jsonOutput = jsonOutput
 
+	if rawFields, _ := cmd.Flags().GetStringSlice("fields"); len(rawFields) > 0 {
+		if !in.jsonOutput {
+			return in, HandleError("--fields requires --json")
+		}
+		fields, err := parseListFields(rawFields)
+		if err != nil {
+			return in, HandleError("%v", err)
+		}
+		if !legacyJSONKeys && slices.Contains(fields, "parent") {
+			return in, HandleError("--fields parent is a legacy key: use parent_id (or --legacy-keys)")
+		}
+		in.fields = fields
+	}
+	in.annotate, _ = cmd.Flags().GetBool("annotate")
+	if in.annotate && !in.jsonOutput {
+		return in, HandleError("--annotate requires --json")
+	}
+	if slices.ContainsFunc(in.fields, func(f string) bool {
+		return f == "age" || f == "idle" || f == "due_in" || f == "overdue_by" || f == "blockers" || f == "blocking"
+	}) {
+		in.annotate = true
+	}
+	in.annotateHierarchy, _ = cmd.Flags().GetBool("annotate-hierarchy")
+	if in.annotateHierarchy && !in.jsonOutput {
+		return in, HandleError("--annotate-hierarchy requires --json")
+	}
+	if slices.Contains(in.fields, "depth") || slices.Contains(in.fields, "path") {
+		in.annotateHierarchy = true
+	}
+	in.rollup, _ = cmd.Flags().GetBool("rollup")
+	if in.rollup && !in.jsonOutput {
+		return in, HandleError("--rollup requires --json")
+	}
+	if slices.Contains(in.fields, "rollup") {
+		in.rollup = true
+	}
+
 	in.labels, _ = cmd.Flags().GetStringSlice("label")
 	in.labelsAny, _ = cmd.Flags().GetStringSlice("label-any")
 	in.excludeLabels, _ = cmd.Flags().GetStringSlice("exclude-label")
 	in.labelPattern, _ = cmd.Flags().GetString("label-pattern")
 	in.labelRegex, _ = cmd.Flags().GetString("label-regex")
 	in.titleSearch, _ = cmd.Flags().GetString("title")
+	in.specID, _ = cmd.Flags().GetString("spec-id")
 	in.specPrefix, _ = cmd.Flags().GetString("spec")
+	if prefix, _ := cmd.Flags().GetString("spec-id-prefix"); prefix != "" {
+		in.specPrefix = prefix
+	}
 	in.idFilter, _ = cmd.Flags().GetString("id")
 	in.longFormat, _ = cmd.Flags().GetBool("long")
 	in.sortBy, _ = cmd.Flags().GetString("sort")
@@ -142,10 +215,19 @@ func gatherListInput(cmd *cobra.Command) (listInput, error) {
 	in.descContains, _ = cmd.Flags().GetString("desc-contains")
 	in.notesContains, _ = cmd.Flags().GetString("notes-contains")
 	in.externalContains, _ = cmd.Flags().GetString("external-contains")
+	in.closeReasonContains, _ = cmd.Flags().GetString("close-reason-contains")
 	in.externalRef, _ = cmd.Flags().GetString("external-ref")
 
 	in.emptyDesc, _ = cmd.Flags().GetBool("empty-description")
 	in.noAssignee, _ = cmd.Flags().GetBool("no-assignee")
+	noAssignee, assigneeErr := resolveNoAssignee(in.assignee, cmd.Flags().Changed("assignee"), in.noAssignee)
+	if assigneeErr != nil {
+		return in, HandleError("%v", assigneeErr)
+	}
+	in.noAssignee = noAssignee
+	if in.noAssignee && in.assigneePat != "" {
+		return in, HandleError("--assignee-pattern cannot be combined with --no-assignee")
+	}
 	in.noLabels, _ = cmd.Flags().GetBool("no-labels")
 
 	in.skipLabels, _ = cmd.Flags().GetBool("skip-labels")
@@ -184,6 +266,11 @@ func gatherListInput(cmd *cobra.Command) (listInput, error) {
 		in.priorityMax = p
 		in.priorityMaxSet = true
 	}
+	if in.priorityMinSet && in.priorityMaxSet {
+		if err := priorityRangeError(in.priorityMin, in.priorityMax); err != nil {
+			return in, HandleError("%v", err)
+		}
+	}
 
 	in.pinnedFlag, _ = cmd.Flags().GetBool("pinned")
 	in.noPinnedFlag, _ = cmd.Flags().GetBool("no-pinned")
@@ -224,6 +311,15 @@ func gatherListInput(cmd *cobra.Command) (listInput, error) {
 	in.overdueFlag, _ = cmd.Flags().GetBool("overdue")
 
 	var err error
+	if in.csv, err = readCSVOptions(cmd, in.formatStr); err != nil {
+		return in, HandleError("%v", err)
+	}
+	if in.md, err = readMarkdownOptions(cmd, in.formatStr); err != nil {
+		return in, HandleError("%v", err)
+	}
+	if in.tz, err = readTimezoneFlag(cmd); err != nil {
+		return in, HandleError("%v", err)
+	}
 	if in.createdAfter, err = parseListTimeFlag(cmd, "created-after"); err != nil {
 		return in, err
 	}
@@ -254,20 +350,34 @@ func gatherListInput(cmd *cobra.Command) (listInput, error) {
 	if in.dueBefore, err = parseListTimeFlag(cmd, "due-before"); err != nil {
 		return in, err
 	}
+	for _, r := range []timeRange{
+		{"created", in.createdAfter, in.createdBefore},
+		{"updated", in.updatedAfter, in.updatedBefore},
+		{"closed", in.closedAfter, in.closedBefore},
+		{"defer", in.deferAfter, in.deferBefore},
+		{"due", in.dueAfter, in.dueBefore},
+	} {
+		if err := r.err(); err != nil {
+			return in, HandleError("%v", err)
+		}
+	}
 
 	metadataFieldFlags, _ := cmd.Flags().GetStringArray("metadata-field")
-	if len(metadataFieldFlags) > 0 {
-		in.metadataFields = make(map[string]string, len(metadataFieldFlags))
-		for _, mf := range metadataFieldFlags {
-			k, v, ok := strings.Cut(mf, "=")
-			if !ok || k == "" {
-				return in, HandleErrorRespectJSON("invalid --metadata-field: expected key=value, got %q", mf)
-			}
-			if err := storage.ValidateMetadataKey(k); err != nil {
-				return in, HandleErrorRespectJSON("invalid --metadata-field key: %v", err)
-			}
-			in.metadataFields[k] = v
+	for _, mf := range metadataFieldFlags {
+		k, v, ok := strings.Cut(mf, "=")
+		if !ok || k == "" {
+			return in, HandleErrorRespectJSON("invalid --metadata-field: expected key=value, got %q", mf)
+		}
+		if err := storage.ValidateMetadataKey(k); err != nil {
+			return in, HandleErrorRespectJSON("invalid --metadata-field key: %v", err)
+		}
+		if in.metadataFields == nil {
+			in.metadataFields = make(map[string]string, len(metadataFieldFlags))
 		}
+		if prev, dup := in.metadataFields[k]; dup && prev != v {
+			fmt.Fprintf(os.Stderr, "Warning: --metadata-field %s given more than once; filtering on the last value %q\n", k, v)
+		}
+		in.metadataFields[k] = v
 	}
 	if k, _ := cmd.Flags().GetString("has-metadata-key"); k != "" {
 		if err := storage.ValidateMetadataKey(k); err != nil {
@@ -289,16 +399,33 @@ func gatherListInput(cmd *cobra.Command) (listInput, error) {
 	}
 	in.noPager, _ = cmd.Flags().GetBool("no-pager")
 	in.readyFlag, _ = cmd.Flags().GetBool("ready")
-
-	if in.sortBy != "" {
-		validSortFields := map[string]bool{
-			"priority": true, "created": true, "updated": true, "closed": true,
-			"status": true, "id": true, "title": true, "type": true, "assignee": true,
-		}
-		if !validSortFields[in.sortBy] {
-			return in, HandleError("invalid sort field %q (valid: priority, created, updated, closed, status, id, title, type, assignee)", in.sortBy)
+	if in.readyFlag {
+		if err := readyStatusConflict(in.status); err != nil {
+			return in, HandleError("%v", err)
 		}
+		// Whatever --status said is now implied by --ready.
+		in.status = ""
 	}
+	// --status ready is derived from the dependency graph, not read from the
+	// stored status column: it is bd ready's set. --status blocked keeps the
+	// stored status and adds bd blocked's set (see applyBlockedStatusIDs).
+	derived, err := derivedListStatus(in.status)
+	if err != nil {
+		return in, HandleError("%v", err)
+	}
+	if derived == "ready" {
+		in.readyFlag, in.status = true, ""
+	}
+	in.blockedFlag = listStatusIncludesBlocked(in.status)
+	if in.readyFlag && len(in.issueTypes) > 1 {
+		return in, HandleError("--ready supports a single --type value")
+	}
+
+	sortBy, reverse, err := resolveSortFlag(in.sortBy, in.reverse)
+	if err != nil {
+		return in, HandleError("%v", err)
+	}
+	in.sortBy, in.reverse = sortBy, reverse
 
 	in.labels = utils.NormalizeLabels(in.labels)
 	in.labelsAny = utils.NormalizeLabels(in.labelsAny)
@@ -323,12 +450,22 @@ func gatherListInput(cmd *cobra.Command) (listInput, error) {
 	case ui.IsAgentMode():
 		in.effectiveLimit = 20
 	}
+	in.countOnly, _ = cmd.Flags().GetBool("count-only")
+	if in.countOnly {
+		if err := countOnlyConflict(cmd); err != nil {
+			return in, HandleError("%v", err)
+		}
+		// The count covers every match, whatever list.limit, --all or the
+		// terminal would otherwise cap the page at.
+		in.effectiveLimit = 0
+	}
 	in.sqlLimit = in.effectiveLimit
 	// --sort id requires natural-numeric comparison (bd-9 < bd-10) that
-	// SQL can't express without a schema-side sort column. Fall back to
-	// fetching everything and sorting client-side. Other sorts (including
-	// title via LOWER()) are pushed into SQL ORDER BY.
-	if in.sortBy == "id" {
+	// SQL can't express without a schema-side sort column; due dates and
+	// multi-key sorts have no ORDER BY either. Fall back to fetching
+	// everything and sorting client-side. Other sorts (including title via
+	// LOWER()) are pushed into SQL ORDER BY.
+	if isGoSideListSort(in.sortBy) {
 		in.sqlLimit = 0
 	}
 
@@ -338,11 +475,11 @@ func gatherListInput(cmd *cobra.Command) (listInput, error) {
 			return in, HandleError("--offset must be >= 0")
 		}
 		// --offset only makes sense when pagination happens in SQL. Sorts
-		// that fall back to Go-side (currently --sort id) fetch everything
+		// that fall back to Go-side (see isGoSideListSort) fetch everything
 		// regardless, so combining them with --offset is misleading — the
 		// caller would think they're paging when they're really pulling
 		// the whole result set.
-		if offset > 0 && in.sqlLimit == 0 && in.sortBy == "id" {
+		if offset > 0 && in.sqlLimit == 0 && isGoSideListSort(in.sortBy) {
 			return in, HandleError("--offset is not supported with --sort %s (sort requires fetching the full result set)", in.sortBy)
 		}
 		in.offset = offset
@@ -365,3 +502,98 @@ func parseListTimeFlag(cmd *cobra.Command, name string) (*time.Time, error) {
 	}
 	return &t, nil
 }
+
+// limitFlagError rejects a negative --limit. Zero is the documented "no
+// limit"; a negative value used to slip past the > 0 checks and act the same.
+func limitFlagError(limit int) error {
+	if limit < 0 {
+		return fmt.Errorf("--limit must be >= 0 (use 0 for no limit), got %d", limit)
+	}
+	return nil
+}
+
+// priorityFlagError rejects an integer --priority outside 0-4. No issue can
+// carry such a priority, so the filter used to print an empty result.
+func priorityFlagError(priority int) error {
+	if priority < 0 || priority > 4 {
+		return fmt.Errorf("--priority must be between 0 and 4 (0=critical, 4=backlog), got %d", priority)
+	}
+	return nil
+}
+
+// countOnlyOutputFlags shape the rendered issues, which --count-only never
+// prints, so combining them with it is rejected rather than ignored.
+var countOnlyOutputFlags = []string{"watch", "fields", "format", "annotate", "annotate-hierarchy", "rollup", "offset", "long"}
+
+// countOnlyConflict rejects --count-only alongside a flag that only changes
+// how issues are displayed, or a nonzero --limit, which would cap the count.
+func countOnlyConflict(cmd *cobra.Command) error {
+	for _, name := range countOnlyOutputFlags {
+		if cmd.Flags().Changed(name) {
+			return fmt.Errorf("--count-only prints only the number of matches and conflicts with --%s", name)
+		}
+	}
+	if limit, _ := cmd.Flags().GetInt("limit"); cmd.Flags().Changed("limit") && limit != 0 {
+		return fmt.Errorf("--count-only counts every match and conflicts with --limit %d (--limit 0 is fine)", limit)
+	}
+	return nil
+}
+
+// resolveNoAssignee returns whether the unassigned filter applies. An
+// explicitly empty --assignee asks for unassigned issues, the same as
+// --no-assignee, rather than dropping the filter; a named --assignee together
+// with --no-assignee can match nothing and is rejected.
+func resolveNoAssignee(assignee string, assigneeSet, noAssignee bool) (bool, error) {
+	if !assigneeSet {
+		return noAssignee, nil
+	}
+	if strings.TrimSpace(assignee) == "" {
+		return true, nil
+	}
+	if noAssignee {
+		return false, fmt.Errorf("--assignee %q conflicts with --no-assignee; use one or the other", assignee)
+	}
+	return false, nil
+}
+
+// priorityRangeError rejects a --priority-min above --priority-max. Such a
+// range can match no issue, and running it would just print an empty result.
+func priorityRangeError(minPriority, maxPriority int) error {
+	if minPriority > maxPriority {
+		return fmt.Errorf("--priority-min %d is greater than --priority-max %d; no issue can match (did you mean --priority-min %d --priority-max %d?)",
+			minPriority, maxPriority, maxPriority, minPriority)
+	}
+	return nil
+}
+
+// timeRange is a --<name>-after/--<name>-before flag pair.
+type timeRange struct {
+	name          string
+	after, before *time.Time
+}
+
+// err rejects an after bound that is not earlier than the before bound. Both
+// bounds are exclusive, so such a range can match no issue; saying so beats
+// printing an empty result.
+func (r timeRange) err() error {
+	if r.after == nil || r.before == nil || r.after.Before(*r.before) {
+		return nil
+	}
+	return fmt.Errorf("--%s-after %s is not earlier than --%s-before %s; no issue can match",
+		r.name, r.after.Format(time.RFC3339), r.name, r.before.Format(time.RFC3339))
+}
+
+// filterTimeRangeError checks the created, updated and closed ranges of a
+// filter built from bd search or bd count flags.
+func filterTimeRangeError(filter types.IssueFilter) error {
+	for _, r := range []timeRange{
+		{"created", filter.CreatedAfter, filter.CreatedBefore},
+		{"updated", filter.UpdatedAfter, filter.UpdatedBefore},
+		{"closed", filter.ClosedAfter, filter.ClosedBefore},
+	} {
+		if err := r.err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+
+	"github.com/steveyegge/beads/internal/listjson"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/uow"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// listJSONOptions maps the bd list flags onto the optional --json fields.
+func listJSONOptions(in listInput) listjson.Options {
+	return listjson.Options{
+		Annotate:  in.annotate,
+		Hierarchy: in.annotateHierarchy,
+		Rollup:    in.rollup,
+	}
+}
+
+// listJSONPayload renders the `bd list --json` array shared by list, search
+// and query: the listjson items with the canonical keys, projected to fields
+// when any are given.
+func listJSONPayload(ctx context.Context, iwc []*types.IssueWithCounts, opts listjson.Options, fields []string, lookups listjson.Lookups) (interface{}, error) {
+	items, err := listjson.Items(ctx, iwc, opts, lookups)
+	if err != nil {
+		return nil, HandleError("%v", err)
+	}
+	return finishListJSON(items, fields)
+}
+
+// storeListJSONPayload is listJSONPayload with its lookups served by st.
+func storeListJSONPayload(ctx context.Context, st storage.DoltStorage, iwc []*types.IssueWithCounts, opts listjson.Options, fields []string) (interface{}, error) {
+	return listJSONPayload(ctx, iwc, opts, fields, storeListLookups(st))
+}
+
+// proxiedListJSONPayload is listJSONPayload with its lookups served by uw.
+func proxiedListJSONPayload(ctx context.Context, uw uow.UnitOfWork, iwc []*types.IssueWithCounts, opts listjson.Options, fields []string) (interface{}, error) {
+	return listJSONPayload(ctx, iwc, opts, fields, proxiedListLookups(uw))
+}
+
+func storeListLookups(st storage.DoltStorage) listjson.Lookups {
+	return listjson.Lookups{
+		Parents: func(ctx context.Context, ids []string) (map[string]string, error) {
+			_, _, parents, err := st.GetBlockingInfoForIssues(ctx, ids)
+			return parents, err
+		},
+		Blocking: func(ctx context.Context, ids []string) (map[string][]string, map[string][]string, error) {
+			blockers, blocking, _, err := st.GetBlockingInfoForIssues(ctx, ids)
+			return blockers, blocking, err
+		},
+		Descendants: storeDescendantLookup(st),
+	}
+}
+
+func proxiedListLookups(uw uow.UnitOfWork) listjson.Lookups {
+	return listjson.Lookups{
+		Parents: func(ctx context.Context, ids []string) (map[string]string, error) {
+			info, err := uw.DependencyUseCase().GetBlockingInfo(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			return info.Parent, nil
+		},
+		Blocking: func(ctx context.Context, ids []string) (map[string][]string, map[string][]string, error) {
+			info, err := uw.DependencyUseCase().GetBlockingInfo(ctx, ids)
+			if err != nil {
+				return nil, nil, err
+			}
+			return info.BlockedBy, info.Blocks, nil
+		},
+		Descendants: proxiedDescendantLookup(uw),
+	}
+}
+
+// finishListJSON applies the canonical keys, then the --fields projection, so
+// projected fields use the same names as the full payload.
+func finishListJSON[T any](items []T, fields []string) (interface{}, error) {
+	canonical, err := canonicalJSON(items)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return canonical, nil
+	}
+	if elems, ok := canonical.([]interface{}); ok {
+		return projectJSONFields(elems, fields)
+	}
+	return projectJSONFields(items, fields)
+}
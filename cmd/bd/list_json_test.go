@@ -0,0 +1,34 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/listjson"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestListIssueJSONOmitsCommentBodies(t *testing.T) {
+	iwc := &types.IssueWithCounts{
+		Issue: &types.Issue{ID: "bd-3", Comments: []*types.Comment{
+			{ID: "c1", IssueID: "bd-3", Author: "alice", Text: "secret body"},
+		}},
+		CommentCount: 1,
+	}
+	for name, v := range map[string]any{
+		"list":        listjson.NewItems([]*types.IssueWithCounts{iwc})[0],
+		"skip-labels": newSkipLabelsListJSONResponse([]*types.IssueWithCounts{iwc}),
+	} {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), `"comment_count":1`) {
+			t.Errorf("%s: want comment_count 1: %s", name, data)
+		}
+		if strings.Contains(string(data), `"comments"`) || strings.Contains(string(data), "secret body") {
+			t.Errorf("%s: comment bodies leaked into list JSON: %s", name, data)
+		}
+	}
+}
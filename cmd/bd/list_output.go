@@ -43,20 +43,7 @@ func outputDotFormat(issues []*types.Issue, depsByIssueID map[string][]*types.De
 			issue.Title,
 			issue.Status)
 
-		// Color by status only - keep it simple
-		fillColor := "white"
-		fontColor := "black"
-
-		switch issue.Status {
-		case "closed":
-			fillColor = "lightgray"
-			fontColor = "dimgray"
-		case "in_progress":
-			fillColor = "lightyellow"
-		case "blocked":
-			fillColor = "lightcoral"
-		}
-
+		fillColor, fontColor := dotStatusColors(issue.Status)
 		fmt.Printf("  %q [label=%q, style=\"rounded,filled\", fillcolor=%q, fontcolor=%q];\n",
 			issue.ID, label, fillColor, fontColor)
 	}
@@ -67,22 +54,7 @@ func outputDotFormat(issues []*types.Issue, depsByIssueID map[string][]*types.De
 		for _, dep := range depsByIssueID[issue.ID] {
 			// Only output edges where both nodes are in the filtered list
 			if issueMap[dep.DependsOnID] != nil {
-				// Color code by dependency type
-				color := "black"
-				style := "solid"
-				switch dep.Type {
-				case "blocks":
-					color = "red"
-					style = "bold"
-				case "parent-child":
-					color = "blue"
-				case "discovered-from":
-					color = "green"
-					style = "dashed"
-				case "related":
-					color = "gray"
-					style = "dashed"
-				}
+				color, style := dotDepEdgeAttrs(dep.Type)
 				fmt.Printf("  %q -> %q [label=%q, color=%s, style=%s];\n",
 					issue.ID, dep.DependsOnID, dep.Type, color, style)
 			}
@@ -93,6 +65,39 @@ func outputDotFormat(issues []*types.Issue, depsByIssueID map[string][]*types.De
 	return nil
 }
 
+// dotStatusColors returns the Graphviz fill and font colors of a node. Only
+// the status drives the color, to keep graphs readable.
+func dotStatusColors(status types.Status) (fillColor, fontColor string) {
+	switch status {
+	case types.StatusClosed:
+		return "lightgray", "dimgray"
+	case types.StatusInProgress:
+		return "lightyellow", "black"
+	case types.StatusBlocked:
+		return "lightcoral", "black"
+	}
+	return "white", "black"
+}
+
+// dotDepEdgeAttrs returns the Graphviz color and style of a dependency edge.
+func dotDepEdgeAttrs(depType types.DependencyType) (color, style string) {
+	switch depType {
+	case types.DepBlocks:
+		return "red", "bold"
+	case types.DepConditionalBlocks:
+		return "orange", "bold"
+	case types.DepWaitsFor:
+		return "purple", "bold"
+	case types.DepParentChild:
+		return "blue", "solid"
+	case types.DepDiscoveredFrom:
+		return "green", "dashed"
+	case types.DepRelated, types.DepRelatesTo:
+		return "gray", "dashed"
+	}
+	return "black", "solid"
+}
+
 func outputFormattedList(issues []*types.Issue, depsByIssueID map[string][]*types.Dependency, formatStr string) error {
 	// Handle special 'dot' format (Graphviz output)
 	if formatStr == "dot" {
@@ -430,7 +430,10 @@ func TestProxiedServerList(t *testing.T) {
 				t.Error("issue title should not be empty in JSON output")
 			}
 		}
-		for _, issue := range issues {
+		// Consumers decoding into types.IssueWithCounts read the legacy parent
+		// key, which --legacy-keys keeps for the transition period.
+		legacy := bdProxiedListJSON(t, bd, p, "--all", "--legacy-keys")
+		for _, issue := range legacy {
 			if issue.ID == seed.childTaskA {
 				if issue.Parent == nil || *issue.Parent != seed.epic {
 					t.Errorf("child A should have parent=%s, got %v", seed.epic, issue.Parent)
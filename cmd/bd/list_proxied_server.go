@@ -22,6 +22,8 @@ func runListProxiedServer(cmd *cobra.Command, ctx context.Context, in listInput)
 		return errors.New("--repo is not supported with --proxied-server")
 	}
 	switch {
+	case in.countOnly:
+		return runListProxiedCount(ctx, in)
 	case in.watchMode:
 		return runListProxiedWatch(cmd, ctx, in)
 	case in.readyFlag:
@@ -57,6 +59,10 @@ func openAndPrepare(ctx context.Context, in listInput) (uow.UnitOfWork, types.Is
 		uw.Close(ctx)
 		return nil, types.IssueFilter{}, err
 	}
+	if err := applyBlockedStatusIDs(ctx, in, &filter, uw.IssueUseCase().GetBlockedIssues); err != nil {
+		uw.Close(ctx)
+		return nil, types.IssueFilter{}, err
+	}
 	return uw, filter, nil
 }
 
@@ -79,7 +85,7 @@ func runListProxiedSearch(_ *cobra.Command, ctx context.Context, in listInput) e
 		if err != nil {
 			return err
 		}
-		return emitProxiedListJSONResult(page.Items, in, page.HasMore)
+		return emitProxiedListJSONResult(ctx, uw, page.Items, in, page.HasMore)
 	}
 
 	page, err := uw.IssueUseCase().SearchIssues(ctx, "", filter)
@@ -92,6 +98,28 @@ func runListProxiedSearch(_ *cobra.Command, ctx context.Context, in listInput) e
 	return renderProxiedListText(ctx, uw, page.Items, in, page.HasMore)
 }
 
+func runListProxiedCount(ctx context.Context, in listInput) error {
+	uw, filter, err := openAndPrepare(ctx, in)
+	if err != nil {
+		return err
+	}
+	defer uw.Close(ctx)
+
+	if in.readyFlag {
+		page, err := uw.IssueUseCase().GetReadyWork(ctx, readyWorkFilterFromIssueFilter(filter))
+		if err != nil {
+			return err
+		}
+		return printListCount(len(page.Items))
+	}
+
+	page, err := uw.IssueUseCase().SearchIssues(ctx, "", filter)
+	if err != nil {
+		return err
+	}
+	return printListCount(len(page.Items))
+}
+
 func runListProxiedHierarchicalParent(ctx context.Context, uw uow.UnitOfWork, in listInput, filter types.IssueFilter) error {
 	treeIssues, err := gatherProxiedHierarchical(ctx, uw, in.parentID, filter)
 	if err != nil {
@@ -149,7 +177,7 @@ func runListProxiedReady(_ *cobra.Command, ctx context.Context, in listInput) er
 		if err != nil {
 			return err
 		}
-		return emitProxiedListJSONResult(page.Items, in, page.HasMore)
+		return emitProxiedListJSONResult(ctx, uw, page.Items, in, page.HasMore)
 	}
 
 	page, err := uw.IssueUseCase().GetReadyWork(ctx, wf)
@@ -252,16 +280,21 @@ func runListProxiedWatch(_ *cobra.Command, ctx context.Context, in listInput) er
 	}
 }
 
-func emitProxiedListJSONResult(iwc []*types.IssueWithCounts, in listInput, hasMore bool) error {
+func emitProxiedListJSONResult(ctx context.Context, uw uow.UnitOfWork, iwc []*types.IssueWithCounts, in listInput, hasMore bool) error {
 	sortIssuesWithCounts(iwc, in.sortBy, in.reverse)
 	if iwc == nil {
 		iwc = []*types.IssueWithCounts{}
 	}
+	localizeIssuesWithCounts(in.tz, iwc)
 	var err error
-	if in.skipLabels {
-		err = outputJSON(newSkipLabelsListJSONResponse(iwc))
+	if in.skipLabels && len(in.fields) == 0 {
+		err = outputCanonicalJSON(newSkipLabelsListJSONResponse(iwc))
 	} else {
-		err = outputJSON(iwc)
+		var payload interface{}
+		payload, err = proxiedListJSONPayload(ctx, uw, iwc, listJSONOptions(in), in.fields)
+		if err == nil {
+			err = outputJSON(payload)
+		}
 	}
 	if err != nil {
 		return err
@@ -279,6 +312,22 @@ func loadDepsForIssues(ctx context.Context, uw uow.UnitOfWork, issues []*types.I
 }
 
 func renderProxiedListText(ctx context.Context, uw uow.UnitOfWork, issues []*types.Issue, in listInput, truncated bool) error {
+	localizeIssueTimes(in.tz, issues...)
+	if in.csv != nil {
+		if err := outputIssuesCSV(ctx, issues, in.csv, proxiedLabelsLookup(uw, issues)); err != nil {
+			return err
+		}
+		printTruncationHint(truncated, in.effectiveLimit)
+		return nil
+	}
+	if in.md != nil {
+		if err := writeIssuesMarkdown(os.Stdout, issues, in.md); err != nil {
+			return err
+		}
+		printTruncationHint(truncated, in.effectiveLimit)
+		return nil
+	}
+
 	if in.formatStr != "" {
 		depsByIssueID, err := loadDepsForIssues(ctx, uw, issues)
 		if err != nil {
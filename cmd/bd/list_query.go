@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// queryListIssuesWithCounts runs the `bd list --json` query against st:
+// ready work under --ready, the filtered search otherwise. It fetches one row
+// past the limit to report truncation, then sorts, trims to the limit, and
+// applies --tz. The slice is never nil, so callers encode [] for no matches.
+func queryListIssuesWithCounts(ctx context.Context, st storage.DoltStorage, filter types.IssueFilter, in listInput) ([]*types.IssueWithCounts, bool, error) {
+	var iwc []*types.IssueWithCounts
+	var err error
+	if in.readyFlag {
+		iwc, err = st.GetReadyWorkWithCounts(ctx, readyWorkFilterFromIssueFilter(withFetchOneExtra(filter)))
+	} else {
+		iwc, err = st.SearchIssuesWithCounts(ctx, "", withFetchOneExtra(filter))
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	sortIssuesWithCounts(iwc, in.sortBy, in.reverse)
+	truncated := in.effectiveLimit > 0 && len(iwc) > in.effectiveLimit
+	if truncated {
+		iwc = iwc[:in.effectiveLimit]
+	}
+	if iwc == nil {
+		iwc = []*types.IssueWithCounts{}
+	}
+	localizeIssuesWithCounts(in.tz, iwc)
+	return iwc, truncated, nil
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// listSortFields maps every accepted --sort field name to its sort key. The
+// *_at spellings match the JSON field names.
+var listSortFields = map[string]string{
+	"priority":   "priority",
+	"created":    "created",
+	"created_at": "created",
+	"updated":    "updated",
+	"updated_at": "updated",
+	"closed":     "closed",
+	"closed_at":  "closed",
+	"due":        "due",
+	"due_at":     "due",
+	"status":     "status",
+	"id":         "id",
+	"title":      "title",
+	"type":       "type",
+	"assignee":   "assignee",
+}
+
+const listSortFieldsHelp = "priority, created, updated, closed, due, status, id, title, type, assignee"
+
+// sortKey is one key of a --sort value: a field and an optional explicit
+// direction ("asc" or "desc"). Without one the field sorts in its default
+// direction, the one --sort <field> has always used.
+type sortKey struct {
+	field string
+	dir   string
+}
+
+// sortFieldDefaultDesc reports fields whose default order is descending:
+// timestamps sort newest first. Everything else, due dates included, sorts
+// ascending.
+func sortFieldDefaultDesc(field string) bool {
+	return field == "created" || field == "updated" || field == "closed"
+}
+
+// parseSortSpec parses a --sort value: comma-separated field[:asc|:desc]
+// keys, applied in order so later keys break ties in earlier ones.
+func parseSortSpec(spec string) ([]sortKey, error) {
+	var keys []sortKey
+	for _, part := range strings.Split(spec, ",") {
+		name, dir, _ := strings.Cut(strings.TrimSpace(part), ":")
+		field, ok := listSortFields[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("invalid sort field %q (valid: %s; add :asc or :desc for a direction, and separate keys with commas)", name, listSortFieldsHelp)
+		}
+		dir = strings.ToLower(dir)
+		if dir != "" && dir != "asc" && dir != "desc" {
+			return nil, fmt.Errorf("invalid sort direction %q for %s (use asc or desc)", dir, name)
+		}
+		keys = append(keys, sortKey{field: field, dir: dir})
+	}
+	return keys, nil
+}
+
+// formatSortSpec renders keys back into a --sort value with aliases resolved.
+func formatSortSpec(keys []sortKey) string {
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k.field
+		if k.dir != "" {
+			parts[i] += ":" + k.dir
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// resolveSortFlag validates a --sort value and returns the sort to apply. A
+// single key becomes its plain field, with an explicit direction against the
+// field's default folded into reverse, so list keeps pushing it into SQL.
+// Several keys come back as one canonical spec.
+func resolveSortFlag(sortBy string, reverse bool) (string, bool, error) {
+	if sortBy == "" {
+		return "", reverse, nil
+	}
+	keys, err := parseSortSpec(sortBy)
+	if err != nil {
+		return "", reverse, err
+	}
+	if len(keys) > 1 {
+		return formatSortSpec(keys), reverse, nil
+	}
+	k := keys[0]
+	if k.dir != "" && (k.dir == "desc") != sortFieldDefaultDesc(k.field) {
+		reverse = !reverse
+	}
+	return k.field, reverse, nil
+}
+
+// compare orders a and b by this key, honoring an explicit direction.
+func (k sortKey) compare(a, b *types.Issue) int {
+	r := compareIssuesBy(a, b, k.field)
+	if k.dir != "" && (k.dir == "desc") != sortFieldDefaultDesc(k.field) {
+		return -r
+	}
+	return r
+}
+
+// isGoSideListSort reports --sort values SQL cannot order by, so bd list
+// fetches every match and sorts in Go: natural ID order, due dates, and
+// multi-key sorts.
+func isGoSideListSort(sortBy string) bool {
+	return sortBy == "id" || sortBy == "due" || strings.ContainsAny(sortBy, ",:")
+}
+
+// issueComparator returns the comparison for a --sort value: a single field,
+// or comma-separated field[:asc|:desc] keys. Keys that do not parse compare
+// equal, as an unknown single field always has.
+func issueComparator(sortBy string) func(a, b *types.Issue) int {
+	if !strings.ContainsAny(sortBy, ",:") {
+		return func(a, b *types.Issue) int { return compareIssuesBy(a, b, sortBy) }
+	}
+	keys, _ := parseSortSpec(sortBy)
+	return func(a, b *types.Issue) int {
+		for _, k := range keys {
+			if r := k.compare(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
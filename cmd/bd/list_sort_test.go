@@ -0,0 +1,105 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestParseSortSpec(t *testing.T) {
+	keys, err := parseSortSpec("priority, created_at:ASC,due_at:desc")
+	if err != nil {
+		t.Fatalf("parseSortSpec: %v", err)
+	}
+	want := []sortKey{{field: "priority"}, {field: "created", dir: "asc"}, {field: "due", dir: "desc"}}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("keys = %+v, want %+v", keys, want)
+	}
+	if got := formatSortSpec(keys); got != "priority,created:asc,due:desc" {
+		t.Errorf("formatSortSpec = %q", got)
+	}
+
+	for _, tc := range []struct{ spec, wantErr string }{
+		{"nonexistent_field", `invalid sort field "nonexistent_field"`},
+		{"priority,bogus", `invalid sort field "bogus"`},
+		{"priority,", `invalid sort field ""`},
+		{"title:up", `invalid sort direction "up" for title`},
+	} {
+		if _, err := parseSortSpec(tc.spec); err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+			t.Errorf("parseSortSpec(%q) error = %v, want one containing %q", tc.spec, err, tc.wantErr)
+		}
+	}
+}
+
+func TestResolveSortFlag(t *testing.T) {
+	tests := []struct {
+		spec        string
+		reverse     bool
+		wantSort    string
+		wantReverse bool
+	}{
+		{spec: "", wantSort: ""},
+		{spec: "priority", wantSort: "priority"},
+		{spec: "priority:asc", wantSort: "priority"},
+		{spec: "priority:desc", wantSort: "priority", wantReverse: true},
+		{spec: "priority:desc", reverse: true, wantSort: "priority"},
+		{spec: "created_at:desc", wantSort: "created"},
+		{spec: "created_at:asc", wantSort: "created", wantReverse: true},
+		{spec: "priority,created_at", wantSort: "priority,created"},
+		{spec: "priority,created_at", reverse: true, wantSort: "priority,created", wantReverse: true},
+	}
+	for _, tt := range tests {
+		sortBy, reverse, err := resolveSortFlag(tt.spec, tt.reverse)
+		if err != nil {
+			t.Fatalf("resolveSortFlag(%q): %v", tt.spec, err)
+		}
+		if sortBy != tt.wantSort || reverse != tt.wantReverse {
+			t.Errorf("resolveSortFlag(%q, %v) = (%q, %v), want (%q, %v)", tt.spec, tt.reverse, sortBy, reverse, tt.wantSort, tt.wantReverse)
+		}
+	}
+	if _, _, err := resolveSortFlag("nonexistent_field", false); err == nil {
+		t.Error("an unknown field should be rejected")
+	}
+}
+
+func TestSortIssuesMultiKey(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	due := base.Add(48 * time.Hour)
+	issues := []*types.Issue{
+		{ID: "bd-1", Priority: 2, CreatedAt: base},
+		{ID: "bd-2", Priority: 1, CreatedAt: base},
+		{ID: "bd-3", Priority: 1, CreatedAt: base.Add(time.Hour)},
+		{ID: "bd-4", Priority: 2, CreatedAt: base.Add(time.Hour), DueAt: &due},
+	}
+	ids := func() string {
+		out := make([]string, len(issues))
+		for i, issue := range issues {
+			out[i] = issue.ID
+		}
+		return strings.Join(out, ",")
+	}
+
+	sortIssues(issues, "priority,created", false)
+	if got := ids(); got != "bd-3,bd-2,bd-4,bd-1" {
+		t.Errorf("priority,created (newest first within a priority) = %s", got)
+	}
+	sortIssues(issues, "priority,created:asc", false)
+	if got := ids(); got != "bd-2,bd-3,bd-1,bd-4" {
+		t.Errorf("priority,created:asc = %s", got)
+	}
+	sortIssues(issues, "priority:desc,created:asc", false)
+	if got := ids(); got != "bd-1,bd-4,bd-2,bd-3" {
+		t.Errorf("priority:desc,created:asc = %s", got)
+	}
+	sortIssues(issues, "priority,created:asc", true)
+	if got := ids(); got != "bd-4,bd-1,bd-3,bd-2" {
+		t.Errorf("--reverse should flip the whole multi-key order, got %s", got)
+	}
+	sortIssues(issues, "due,id", false)
+	if got := ids(); got != "bd-4,bd-1,bd-2,bd-3" {
+		t.Errorf("due should put dated issues first, then break ties by id, got %s", got)
+	}
+}
@@ -669,6 +669,21 @@ func TestListLabelFiltersAcnquj(t *testing.T) {
 		}
 	})
 
+	// --label-pattern '!glob' excludes issues with any matching label.
+	t.Run("label_pattern_negated", func(t *testing.T) {
+		results, err := s.SearchIssues(ctx, "", types.IssueFilter{LabelPattern: "!tech-*"})
+		if err != nil {
+			t.Fatalf("search: %v", err)
+		}
+		got := idsOf(results)
+		if got[techDebt.ID] || got[techLegacy.ID] {
+			t.Errorf("tech-* labeled issues should be excluded by !tech-*, got %v", got)
+		}
+		if !got[apple.ID] || !got[rock.ID] {
+			t.Errorf("issues without a tech-* label (labeled or not) should remain, got %v", got)
+		}
+	})
+
 	// AC#4: --title-contains case-insensitive substring.
 	t.Run("title_contains_case_insensitive", func(t *testing.T) {
 		results, err := s.SearchIssues(ctx, "", types.IssueFilter{TitleContains: "PIE"})
@@ -1339,6 +1354,43 @@ func TestParseTimeFlag(t *testing.T) {
 	}
 }
 
+func TestParseTimeFlagAtUTC(t *testing.T) {
+	t.Parallel()
+	// Friday 2026-10-16 14:30 in a zone east of UTC, so the UTC conversion is visible.
+	zone := time.FixedZone("UTC+2", 2*60*60)
+	now := time.Date(2026, 10, 16, 14, 30, 0, 0, zone)
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"today", time.Date(2026, 10, 16, 12, 30, 0, 0, time.UTC)},
+		{"tomorrow", time.Date(2026, 10, 17, 12, 30, 0, 0, time.UTC)},
+		{"+3d", time.Date(2026, 10, 19, 12, 30, 0, 0, time.UTC)},
+		{"+1w", time.Date(2026, 10, 23, 12, 30, 0, 0, time.UTC)},
+		{"next friday", time.Date(2026, 10, 23, 12, 30, 0, 0, time.UTC)},
+		{"next monday", time.Date(2026, 10, 19, 12, 30, 0, 0, time.UTC)},
+		{" 2026-11-02 ", time.Date(2026, 11, 1, 22, 0, 0, 0, time.UTC)},
+		{"2026-11-02T09:00:00", time.Date(2026, 11, 2, 7, 0, 0, 0, time.UTC)},
+		{"2026-11-02T09:00:00Z", time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)},
+		{"2026-11-02T09:00:00-05:00", time.Date(2026, 11, 2, 14, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := parseTimeFlagAt(tt.input, now)
+		if err != nil {
+			t.Fatalf("parseTimeFlagAt(%q): %v", tt.input, err)
+		}
+		if got.Location() != time.UTC || !got.Equal(tt.want) {
+			t.Errorf("parseTimeFlagAt(%q) = %v, want %v in UTC", tt.input, got, tt.want)
+		}
+	}
+
+	_, err := parseTimeFlagAt("whenever", now)
+	if err == nil || !strings.Contains(err.Error(), timeFlagExamples) {
+		t.Errorf("an unparseable expression should be rejected with examples, got %v", err)
+	}
+}
+
 // TestListTimeBasedFilters tests the time-based scheduling filters (GH#820)
 func TestListTimeBasedFilters(t *testing.T) {
 	t.Parallel()
@@ -438,7 +438,7 @@ func configCommandCanRunWithoutStore(cmd *cobra.Command, args []string) bool {
 	}
 
 	switch cmd.Name() {
-	case "show", "validate", "drift", "apply":
+	case "show", "validate", "drift", "apply", "backend-info":
 		return true
 	case "set", "get", "unset":
 		if len(args) == 0 {
@@ -621,6 +621,7 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&doltAutoCommit, "dolt-auto-commit", "", "Dolt auto-commit policy (off|on|batch). 'on': commit after each write. 'batch': defer commits to bd dolt commit; uncommitted changes persist in the working set until then. SIGTERM/SIGHUP flush pending batch commits. Default: off. Override via config key dolt.auto-commit")
 	rootCmd.PersistentFlags().BoolVar(&profileEnabled, "profile", false, "Generate CPU profile for performance analysis")
 	rootCmd.PersistentFlags().StringVar(&memProfilePath, "mem-profile", "", "Write heap profile to FILE on exit (also respects BEADS_MEM_PROFILE)")
+	rootCmd.PersistentFlags().BoolVar(&timingFlag, "timing", false, "Print wall-clock time for server-ensure, query, and render phases to stderr")
 	rootCmd.PersistentFlags().BoolVarP(&verboseFlag, "verbose", "v", false, "Enable verbose/debug output")
 	rootCmd.PersistentFlags().BoolVarP(&quietFlag, "quiet", "q", false, "Suppress non-essential output (errors only)")
 	rootCmd.PersistentFlags().BoolVar(&ignoreSchemaSkew, "ignore-schema-skew", false, "Proceed despite forward schema drift (some queries may fail)")
@@ -718,6 +719,13 @@ var rootCmd = &cobra.Command{
 		// Initialize CommandContext to hold runtime state (replaces scattered globals)
 		initCommandContext()
 
+		// Start the --timing stopwatch before any store work so server-ensure
+		// is measured from the top of the command.
+		cmdTimer = nil
+		if timingFlag {
+			cmdTimer = newCommandTimer()
+		}
+
 		// Reset per-command write tracking (used by Dolt auto-commit).
 		commandDidWrite.Store(false)
 		commandMayEmptyJSONLExport.Store(false)
@@ -1259,6 +1267,7 @@ var rootCmd = &cobra.Command{
 		// In proxied mode the CLI short-circuits to the uowProvider path and
 		// dispatches through the *_proxied_server.go duals.
 		if proxiedServerMode {
+			beginTimingPhase(timingPhaseServerEnsure)
 			p, err := newProxiedServerUOWProvider(rootCtx, beadsDir)
 			if err != nil {
 				return HandleError("failed to open uow provider: %v", err)
@@ -1268,6 +1277,7 @@ var rootCmd = &cobra.Command{
 			reconcileVersionProxiedServer(rootCtx)
 
 			syncCommandContext()
+			beginTimingPhase(timingPhaseQuery)
 			return nil
 		}
 
@@ -1292,6 +1302,7 @@ var rootCmd = &cobra.Command{
 		// Removing them WILL cause unrecoverable data corruption and data loss.
 		// Dolt manages these files itself; external interference is never safe.
 
+		beginTimingPhase(timingPhaseServerEnsure)
 		store, err = newDoltStore(rootCtx, doltCfg)
 
 		// Track final read-only state for staleness checks (GH#1089)
@@ -1411,6 +1422,7 @@ var rootCmd = &cobra.Command{
 
 		// Tips (including sync conflict proactive checks) are shown via maybeShowTip()
 		// after successful command execution, not in PreRun
+		beginTimingPhase(timingPhaseQuery)
 		return nil
 	},
 	PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
@@ -1493,6 +1505,8 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
+		flushCommandTiming()
+
 		// End the command span and flush OTel data before process exit.
 		if commandSpan != nil {
 			commandSpan.End()
@@ -1780,6 +1794,8 @@ func main() {
 	// there never runs -- without this the process would exit while the drain
 	// goroutine may be mid-dispatch. Idempotent (no-op if already joined).
 	joinSpoolDrain()
+	// Same backstop for the --timing report (idempotent).
+	flushCommandTiming()
 
 	// Finalize queued metrics and detach the uploader. Shared with the os.Exit
 	// guards (CheckReadonly and the pre-run gates) so every exit path flushes the
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// markdownOptions is the validated flag set of --format md.
+type markdownOptions struct {
+	linkBase string // --md-links; empty renders plain IDs
+}
+
+// registerMarkdownFlags adds the --format md flags to cmd.
+func registerMarkdownFlags(cmd *cobra.Command) {
+	cmd.Flags().String("md-links", "", "With --format md, link each ID to <baseurl>/<id>")
+}
+
+// readMarkdownOptions returns the Markdown options when format is md, and nil
+// otherwise. --md-links is rejected without --format md.
+func readMarkdownOptions(cmd *cobra.Command, format string) (*markdownOptions, error) {
+	if !strings.EqualFold(format, "md") {
+		if cmd.Flags().Changed("md-links") {
+			return nil, fmt.Errorf("--md-links requires --format md")
+		}
+		return nil, nil
+	}
+	if jsonOutput {
+		return nil, fmt.Errorf("--format md cannot be combined with --json")
+	}
+	opts := &markdownOptions{}
+	opts.linkBase, _ = cmd.Flags().GetString("md-links")
+	opts.linkBase = strings.TrimSpace(opts.linkBase)
+	return opts, nil
+}
+
+// writeIssuesMarkdown writes issues as a GitHub-flavored Markdown table. IDs
+// are code spans so Markdown leaves them alone; cell text has pipes escaped
+// and line breaks flattened so each issue stays on one row.
+func writeIssuesMarkdown(w io.Writer, issues []*types.Issue, opts *markdownOptions) error {
+	var b strings.Builder
+	b.WriteString("| ID | Title | Status | Priority | Assignee |\n")
+	b.WriteString("| --- | --- | --- | --- | --- |\n")
+	for _, issue := range issues {
+		id := "`" + issue.ID + "`"
+		if opts.linkBase != "" {
+			id = fmt.Sprintf("[%s](%s/%s)", id, strings.TrimRight(opts.linkBase, "/"), url.PathEscape(issue.ID))
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | P%d | %s |\n", id, markdownCell(issue.Title),
+			markdownCell(string(issue.Status)), issue.Priority, markdownCell(issue.Assignee))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownCell makes s safe inside a table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	return strings.NewReplacer("\n", " ", "\r", " ").Replace(s)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestWriteIssuesMarkdown(t *testing.T) {
+	issues := []*types.Issue{
+		{ID: "bd-1", Title: "Split a|b\nacross lines", Status: types.StatusOpen, Priority: 1, Assignee: "alice"},
+		{ID: "bd-2.1", Title: `Keep C:\tmp`, Status: types.StatusBlocked, Priority: 3},
+	}
+
+	var buf bytes.Buffer
+	if err := writeIssuesMarkdown(&buf, issues, &markdownOptions{}); err != nil {
+		t.Fatalf("writeIssuesMarkdown: %v", err)
+	}
+	want := "| ID | Title | Status | Priority | Assignee |\n" +
+		"| --- | --- | --- | --- | --- |\n" +
+		"| `bd-1` | Split a\\|b across lines | open | P1 | alice |\n" +
+		"| `bd-2.1` | Keep C:\\\\tmp | blocked | P3 |  |\n"
+	if buf.String() != want {
+		t.Errorf("table =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := writeIssuesMarkdown(&buf, issues[:1], &markdownOptions{linkBase: "https://example.com/issues/"}); err != nil {
+		t.Fatalf("writeIssuesMarkdown: %v", err)
+	}
+	if !strings.Contains(buf.String(), "| [`bd-1`](https://example.com/issues/bd-1) |") {
+		t.Errorf("--md-links should link the ID:\n%s", buf.String())
+	}
+}
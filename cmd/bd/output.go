@@ -16,6 +16,7 @@ func jsonEnvelopeEnabled() bool {
 }
 
 func outputJSON(v interface{}) error {
+	beginTimingPhase(timingPhaseRender)
 	wrapped := wrapWithSchemaVersion(v)
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
@@ -403,9 +403,8 @@ type depEdge struct {
 // requireDepEdgesEqual asserts that the dependency objects contain exactly
 // the expected depends-on targets (order-independent).
 //
-// Handles two JSON formats:
-//   - list --json:  objects with "issue_id" and "depends_on_id" fields
-//   - show --json:  embedded Issue objects where "id" = the depends-on target
+// list and show --json both name an edge's endpoints "issue_id" and
+// "depends_on_id" (show embeds the depended-on issue alongside them).
 //
 // NOTE: This compares targets only, not dependency type (blocks vs
 // parent-child etc.). Current protocol tests create one dep type per
@@ -418,16 +417,11 @@ func requireDepEdgesEqual(t *testing.T, gotObjs []map[string]any, want []depEdge
 	for _, obj := range gotObjs {
 		issueID, _ := obj["issue_id"].(string)
 		dependsOn, _ := obj["depends_on_id"].(string)
-		// show --json embeds the depended-on issue directly; its "id" is the target.
-		if dependsOn == "" {
-			dependsOn, _ = obj["id"].(string)
-		}
 		got = append(got, depEdge{issueID: issueID, dependsOnID: dependsOn})
 	}
 
-	// Compare only the depends_on_id targets. The issue_id may be empty in
-	// the show --json format (it's implicit from the parent), so we compare
-	// just the target set to stay format-agnostic.
+	// Compare only the depends_on_id targets: every edge passed in belongs to
+	// the same issue, so its issue_id adds nothing.
 	gotTargets := make([]string, len(got))
 	for i, e := range got {
 		gotTargets[i] = e.dependsOnID
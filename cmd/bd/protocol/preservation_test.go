@@ -175,9 +175,6 @@ func TestProtocol_DeleteCleansUpDeps(t *testing.T) {
 		deps := getObjectSlice(issue, "dependencies")
 		for _, dep := range deps {
 			depID, _ := dep["depends_on_id"].(string)
-			if depID == "" {
-				depID, _ = dep["id"].(string)
-			}
 			if depID == idB {
 				t.Errorf("issue %s still has dangling dependency on deleted %s", survivorID, idB)
 			}
@@ -188,18 +188,12 @@ func TestProtocol_ParentChildDepShowRoundTrip(t *testing.T) {
 		childDeps := getObjectSlice(childIssue, "dependencies")
 		found := false
 		for _, dep := range childDeps {
-			// show --json embeds the depended-on issue; "id" is the target
-			depID, _ := dep["id"].(string)
-			if depID == "" {
-				depID, _ = dep["depends_on_id"].(string)
-			}
+			// show --json names both endpoints of every edge
+			depID, _ := dep["depends_on_id"].(string)
 			if depID == parent {
 				found = true
 				// Verify it's a parent-child type
 				depType, _ := dep["dependency_type"].(string)
-				if depType == "" {
-					depType, _ = dep["type"].(string)
-				}
 				if depType != "parent-child" {
 					t.Errorf("child→parent dep type = %q, want %q", depType, "parent-child")
 				}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/listjson"
 	"github.com/steveyegge/beads/internal/metrics"
 	"github.com/steveyegge/beads/internal/query"
 	"github.com/steveyegge/beads/internal/types"
@@ -73,7 +74,11 @@ Examples:
   bd query "assignee=none AND type=task"
   bd query "created>30d AND status!=closed"
   bd query "label=frontend OR label=backend"
-  bd query "title=authentication AND priority=0"`,
+  bd query "title=authentication AND priority=0"
+
+Use --explain to print the SQL a query would run instead of running it.
+Filter values are shown as ? placeholders with their bindings listed
+separately, never interpolated into the statement.`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -99,11 +104,19 @@ Examples:
 		queryStr := strings.Join(args, " ")
 
 		limit, _ := cmd.Flags().GetInt("limit")
+		if err := limitFlagError(limit); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
 		allFlag, _ := cmd.Flags().GetBool("all")
 		longFormat, _ := cmd.Flags().GetBool("long")
 		sortBy, _ := cmd.Flags().GetString("sort")
 		reverse, _ := cmd.Flags().GetBool("reverse")
+		sortBy, reverse, err := resolveSortFlag(sortBy, reverse)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
 		parseOnly, _ := cmd.Flags().GetBool("parse-only")
+		explain, _ := cmd.Flags().GetBool("explain")
 		offset, _ := cmd.Flags().GetInt("offset")
 		if offset < 0 {
 			return HandleErrorRespectJSON("--offset must be non-negative")
@@ -136,12 +149,6 @@ Examples:
 			result.Filter.ExcludeStatus = append(result.Filter.ExcludeStatus, types.StatusClosed)
 		}
 
-		ctx := rootCtx
-
-		if store == nil {
-			return HandleErrorRespectJSON("no storage available")
-		}
-
 		searchFilter := result.Filter
 		if result.RequiresPredicate && limit > 0 {
 			searchFilter.Limit = limit * 3
@@ -150,6 +157,16 @@ Examples:
 			}
 		}
 
+		if explain {
+			return runQueryExplain(queryStr, searchFilter, result.RequiresPredicate)
+		}
+
+		ctx := rootCtx
+
+		if store == nil {
+			return HandleErrorRespectJSON("no storage available")
+		}
+
 		if jsonOutput {
 			iwc, err := store.SearchIssuesWithCounts(ctx, "", searchFilter)
 			if err != nil {
@@ -174,7 +191,11 @@ Examples:
 			if iwc == nil {
 				iwc = []*types.IssueWithCounts{}
 			}
-			return outputJSON(iwc)
+			payload, err := storeListJSONPayload(ctx, store, iwc, listjson.Options{}, nil)
+			if err != nil {
+				return err
+			}
+			return outputJSON(payload)
 		}
 
 		issues, err := store.SearchIssues(ctx, "", searchFilter)
@@ -284,9 +305,10 @@ func init() {
 	queryCmd.Flags().Int("offset", 0, "Skip the first N matching results (0-based). Only supported under --proxied-server.")
 	queryCmd.Flags().BoolP("all", "a", false, "Include closed issues (default: exclude closed)")
 	queryCmd.Flags().Bool("long", false, "Show detailed multi-line output for each issue")
-	queryCmd.Flags().String("sort", "", "Sort by field: priority, created, updated, closed, status, id, title, type, assignee")
+	queryCmd.Flags().String("sort", "", "Sort by field: "+listSortFieldsHelp+"; add :asc or :desc for a direction, and separate keys with commas (e.g. priority,created:asc)")
 	queryCmd.Flags().BoolP("reverse", "r", false, "Reverse sort order")
 	queryCmd.Flags().Bool("parse-only", false, "Only parse the query and show the AST (for debugging)")
+	queryCmd.Flags().Bool("explain", false, "Print the SQL (with ? placeholders) and parameters the query would run, without running it")
 
 	rootCmd.AddCommand(queryCmd)
 }
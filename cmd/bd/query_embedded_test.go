@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -237,6 +238,19 @@ func TestEmbeddedQuery(t *testing.T) {
 		}
 	})
 
+	t.Run("query_explain", func(t *testing.T) {
+		out := bdQuery(t, bd, dir, "--explain", "priority<=1 AND type=bug")
+		if !strings.Contains(out, "WHERE status NOT IN (?) AND issue_type = ? AND priority <= ?") {
+			t.Errorf("expected the placeholder WHERE clause in --explain output: %s", out)
+		}
+		if !strings.Contains(out, `= "bug"`) || strings.Contains(out, "'bug'") {
+			t.Errorf("expected bug as a listed binding, not inlined SQL: %s", out)
+		}
+		if strings.Contains(out, "Found") {
+			t.Errorf("--explain should not run the query: %s", out)
+		}
+	})
+
 	// ===== Error Cases =====
 
 	t.Run("query_no_expression", func(t *testing.T) {
@@ -254,6 +268,28 @@ func TestEmbeddedQuery(t *testing.T) {
 		}
 	})
 
+	t.Run("query_json_matches_list", func(t *testing.T) {
+		epic := bdCreate(t, bd, dir, "Shape epic", "--type", "epic")
+		child := bdCreate(t, bd, dir, "Shape child", "--parent", epic.ID, "--label", "shape")
+		bdComment(t, bd, dir, child.ID, "a remark")
+
+		var listed []map[string]interface{}
+		out := bdList(t, bd, dir, "--json", "--id", child.ID)
+		if err := json.Unmarshal([]byte(out), &listed); err != nil || len(listed) != 1 {
+			t.Fatalf("parse list JSON (%v): %s", err, out)
+		}
+		queried := bdQueryJSON(t, bd, dir, "id="+child.ID)
+		if len(queried) != 1 {
+			t.Fatalf("expected 1 query result, got %d", len(queried))
+		}
+		if !reflect.DeepEqual(listed[0], queried[0]) {
+			t.Errorf("query and list objects differ:\nlist:  %v\nquery: %v", listed[0], queried[0])
+		}
+		if queried[0]["parent_id"] != epic.ID {
+			t.Errorf("query parent_id = %v, want %s", queried[0]["parent_id"], epic.ID)
+		}
+	})
+
 	_ = taskHigh
 	_ = bugMed
 	_ = featureLow
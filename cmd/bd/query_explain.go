@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// queryExplanation is what bd query --explain reports: the statements the
+// search would run, with filter values left as ? placeholders, and whether
+// part of the query is evaluated in-process after the rows come back.
+type queryExplanation struct {
+	Query      string             `json:"query"`
+	Statements []explainStatement `json:"statements"`
+	PostFilter bool               `json:"post_filter"`
+}
+
+// explainStatement is one SELECT against the issues or wisps table. Args are
+// the values bound to its placeholders, in order.
+type explainStatement struct {
+	Table string        `json:"table"`
+	SQL   string        `json:"sql"`
+	Args  []interface{} `json:"args"`
+}
+
+// explainQuery builds the explanation for a query whose evaluated filter is
+// filter. The statements follow the search's table routing: issues then
+// wisps, wisps first for ephemeral-only filters, and issues alone when wisps
+// are skipped. Only the ID-selecting statement is shown; hydration reads the
+// matched rows by ID.
+func explainQuery(queryStr string, filter types.IssueFilter, postFilter bool) (*queryExplanation, error) {
+	tables := []issueops.FilterTables{issueops.IssuesFilterTables, issueops.WispsFilterTables}
+	switch {
+	case filter.Ephemeral != nil && *filter.Ephemeral:
+		tables = []issueops.FilterTables{issueops.WispsFilterTables, issueops.IssuesFilterTables}
+	case filter.SkipWisps:
+		tables = tables[:1]
+	}
+	exp := &queryExplanation{Query: queryStr, PostFilter: postFilter}
+	for _, t := range tables {
+		sql, args, err := issueops.ExplainSearchSQL("", filter, t)
+		if err != nil {
+			return nil, err
+		}
+		if args == nil {
+			args = []interface{}{}
+		}
+		exp.Statements = append(exp.Statements, explainStatement{Table: t.Main, SQL: sql, Args: args})
+	}
+	return exp, nil
+}
+
+// runQueryExplain prints the explanation for bd query --explain. It needs no
+// store: the statements come from the same builder the search uses.
+func runQueryExplain(queryStr string, filter types.IssueFilter, postFilter bool) error {
+	exp, err := explainQuery(queryStr, filter, postFilter)
+	if err != nil {
+		return HandleErrorRespectJSON("explaining query: %v", err)
+	}
+	if jsonOutput {
+		return outputJSON(exp)
+	}
+	writeQueryExplanation(os.Stdout, exp)
+	return nil
+}
+
+// writeQueryExplanation prints exp for a terminal. Placeholders are never
+// filled in; each statement's bindings are listed under it.
+func writeQueryExplanation(w io.Writer, exp *queryExplanation) {
+	fmt.Fprintf(w, "Query: %s\n", exp.Query)
+	for _, st := range exp.Statements {
+		// The builder leaves doubled spaces where optional clauses are empty.
+		fmt.Fprintf(w, "\n%s:\n  %s\n", st.Table, strings.Join(strings.Fields(st.SQL), " "))
+		for i, arg := range st.Args {
+			fmt.Fprintf(w, "  ?%d = %s\n", i+1, formatExplainArg(arg))
+		}
+	}
+	if exp.PostFilter {
+		fmt.Fprintln(w, "\nPart of this query (OR or NOT across fields) cannot be expressed as SQL;")
+		fmt.Fprintln(w, "the statements select candidates and the full query is applied to them in-process.")
+	}
+}
+
+// formatExplainArg renders one binding, quoting string values (including
+// named string types such as types.IssueType) so empty strings stay visible.
+func formatExplainArg(arg interface{}) string {
+	if t, ok := arg.(time.Time); ok {
+		return t.UTC().Format(time.RFC3339)
+	}
+	if arg != nil && reflect.TypeOf(arg).Kind() == reflect.String {
+		return fmt.Sprintf("%q", arg)
+	}
+	return fmt.Sprintf("%v", arg)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/query"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestExplainQuery(t *testing.T) {
+	explain := func(t *testing.T, q string) (*queryExplanation, string) {
+		t.Helper()
+		node, err := query.Parse(q)
+		if err != nil {
+			t.Fatalf("parse %q: %v", q, err)
+		}
+		result, err := query.NewEvaluator(time.Now()).Evaluate(node)
+		if err != nil {
+			t.Fatalf("evaluate %q: %v", q, err)
+		}
+		exp, err := explainQuery(q, result.Filter, result.RequiresPredicate)
+		if err != nil {
+			t.Fatalf("explain %q: %v", q, err)
+		}
+		var buf bytes.Buffer
+		writeQueryExplanation(&buf, exp)
+		return exp, buf.String()
+	}
+
+	t.Run("placeholders", func(t *testing.T) {
+		exp, out := explain(t, "priority<=1 AND type=bug")
+		if !strings.Contains(out, "FROM issues WHERE issue_type = ? AND priority <= ?") {
+			t.Errorf("missing expected WHERE clause:\n%s", out)
+		}
+		if !strings.Contains(out, `?1 = "bug"`) || !strings.Contains(out, "?2 = 1") {
+			t.Errorf("missing bindings:\n%s", out)
+		}
+		for _, st := range exp.Statements {
+			if strings.Contains(st.SQL, "bug") {
+				t.Errorf("%s statement interpolates a value: %s", st.Table, st.SQL)
+			}
+		}
+		if exp.PostFilter || strings.Contains(out, "in-process") {
+			t.Errorf("an AND of simple comparisons needs no post-filter:\n%s", out)
+		}
+	})
+
+	t.Run("tables", func(t *testing.T) {
+		exp, _ := explain(t, "type=bug")
+		if len(exp.Statements) != 2 || exp.Statements[0].Table != "issues" || exp.Statements[1].Table != "wisps" {
+			t.Errorf("expected issues then wisps, got %+v", exp.Statements)
+		}
+		exp, _ = explain(t, "ephemeral=true")
+		if len(exp.Statements) != 2 || exp.Statements[0].Table != "wisps" {
+			t.Errorf("ephemeral-only queries search wisps first, got %+v", exp.Statements)
+		}
+	})
+
+	t.Run("post filter", func(t *testing.T) {
+		exp, out := explain(t, "status=open OR label=x")
+		if !exp.PostFilter || !strings.Contains(out, "in-process") {
+			t.Errorf("an OR across fields should report the in-process filter:\n%s", out)
+		}
+	})
+}
+
+func TestFormatExplainArg(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		arg  interface{}
+		want string
+	}{
+		{"", `""`},
+		{types.TypeBug, `"bug"`},
+		{2, "2"},
+		{at, "2026-01-02T03:04:05Z"},
+	}
+	for _, tt := range tests {
+		if got := formatExplainArg(tt.arg); got != tt.want {
+			t.Errorf("formatExplainArg(%#v) = %s, want %s", tt.arg, got, tt.want)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/steveyegge/beads/internal/listjson"
 	"github.com/steveyegge/beads/internal/query"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -25,11 +26,19 @@ func runQueryProxiedServer(cmd *cobra.Command, ctx context.Context, args []strin
 	queryStr := strings.Join(args, " ")
 
 	limit, _ := cmd.Flags().GetInt("limit")
+	if err := limitFlagError(limit); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
 	allFlag, _ := cmd.Flags().GetBool("all")
 	longFormat, _ := cmd.Flags().GetBool("long")
 	sortBy, _ := cmd.Flags().GetString("sort")
 	reverse, _ := cmd.Flags().GetBool("reverse")
+	sortBy, reverse, err := resolveSortFlag(sortBy, reverse)
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
 	parseOnly, _ := cmd.Flags().GetBool("parse-only")
+	explain, _ := cmd.Flags().GetBool("explain")
 	offset, _ := cmd.Flags().GetInt("offset")
 	if offset < 0 {
 		return HandleErrorRespectJSON("--offset must be non-negative")
@@ -76,6 +85,10 @@ func runQueryProxiedServer(cmd *cobra.Command, ctx context.Context, args []strin
 		}
 	}
 
+	if explain {
+		return runQueryExplain(queryStr, searchFilter, result.RequiresPredicate)
+	}
+
 	uw, err := openProxiedListUOW(ctx)
 	if err != nil {
 		return HandleErrorRespectJSON("%v", err)
@@ -109,7 +122,11 @@ func runQueryProxiedServer(cmd *cobra.Command, ctx context.Context, args []strin
 		if iwc == nil {
 			iwc = []*types.IssueWithCounts{}
 		}
-		if err := outputJSON(iwc); err != nil {
+		payload, err := proxiedListJSONPayload(ctx, uw, iwc, listjson.Options{}, nil)
+		if err != nil {
+			return err
+		}
+		if err := outputJSON(payload); err != nil {
 			return err
 		}
 		printTruncationHint(truncated, limit)
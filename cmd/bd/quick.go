@@ -46,8 +46,8 @@ Example:
 
 		title := strings.Join(args, " ")
 
-		priorityStr, _ := cmd.Flags().GetString("priority")
-		issueType, _ := cmd.Flags().GetString("type")
+		priorityStr := createFlagOrDefault(cmd, "priority", "create.default_priority")
+		issueType := createFlagOrDefault(cmd, "type", "create.default_type")
 		labels, _ := cmd.Flags().GetStringSlice("labels")
 		parentID, _ := cmd.Flags().GetString("parent")
 
@@ -103,8 +103,8 @@ Example:
 }
 
 func init() {
-	quickCmd.Flags().StringP("priority", "p", "2", "Priority (0-4 or P0-P4)")
-	quickCmd.Flags().StringP("type", "t", "task", "Issue type")
+	quickCmd.Flags().StringP("priority", "p", "2", "Priority (0-4 or P0-P4); default from create.default_priority when set")
+	quickCmd.Flags().StringP("type", "t", "task", "Issue type; default from create.default_type when set")
 	quickCmd.Flags().StringSliceP("labels", "l", []string{}, "Labels")
 	quickCmd.Flags().String("parent", "", "Parent issue ID for hierarchical child (e.g., 'bd-a3f8e9')")
 	rootCmd.AddCommand(quickCmd)
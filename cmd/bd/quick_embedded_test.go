@@ -98,6 +98,27 @@ func TestEmbeddedQuick(t *testing.T) {
 		}
 	})
 
+	t.Run("quick_configured_defaults", func(t *testing.T) {
+		cdDir, _, _ := bdInit(t, bd, "--prefix", "qd")
+		for _, kv := range [][2]string{{"create.default_priority", "P1"}, {"create.default_type", "bug"}} {
+			cmd := exec.Command(bd, "config", "set", kv[0], kv[1])
+			cmd.Dir = cdDir
+			cmd.Env = bdEnv(cdDir)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("bd config set %s failed: %v\n%s", kv[0], err, out)
+			}
+		}
+
+		got := bdShow(t, bd, cdDir, bdQuick(t, bd, cdDir, "Uses configured defaults"))
+		if got.Priority != 1 || got.IssueType != "bug" {
+			t.Errorf("no flags: got P%d %q, want P1 bug", got.Priority, got.IssueType)
+		}
+		explicit := bdShow(t, bd, cdDir, bdQuick(t, bd, cdDir, "Explicit flags win", "-p", "3", "-t", "task"))
+		if explicit.Priority != 3 || explicit.IssueType != "task" {
+			t.Errorf("explicit flags: got P%d %q, want P3 task", explicit.Priority, explicit.IssueType)
+		}
+	})
+
 	t.Run("quick_labels", func(t *testing.T) {
 		id := bdQuick(t, bd, dir, "Labeled quick", "-l", "urgent", "-l", "backend")
 		// Verify labels via show --json
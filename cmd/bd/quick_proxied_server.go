@@ -16,8 +16,8 @@ import (
 func runQuickProxiedServer(cmd *cobra.Command, ctx context.Context, args []string) error {
 	title := strings.Join(args, " ")
 
-	priorityStr, _ := cmd.Flags().GetString("priority")
-	issueType, _ := cmd.Flags().GetString("type")
+	priorityStr := createFlagOrDefault(cmd, "priority", "create.default_priority")
+	issueType := createFlagOrDefault(cmd, "type", "create.default_type")
 	labels, _ := cmd.Flags().GetStringSlice("labels")
 	parentID, _ := cmd.Flags().GetString("parent")
 
@@ -35,6 +35,12 @@ Use --gated to find molecules ready for gate-resume dispatch:
 Use --claim to atomically claim the first ready issue matching the filters:
   bd ready --claim --json
 
+Use --format csv for a spreadsheet-friendly export of the same list:
+  bd ready --format csv --columns id,title,priority,labels > ready.csv
+
+Use --format md for a Markdown table to paste into docs or PRs:
+  bd ready --format md --md-links https://tracker.example.com/issues
+
 This is useful for agents executing molecules to see which steps can run next.`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -69,12 +75,19 @@ This is useful for agents executing molecules to see which steps can run next.`,
 					return err
 				}
 			}
+			if claimReady {
+				reportWokenDeferrals(wakeExpiredDeferralsProxied(rootCtx))
+			}
 			return runReadyProxiedServer(cmd, rootCtx)
 		}
 
 		if offset, _ := cmd.Flags().GetInt("offset"); offset > 0 {
 			return HandleErrorRespectJSON("--offset is only supported under --proxied-server")
 		}
+		csvOpts, mdOpts, err := readyFormatOptions(cmd)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
 
 		gated, _ := cmd.Flags().GetBool("gated")
 		if gated {
@@ -103,6 +116,9 @@ This is useful for agents executing molecules to see which steps can run next.`,
 		}
 
 		limit, _ := cmd.Flags().GetInt("limit")
+		if err := limitFlagError(limit); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
 		assignee, _ := cmd.Flags().GetString("assignee")
 		unassigned, _ := cmd.Flags().GetBool("unassigned")
 		sortPolicy, _ := cmd.Flags().GetString("sort")
@@ -174,6 +190,9 @@ This is useful for agents executing molecules to see which steps can run next.`,
 		// Use Changed() to properly handle P0 (priority=0)
 		if cmd.Flags().Changed("priority") {
 			priority, _ := cmd.Flags().GetInt("priority")
+			if err := priorityFlagError(priority); err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
 			filter.Priority = &priority
 		}
 		if assignee != "" && !unassigned {
@@ -217,6 +236,9 @@ This is useful for agents executing molecules to see which steps can run next.`,
 		activeStore := store
 		if claimReady {
 			CheckReadonly("ready --claim")
+			// Claiming writes, so persist expired deferrals first: the
+			// claim only takes rows whose stored status is open.
+			reportWokenDeferrals(wakeExpiredDeferrals(ctx, store))
 		} else {
 			routedStore, routed, err := openRoutedReadStore(ctx, activeStore)
 			if err != nil {
@@ -238,7 +260,7 @@ This is useful for agents executing molecules to see which steps can run next.`,
 			}
 			if claimed == nil {
 				if jsonOutput {
-					return outputJSON([]*types.IssueWithCounts{})
+					return outputCanonicalJSON([]*types.IssueWithCounts{})
 				}
 				fmt.Printf("\n%s No ready work to claim\n\n", ui.RenderWarn("○"))
 				return nil
@@ -251,24 +273,35 @@ This is useful for agents executing molecules to see which steps can run next.`,
 			}
 			SetLastTouchedID(claimed.ID)
 			if jsonOutput {
-				return outputJSON(buildReadyIssueOutput(ctx, activeStore, []*types.Issue{claimed}))
+				return outputCanonicalJSON(buildReadyIssueOutput(ctx, activeStore, []*types.Issue{claimed}))
 			}
 			fmt.Printf("%s Claimed issue: %s\n", ui.RenderPass("✓"), formatFeedbackID(claimed.ID, claimed.Title))
 			return nil
 		}
 
+		// Fetch one row past the limit so a full page can tell "exactly N
+		// ready" from "more than N" and only size the ready set when there is
+		// more. LIMIT is pushed into SQL (ORDER BY included), so a small -n
+		// stops after N+1 ready rows instead of materializing the ready set.
+		probe := filter
+		probe.Limit, probe.MaxRows = fetchOneExtra(filter.Limit, filter.MaxRows)
+
 		if jsonOutput {
-			results, err := activeStore.GetReadyWorkWithCounts(ctx, filter)
+			results, err := activeStore.GetReadyWorkWithCounts(ctx, probe)
 			if err != nil {
 				if capErr := handleMaxRowsError(err); capErr != nil {
 					return capErr
 				}
 				return HandleErrorRespectJSON("%v", err)
 			}
+			hasMore := filter.Limit > 0 && len(results) > filter.Limit
+			if hasMore {
+				results = results[:filter.Limit]
+			}
 			totalReady := len(results)
 			truncated := false
-			if filter.Limit > 0 && len(results) == filter.Limit {
-				// The page is full, so there may be more ready work. Size the true
+			if hasMore {
+				// The probe row proved there is more ready work. Size the true
 				// total N over the same ready predicate, zeroing the limit so the
 				// count is the full ready set (byte-identical to
 				// len(GetReadyWorkWithCounts(Limit=0))). Prefer the cheap COUNT(*)
@@ -293,7 +326,7 @@ This is useful for agents executing molecules to see which steps can run next.`,
 			if results == nil {
 				results = []*types.IssueWithCounts{}
 			}
-			if jerr := outputJSON(results); jerr != nil {
+			if jerr := outputCanonicalJSON(results); jerr != nil {
 				return jerr
 			}
 			if truncated {
@@ -302,17 +335,21 @@ This is useful for agents executing molecules to see which steps can run next.`,
 			return nil
 		}
 
-		issues, err := activeStore.GetReadyWork(ctx, filter)
+		issues, err := activeStore.GetReadyWork(ctx, probe)
 		if err != nil {
 			if capErr := handleMaxRowsError(err); capErr != nil {
 				return capErr
 			}
 			return HandleErrorRespectJSON("%v", err)
 		}
+		hasMore := filter.Limit > 0 && len(issues) > filter.Limit
+		if hasMore {
+			issues = issues[:filter.Limit]
+		}
 
 		totalReady := len(issues)
 		truncated := false
-		if !jsonOutput && filter.Limit > 0 && len(issues) == filter.Limit {
+		if !jsonOutput && hasMore {
 			// sys-56cls: cheap COUNT(*) for the truncation footer instead of a
 			// second GetReadyWork(Limit=0) that materialized every ready row.
 			if all, countErr := activeStore.CountReadyWork(ctx, filter); countErr == nil && all > len(issues) {
@@ -320,11 +357,28 @@ This is useful for agents executing molecules to see which steps can run next.`,
 				truncated = true
 			}
 		}
+		beginTimingPhase(timingPhaseRender)
+		if csvOpts != nil || mdOpts != nil {
+			if csvOpts != nil {
+				err = outputIssuesCSV(ctx, issues, csvOpts, activeStore.GetLabelsForIssues)
+			} else {
+				err = writeIssuesMarkdown(os.Stdout, issues, mdOpts)
+			}
+			if err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
+			if truncated {
+				fmt.Fprintf(os.Stderr, "Showing %d of %d ready issues. Use -n to show more.\n", len(issues), totalReady)
+			}
+			return nil
+		}
 		maybeShowUpgradeNotification()
 
 		if len(issues) == 0 {
 			hasOpenIssues := false
-			if stats, statsErr := activeStore.GetStatistics(ctx); statsErr == nil {
+			// Only the open/in-progress counts matter here, so skip the
+			// blocked-set traversal GetStatistics would run.
+			if stats, statsErr := activeStore.GetStatisticsNoBlocked(ctx); statsErr == nil {
 				hasOpenIssues = stats.OpenIssues > 0 || stats.InProgressIssues > 0
 			}
 			if hasOpenIssues {
@@ -367,8 +421,27 @@ This is useful for agents executing molecules to see which steps can run next.`,
 	},
 }
 var blockedCmd = &cobra.Command{
-	Use:           "blocked",
-	Short:         "Show blocked issues",
+	Use:   "blocked",
+	Short: "Show blocked issues",
+	Long: `Show open issues that cannot start yet.
+
+By default this lists issues with an open blocker of their own. --transitive
+also lists the issues blocked only through a parent chain, e.g. the children
+and grandchildren of a blocked epic, which bd ready skips too.
+
+With --json every issue carries a "reason" array: one {"type", "id"} entry per
+open blocking dependency (type is the dependency type), or a single
+{"type": "parent", "id": <parent>} entry for an issue blocked through its
+parent.
+
+--parent accepts a partial ID, as bd show does; a parent that does not exist
+is an error rather than an empty list.
+
+Examples:
+  bd blocked
+  bd blocked --transitive --json
+  bd blocked --parent bd-epic
+  bd blocked --format md`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -379,18 +452,21 @@ var blockedCmd = &cobra.Command{
 			}
 		}()
 
+		mdOpts, err := blockedFormatOptions(cmd)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		filter, err := blockedWorkFilter(cmd)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
 		if usesProxiedServer() {
-			return runBlockedProxiedServer(cmd, rootCtx)
+			return runBlockedProxiedServer(rootCtx, filter, mdOpts)
 		}
 		// Use global jsonOutput set by PersistentPreRun (respects config.yaml + env vars)
 		// Use factory to respect backend configuration (bd-m2jr: SQLite fallback fix)
 		ctx := rootCtx
-		parentID, _ := cmd.Flags().GetString("parent")
-		var blockedFilter types.WorkFilter
-		if parentID != "" {
-			blockedFilter.ParentID = &parentID
-		}
-		blocked, err := store.GetBlockedIssues(ctx, blockedFilter)
+		blocked, err := store.GetBlockedIssues(ctx, filter)
 		if err != nil {
 			return HandleErrorRespectJSON("%v", err)
 		}
@@ -398,27 +474,78 @@ var blockedCmd = &cobra.Command{
 			if blocked == nil {
 				blocked = []*types.BlockedIssue{}
 			}
-			return outputJSON(blocked)
+			return outputCanonicalJSON(blocked)
+		}
+		beginTimingPhase(timingPhaseRender)
+		if mdOpts != nil {
+			return writeBlockedMarkdown(blocked, mdOpts)
 		}
 		if len(blocked) == 0 {
 			fmt.Printf("\n%s No blocked issues\n\n", ui.RenderPass("✨"))
 			return nil
 		}
-		fmt.Printf("\n%s Blocked issues (%d):\n\n", ui.RenderFail("🚫"), len(blocked))
-		for _, issue := range blocked {
-			fmt.Printf("[%s] %s: %s\n",
-				ui.RenderPriority(issue.Priority),
-				ui.RenderID(issue.ID), issue.Title)
+		printBlockedIssues(blocked)
+		return nil
+	},
+}
+
+// blockedWorkFilter builds the GetBlockedIssues filter for bd blocked. The
+// --parent value is resolved first, so a bogus parent is an error rather
+// than an empty result.
+func blockedWorkFilter(cmd *cobra.Command) (types.WorkFilter, error) {
+	var filter types.WorkFilter
+	if parentID, _ := cmd.Flags().GetString("parent"); parentID != "" {
+		fullID, err := resolveParentID(rootCtx, parentID)
+		if err != nil {
+			return filter, err
+		}
+		filter.ParentID = &fullID
+	}
+	transitive, _ := cmd.Flags().GetBool("transitive")
+	filter.DirectBlockedOnly = !transitive
+	return filter, nil
+}
+
+// blockedFormatOptions reads --format, which bd blocked supports for md only.
+func blockedFormatOptions(cmd *cobra.Command) (*markdownOptions, error) {
+	format, _ := cmd.Flags().GetString("format")
+	if format != "" && !strings.EqualFold(format, "md") {
+		return nil, fmt.Errorf("invalid --format %q (bd blocked supports md)", format)
+	}
+	return readMarkdownOptions(cmd, format)
+}
+
+// writeBlockedMarkdown renders bd blocked --format md. An empty result is
+// still a table, so pasted output never changes shape.
+func writeBlockedMarkdown(blocked []*types.BlockedIssue, opts *markdownOptions) error {
+	issues := make([]*types.Issue, len(blocked))
+	for i, b := range blocked {
+		issues[i] = &b.Issue
+	}
+	if err := writeIssuesMarkdown(os.Stdout, issues, opts); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	return nil
+}
+
+func printBlockedIssues(blocked []*types.BlockedIssue) {
+	fmt.Printf("\n%s Blocked issues (%d):\n\n", ui.RenderFail("🚫"), len(blocked))
+	for _, issue := range blocked {
+		fmt.Printf("[%s] %s: %s\n",
+			ui.RenderPriority(issue.Priority),
+			ui.RenderID(issue.ID), issue.Title)
+		if len(issue.Reason) == 1 && issue.Reason[0].Type == types.BlockedReasonParent {
+			fmt.Printf("  Blocked via parent %s\n", issue.Reason[0].ID)
+		} else {
 			blockedBy := issue.BlockedBy
 			if blockedBy == nil {
 				blockedBy = []string{}
 			}
 			fmt.Printf("  Blocked by %d open dependencies: %v\n",
 				issue.BlockedByCount, blockedBy)
-			fmt.Println()
 		}
-		return nil
-	},
+		fmt.Println()
+	}
 }
 
 // buildParentEpicMap builds a map from child issue ID to parent epic title.
@@ -781,7 +908,7 @@ type MoleculeReadyOutput struct {
 func init() {
 	readyCmd.Flags().IntP("limit", "n", 100, "Maximum issues to show (use 0 for unlimited)")
 	readyCmd.Flags().Int("offset", 0, "Skip the first N matching results (0-based). Only supported under --proxied-server.")
-	readyCmd.Flags().IntP("priority", "p", 0, "Filter by priority")
+	readyCmd.Flags().IntP("priority", "p", 0, "Filter by priority (0-4: 0=critical, 1=high, 2=medium, 3=low, 4=backlog)")
 	readyCmd.Flags().StringP("assignee", "a", "", "Filter by assignee")
 	readyCmd.Flags().BoolP("unassigned", "u", false, "Show only unassigned issues")
 	readyCmd.Flags().StringP("sort", "s", "priority", "Sort policy: priority (default), hybrid, oldest")
@@ -794,6 +921,9 @@ func init() {
 	readyCmd.Flags().String("mol-type", "", "Filter by molecule type: swarm, patrol, or work")
 	readyCmd.Flags().Bool("pretty", true, "Display issues in a tree format with status/priority symbols")
 	readyCmd.Flags().Bool("plain", false, "Display issues as a plain numbered list")
+	readyCmd.Flags().String("format", "", "Output format: 'csv' (see --columns) or 'md' (Markdown table, see --md-links)")
+	registerCSVFlags(readyCmd)
+	registerMarkdownFlags(readyCmd)
 	readyCmd.Flags().Bool("include-deferred", false, "Include issues with future defer_until timestamps")
 	readyCmd.Flags().Bool("include-ephemeral", false, "Include ephemeral issues (wisps) in results")
 	readyCmd.Flags().Bool("gated", false, "Find molecules ready for gate-resume dispatch")
@@ -805,7 +935,12 @@ func init() {
 	readyCmd.Flags().String("has-metadata-key", "", "Filter issues that have this metadata key set")
 	// Defensive row cap (be-x42v): exits 2 on overage, default disabled.
 	addMaxRowsFlag(readyCmd)
+	addLegacyKeysFlag(readyCmd)
 	rootCmd.AddCommand(readyCmd)
 	blockedCmd.Flags().String("parent", "", "Filter to descendants of this bead/epic")
+	blockedCmd.Flags().Bool("transitive", false, "Also list issues blocked only through a chain of blocked parents")
+	blockedCmd.Flags().String("format", "", "Output format: 'md' (Markdown table, see --md-links)")
+	registerMarkdownFlags(blockedCmd)
+	addLegacyKeysFlag(blockedCmd)
 	rootCmd.AddCommand(blockedCmd)
 }
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/uow"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// readyDeltaSource is the read surface a ready-set snapshot needs. Both
+// storage.DoltStorage and proxiedReadyDeltaSource satisfy it.
+type readyDeltaSource interface {
+	GetReadyWork(ctx context.Context, filter types.WorkFilter) ([]*types.Issue, error)
+	GetBlockedIssues(ctx context.Context, filter types.WorkFilter) ([]*types.BlockedIssue, error)
+}
+
+// proxiedReadyDeltaSource adapts a proxied-server unit of work to
+// readyDeltaSource.
+type proxiedReadyDeltaSource struct {
+	uw uow.UnitOfWork
+}
+
+func (s proxiedReadyDeltaSource) GetReadyWork(ctx context.Context, filter types.WorkFilter) ([]*types.Issue, error) {
+	page, err := s.uw.IssueUseCase().GetReadyWork(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+func (s proxiedReadyDeltaSource) GetBlockedIssues(ctx context.Context, filter types.WorkFilter) ([]*types.BlockedIssue, error) {
+	return s.uw.IssueUseCase().GetBlockedIssues(ctx, filter)
+}
+
+// readySnapshot records which issues were ready and which were blocked at one
+// point in time, with the same filters bd ready and bd blocked use by default.
+type readySnapshot struct {
+	ready   map[string]*types.Issue
+	blocked map[string]*types.Issue
+}
+
+func takeReadySnapshot(ctx context.Context, src readyDeltaSource) (readySnapshot, error) {
+	ready, err := src.GetReadyWork(ctx, types.WorkFilter{Status: types.StatusOpen})
+	if err != nil {
+		return readySnapshot{}, fmt.Errorf("get ready work: %w", err)
+	}
+	blocked, err := src.GetBlockedIssues(ctx, types.WorkFilter{})
+	if err != nil {
+		return readySnapshot{}, fmt.Errorf("get blocked issues: %w", err)
+	}
+	snap := readySnapshot{
+		ready:   make(map[string]*types.Issue, len(ready)),
+		blocked: make(map[string]*types.Issue, len(blocked)),
+	}
+	for _, issue := range ready {
+		snap.ready[issue.ID] = issue
+	}
+	for _, b := range blocked {
+		snap.blocked[b.ID] = &b.Issue
+	}
+	return snap, nil
+}
+
+// readyDelta is the change in ready and blocked work caused by a close or
+// reopen, as emitted by --ready-delta.
+type readyDelta struct {
+	NewlyReady   []*types.Issue `json:"newly_ready"`
+	NewlyBlocked []*types.Issue `json:"newly_blocked"`
+}
+
+// readyDeltaBetween lists the issues that are ready (blocked) in after but
+// were not in before, ordered by ID. Both lists are non-nil so the JSON keys
+// are always arrays.
+func readyDeltaBetween(before, after readySnapshot) readyDelta {
+	added := func(was, now map[string]*types.Issue) []*types.Issue {
+		out := []*types.Issue{}
+		for id, issue := range now {
+			if _, ok := was[id]; !ok {
+				out = append(out, issue)
+			}
+		}
+		sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+		return out
+	}
+	return readyDelta{
+		NewlyReady:   added(before.ready, after.ready),
+		NewlyBlocked: added(before.blocked, after.blocked),
+	}
+}
+
+// validateReadyDeltaFlags rejects --ready-delta alongside the close flags
+// that already replace the --json shape.
+func validateReadyDeltaFlags(cmd *cobra.Command) error {
+	if on, _ := cmd.Flags().GetBool("ready-delta"); !on {
+		return nil
+	}
+	for _, name := range []string{"suggest-next", "continue", "claim-next"} {
+		if set, _ := cmd.Flags().GetBool(name); set {
+			return fmt.Errorf("--ready-delta cannot be combined with --%s", name)
+		}
+	}
+	return nil
+}
+
+// printReadyDelta renders a readyDelta for human output.
+func printReadyDelta(delta readyDelta) {
+	if len(delta.NewlyReady) > 0 {
+		fmt.Printf("\nNewly ready:\n")
+		for _, issue := range delta.NewlyReady {
+			fmt.Printf("  • %s (P%d)\n", formatFeedbackID(issue.ID, issue.Title), issue.Priority)
+		}
+	}
+	if len(delta.NewlyBlocked) > 0 {
+		fmt.Printf("\n%s Newly blocked:\n", ui.RenderWarn("⚠"))
+		for _, issue := range delta.NewlyBlocked {
+			fmt.Printf("  • %s (P%d)\n", formatFeedbackID(issue.ID, issue.Title), issue.Priority)
+		}
+	}
+}
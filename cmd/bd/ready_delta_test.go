@@ -0,0 +1,44 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestReadyDeltaBetween(t *testing.T) {
+	issue := func(id string) *types.Issue { return &types.Issue{ID: id} }
+	snapshot := func(ready, blocked []string) readySnapshot {
+		s := readySnapshot{ready: map[string]*types.Issue{}, blocked: map[string]*types.Issue{}}
+		for _, id := range ready {
+			s.ready[id] = issue(id)
+		}
+		for _, id := range blocked {
+			s.blocked[id] = issue(id)
+		}
+		return s
+	}
+	ids := func(issues []*types.Issue) []string {
+		out := []string{}
+		for _, i := range issues {
+			out = append(out, i.ID)
+		}
+		return out
+	}
+
+	before := snapshot([]string{"bd-1", "bd-2"}, []string{"bd-3", "bd-4", "bd-5"})
+	after := snapshot([]string{"bd-2", "bd-5", "bd-3"}, []string{"bd-4", "bd-6"})
+	delta := readyDeltaBetween(before, after)
+
+	if got := ids(delta.NewlyReady); len(got) != 2 || got[0] != "bd-3" || got[1] != "bd-5" {
+		t.Errorf("NewlyReady = %v, want [bd-3 bd-5]", got)
+	}
+	if got := ids(delta.NewlyBlocked); len(got) != 1 || got[0] != "bd-6" {
+		t.Errorf("NewlyBlocked = %v, want [bd-6]", got)
+	}
+
+	empty := readyDeltaBetween(before, before)
+	if empty.NewlyReady == nil || empty.NewlyBlocked == nil {
+		t.Errorf("no-change delta should hold empty, non-nil slices: %+v", empty)
+	}
+}
@@ -4,11 +4,13 @@ package main
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -109,6 +111,55 @@ func TestEmbeddedReady(t *testing.T) {
 		}
 	})
 
+	t.Run("ready_negative_limit", func(t *testing.T) {
+		cmd := exec.Command(bd, "ready", "-n", "-1")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		out, err := cmd.CombinedOutput()
+		if err == nil {
+			t.Fatalf("bd ready -n -1 should exit non-zero, got: %s", out)
+		}
+		if !strings.Contains(string(out), "--limit must be >= 0") {
+			t.Errorf("expected a negative limit error, got: %s", out)
+		}
+	})
+
+	t.Run("ready_priority_range", func(t *testing.T) {
+		cmd := exec.Command(bd, "ready", "--priority", "5")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		out, err := cmd.CombinedOutput()
+		if err == nil {
+			t.Fatalf("bd ready --priority 5 should exit non-zero, got: %s", out)
+		}
+		if !strings.Contains(string(out), "--priority must be between 0 and 4") {
+			t.Errorf("expected a priority range error, got: %s", out)
+		}
+
+		backlog := bdCreate(t, bd, dir, "Ready backlog item", "--type", "task", "--priority", "4")
+		cmd = exec.Command(bd, "ready", "--json", "--priority", "4")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd ready --json --priority 4 failed: %v\nstderr:\n%s", err, stderr.String())
+		}
+		var issues []*types.IssueWithCounts
+		if err := json.Unmarshal([]byte(stdout.String()[max(strings.Index(stdout.String(), "["), 0):]), &issues); err != nil {
+			t.Fatalf("parse bd ready --json: %v\n%s", err, stdout.String())
+		}
+		found := false
+		for _, issue := range issues {
+			if issue.Priority != 4 {
+				t.Errorf("--priority 4 returned %s at P%d", issue.ID, issue.Priority)
+			}
+			found = found || issue.ID == backlog.ID
+		}
+		if !found {
+			t.Errorf("--priority 4 should return %s", backlog.ID)
+		}
+	})
+
 	t.Run("ready_claim_json", func(t *testing.T) {
 		issue := bdCreate(t, bd, dir, "Ready claim json", "--type", "task", "--label", "ready-claim-json")
 
@@ -182,6 +233,104 @@ func TestEmbeddedReady(t *testing.T) {
 		}
 	})
 
+	t.Run("list_ready_matches_ready", func(t *testing.T) {
+		pdir, _, _ := bdInit(t, bd, "--prefix", "rp")
+		// Chain: tail is blocked by mid, which is blocked by head.
+		head := bdCreate(t, bd, pdir, "Chain head", "--type", "task", "--priority", "2")
+		mid := bdCreate(t, bd, pdir, "Chain mid", "--type", "task", "--priority", "0")
+		tail := bdCreate(t, bd, pdir, "Chain tail", "--type", "task", "--priority", "0")
+		bdDep(t, bd, pdir, "add", mid.ID, head.ID)
+		bdDep(t, bd, pdir, "add", tail.ID, mid.ID)
+		// A blocked epic blocks its child too.
+		blocker := bdCreate(t, bd, pdir, "Epic blocker", "--type", "task", "--priority", "3")
+		epic := bdCreate(t, bd, pdir, "Blocked epic", "--type", "epic", "--priority", "1")
+		bdCreate(t, bd, pdir, "Child of blocked epic", "--type", "task", "--priority", "0", "--parent", epic.ID)
+		bdDep(t, bd, pdir, "add", epic.ID, blocker.ID)
+		bdCreate(t, bd, pdir, "Free low", "--type", "task", "--priority", "4")
+		bdCreate(t, bd, pdir, "Free high", "--type", "task", "--priority", "1")
+
+		readyIDs := func(args ...string) []string {
+			cmd := exec.Command(bd, append([]string{"ready", "--json"}, args...)...)
+			cmd.Dir = pdir
+			cmd.Env = bdEnv(pdir)
+			stdout, stderr, err := runCommandBuffers(t, cmd)
+			if err != nil {
+				t.Fatalf("bd ready --json %v failed: %v\nstderr:\n%s", args, err, stderr.String())
+			}
+			out := stdout.String()
+			var issues []*types.IssueWithCounts
+			if err := json.Unmarshal([]byte(out[max(strings.Index(out, "["), 0):]), &issues); err != nil {
+				t.Fatalf("parse bd ready --json: %v\n%s", err, out)
+			}
+			var ids []string
+			for _, issue := range issues {
+				ids = append(ids, issue.ID)
+			}
+			slices.Sort(ids)
+			return ids
+		}
+		listReadyIDs := func(args ...string) []string {
+			var ids []string
+			for _, issue := range bdListJSON(t, bd, pdir, append([]string{"--ready"}, args...)...) {
+				ids = append(ids, issue.ID)
+			}
+			slices.Sort(ids)
+			return ids
+		}
+
+		all := readyIDs("-n", "0")
+		if !slices.Contains(all, head.ID) || !slices.Contains(all, blocker.ID) ||
+			slices.Contains(all, mid.ID) || slices.Contains(all, tail.ID) || slices.Contains(all, epic.ID) {
+			t.Fatalf("bd ready should hold the chain head and the epic blocker but no blocked issue, got %v", all)
+		}
+		for _, n := range []string{"0", "2"} {
+			if got, want := listReadyIDs("-n", n), readyIDs("-n", n); !slices.Equal(got, want) {
+				t.Errorf("-n %s: bd list --ready = %v, bd ready = %v", n, got, want)
+			}
+		}
+	})
+
+	// ===== --format csv =====
+
+	t.Run("ready_format_csv", func(t *testing.T) {
+		ready := bdCreate(t, bd, dir, "CSV ready, with comma", "--type", "task", "--label", "csv-ready", "--label", "extra")
+		blocker := bdCreate(t, bd, dir, "CSV blocker", "--type", "task", "--label", "csv-ready")
+		blocked := bdCreate(t, bd, dir, "CSV blocked", "--type", "task", "--label", "csv-ready")
+		bdDepAdd(t, bd, dir, blocked.ID, blocker.ID)
+
+		cmd := exec.Command(bd, "ready", "--format", "csv", "--label", "csv-ready", "--columns", "id,title,labels", "--csv-sep", "|")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd ready --format csv failed: %v\nstdout:\n%s\nstderr:\n%s", err, stdout.String(), stderr.String())
+		}
+		records, err := csv.NewReader(&stdout).ReadAll()
+		if err != nil {
+			t.Fatalf("parse CSV: %v\n%s", err, stdout.String())
+		}
+		rows := make(map[string][]string)
+		for _, record := range records[1:] {
+			rows[record[0]] = record
+		}
+		if !slices.Equal(records[0], []string{"id", "title", "labels"}) {
+			t.Errorf("header = %q", records[0])
+		}
+		if got := rows[ready.ID]; got == nil || got[1] != "CSV ready, with comma" || got[2] != "csv-ready|extra" {
+			t.Errorf("%s row = %q, want its title and labels joined by |", ready.ID, got)
+		}
+		if _, ok := rows[blocked.ID]; ok {
+			t.Errorf("blocked issue %s should not be in the ready CSV", blocked.ID)
+		}
+
+		cmd = exec.Command(bd, "ready", "--format", "csv", "--claim")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		if out, err := cmd.CombinedOutput(); err == nil || !strings.Contains(string(out), "cannot be combined with --claim") {
+			t.Errorf("--format csv --claim: err=%v\n%s", err, out)
+		}
+	})
+
 	// ===== Exclude Label =====
 
 	t.Run("ready_exclude_label", func(t *testing.T) {
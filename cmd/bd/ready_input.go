@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -23,6 +24,8 @@ type readyInput struct {
 	plainFormat  bool
 	parentID     string
 	jsonOut      bool
+	csv          *csvOptions      // --format csv
+	md           *markdownOptions // --format md
 }
 
 func gatherReadyInput(cmd *cobra.Command) (readyInput, error) {
@@ -35,8 +38,16 @@ func gatherReadyInput(cmd *cobra.Command) (readyInput, error) {
 	in.prettyFormat, _ = cmd.Flags().GetBool("pretty")
 	in.plainFormat, _ = cmd.Flags().GetBool("plain")
 	in.jsonOut = jsonOutput
+	csvOpts, mdOpts, err := readyFormatOptions(cmd)
+	if err != nil {
+		return in, HandleErrorRespectJSON("%v", err)
+	}
+	in.csv, in.md = csvOpts, mdOpts
 
 	in.limit, _ = cmd.Flags().GetInt("limit")
+	if err := limitFlagError(in.limit); err != nil {
+		return in, HandleError("%v", err)
+	}
 	if cmd.Flags().Changed("offset") {
 		offset, _ := cmd.Flags().GetInt("offset")
 		if offset < 0 {
@@ -128,6 +139,9 @@ func gatherReadyInput(cmd *cobra.Command) (readyInput, error) {
 	}
 	if cmd.Flags().Changed("priority") {
 		priority, _ := cmd.Flags().GetInt("priority")
+		if err := priorityFlagError(priority); err != nil {
+			return in, HandleError("%v", err)
+		}
 		in.filter.Priority = &priority
 	}
 	if assignee != "" && !unassigned {
@@ -168,3 +182,27 @@ func gatherReadyInput(cmd *cobra.Command) (readyInput, error) {
 
 	return in, nil
 }
+
+// readyFormatOptions reads --format, which bd ready supports for csv and md.
+// Both are renderings of the ready list, so they reject the modes that print
+// something else.
+func readyFormatOptions(cmd *cobra.Command) (*csvOptions, *markdownOptions, error) {
+	format, _ := cmd.Flags().GetString("format")
+	if format != "" && !strings.EqualFold(format, "csv") && !strings.EqualFold(format, "md") {
+		return nil, nil, fmt.Errorf("invalid --format %q (bd ready supports csv, md)", format)
+	}
+	csvOpts, err := readCSVOptions(cmd, format)
+	if err != nil {
+		return nil, nil, err
+	}
+	mdOpts, err := readMarkdownOptions(cmd, format)
+	if err != nil || (csvOpts == nil && mdOpts == nil) {
+		return nil, nil, err
+	}
+	for _, name := range []string{"claim", "gated", "mol", "explain"} {
+		if cmd.Flags().Changed(name) {
+			return nil, nil, fmt.Errorf("--format %s cannot be combined with --%s", strings.ToLower(format), name)
+		}
+	}
+	return csvOpts, mdOpts, nil
+}
@@ -47,7 +47,7 @@ func runReadyProxiedServer(cmd *cobra.Command, ctx context.Context) error {
 	}
 }
 
-func runBlockedProxiedServer(cmd *cobra.Command, ctx context.Context) error {
+func runBlockedProxiedServer(ctx context.Context, filter types.WorkFilter, mdOpts *markdownOptions) error {
 	if uowProvider == nil {
 		return HandleError("proxied-server UOW provider not initialized")
 	}
@@ -57,11 +57,6 @@ func runBlockedProxiedServer(cmd *cobra.Command, ctx context.Context) error {
 	}
 	defer uw.Close(ctx)
 
-	var filter types.WorkFilter
-	if parentID, _ := cmd.Flags().GetString("parent"); parentID != "" {
-		filter.ParentID = &parentID
-	}
-
 	blocked, err := uw.IssueUseCase().GetBlockedIssues(ctx, filter)
 	if err != nil {
 		return HandleErrorRespectJSON("%v", err)
@@ -71,26 +66,17 @@ func runBlockedProxiedServer(cmd *cobra.Command, ctx context.Context) error {
 		if blocked == nil {
 			blocked = []*types.BlockedIssue{}
 		}
-		_ = outputJSON(blocked)
+		_ = outputCanonicalJSON(blocked)
 		return nil
 	}
+	if mdOpts != nil {
+		return writeBlockedMarkdown(blocked, mdOpts)
+	}
 	if len(blocked) == 0 {
 		fmt.Printf("\n%s No blocked issues\n\n", ui.RenderPass("✨"))
 		return nil
 	}
-	fmt.Printf("\n%s Blocked issues (%d):\n\n", ui.RenderFail("🚫"), len(blocked))
-	for _, issue := range blocked {
-		fmt.Printf("[%s] %s: %s\n",
-			ui.RenderPriority(issue.Priority),
-			ui.RenderID(issue.ID), issue.Title)
-		blockedBy := issue.BlockedBy
-		if blockedBy == nil {
-			blockedBy = []string{}
-		}
-		fmt.Printf("  Blocked by %d open dependencies: %v\n",
-			issue.BlockedByCount, blockedBy)
-		fmt.Println()
-	}
+	printBlockedIssues(blocked)
 	return nil
 }
 
@@ -104,7 +90,7 @@ func runReadyProxiedList(ctx context.Context, uw uow.UnitOfWork, in readyInput)
 		if results == nil {
 			results = []*types.IssueWithCounts{}
 		}
-		_ = outputJSON(results)
+		_ = outputCanonicalJSON(results)
 		if page.HasMore && in.filter.Limit > 0 {
 			fmt.Fprintf(os.Stderr, "Showing %d ready issues; more matched but were hidden by --limit. Use --limit 0 for all, or --limit N to raise the cap.\n", len(results))
 		}
@@ -118,6 +104,21 @@ func runReadyProxiedList(ctx context.Context, uw uow.UnitOfWork, in readyInput)
 	issues := page.Items
 	truncated := page.HasMore && in.filter.Limit > 0
 
+	if in.csv != nil || in.md != nil {
+		if in.csv != nil {
+			err = outputIssuesCSV(ctx, issues, in.csv, proxiedLabelsLookup(uw, issues))
+		} else {
+			err = writeIssuesMarkdown(os.Stdout, issues, in.md)
+		}
+		if err != nil {
+			return HandleError("%v", err)
+		}
+		if truncated {
+			fmt.Fprintf(os.Stderr, "Showing %d ready issues; more matched but were hidden by --limit.\n", len(issues))
+		}
+		return nil
+	}
+
 	maybeShowUpgradeNotification()
 
 	if len(issues) == 0 {
@@ -196,7 +197,7 @@ func runReadyProxiedClaim(ctx context.Context, _ uow.UnitOfWork, in readyInput)
 
 	if !res.claimed {
 		if in.jsonOut {
-			_ = outputJSON([]*types.IssueWithCounts{})
+			_ = outputCanonicalJSON([]*types.IssueWithCounts{})
 		} else {
 			fmt.Printf("\n%s No ready work to claim\n\n", ui.RenderWarn("○"))
 		}
@@ -206,7 +207,7 @@ func runReadyProxiedClaim(ctx context.Context, _ uow.UnitOfWork, in readyInput)
 	SetLastTouchedID(res.issue.ID)
 
 	if in.jsonOut {
-		_ = outputJSON(res.jsonPayload)
+		_ = outputCanonicalJSON(res.jsonPayload)
 	} else {
 		fmt.Printf("%s Claimed issue: %s\n", ui.RenderPass("✓"), formatFeedbackID(res.issue.ID, res.issue.Title))
 	}
@@ -51,6 +51,7 @@ Examples:
 			return HandleError("storage backend does not support is_blocked recompute")
 		}
 		changed, err := recomputer.RecomputeAllBlocked(ctx)
+		storage.InvalidateBlockedCache(store)
 		if err != nil {
 			return HandleError("recompute is_blocked: %v", err)
 		}
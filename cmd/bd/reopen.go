@@ -9,6 +9,7 @@ import (
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/validation"
 )
 
 var reopenCmd = &cobra.Command{
@@ -16,7 +17,19 @@ var reopenCmd = &cobra.Command{
 	GroupID: "issues",
 	Short:   "Reopen one or more closed issues",
 	Long: `Reopen closed issues by setting status to 'open' and clearing the closed_at timestamp.
-This is more explicit than 'bd update --status open' and emits a Reopened event.`,
+This is more explicit than 'bd update --status open' and emits a Reopened event.
+
+Only closed issues can be reopened: an issue that is open, in progress or
+deferred is reported as an error. Reopening also clears defer_until, so an
+issue deferred before it was closed comes back open and ready rather than
+hidden behind its old defer date; run bd defer again to re-defer it.
+
+With --ready-delta, also report the issues that became ready or blocked as a
+result, e.g. the dependents of a reopened blocker.
+
+With --cascade, also reopen the descendants that "bd close <id> --cascade"
+closed, found through their close events. Children that were closed on their
+own, before or after the cascade, stay closed.`,
 	Args:          cobra.MinimumNArgs(1),
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -35,6 +48,8 @@ This is more explicit than 'bd update --status open' and emits a Reopened event.
 		}
 
 		reason, _ := cmd.Flags().GetString("reason")
+		readyDeltaFlag, _ := cmd.Flags().GetBool("ready-delta")
+		cascade, _ := cmd.Flags().GetBool("cascade")
 		ctx := rootCtx
 
 		reopenedIssues := []*types.Issue{}
@@ -44,6 +59,27 @@ This is more explicit than 'bd update --status open' and emits a Reopened event.
 		if store == nil {
 			return HandleErrorWithHint("database not initialized", diagHint())
 		}
+		var deltaBefore readySnapshot
+		if readyDeltaFlag {
+			var err error
+			if deltaBefore, err = takeReadySnapshot(ctx, store); err != nil {
+				return HandleErrorRespectJSON("ready delta: %v", err)
+			}
+		}
+		recordReopened := func(s storage.DoltStorage, id string) {
+			mutatedStores[s] = append(mutatedStores[s], id)
+			if jsonOutput {
+				if updated, _ := s.GetIssue(ctx, id); updated != nil {
+					reopenedIssues = append(reopenedIssues, updated)
+				}
+				return
+			}
+			reasonMsg := ""
+			if reason != "" {
+				reasonMsg = ": " + reason
+			}
+			fmt.Printf("%s Reopened %s%s\n", ui.RenderAccent("↻"), id, reasonMsg)
+		}
 		for _, id := range args {
 			// Resolve with prefix routing (supports cross-rig reopens like `bd reopen xe-5ls`)
 			result, err := resolveAndGetIssueForMutation(ctx, store, id)
@@ -56,33 +92,63 @@ This is more explicit than 'bd update --status open' and emits a Reopened event.
 			issueStore := result.Store
 			issue := result.Issue
 
-			if issue.Status == types.StatusOpen {
+			reopenedHere := false
+			if err := validation.ForReopen()(fullID, issue); err != nil {
+				// --cascade may still have children to restore under an
+				// issue that was reopened on its own.
+				if !cascade || issue.Status != types.StatusOpen {
+					fmt.Fprintf(os.Stderr, "Error reopening %s: %v\n", fullID, err)
+					hasError = true
+					result.Close()
+					continue
+				}
 				fmt.Fprintf(os.Stderr, "%s is already open\n", fullID)
-				result.Close()
-				continue
-			}
-			if err := issueStore.ReopenIssue(ctx, fullID, reason, actor); err != nil {
+			} else if err := issueStore.ReopenIssue(ctx, fullID, reason, actor); err != nil {
 				fmt.Fprintf(os.Stderr, "Error reopening %s: %v\n", fullID, err)
 				hasError = true
 				result.Close()
 				continue
+			} else {
+				reopenedHere = true
+				recordReopened(issueStore, fullID)
 			}
-			mutatedStores[issueStore] = append(mutatedStores[issueStore], fullID)
-			pendingCloseResults = append(pendingCloseResults, result)
-			if jsonOutput {
-				updated, _ := issueStore.GetIssue(ctx, fullID)
-				if updated != nil {
-					reopenedIssues = append(reopenedIssues, updated)
+
+			// --cascade restores the descendants a bd close --cascade of this
+			// issue closed, and only those. It also runs when the issue itself
+			// was already reopened without --cascade.
+			if cascade {
+				children, err := cascadeClosedDescendants(ctx, issueStore, fullID)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error finding cascade-closed children of %s: %v\n", fullID, err)
+					hasError = true
 				}
-			} else {
-				reasonMsg := ""
-				if reason != "" {
-					reasonMsg = ": " + reason
+				for _, child := range children {
+					if err := issueStore.ReopenIssue(ctx, child.ID, reason, actor); err != nil {
+						fmt.Fprintf(os.Stderr, "Error reopening %s: %v\n", child.ID, err)
+						hasError = true
+						continue
+					}
+					reopenedHere = true
+					recordReopened(issueStore, child.ID)
 				}
-				fmt.Printf("%s Reopened %s%s\n", ui.RenderAccent("↻"), fullID, reasonMsg)
+			}
+
+			if reopenedHere {
+				pendingCloseResults = append(pendingCloseResults, result)
+			} else {
+				result.Close()
 			}
 		}
 
+		var delta readyDelta
+		if readyDeltaFlag && len(mutatedStores) > 0 {
+			deltaAfter, err := takeReadySnapshot(ctx, store)
+			if err != nil {
+				return HandleErrorRespectJSON("ready delta: %v", err)
+			}
+			delta = readyDeltaBetween(deltaBefore, deltaAfter)
+		}
+
 		for s, ids := range mutatedStores {
 			if err := commitPendingIfEmbedded(ctx, s, actor, doltAutoCommitParams{
 				Command:  "reopen",
@@ -99,9 +165,19 @@ This is more explicit than 'bd update --status open' and emits a Reopened event.
 		}
 
 		if jsonOutput && len(reopenedIssues) > 0 {
-			if jerr := outputJSON(reopenedIssues); jerr != nil {
+			var payload interface{} = reopenedIssues
+			if readyDeltaFlag {
+				payload = map[string]interface{}{
+					"reopened":      reopenedIssues,
+					"newly_ready":   delta.NewlyReady,
+					"newly_blocked": delta.NewlyBlocked,
+				}
+			}
+			if jerr := outputJSON(payload); jerr != nil {
 				return jerr
 			}
+		} else if readyDeltaFlag && !jsonOutput {
+			printReadyDelta(delta)
 		}
 
 		if hasError {
@@ -113,6 +189,8 @@ This is more explicit than 'bd update --status open' and emits a Reopened event.
 
 func init() {
 	reopenCmd.Flags().StringP("reason", "r", "", "Reason for reopening")
+	reopenCmd.Flags().Bool("cascade", false, "Also reopen the descendants closed by a prior bd close --cascade of this issue")
+	reopenCmd.Flags().Bool("ready-delta", false, "Report issues that became ready or blocked (--json: {reopened, newly_ready, newly_blocked})")
 	reopenCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(reopenCmd)
 }
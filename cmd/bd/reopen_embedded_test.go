@@ -27,6 +27,20 @@ func bdReopen(t *testing.T, bd, dir string, args ...string) string {
 	return stdout.String()
 }
 
+// bdReopenFail runs "bd reopen" expecting failure and returns combined output.
+func bdReopenFail(t *testing.T, bd, dir string, args ...string) string {
+	t.Helper()
+	fullArgs := append([]string{"reopen"}, args...)
+	cmd := exec.Command(bd, fullArgs...)
+	cmd.Dir = dir
+	cmd.Env = bdEnv(dir)
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("expected bd reopen %s to fail, but succeeded:\n%s", strings.Join(args, " "), out)
+	}
+	return string(out)
+}
+
 func TestEmbeddedReopen(t *testing.T) {
 	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
 		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
@@ -122,10 +136,33 @@ func TestEmbeddedReopen(t *testing.T) {
 
 	t.Run("reopen_already_open", func(t *testing.T) {
 		issue := bdCreate(t, bd, dir, "Already open", "--type", "task")
-		// Reopen an already-open issue — should not error, just print message
-		out := bdReopen(t, bd, dir, issue.ID)
+		out := bdReopenFail(t, bd, dir, issue.ID)
 		if !strings.Contains(out, "already open") {
-			t.Logf("already-open message: %s", out)
+			t.Errorf("expected 'already open' in output: %s", out)
+		}
+		if strings.Contains(out, "Reopened") {
+			t.Errorf("an open issue must not be reported as reopened: %s", out)
+		}
+	})
+
+	t.Run("reopen_not_closed_rejected", func(t *testing.T) {
+		inProgress := bdCreate(t, bd, dir, "In progress reopen", "--type", "task")
+		bdUpdate(t, bd, dir, inProgress.ID, "--status", "in_progress")
+		deferred := bdCreate(t, bd, dir, "Deferred not closed", "--type", "task", "--defer", "2030-01-01")
+		closed := bdCreate(t, bd, dir, "Closed in batch", "--type", "task")
+		bdClose(t, bd, dir, closed.ID)
+
+		out := bdReopenFail(t, bd, dir, inProgress.ID, deferred.ID, closed.ID)
+		for _, want := range []string{"already in_progress", "already deferred"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("expected %q in output: %s", want, out)
+			}
+		}
+		if got := bdShow(t, bd, dir, deferred.ID); got.Status != types.StatusDeferred || got.DeferUntil == nil {
+			t.Errorf("a rejected reopen must leave the deferral alone, got status %s defer_until %v", got.Status, got.DeferUntil)
+		}
+		if got := bdShow(t, bd, dir, closed.ID); got.Status != types.StatusOpen {
+			t.Errorf("the closed issue in the batch should still reopen, got %s", got.Status)
 		}
 	})
 
@@ -137,6 +174,35 @@ func TestEmbeddedReopen(t *testing.T) {
 		if got.Status != types.StatusOpen {
 			t.Errorf("expected open, got %s", got.Status)
 		}
+		if got.DeferUntil != nil {
+			t.Errorf("expected defer_until cleared on reopen, got %v", got.DeferUntil)
+		}
+	})
+
+	t.Run("reopen_cascade_restores_only_cascade_closed", func(t *testing.T) {
+		epic := bdCreate(t, bd, dir, "Cascade epic", "--type", "epic")
+		doneEarly := bdCreate(t, bd, dir, "Finished before cascade", "--type", "task", "--parent", epic.ID)
+		child := bdCreate(t, bd, dir, "Open child", "--type", "task", "--parent", epic.ID)
+		subEpic := bdCreate(t, bd, dir, "Sub epic", "--type", "epic", "--parent", epic.ID)
+		grandchild := bdCreate(t, bd, dir, "Grandchild", "--type", "task", "--parent", subEpic.ID)
+
+		bdClose(t, bd, dir, doneEarly.ID)
+		bdClose(t, bd, dir, epic.ID, "--cascade", "--reason", "descoped")
+		for _, id := range []string{epic.ID, doneEarly.ID, child.ID, subEpic.ID, grandchild.ID} {
+			if got := bdShow(t, bd, dir, id); got.Status != types.StatusClosed {
+				t.Fatalf("%s should be closed after cascade close, got %s", id, got.Status)
+			}
+		}
+
+		bdReopen(t, bd, dir, epic.ID, "--cascade")
+		for _, id := range []string{epic.ID, child.ID, subEpic.ID, grandchild.ID} {
+			if got := bdShow(t, bd, dir, id); got.Status != types.StatusOpen {
+				t.Errorf("%s should be reopened by cascade, got %s", id, got.Status)
+			}
+		}
+		if got := bdShow(t, bd, dir, doneEarly.ID); got.Status != types.StatusClosed {
+			t.Errorf("%s was closed before the cascade and should stay closed, got %s", doneEarly.ID, got.Status)
+		}
 	})
 
 	t.Run("reopen_nonexistent", func(t *testing.T) {
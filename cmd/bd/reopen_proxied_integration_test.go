@@ -136,16 +136,13 @@ func TestProxiedServerReopen(t *testing.T) {
 		}
 	})
 
-	t.Run("already_open_prints_warning", func(t *testing.T) {
+	t.Run("already_open_errors", func(t *testing.T) {
 		t.Parallel()
 		p := newSharedProxiedProject(t, bd, "roao")
 		issue := bdProxiedCreate(t, bd, p.dir, "Already open")
-		stdout, stderr, err := bdProxiedRunBuffers(t, bd, p.dir, "reopen", issue.ID)
-		if err != nil {
-			t.Fatalf("already-open reopen should exit 0, got: %v\nstdout:\n%s\nstderr:\n%s", err, stdout, stderr)
-		}
-		if !strings.Contains(stderr, "already open") {
-			t.Errorf("expected 'already open' on stderr, got stdout=%q stderr=%q", stdout, stderr)
+		out := bdProxiedReopenFail(t, bd, p.dir, issue.ID)
+		if !strings.Contains(out, "already open") {
+			t.Errorf("expected 'already open' error, got: %s", out)
 		}
 	})
 
@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/beads/internal/storage/uow"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/validation"
 )
 
 type reopenProxiedOutcome struct {
@@ -27,6 +28,7 @@ type reopenProxiedOutcome struct {
 
 type reopenProxiedTxResult struct {
 	outcomes []reopenProxiedOutcome
+	delta    *readyDelta
 	hasError bool
 	errors   []string
 }
@@ -37,6 +39,10 @@ func runReopenProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 	}
 	reason, _ := cmd.Flags().GetString("reason")
 	jsonOut, _ := cmd.Flags().GetBool("json")
+	readyDeltaFlag, _ := cmd.Flags().GetBool("ready-delta")
+	if cascade, _ := cmd.Flags().GetBool("cascade"); cascade {
+		return HandleErrorRespectJSON("--cascade is not supported in proxied-server mode")
+	}
 
 	if uowProvider == nil {
 		return HandleError("proxied-server UOW provider not initialized")
@@ -45,6 +51,14 @@ func runReopenProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 	res, err := uow.RunTxResult(ctx, uowProvider, func(ctx context.Context, uw uow.UnitOfWork) (reopenProxiedTxResult, string, error) {
 		var result reopenProxiedTxResult
 
+		var deltaBefore readySnapshot
+		if readyDeltaFlag {
+			var err error
+			if deltaBefore, err = takeReadySnapshot(ctx, proxiedReadyDeltaSource{uw: uw}); err != nil {
+				return result, "", fmt.Errorf("ready delta: %w", err)
+			}
+		}
+
 		for _, id := range args {
 			outcome, ok := reopenProxiedOne(ctx, uw, id, reason, &result.errors)
 			if !ok {
@@ -60,6 +74,15 @@ func runReopenProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 			return result, "", nil
 		}
 
+		if readyDeltaFlag {
+			deltaAfter, err := takeReadySnapshot(ctx, proxiedReadyDeltaSource{uw: uw})
+			if err != nil {
+				return result, "", fmt.Errorf("ready delta: %w", err)
+			}
+			delta := readyDeltaBetween(deltaBefore, deltaAfter)
+			result.delta = &delta
+		}
+
 		return result, reopenProxiedCommitMessage(result.outcomes), nil
 	})
 	if err != nil {
@@ -91,7 +114,13 @@ func runReopenProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 		for i, o := range res.outcomes {
 			reopenedIssues[i] = o.after
 		}
-		_ = outputJSON(reopenedIssues)
+		if res.delta != nil {
+			_ = outputJSON(map[string]interface{}{"reopened": reopenedIssues, "newly_ready": res.delta.NewlyReady, "newly_blocked": res.delta.NewlyBlocked})
+		} else {
+			_ = outputJSON(reopenedIssues)
+		}
+	} else if !jsonOut && res.delta != nil {
+		printReadyDelta(*res.delta)
 	}
 
 	if res.hasError {
@@ -106,9 +135,9 @@ func reopenProxiedOne(ctx context.Context, uw uow.UnitOfWork, id, reason string,
 		*errors = append(*errors, fmt.Sprintf("Issue %s not found", id))
 		return reopenProxiedOutcome{}, false
 	}
-	if current.Status != types.StatusClosed {
-		*errors = append(*errors, fmt.Sprintf("%s is already %s", id, current.Status))
-		return reopenProxiedOutcome{id: id, before: current, after: current, reopened: false}, true
+	if err := validation.ForReopen()(id, current); err != nil {
+		*errors = append(*errors, fmt.Sprintf("Error reopening %s: %v", id, err))
+		return reopenProxiedOutcome{}, false
 	}
 
 	params := domain.ReopenIssueParams{Reason: reason}
@@ -12,10 +12,14 @@ import (
 	"github.com/steveyegge/beads/internal/ui"
 )
 
-var restoreApply bool
+var (
+	restoreApply    bool
+	restoreDoltDump string
+	restoreForce    bool
+)
 
 var restoreCmd = &cobra.Command{
-	Use:           "restore <issue-id>",
+	Use:           "restore <issue-id> | --dolt-dump <dir>",
 	GroupID:       "sync",
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -32,12 +36,36 @@ and step its compaction level back down.
 
 If no archived snapshot exists (e.g. the issue was compacted by an older bd
 before snapshot archiving), restore falls back to a best-effort reconstruction
-from Dolt version history, which can only be displayed, not applied.`,
-	Args: cobra.ExactArgs(1),
+from Dolt version history, which can only be displayed, not applied.
+
+With --dolt-dump, restore instead rebuilds the whole database from a snapshot
+written by 'bd export --dolt-dump': the dump is replayed into a freshly
+initialized Dolt database, which then replaces the current one. The snapshot
+is checked against its manifest and refused if its schema version is newer
+than this bd supports. A database that already holds issues is only replaced
+with --force; the replaced database is kept beside it. Requires the dolt CLI
+and embedded mode.
+
+Examples:
+  bd restore bd-42 --apply                # Un-compact one issue
+  bd restore --dolt-dump backups/         # Rebuild an empty database from a snapshot
+  bd restore --dolt-dump backups/ --force # Replace a populated database`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if restoreDoltDump != "" {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if usesProxiedServer() {
 			return HandleErrorRespectJSON("restore is not supported in proxied-server mode")
 		}
+		if restoreDoltDump != "" {
+			return runRestoreDoltDump(restoreDoltDump, restoreForce)
+		}
+		if restoreForce {
+			return HandleErrorRespectJSON("--force only applies with --dolt-dump")
+		}
 		issueID := args[0]
 		ctx := rootCtx
 
@@ -178,6 +206,8 @@ func issueContentSize(issue *types.Issue) int {
 func init() {
 	restoreCmd.Flags().BoolVar(&jsonOutput, "json", false, "Output restore results in JSON format")
 	restoreCmd.Flags().BoolVar(&restoreApply, "apply", false, "Write the restored content back into the issue (default: display only)")
+	restoreCmd.Flags().StringVar(&restoreDoltDump, "dolt-dump", "", "Rebuild the whole database from a 'bd export --dolt-dump' directory")
+	restoreCmd.Flags().BoolVar(&restoreForce, "force", false, "With --dolt-dump, replace a database that already holds issues")
 	rootCmd.AddCommand(restoreCmd)
 }
 
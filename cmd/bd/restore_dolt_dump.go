@@ -0,0 +1,186 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/doltserver"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/schema"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// --- bd restore --dolt-dump ---
+//
+// The inverse of bd export --dolt-dump: replay a dump into a freshly
+// initialized Dolt database next to the live one, then swap it into place.
+// The replaced database is kept beside it rather than deleted.
+
+// readDoltDumpManifest loads and checks the manifest of a dump directory:
+// it must describe a Dolt dump whose file is present and unmodified.
+func readDoltDumpManifest(dir string) (*doltDumpManifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, doltDumpManifestName)) // #nosec G304 -- user-named dump directory
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%s has no %s; expected a directory written by 'bd export --dolt-dump'", dir, doltDumpManifestName)
+		}
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+	var m doltDumpManifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %w", err)
+	}
+	if m.Format != doltDumpFormat {
+		return nil, fmt.Errorf("manifest format %q is not %q", m.Format, doltDumpFormat)
+	}
+	if m.DumpFile == "" || filepath.Base(m.DumpFile) != m.DumpFile {
+		return nil, fmt.Errorf("manifest names an invalid dump file %q", m.DumpFile)
+	}
+	if m.Database == "" || m.Database == "." || filepath.Base(m.Database) != m.Database {
+		return nil, fmt.Errorf("manifest names an invalid database %q", m.Database)
+	}
+	size, sum, err := fileSHA256(filepath.Join(dir, m.DumpFile))
+	if err != nil {
+		return nil, fmt.Errorf("reading dump: %w", err)
+	}
+	if size != m.SizeBytes || sum != m.SHA256 {
+		return nil, fmt.Errorf("%s does not match its manifest (size %d, sha256 %s); the snapshot is corrupt or was modified", m.DumpFile, size, sum)
+	}
+	return &m, nil
+}
+
+// checkDoltDumpSchema verifies this binary can open a database at the dump's
+// schema version. Older snapshots are fine: migrations bring them forward on
+// the next open.
+func checkDoltDumpSchema(m *doltDumpManifest, latest int) error {
+	if m.SchemaVersion <= 0 {
+		return fmt.Errorf("manifest records no schema version; cannot verify the snapshot is compatible")
+	}
+	if m.SchemaVersion > latest {
+		return fmt.Errorf("snapshot schema version %d is newer than this bd supports (%d); upgrade bd (snapshot written by bd %s) before restoring", m.SchemaVersion, latest, m.BDVersion)
+	}
+	return nil
+}
+
+func runRestoreDoltDump(dir string, force bool) error {
+	m, err := readDoltDumpManifest(dir)
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	if err := checkDoltDumpSchema(m, schema.LatestVersion()); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	if !isEmbeddedMode() {
+		return HandleErrorRespectJSON("restore --dolt-dump replaces the embedded database directory; in server mode, stop the server and replay %s into its data directory with 'dolt sql'", m.DumpFile)
+	}
+	if store == nil {
+		return HandleErrorRespectJSON("database is not initialized. Run 'bd init' first")
+	}
+	locator, ok := storage.UnwrapStore(store).(storage.StoreLocator)
+	if !ok || locator.CLIDir() == "" {
+		return HandleErrorRespectJSON("storage backend does not expose its database directory")
+	}
+	target := locator.CLIDir()
+	if _, err := exec.LookPath("dolt"); err != nil {
+		return HandleErrorWithHintRespectJSON("dolt command not found in PATH", "install Dolt from https://github.com/dolthub/dolt")
+	}
+
+	ctx := rootCtx
+	stats, err := store.GetStatistics(ctx)
+	if err != nil {
+		return HandleErrorRespectJSON("checking the current database: %v", err)
+	}
+	if stats.TotalIssues > 0 && !force {
+		return HandleErrorRespectJSON("database %s already holds %d issues; pass --force to replace it with the snapshot", filepath.Base(target), stats.TotalIssues)
+	}
+
+	// Build the restored database beside the live one so the final swap is
+	// a rename on the same filesystem.
+	staging, err := os.MkdirTemp(filepath.Dir(target), ".restore-")
+	if err != nil {
+		return HandleErrorRespectJSON("creating staging directory: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(staging) }()
+	fresh := filepath.Join(staging, m.Database)
+	if err := loadDoltDump(fresh, filepath.Join(dir, m.DumpFile)); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+
+	// The open store holds the live database; release it before the swap.
+	storeMutex.Lock()
+	storeActive = false
+	storeMutex.Unlock()
+	_ = store.Close()
+	setStore(nil)
+
+	previous := fmt.Sprintf("%s.pre-restore-%s", target, time.Now().UTC().Format("20060102T150405Z"))
+	if err := os.Rename(target, previous); err != nil {
+		return HandleErrorRespectJSON("moving the current database aside: %v", err)
+	}
+	if err := os.Rename(fresh, target); err != nil {
+		if undo := os.Rename(previous, target); undo != nil {
+			return HandleErrorRespectJSON("installing the restored database: %v (the previous database is at %s)", err, previous)
+		}
+		return HandleErrorRespectJSON("installing the restored database: %v", err)
+	}
+
+	// The snapshot may come from another project; keep metadata.json's
+	// identity in step with the restored database, as bd backup restore does.
+	if m.ProjectID != "" {
+		if err := syncProjectID(m.ProjectID); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to sync project ID after restore: %v\n", err)
+		}
+	}
+
+	if jsonOutput {
+		return outputJSON(map[string]interface{}{
+			"restored":          dir,
+			"database":          filepath.Base(target),
+			"issue_count":       m.IssueCount,
+			"schema_version":    m.SchemaVersion,
+			"previous_database": previous,
+		})
+	}
+	fmt.Printf("%s Restored %d issues from %s\n", ui.RenderPass("✓"), m.IssueCount, dir)
+	fmt.Printf("  Previous database kept at %s\n", previous)
+	return nil
+}
+
+// loadDoltDump initializes a new Dolt database at dir, replays dumpPath into
+// it, and commits the result.
+func loadDoltDump(dir, dumpPath string) error {
+	if err := doltserver.EnsureDoltIdentity(); err != nil {
+		return fmt.Errorf("configuring dolt identity: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("creating database directory: %w", err)
+	}
+	run := func(stdin *os.File, args ...string) error {
+		cmd := exec.CommandContext(rootCtx, "dolt", args...) // #nosec G204 -- fixed command
+		cmd.Dir = dir
+		if stdin != nil {
+			cmd.Stdin = stdin
+		}
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("dolt %s: %w\n%s", args[0], err, strings.TrimSpace(string(out)))
+		}
+		return nil
+	}
+	if err := run(nil, "init"); err != nil {
+		return err
+	}
+	dump, err := os.Open(dumpPath) // #nosec G304 -- verified against the manifest
+	if err != nil {
+		return fmt.Errorf("opening dump: %w", err)
+	}
+	defer dump.Close()
+	if err := run(dump, "sql"); err != nil {
+		return err
+	}
+	return run(nil, "commit", "-Am", "bd restore --dolt-dump")
+}
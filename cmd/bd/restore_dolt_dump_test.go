@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadDoltDumpManifest(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, doltDumpFileName), []byte("abc"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	write := func(m doltDumpManifest) {
+		t.Helper()
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, doltDumpManifestName), data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	valid := doltDumpManifest{
+		Format:        doltDumpFormat,
+		Database:      "beads",
+		SchemaVersion: 3,
+		DumpFile:      doltDumpFileName,
+		SizeBytes:     3,
+		SHA256:        "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+	}
+
+	if _, err := readDoltDumpManifest(dir); err == nil || !strings.Contains(err.Error(), "bd export --dolt-dump") {
+		t.Errorf("missing manifest should point at bd export --dolt-dump, got %v", err)
+	}
+
+	write(valid)
+	m, err := readDoltDumpManifest(dir)
+	if err != nil || m.Database != "beads" {
+		t.Fatalf("readDoltDumpManifest = %+v, %v", m, err)
+	}
+
+	tampered := valid
+	tampered.SHA256 = strings.Repeat("0", 64)
+	write(tampered)
+	if _, err := readDoltDumpManifest(dir); err == nil || !strings.Contains(err.Error(), "does not match its manifest") {
+		t.Errorf("checksum mismatch should be refused, got %v", err)
+	}
+
+	escaping := valid
+	escaping.Database = "../elsewhere"
+	write(escaping)
+	if _, err := readDoltDumpManifest(dir); err == nil {
+		t.Error("a database name with a path should be refused")
+	}
+}
+
+func TestCheckDoltDumpSchema(t *testing.T) {
+	for _, tc := range []struct {
+		version int
+		wantErr string
+	}{
+		{version: 5},
+		{version: 3},
+		{version: 6, wantErr: "newer than this bd supports"},
+		{version: 0, wantErr: "no schema version"},
+	} {
+		err := checkDoltDumpSchema(&doltDumpManifest{SchemaVersion: tc.version}, 5)
+		if tc.wantErr == "" && err != nil {
+			t.Errorf("version %d: unexpected error %v", tc.version, err)
+		}
+		if tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+			t.Errorf("version %d: error %v, want %q", tc.version, err, tc.wantErr)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -153,6 +154,45 @@ func TestEmbeddedRestore(t *testing.T) {
 			t.Errorf("expected restored description in output, got: %s", out)
 		}
 	})
+
+	t.Run("dolt_dump_round_trip", func(t *testing.T) {
+		if _, err := exec.LookPath("dolt"); err != nil {
+			t.Skip("dolt CLI not in PATH")
+		}
+		src, _, _ := bdInit(t, bd, "--prefix", "rstdump")
+		bdCreateSilent(t, bd, src, "round trip open")
+		closed := bdCreateSilent(t, bd, src, "round trip closed")
+		bdClose(t, bd, src, closed)
+		snapshot := filepath.Join(t.TempDir(), "snapshot")
+		bdExport(t, bd, src, "--dolt-dump", "-o", snapshot)
+
+		ids := func(dir string) map[string]bool {
+			out := make(map[string]bool)
+			for _, issue := range bdListJSON(t, bd, dir, "--all", "-n", "0") {
+				out[issue.ID] = true
+			}
+			return out
+		}
+		want := ids(src)
+
+		dst, _, _ := bdInit(t, bd, "--prefix", "rstdst")
+		bdCreateSilent(t, bd, dst, "to be replaced")
+		out := bdRestoreFail(t, bd, dst, "--dolt-dump", snapshot)
+		if !strings.Contains(out, "--force") {
+			t.Errorf("restoring over a populated database should require --force, got: %s", out)
+		}
+
+		bdRestore(t, bd, dst, "--dolt-dump", snapshot, "--force")
+		got := ids(dst)
+		if len(got) != len(want) {
+			t.Errorf("restored issue set = %v, want %v", got, want)
+		}
+		for id := range want {
+			if !got[id] {
+				t.Errorf("restored database is missing %s (got %v)", id, got)
+			}
+		}
+	})
 }
 
 func TestEmbeddedRestoreConcurrent(t *testing.T) {
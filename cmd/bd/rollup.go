@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+
+	"github.com/steveyegge/beads/internal/listjson"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/uow"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// rollupFlagUsage is the --rollup help shared by list and show.
+const rollupFlagUsage = "Add estimate rollups over each epic's descendants to --json (estimate_total, actual_total, remaining)"
+
+// storeDescendantLookup walks the subtree with the same per-level parent
+// search bd list --parent uses. Closed descendants are included so completed
+// work counts toward actual_total.
+func storeDescendantLookup(s storage.DoltStorage) listjson.DescendantLookup {
+	return func(ctx context.Context, rootID string) ([]*types.Issue, error) {
+		found := make(map[string]*types.Issue)
+		if err := findAllDescendants(ctx, s, "", rootID, types.IssueFilter{}, found); err != nil {
+			return nil, err
+		}
+		out := make([]*types.Issue, 0, len(found))
+		for _, issue := range found {
+			out = append(out, issue)
+		}
+		return out, nil
+	}
+}
+
+// proxiedDescendantLookup is storeDescendantLookup for the proxied server.
+func proxiedDescendantLookup(uw uow.UnitOfWork) listjson.DescendantLookup {
+	return func(ctx context.Context, rootID string) ([]*types.Issue, error) {
+		return uw.IssueUseCase().GetDescendants(ctx, rootID, types.IssueFilter{})
+	}
+}
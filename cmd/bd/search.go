@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/listjson"
 	"github.com/steveyegge/beads/internal/metrics"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
@@ -23,11 +24,17 @@ ID-like queries (e.g., "bd-123", "hq-319") use fast exact/prefix matching.
 Text queries search titles. Use --desc-contains for description search.
 Use --status all to include closed issues.
 
+Multiple words are searched as one phrase. With --all or --any each argument
+is matched on its own (title or ID) and issues must match every term (--all)
+or at least one term (--any).
+
 Examples:
   bd search "authentication bug"
   bd search "login" --status open
   bd search "database" --label backend --limit 10
   bd search --query "performance" --assignee alice
+  bd search auth login --all     # Both "auth" and "login" must match
+  bd search auth login --any     # Either "auth" or "login" may match
   bd search "bd-5q" # Search by partial ID (fast prefix match)
   bd search "security" --priority-min 0 --priority-max 2
   bd search "bug" --created-after 2025-01-01
@@ -50,15 +57,13 @@ Examples:
 			return runSearchProxiedServer(cmd, rootCtx, args)
 		}
 
-		queryFlag, _ := cmd.Flags().GetString("query")
-		var query string
-		if len(args) > 0 {
-			query = strings.Join(args, " ")
-		} else if queryFlag != "" {
-			query = queryFlag
+		sq, err := resolveSearchQuery(cmd, args)
+		if err != nil {
+			return HandleError("%v", err)
 		}
+		query := sq.text
 
-		if query == "" {
+		if sq.empty() {
 			if err := cmd.Help(); err != nil {
 				fmt.Fprintf(os.Stderr, "Error displaying help: %v\n", err)
 			}
@@ -66,15 +71,23 @@ Examples:
 		}
 
 		// Get filter flags
-		status, _ := cmd.Flags().GetString("status")
+		statuses, _ := cmd.Flags().GetStringSlice("status")
+		status := strings.Join(statuses, ",")
 		assignee, _ := cmd.Flags().GetString("assignee")
 		issueType, _ := cmd.Flags().GetString("type")
 		limit, _ := cmd.Flags().GetInt("limit")
+		if err := limitFlagError(limit); err != nil {
+			return HandleError("%v", err)
+		}
 		labels, _ := cmd.Flags().GetStringSlice("label")
 		labelsAny, _ := cmd.Flags().GetStringSlice("label-any")
 		longFormat, _ := cmd.Flags().GetBool("long")
 		sortBy, _ := cmd.Flags().GetString("sort")
 		reverse, _ := cmd.Flags().GetBool("reverse")
+		sortBy, reverse, err = resolveSortFlag(sortBy, reverse)
+		if err != nil {
+			return HandleError("%v", err)
+		}
 
 		// Date range flags
 		createdAfter, _ := cmd.Flags().GetString("created-after")
@@ -92,6 +105,13 @@ Examples:
 		descContains, _ := cmd.Flags().GetString("desc-contains")
 		notesContains, _ := cmd.Flags().GetString("notes-contains")
 		externalContains, _ := cmd.Flags().GetString("external-contains")
+		specID, _ := cmd.Flags().GetString("spec-id")
+		specPrefix, _ := cmd.Flags().GetString("spec-id-prefix")
+
+		jsonOpts, err := searchJSONOptions(cmd)
+		if err != nil {
+			return HandleError("%v", err)
+		}
 
 		// Empty/null check flags
 		emptyDesc, _ := cmd.Flags().GetBool("empty-description")
@@ -106,6 +126,7 @@ Examples:
 		filter := types.IssueFilter{
 			Limit: limit,
 		}
+		sq.apply(&filter)
 
 		if status != "" && status != "all" {
 			cfg, err := loadDirectListFilterConfig(rootCtx, store)
@@ -150,6 +171,12 @@ Examples:
 		if externalContains != "" {
 			filter.ExternalRefContains = externalContains
 		}
+		if specID != "" {
+			filter.SpecID = specID
+		}
+		if specPrefix != "" {
+			filter.SpecIDPrefix = specPrefix
+		}
 
 		// Empty/null checks
 		if emptyDesc {
@@ -220,6 +247,14 @@ Examples:
 			}
 			filter.PriorityMax = &priorityMax
 		}
+		if filter.PriorityMin != nil && filter.PriorityMax != nil {
+			if err := priorityRangeError(*filter.PriorityMin, *filter.PriorityMax); err != nil {
+				return HandleError("%v", err)
+			}
+		}
+		if err := filterTimeRangeError(filter); err != nil {
+			return HandleError("%v", err)
+		}
 
 		metadataFieldFlags, _ := cmd.Flags().GetStringArray("metadata-field")
 		if len(metadataFieldFlags) > 0 {
@@ -245,58 +280,30 @@ Examples:
 
 		ctx := rootCtx
 
-		issues, err := store.SearchIssues(ctx, query, filter)
-		if err != nil {
-			return HandleError("%v", err)
-		}
-
-		// Apply sorting
-		sortIssues(issues, sortBy, reverse)
-
 		if jsonOutput {
-			// Get labels and dependency counts
-			issueIDs := make([]string, len(issues))
-			for i, issue := range issues {
-				issueIDs[i] = issue.ID
-			}
-			labelsMap, err := store.GetLabelsForIssues(ctx, issueIDs)
+			iwc, err := store.SearchIssuesWithCounts(ctx, query, filter)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "Warning: failed to get labels: %v\n", err)
-				labelsMap = make(map[string][]string)
+				return HandleError("%v", err)
 			}
-			depCounts, err := store.GetDependencyCounts(ctx, issueIDs)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Warning: failed to get dependency counts: %v\n", err)
-				depCounts = make(map[string]*types.DependencyCounts)
+			sortIssuesWithCounts(iwc, sortBy, reverse)
+			if iwc == nil {
+				iwc = []*types.IssueWithCounts{}
 			}
-			commentCounts, err := store.GetCommentCounts(ctx, issueIDs)
+			payload, err := storeListJSONPayload(ctx, store, iwc, jsonOpts, nil)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "Warning: failed to get comment counts: %v\n", err)
-				commentCounts = make(map[string]int)
-			}
-
-			// Populate labels
-			for _, issue := range issues {
-				issue.Labels = labelsMap[issue.ID]
+				return err
 			}
+			return outputJSON(payload)
+		}
 
-			// Build response with counts
-			issuesWithCounts := make([]*types.IssueWithCounts, len(issues))
-			for i, issue := range issues {
-				counts := depCounts[issue.ID]
-				if counts == nil {
-					counts = &types.DependencyCounts{DependencyCount: 0, DependentCount: 0}
-				}
-				issuesWithCounts[i] = &types.IssueWithCounts{
-					Issue:           issue,
-					DependencyCount: counts.DependencyCount,
-					DependentCount:  counts.DependentCount,
-					CommentCount:    commentCounts[issue.ID],
-				}
-			}
-			return outputJSON(issuesWithCounts)
+		issues, err := store.SearchIssues(ctx, query, filter)
+		if err != nil {
+			return HandleError("%v", err)
 		}
 
+		// Apply sorting
+		sortIssues(issues, sortBy, reverse)
+
 		// Load labels for display
 		issueIDs := make([]string, len(issues))
 		for i, issue := range issues {
@@ -307,11 +314,91 @@ Examples:
 			issue.Labels = labelsMap[issue.ID]
 		}
 
-		outputSearchResults(issues, query, longFormat)
+		beginTimingPhase(timingPhaseRender)
+		outputSearchResults(issues, sq.String(), longFormat)
 		return nil
 	},
 }
 
+// searchJSONOptions reads the --json annotations search shares with bd list.
+// Search results render through listJSONPayload, so an issue's search --json
+// object has the same shape as its list --json object.
+func searchJSONOptions(cmd *cobra.Command) (listjson.Options, error) {
+	var opts listjson.Options
+	opts.Annotate, _ = cmd.Flags().GetBool("annotate")
+	if opts.Annotate && !jsonOutput {
+		return opts, fmt.Errorf("--annotate requires --json")
+	}
+	opts.Hierarchy, _ = cmd.Flags().GetBool("annotate-hierarchy")
+	if opts.Hierarchy && !jsonOutput {
+		return opts, fmt.Errorf("--annotate-hierarchy requires --json")
+	}
+	return opts, nil
+}
+
+// searchQuery is the text half of a bd search: either a single phrase or,
+// with --all/--any, a list of terms matched independently.
+type searchQuery struct {
+	text     string   // phrase passed to SearchIssues
+	terms    []string // --all/--any terms, matched via IssueFilter.SearchTerms
+	matchAny bool     // --any: OR the terms instead of AND
+}
+
+// resolveSearchQuery reads the query from the positional arguments or
+// --query. Without --all/--any the words form one phrase, as before; with
+// either flag each argument (or each word of --query) is its own term.
+func resolveSearchQuery(cmd *cobra.Command, args []string) (searchQuery, error) {
+	all, _ := cmd.Flags().GetBool("all")
+	matchAny, _ := cmd.Flags().GetBool("any")
+	if all && matchAny {
+		return searchQuery{}, fmt.Errorf("--all and --any are mutually exclusive")
+	}
+
+	queryFlag, _ := cmd.Flags().GetString("query")
+	if !all && !matchAny {
+		if len(args) > 0 {
+			return searchQuery{text: strings.Join(args, " ")}, nil
+		}
+		return searchQuery{text: queryFlag}, nil
+	}
+
+	words := args
+	if len(words) == 0 {
+		words = strings.Fields(queryFlag)
+	}
+	var terms []string
+	for _, w := range words {
+		if w = strings.TrimSpace(w); w != "" {
+			terms = append(terms, w)
+		}
+	}
+	return searchQuery{terms: terms, matchAny: matchAny}, nil
+}
+
+func (q searchQuery) empty() bool {
+	return q.text == "" && len(q.terms) == 0
+}
+
+// apply adds the --all/--any terms to filter. Phrase queries are passed to
+// SearchIssues directly and need nothing here.
+func (q searchQuery) apply(filter *types.IssueFilter) {
+	if len(q.terms) > 0 {
+		filter.SearchTerms = q.terms
+		filter.SearchAny = q.matchAny
+	}
+}
+
+// String renders the query for result headers, e.g. "auth OR login".
+func (q searchQuery) String() string {
+	if len(q.terms) == 0 {
+		return q.text
+	}
+	if q.matchAny {
+		return strings.Join(q.terms, " OR ")
+	}
+	return strings.Join(q.terms, " AND ")
+}
+
 // outputSearchResults formats and displays search results
 func outputSearchResults(issues []*types.Issue, query string, longFormat bool) {
 	if len(issues) == 0 {
@@ -354,23 +441,28 @@ func outputSearchResults(issues []*types.Issue, query string, longFormat bool) {
 
 func init() {
 	searchCmd.Flags().String("query", "", "Search query (alternative to positional argument)")
-	searchCmd.Flags().StringP("status", "s", "", "Filter by stored status (comma-separated for OR; open, in_progress, blocked, deferred, closed, all). Default excludes closed; use 'all' to include closed. Note: dependency-blocked issues use 'bd blocked'")
+	searchCmd.Flags().Bool("all", false, "Treat each argument as a separate term; issues must match ALL terms")
+	searchCmd.Flags().Bool("any", false, "Treat each argument as a separate term; issues must match AT LEAST ONE term")
+	searchCmd.Flags().StringSliceP("status", "s", nil, "Filter by stored status (comma-separated or repeatable for OR; open, in_progress, blocked, deferred, closed, all). Default excludes closed; use 'all' to include closed. Note: dependency-blocked issues use 'bd blocked'")
 	searchCmd.Flags().StringP("assignee", "a", "", "Filter by assignee")
 	searchCmd.Flags().StringP("type", "t", "", "Filter by type (bug, feature, task, epic, chore, decision, merge-request, molecule, gate)")
 	searchCmd.Flags().StringSliceP("label", "l", []string{}, "Filter by labels (AND: must have ALL)")
 	searchCmd.Flags().StringSlice("label-any", []string{}, "Filter by labels (OR: must have AT LEAST ONE)")
 	searchCmd.Flags().IntP("limit", "n", 50, "Limit results (default: 50)")
 	searchCmd.Flags().Bool("long", false, "Show detailed multi-line output for each issue")
-	searchCmd.Flags().String("sort", "", "Sort by field: priority, created, updated, closed, status, id, title, type, assignee")
+	searchCmd.Flags().String("sort", "", "Sort by field: "+listSortFieldsHelp+"; add :asc or :desc for a direction, and separate keys with commas (e.g. priority,created:asc)")
 	searchCmd.Flags().BoolP("reverse", "r", false, "Reverse sort order")
+	searchCmd.Flags().Bool("annotate", false, "With --json, add each issue's age (seconds since created), idle (seconds since last update), due_in and overdue_by (seconds until or past due_at), blockers (open issues blocking it) and blocking (issues it blocks)")
+	searchCmd.Flags().Bool("annotate-hierarchy", false, "With --json, add each issue's depth (hops from its root) and path (ancestor IDs, root first)")
+	addLegacyKeysFlag(searchCmd)
 
 	// Date range flags
-	searchCmd.Flags().String("created-after", "", "Filter issues created after date (YYYY-MM-DD or RFC3339)")
-	searchCmd.Flags().String("created-before", "", "Filter issues created before date (YYYY-MM-DD or RFC3339)")
-	searchCmd.Flags().String("updated-after", "", "Filter issues updated after date (YYYY-MM-DD or RFC3339)")
-	searchCmd.Flags().String("updated-before", "", "Filter issues updated before date (YYYY-MM-DD or RFC3339)")
-	searchCmd.Flags().String("closed-after", "", "Filter issues closed after date (YYYY-MM-DD or RFC3339)")
-	searchCmd.Flags().String("closed-before", "", "Filter issues closed before date (YYYY-MM-DD or RFC3339)")
+	searchCmd.Flags().String("created-after", "", "Filter issues created after date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	searchCmd.Flags().String("created-before", "", "Filter issues created before date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	searchCmd.Flags().String("updated-after", "", "Filter issues updated after date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	searchCmd.Flags().String("updated-before", "", "Filter issues updated before date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	searchCmd.Flags().String("closed-after", "", "Filter issues closed after date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
+	searchCmd.Flags().String("closed-before", "", "Filter issues closed before date (YYYY-MM-DD, RFC3339, or relative: -7d, yesterday)")
 
 	// Priority range flags
 	searchCmd.Flags().String("priority-min", "", "Filter by minimum priority (inclusive, 0-4 or P0-P4)")
@@ -381,6 +473,10 @@ func init() {
 	searchCmd.Flags().String("notes-contains", "", "Filter by notes substring (case-insensitive)")
 	searchCmd.Flags().String("external-contains", "", "Filter by external ref substring (case-insensitive)")
 
+	// Spec flags
+	searchCmd.Flags().String("spec-id", "", "Filter by exact spec_id (e.g., RFC-007)")
+	searchCmd.Flags().String("spec-id-prefix", "", "Filter by spec_id prefix")
+
 	// Empty/null check flags
 	searchCmd.Flags().Bool("empty-description", false, "Filter issues with empty or missing description")
 	searchCmd.Flags().Bool("no-assignee", false, "Filter issues with no assignee")
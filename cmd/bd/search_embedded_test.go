@@ -5,8 +5,11 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
 	"os/exec"
+	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -116,6 +119,50 @@ func TestEmbeddedSearch(t *testing.T) {
 		}
 	})
 
+	// ===== Multi-term Search =====
+
+	searchIDs := func(results []map[string]interface{}) map[string]bool {
+		ids := make(map[string]bool, len(results))
+		for _, r := range results {
+			ids[r["id"].(string)] = true
+		}
+		return ids
+	}
+
+	t.Run("search_all_terms", func(t *testing.T) {
+		ids := searchIDs(bdSearchJSON(t, bd, dir, "alpha", "task", "--all"))
+		if !ids[taskA.ID] {
+			t.Errorf("expected %s to match both 'alpha' and 'task'", taskA.ID)
+		}
+		if ids[taskD.ID] {
+			t.Errorf("%s matches only 'task' and should be excluded with --all", taskD.ID)
+		}
+	})
+
+	t.Run("search_any_terms", func(t *testing.T) {
+		ids := searchIDs(bdSearchJSON(t, bd, dir, "alpha", "beta", "--any"))
+		if !ids[taskA.ID] || !ids[taskB.ID] {
+			t.Errorf("expected %s and %s with --any, got %v", taskA.ID, taskB.ID, ids)
+		}
+		if ids[taskC.ID] {
+			t.Errorf("%s matches neither term and should be excluded", taskC.ID)
+		}
+	})
+
+	t.Run("search_any_query_flag", func(t *testing.T) {
+		ids := searchIDs(bdSearchJSON(t, bd, dir, "--query", "gamma beta", "--any"))
+		if !ids[taskB.ID] || !ids[taskC.ID] {
+			t.Errorf("expected %s and %s for --query split into terms, got %v", taskB.ID, taskC.ID, ids)
+		}
+	})
+
+	t.Run("search_all_any_exclusive", func(t *testing.T) {
+		out := bdSearchFail(t, bd, dir, "alpha", "beta", "--all", "--any")
+		if !strings.Contains(out, "mutually exclusive") {
+			t.Errorf("expected mutual exclusion error, got: %s", out)
+		}
+	})
+
 	// ===== Status Filter =====
 
 	t.Run("search_status_open", func(t *testing.T) {
@@ -163,6 +210,24 @@ func TestEmbeddedSearch(t *testing.T) {
 		}
 	})
 
+	t.Run("search_status_repeated", func(t *testing.T) {
+		results := bdSearchJSON(t, bd, dir, "sr-", "--status", "open", "--status", "closed")
+		ids := map[string]bool{}
+		for _, r := range results {
+			ids[r["id"].(string)] = true
+		}
+		if !ids[taskA.ID] || !ids[closedTask.ID] {
+			t.Error("expected both open and closed issues with --status open --status closed")
+		}
+	})
+
+	t.Run("search_status_invalid_member", func(t *testing.T) {
+		out := bdSearchFail(t, bd, dir, "sr-", "--status", "open,not-a-status")
+		if !strings.Contains(out, "invalid status") || !strings.Contains(out, "not-a-status") {
+			t.Errorf("expected the invalid member to be named, got: %s", out)
+		}
+	})
+
 	t.Run("search_status_invalid", func(t *testing.T) {
 		out := bdSearchFail(t, bd, dir, "sr-", "--status", "not-a-status")
 		if !strings.Contains(out, "invalid status") || !strings.Contains(out, "not-a-status") {
@@ -310,6 +375,20 @@ func TestEmbeddedSearch(t *testing.T) {
 		}
 	})
 
+	t.Run("search_priority_range_reversed", func(t *testing.T) {
+		out := bdSearchFail(t, bd, dir, "sr-", "--priority-min", "3", "--priority-max", "1")
+		if !strings.Contains(out, "--priority-min 3 is greater than --priority-max 1") {
+			t.Errorf("expected the reversed range error, got: %s", out)
+		}
+	})
+
+	t.Run("search_negative_limit", func(t *testing.T) {
+		out := bdSearchFail(t, bd, dir, "sr-", "-n", "-1")
+		if !strings.Contains(out, "--limit must be >= 0") {
+			t.Errorf("expected a negative limit error, got: %s", out)
+		}
+	})
+
 	// ===== Description Filters =====
 
 	t.Run("search_desc_contains", func(t *testing.T) {
@@ -371,6 +450,13 @@ func TestEmbeddedSearch(t *testing.T) {
 		}
 	})
 
+	t.Run("search_date_range_reversed", func(t *testing.T) {
+		out := bdSearchFail(t, bd, dir, "sr-", "--closed-after", "2099-01-01", "--closed-before", "2020-01-01")
+		if !strings.Contains(out, "is not earlier than --closed-before") {
+			t.Errorf("expected the reversed range error, got: %s", out)
+		}
+	})
+
 	// ===== Metadata Filters =====
 
 	t.Run("search_metadata_field", func(t *testing.T) {
@@ -404,6 +490,72 @@ func TestEmbeddedSearch(t *testing.T) {
 		}
 	})
 
+	t.Run("search_json_matches_list", func(t *testing.T) {
+		epic := bdCreate(t, bd, dir, "Zeta epic", "--type", "epic")
+		child := bdCreate(t, bd, dir, "Zeta child", "--parent", epic.ID, "--label", "shape")
+		bdComment(t, bd, dir, child.ID, "a remark")
+
+		listObject := func(args ...string) map[string]interface{} {
+			cmd := exec.Command(bd, append([]string{"list", "--json", "--id", child.ID}, args...)...)
+			cmd.Dir = dir
+			cmd.Env = bdEnv(dir)
+			stdout, stderr, err := runCommandBuffers(t, cmd)
+			if err != nil {
+				t.Fatalf("bd list failed: %v\n%s", err, stderr.String())
+			}
+			var results []map[string]interface{}
+			if err := json.Unmarshal(stdout.Bytes(), &results); err != nil || len(results) != 1 {
+				t.Fatalf("parse list JSON (%v): %s", err, stdout.String())
+			}
+			return results[0]
+		}
+		searchObject := func(args ...string) map[string]interface{} {
+			results := bdSearchJSON(t, bd, dir, append([]string{"Zeta child"}, args...)...)
+			if len(results) != 1 {
+				t.Fatalf("expected 1 search result, got %d", len(results))
+			}
+			return results[0]
+		}
+
+		listed, searched := listObject(), searchObject()
+		if !reflect.DeepEqual(listed, searched) {
+			t.Errorf("search and list objects differ:\nlist:   %v\nsearch: %v", listed, searched)
+		}
+		if searched["parent_id"] != epic.ID {
+			t.Errorf("search parent_id = %v, want %s", searched["parent_id"], epic.ID)
+		}
+
+		for _, flag := range []string{"--annotate", "--annotate-hierarchy"} {
+			listKeys := slices.Sorted(maps.Keys(listObject(flag)))
+			searchKeys := slices.Sorted(maps.Keys(searchObject(flag)))
+			if !slices.Equal(listKeys, searchKeys) {
+				t.Errorf("%s: search keys %v, list keys %v", flag, searchKeys, listKeys)
+			}
+		}
+		if out := bdSearchFail(t, bd, dir, "Zeta", "--annotate"); !strings.Contains(out, "requires --json") {
+			t.Errorf("--annotate without --json should fail, got: %s", out)
+		}
+	})
+
+	t.Run("search_spec_id", func(t *testing.T) {
+		rfc7 := bdCreate(t, bd, dir, "Spec seven", "--type", "task", "--spec-id", "RFC-007")
+		rfc70 := bdCreate(t, bd, dir, "Spec seventy", "--type", "task", "--spec-id", "RFC-0070")
+		bdCreate(t, bd, dir, "Spec other", "--type", "task", "--spec-id", "ADR-001")
+
+		results := bdSearchJSON(t, bd, dir, "Spec", "--spec-id", "RFC-007")
+		if len(results) != 1 || results[0]["id"] != rfc7.ID {
+			t.Errorf("--spec-id RFC-007 returned %v, want only %s", results, rfc7.ID)
+		}
+		results = bdSearchJSON(t, bd, dir, "Spec", "--spec-id-prefix", "RFC-")
+		ids := map[string]bool{}
+		for _, r := range results {
+			ids[r["id"].(string)] = true
+		}
+		if len(ids) != 2 || !ids[rfc7.ID] || !ids[rfc70.ID] {
+			t.Errorf("--spec-id-prefix RFC- returned %v, want %s and %s", ids, rfc7.ID, rfc70.ID)
+		}
+	})
+
 	_ = taskB
 	_ = taskC
 	_ = taskD
@@ -14,30 +14,41 @@ import (
 )
 
 func runSearchProxiedServer(cmd *cobra.Command, ctx context.Context, args []string) error {
-	queryFlag, _ := cmd.Flags().GetString("query")
-	var query string
-	if len(args) > 0 {
-		query = strings.Join(args, " ")
-	} else if queryFlag != "" {
-		query = queryFlag
+	sq, err := resolveSearchQuery(cmd, args)
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
 	}
+	query := sq.text
 
-	if query == "" {
+	if sq.empty() {
 		if err := cmd.Help(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error displaying help: %v\n", err)
 		}
 		return HandleErrorRespectJSON("search query is required")
 	}
 
-	status, _ := cmd.Flags().GetString("status")
+	jsonOpts, err := searchJSONOptions(cmd)
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+
+	statuses, _ := cmd.Flags().GetStringSlice("status")
+	status := strings.Join(statuses, ",")
 	assignee, _ := cmd.Flags().GetString("assignee")
 	issueType, _ := cmd.Flags().GetString("type")
 	limit, _ := cmd.Flags().GetInt("limit")
+	if err := limitFlagError(limit); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
 	labels, _ := cmd.Flags().GetStringSlice("label")
 	labelsAny, _ := cmd.Flags().GetStringSlice("label-any")
 	longFormat, _ := cmd.Flags().GetBool("long")
 	sortBy, _ := cmd.Flags().GetString("sort")
 	reverse, _ := cmd.Flags().GetBool("reverse")
+	sortBy, reverse, err = resolveSortFlag(sortBy, reverse)
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
 
 	createdAfter, _ := cmd.Flags().GetString("created-after")
 	createdBefore, _ := cmd.Flags().GetString("created-before")
@@ -52,6 +63,8 @@ func runSearchProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 	descContains, _ := cmd.Flags().GetString("desc-contains")
 	notesContains, _ := cmd.Flags().GetString("notes-contains")
 	externalContains, _ := cmd.Flags().GetString("external-contains")
+	specID, _ := cmd.Flags().GetString("spec-id")
+	specPrefix, _ := cmd.Flags().GetString("spec-id-prefix")
 
 	emptyDesc, _ := cmd.Flags().GetBool("empty-description")
 	noAssignee, _ := cmd.Flags().GetBool("no-assignee")
@@ -63,6 +76,7 @@ func runSearchProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 	filter := types.IssueFilter{
 		Limit: limit,
 	}
+	sq.apply(&filter)
 
 	if status == "" {
 		filter.ExcludeStatus = []types.Status{types.StatusClosed}
@@ -94,6 +108,12 @@ func runSearchProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 	if externalContains != "" {
 		filter.ExternalRefContains = externalContains
 	}
+	if specID != "" {
+		filter.SpecID = specID
+	}
+	if specPrefix != "" {
+		filter.SpecIDPrefix = specPrefix
+	}
 
 	if emptyDesc {
 		filter.EmptyDescription = true
@@ -162,6 +182,14 @@ func runSearchProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 		}
 		filter.PriorityMax = &priorityMax
 	}
+	if filter.PriorityMin != nil && filter.PriorityMax != nil {
+		if err := priorityRangeError(*filter.PriorityMin, *filter.PriorityMax); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+	}
+	if err := filterTimeRangeError(filter); err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
 
 	metadataFieldFlags, _ := cmd.Flags().GetStringArray("metadata-field")
 	if len(metadataFieldFlags) > 0 {
@@ -211,7 +239,11 @@ func runSearchProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 		if items == nil {
 			items = []*types.IssueWithCounts{}
 		}
-		return outputJSON(items)
+		payload, err := proxiedListJSONPayload(ctx, uw, items, jsonOpts, nil)
+		if err != nil {
+			return err
+		}
+		return outputJSON(payload)
 	}
 
 	page, err := uw.IssueUseCase().SearchIssues(ctx, query, filter)
@@ -220,6 +252,6 @@ func runSearchProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 	}
 	issues := page.Items
 	sortIssues(issues, sortBy, reverse)
-	outputSearchResults(issues, query, longFormat)
+	outputSearchResults(issues, sq.String(), longFormat)
 	return nil
 }
@@ -407,7 +407,7 @@ func (c *bdClient) phaseVerify(ids []string) error {
 		return c.errf("show %s: expected dependencies, got none", ids[16])
 	}
 	// Chore 25 parent = epic 20.
-	if err := c.expectField(ids[25], "parent", ids[20]); err != nil {
+	if err := c.expectField(ids[25], "parent_id", ids[20]); err != nil {
 		return err
 	}
 	// Labels on task 3.
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/listjson"
 	"github.com/steveyegge/beads/internal/metrics"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
@@ -46,6 +47,7 @@ var showCmd = &cobra.Command{
 		currentMode, _ := cmd.Flags().GetBool("current")
 		includeDepends, _ := cmd.Flags().GetBool("include-dependents")
 		includeComments, _ := cmd.Flags().GetBool("include-comments")
+		showRollup, _ := cmd.Flags().GetBool("rollup")
 		ctx := rootCtx
 
 		// Helper to format timestamp based on --local-time flag
@@ -111,7 +113,12 @@ var showCmd = &cobra.Command{
 		}
 
 		// Direct mode - use routed resolution for cross-repo lookups
-		allDetails := []interface{}{}
+		var jsonResults []*RoutedResult
+		defer func() {
+			for _, r := range jsonResults {
+				r.Close()
+			}
+		}()
 		foundCount := 0
 		for idx, id := range args {
 			// Resolve and get issue with routing (e.g., gt-xyz routes to another rig)
@@ -144,94 +151,9 @@ var showCmd = &cobra.Command{
 			if jsonOutput {
 				// be-ijck6q: default is count-only (no dependents/comments slice in output).
 				// Use --include-dependents / --include-comments to stream the full lists.
-				details := &types.IssueDetails{Issue: *issue}
-				details.Labels, _ = issueStore.GetLabels(ctx, issue.ID)
-				details.Dependencies, _ = issueStore.GetDependenciesWithMetadata(ctx, issue.ID)
-
-				// Aggregate counts — O(1) queries, no row materialization.
-				depCount, _ := issueStore.CountDependents(ctx, issue.ID)
-				details.DependentCount = &depCount
-				depnCount, _ := issueStore.CountDependencies(ctx, issue.ID)
-				details.DependencyCount = &depnCount
-				cmtCount, _ := issueStore.CountIssueComments(ctx, issue.ID)
-				details.CommentCount = &cmtCount
-
-				// --include-dependents: stream via Iter, shallow-copy each item.
-				// May be slow on hub beads with many dependents.
-				if includeDepends {
-					iter, err := issueStore.IterDependentsWithMetadata(ctx, issue.ID)
-					if err != nil {
-						result.Close()
-						return HandleErrorRespectJSON("iter dependents %s: %v", issue.ID, err)
-					}
-					defer iter.Close() //nolint:errcheck
-					var shallowDeps []*types.IssueWithDependencyMetadata
-					for iter.Next(ctx) {
-						item := iter.Value()
-						shallowDeps = append(shallowDeps, &types.IssueWithDependencyMetadata{
-							Issue: types.Issue{
-								ID:        item.Issue.ID,
-								Status:    item.Issue.Status,
-								IssueType: item.Issue.IssueType,
-								Priority:  item.Issue.Priority,
-								Title:     item.Issue.Title,
-							},
-							DependencyType: item.DependencyType,
-						})
-					}
-					if err := iter.Err(); err != nil {
-						result.Close()
-						return HandleErrorRespectJSON("iter dependents %s: %v", issue.ID, err)
-					}
-					details.Dependents = shallowDeps
-
-					// Epic progress from streamed dependents.
-					if issue.IssueType == types.TypeEpic && len(shallowDeps) > 0 {
-						total, closed := 0, 0
-						for _, dep := range shallowDeps {
-							if dep.DependencyType == types.DepParentChild {
-								total++
-								if dep.Issue.Status == types.StatusClosed {
-									closed++
-								}
-							}
-						}
-						if total > 0 {
-							details.EpicTotalChildren = &total
-							details.EpicClosedChildren = &closed
-							closeable := total == closed
-							details.EpicCloseable = &closeable
-						}
-					}
-				}
-
-				// --include-comments: stream via Iter.
-				// May be slow on issues with many comments.
-				if includeComments {
-					iter, err := issueStore.IterIssueComments(ctx, issue.ID)
-					if err != nil {
-						result.Close()
-						return HandleErrorRespectJSON("iter comments %s: %v", issue.ID, err)
-					}
-					defer iter.Close() //nolint:errcheck
-					for iter.Next(ctx) {
-						details.Comments = append(details.Comments, iter.Value())
-					}
-					if err := iter.Err(); err != nil {
-						result.Close()
-						return HandleErrorRespectJSON("iter comments %s: %v", issue.ID, err)
-					}
-				}
-
-				// Compute parent from dependencies.
-				for _, dep := range details.Dependencies {
-					if dep.DependencyType == types.DepParentChild {
-						details.Parent = &dep.ID
-						break
-					}
-				}
-				allDetails = append(allDetails, details)
-				result.Close()
+				// Relations are loaded for all IDs at once after the loop, so the
+				// routed store stays open until then.
+				jsonResults = append(jsonResults, result)
 				continue
 			}
 			if idx > 0 {
@@ -421,8 +343,20 @@ var showCmd = &cobra.Command{
 		}
 
 		if jsonOutput {
-			if len(allDetails) > 0 {
-				if jerr := outputJSON(allDetails); jerr != nil {
+			if len(jsonResults) > 0 {
+				allDetails, err := buildShowJSONDetails(ctx, jsonResults, includeDepends, includeComments)
+				if err != nil {
+					return HandleErrorRespectJSON("%v", err)
+				}
+				items := showJSONItems(allDetails)
+				if showRollup {
+					if err := attachShowRollups(ctx, items, func(i int) listjson.DescendantLookup {
+						return storeDescendantLookup(jsonResults[i].Store)
+					}); err != nil {
+						return HandleErrorRespectJSON("computing rollup: %v", err)
+					}
+				}
+				if jerr := outputCanonicalJSON(items); jerr != nil {
 					return jerr
 				}
 			} else {
@@ -491,6 +425,8 @@ func init() {
 	showCmd.Flags().Bool("current", false, "Show the currently active issue (in-progress, hooked, or last touched)")
 	showCmd.Flags().Bool("include-dependents", false, "Stream full dependent issues in JSON output (--json only; may be slow on hub beads)")
 	showCmd.Flags().Bool("include-comments", false, "Stream full comment bodies in JSON output (--json only; may be slow on issues with many comments)")
+	showCmd.Flags().Bool("rollup", false, rollupFlagUsage)
+	addLegacyKeysFlag(showCmd)
 	showCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(showCmd)
 }
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/steveyegge/beads/internal/listjson"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// showDetailsBatch carries the relations `bd show --json` attaches to every
+// issue, loaded for the whole argument list at once. Each relation is one
+// batched read keyed by issue ID, so `bd show a b c ... z` costs the same
+// handful of queries as `bd show a` instead of five per issue.
+//
+// Loads are best effort, mirroring the per-issue path they replace: a failed
+// relation leaves that field empty rather than failing the show.
+type showDetailsBatch struct {
+	labels       map[string][]string
+	dependencies map[string][]*types.IssueWithDependencyMetadata
+	dependents   map[string]int64
+	dependsOn    map[string]int64
+	duplicatedBy map[string][]string
+	comments     map[string]int
+}
+
+// loadShowDetailsBatch fetches labels, dependencies (with target issues),
+// dependent/dependency counts and comment counts for ids from s.
+func loadShowDetailsBatch(ctx context.Context, s storage.DoltStorage, ids []string) *showDetailsBatch {
+	b := &showDetailsBatch{
+		dependencies: make(map[string][]*types.IssueWithDependencyMetadata, len(ids)),
+		dependents:   make(map[string]int64, len(ids)),
+		dependsOn:    make(map[string]int64, len(ids)),
+		duplicatedBy: make(map[string][]string),
+	}
+	if len(ids) == 0 {
+		return b
+	}
+
+	b.labels, _ = s.GetLabelsForIssues(ctx, ids)
+	b.comments, _ = s.GetCommentCounts(ctx, ids)
+
+	if inbound, err := s.GetDependentRecordsForIssues(ctx, ids); err == nil {
+		for id, deps := range inbound {
+			b.dependents[id] = int64(len(deps))
+			for _, dep := range deps {
+				if dep.Type == types.DepDuplicates {
+					b.duplicatedBy[id] = append(b.duplicatedBy[id], dep.IssueID)
+				}
+			}
+		}
+	}
+
+	outbound, err := s.GetDependencyRecordsForIssues(ctx, ids)
+	if err != nil {
+		return b
+	}
+	var targetIDs []string
+	seen := make(map[string]bool)
+	for id, deps := range outbound {
+		b.dependsOn[id] = int64(len(deps))
+		for _, dep := range deps {
+			if !seen[dep.DependsOnID] {
+				seen[dep.DependsOnID] = true
+				targetIDs = append(targetIDs, dep.DependsOnID)
+			}
+		}
+	}
+	if len(targetIDs) == 0 {
+		return b
+	}
+	targets, err := s.GetIssuesByIDs(ctx, targetIDs)
+	if err != nil {
+		return b
+	}
+	byID := make(map[string]*types.Issue, len(targets))
+	for _, t := range targets {
+		byID[t.ID] = t
+	}
+	// Targets that did not resolve (external refs, deleted issues) are
+	// skipped, as GetDependenciesWithMetadata does.
+	for id, deps := range outbound {
+		for _, dep := range deps {
+			target, ok := byID[dep.DependsOnID]
+			if !ok {
+				continue
+			}
+			b.dependencies[id] = append(b.dependencies[id], &types.IssueWithDependencyMetadata{
+				Issue:          *target,
+				DependencyType: dep.Type,
+			})
+		}
+	}
+	return b
+}
+
+// showIssueJSON is one `bd show --json` element: the issue details plus a
+// top-level parent_id (null for roots), mirroring bd list --json.
+type showIssueJSON struct {
+	*types.IssueDetails
+	ParentID      *string              `json:"parent_id"`
+	ExternalLinks []externalLink       `json:"external_links,omitempty"` // from metadata.external_links
+	Rollup        *listjson.EpicRollup `json:"rollup,omitempty"`         // --rollup: epic estimate totals
+}
+
+func newShowIssueJSON(details *types.IssueDetails) showIssueJSON {
+	details.NormalizeOrder()
+	return showIssueJSON{
+		IssueDetails:  details,
+		ParentID:      details.Parent,
+		ExternalLinks: externalLinksOf(&details.Issue),
+	}
+}
+
+// showJSONItems adds parent_id and external_links to each
+// *types.IssueDetails in allDetails.
+func showJSONItems(allDetails []interface{}) []interface{} {
+	out := make([]interface{}, len(allDetails))
+	for i, d := range allDetails {
+		if details, ok := d.(*types.IssueDetails); ok {
+			out[i] = newShowIssueJSON(details)
+		} else {
+			out[i] = d
+		}
+	}
+	return out
+}
+
+// attachShowRollups sets rollup on each epic among the showIssueJSON items,
+// walking its subtree with lookup(i).
+func attachShowRollups(ctx context.Context, items []interface{}, lookup func(i int) listjson.DescendantLookup) error {
+	for i, it := range items {
+		item, ok := it.(showIssueJSON)
+		if !ok {
+			continue
+		}
+		rollup, err := listjson.EpicRollupFor(ctx, &item.Issue, lookup(i))
+		if err != nil {
+			return err
+		}
+		item.Rollup = rollup
+		items[i] = item
+	}
+	return nil
+}
+
+// details assembles the count-only IssueDetails for issue from the batch.
+func (b *showDetailsBatch) details(issue *types.Issue) *types.IssueDetails {
+	details := &types.IssueDetails{Issue: *issue}
+	details.Labels = b.labels[issue.ID]
+	details.Dependencies = b.dependencies[issue.ID]
+
+	depCount := b.dependents[issue.ID]
+	details.DependentCount = &depCount
+	depnCount := b.dependsOn[issue.ID]
+	details.DependencyCount = &depnCount
+	cmtCount := int64(b.comments[issue.ID])
+	details.CommentCount = &cmtCount
+
+	for _, dep := range details.Dependencies {
+		if dep.DependencyType == types.DepParentChild {
+			details.Parent = &dep.ID
+			break
+		}
+	}
+	for _, dep := range details.Dependencies {
+		if dep.DependencyType == types.DepDuplicates {
+			details.DuplicateOf = &dep.ID
+			break
+		}
+	}
+	details.DuplicatedBy = b.duplicatedBy[issue.ID]
+	slices.Sort(details.DuplicatedBy)
+	return details
+}
+
+// buildShowJSONDetails renders the `bd show --json` payload for results.
+// Issues are grouped by the store that holds them (routing can spread one
+// invocation across rigs) and each group is loaded with one batch, so the
+// query count depends on the number of stores touched, not on len(results).
+// --include-dependents / --include-comments remain per-issue streams: they
+// are opt-in and can be arbitrarily large.
+func buildShowJSONDetails(ctx context.Context, results []*RoutedResult, includeDepends, includeComments bool) ([]interface{}, error) {
+	idsByStore := make(map[storage.DoltStorage][]string)
+	var storeOrder []storage.DoltStorage
+	for _, r := range results {
+		if _, ok := idsByStore[r.Store]; !ok {
+			storeOrder = append(storeOrder, r.Store)
+		}
+		idsByStore[r.Store] = append(idsByStore[r.Store], r.Issue.ID)
+	}
+	batches := make(map[storage.DoltStorage]*showDetailsBatch, len(storeOrder))
+	for _, s := range storeOrder {
+		batches[s] = loadShowDetailsBatch(ctx, s, idsByStore[s])
+	}
+
+	allDetails := make([]interface{}, 0, len(results))
+	for _, r := range results {
+		details := batches[r.Store].details(r.Issue)
+		if includeDepends {
+			if err := attachShowDependents(ctx, r.Store, details); err != nil {
+				return nil, err
+			}
+		}
+		if includeComments {
+			if err := attachShowComments(ctx, r.Store, details); err != nil {
+				return nil, err
+			}
+		}
+		allDetails = append(allDetails, details)
+	}
+	return allDetails, nil
+}
+
+// attachShowDependents streams the dependents of details.Issue, shallow-copying
+// each item, and fills in epic progress from the parent-child edges.
+// May be slow on hub beads with many dependents.
+func attachShowDependents(ctx context.Context, s storage.DoltStorage, details *types.IssueDetails) error {
+	issue := &details.Issue
+	iter, err := s.IterDependentsWithMetadata(ctx, issue.ID)
+	if err != nil {
+		return fmt.Errorf("iter dependents %s: %w", issue.ID, err)
+	}
+	defer iter.Close() //nolint:errcheck
+	var shallowDeps []*types.IssueWithDependencyMetadata
+	for iter.Next(ctx) {
+		item := iter.Value()
+		shallowDeps = append(shallowDeps, &types.IssueWithDependencyMetadata{
+			Issue: types.Issue{
+				ID:        item.Issue.ID,
+				Status:    item.Issue.Status,
+				IssueType: item.Issue.IssueType,
+				Priority:  item.Issue.Priority,
+				Title:     item.Issue.Title,
+			},
+			DependencyType: item.DependencyType,
+		})
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("iter dependents %s: %w", issue.ID, err)
+	}
+	details.Dependents = shallowDeps
+
+	// Epic progress from streamed dependents.
+	if issue.IssueType == types.TypeEpic && len(shallowDeps) > 0 {
+		total, closed := 0, 0
+		for _, dep := range shallowDeps {
+			if dep.DependencyType == types.DepParentChild {
+				total++
+				if dep.Issue.Status == types.StatusClosed {
+					closed++
+				}
+			}
+		}
+		if total > 0 {
+			details.EpicTotalChildren = &total
+			details.EpicClosedChildren = &closed
+			closeable := total == closed
+			details.EpicCloseable = &closeable
+		}
+	}
+	return nil
+}
+
+// attachShowComments streams the comments of details.Issue.
+// May be slow on issues with many comments.
+func attachShowComments(ctx context.Context, s storage.DoltStorage, details *types.IssueDetails) error {
+	iter, err := s.IterIssueComments(ctx, details.ID)
+	if err != nil {
+		return fmt.Errorf("iter comments %s: %w", details.ID, err)
+	}
+	defer iter.Close() //nolint:errcheck
+	for iter.Next(ctx) {
+		details.Comments = append(details.Comments, iter.Value())
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("iter comments %s: %w", details.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// showBatchCountingStore serves the batched relation reads from memory and
+// counts every call, standing in for one round trip per call.
+type showBatchCountingStore struct {
+	storage.DoltStorage
+	issues  map[string]*types.Issue
+	deps    []*types.Dependency
+	queries int
+}
+
+func newShowBatchCountingStore(n int) (*showBatchCountingStore, []*RoutedResult) {
+	s := &showBatchCountingStore{issues: make(map[string]*types.Issue)}
+	parent := &types.Issue{ID: "bd-root", Title: "root", IssueType: types.TypeEpic}
+	s.issues[parent.ID] = parent
+	var results []*RoutedResult
+	for i := 0; i < n; i++ {
+		issue := &types.Issue{ID: fmt.Sprintf("bd-%d", i), Title: "child"}
+		s.issues[issue.ID] = issue
+		s.deps = append(s.deps, &types.Dependency{IssueID: issue.ID, DependsOnID: parent.ID, Type: types.DepParentChild})
+		results = append(results, &RoutedResult{Issue: issue, Store: s})
+	}
+	return s, results
+}
+
+func (s *showBatchCountingStore) GetLabelsForIssues(_ context.Context, ids []string) (map[string][]string, error) {
+	s.queries++
+	out := make(map[string][]string, len(ids))
+	for _, id := range ids {
+		out[id] = []string{"area:cli"}
+	}
+	return out, nil
+}
+
+func (s *showBatchCountingStore) GetCommentCounts(_ context.Context, ids []string) (map[string]int, error) {
+	s.queries++
+	out := make(map[string]int, len(ids))
+	for _, id := range ids {
+		out[id] = 2
+	}
+	return out, nil
+}
+
+func (s *showBatchCountingStore) GetDependencyRecordsForIssues(_ context.Context, ids []string) (map[string][]*types.Dependency, error) {
+	s.queries++
+	out := make(map[string][]*types.Dependency)
+	for _, d := range s.deps {
+		out[d.IssueID] = append(out[d.IssueID], d)
+	}
+	return out, nil
+}
+
+func (s *showBatchCountingStore) GetDependentRecordsForIssues(_ context.Context, ids []string) (map[string][]*types.Dependency, error) {
+	s.queries++
+	out := make(map[string][]*types.Dependency)
+	for _, d := range s.deps {
+		out[d.DependsOnID] = append(out[d.DependsOnID], d)
+	}
+	return out, nil
+}
+
+func (s *showBatchCountingStore) GetIssuesByIDs(_ context.Context, ids []string) ([]*types.Issue, error) {
+	s.queries++
+	var out []*types.Issue
+	for _, id := range ids {
+		if issue, ok := s.issues[id]; ok {
+			out = append(out, issue)
+		}
+	}
+	return out, nil
+}
+
+func TestBuildShowJSONDetails_QueryCountBounded(t *testing.T) {
+	var want int
+	for _, n := range []int{1, 10, 200} {
+		s, results := newShowBatchCountingStore(n)
+		details, err := buildShowJSONDetails(context.Background(), results, false, false)
+		if err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		if len(details) != n {
+			t.Fatalf("n=%d: got %d details", n, len(details))
+		}
+		if want == 0 {
+			want = s.queries
+		}
+		if s.queries != want {
+			t.Errorf("n=%d: %d queries, want %d (must not grow with result size)", n, s.queries, want)
+		}
+	}
+}
+
+func TestBuildShowJSONDetails_AssemblesRelations(t *testing.T) {
+	_, results := newShowBatchCountingStore(2)
+	got, err := buildShowJSONDetails(context.Background(), results, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := got[1].(*types.IssueDetails)
+	if d.ID != "bd-1" {
+		t.Fatalf("order not preserved: got %s", d.ID)
+	}
+	if len(d.Labels) != 1 || d.Labels[0] != "area:cli" {
+		t.Errorf("labels = %v", d.Labels)
+	}
+	if len(d.Dependencies) != 1 || d.Dependencies[0].ID != "bd-root" {
+		t.Errorf("dependencies = %v", d.Dependencies)
+	}
+	if d.Parent == nil || *d.Parent != "bd-root" {
+		t.Errorf("parent = %v, want bd-root", d.Parent)
+	}
+	if *d.DependencyCount != 1 || *d.DependentCount != 0 || *d.CommentCount != 2 {
+		t.Errorf("counts = deps %d, dependents %d, comments %d", *d.DependencyCount, *d.DependentCount, *d.CommentCount)
+	}
+}
+
+func BenchmarkBuildShowJSONDetails(b *testing.B) {
+	for _, n := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("issues=%d", n), func(b *testing.B) {
+			s, results := newShowBatchCountingStore(n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := buildShowJSONDetails(context.Background(), results, false, false); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(s.queries)/float64(b.N), "queries/op")
+		})
+	}
+}
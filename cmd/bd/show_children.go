@@ -60,7 +60,7 @@ func showIssueChildren(ctx context.Context, args []string, jsonOut bool, shortMo
 
 	// Output results
 	if jsonOut {
-		return outputJSON(allChildren)
+		return outputCanonicalJSON(allChildren)
 	}
 
 	// Display children
@@ -123,7 +123,7 @@ func showIssueAsOf(ctx context.Context, args []string, ref string, shortMode boo
 	}
 
 	if jsonOutput && len(allIssues) > 0 {
-		return outputJSON(allIssues)
+		return outputCanonicalJSON(allIssues)
 	}
 	return nil
 }
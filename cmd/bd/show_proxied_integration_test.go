@@ -233,8 +233,8 @@ func TestProxiedServerShow(t *testing.T) {
 		child := bdProxiedCreate(t, bd, p.dir, "Child", "--type", "task", "--parent", parent.ID)
 
 		m := bdProxiedShowDetailsFirst(t, bd, p.dir, child.ID)
-		if got, _ := m["parent"].(string); got != parent.ID {
-			t.Errorf("parent field: got %v, want %v", m["parent"], parent.ID)
+		if got, _ := m["parent_id"].(string); got != parent.ID {
+			t.Errorf("parent_id field: got %v, want %v", m["parent_id"], parent.ID)
 		}
 	})
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/steveyegge/beads/internal/listjson"
 	"github.com/steveyegge/beads/internal/storage/domain"
 	"github.com/steveyegge/beads/internal/storage/uow"
 	"github.com/steveyegge/beads/internal/types"
@@ -32,6 +33,7 @@ type showProxiedInput struct {
 	currentMode     bool
 	includeDepends  bool
 	includeComments bool
+	rollup          bool
 }
 
 func gatherShowProxiedInput(cmd *cobra.Command, args []string) *showProxiedInput {
@@ -47,6 +49,7 @@ func gatherShowProxiedInput(cmd *cobra.Command, args []string) *showProxiedInput
 	in.currentMode, _ = cmd.Flags().GetBool("current")
 	in.includeDepends, _ = cmd.Flags().GetBool("include-dependents")
 	in.includeComments, _ = cmd.Flags().GetBool("include-comments")
+	in.rollup, _ = cmd.Flags().GetBool("rollup")
 
 	idFlags, _ := cmd.Flags().GetStringArray("id")
 	in.ids = append(in.ids, args...)
@@ -200,7 +203,7 @@ func runShowProxiedAsOf(ctx context.Context, uw uow.UnitOfWork, in *showProxiedI
 		fmt.Println()
 	}
 	if jsonOutput && len(jsonIssues) > 0 {
-		_ = outputJSON(jsonIssues)
+		_ = outputCanonicalJSON(jsonIssues)
 	}
 }
 
@@ -288,7 +291,7 @@ func runShowProxiedChildren(ctx context.Context, uw uow.UnitOfWork, in *showProx
 	}
 
 	if jsonOutput {
-		_ = outputJSON(allChildren)
+		_ = outputCanonicalJSON(allChildren)
 		return
 	}
 	for id, kids := range allChildren {
@@ -456,7 +459,7 @@ func runShowProxiedDefault(ctx context.Context, uw uow.UnitOfWork, in *showProxi
 
 		if jsonOutput {
 			details := proxiedBuildDetails(ctx, uw, issue, isWisp, in)
-			allDetails = append(allDetails, details)
+			allDetails = append(allDetails, newShowIssueJSON(details))
 			continue
 		}
 
@@ -465,7 +468,14 @@ func runShowProxiedDefault(ctx context.Context, uw uow.UnitOfWork, in *showProxi
 
 	if jsonOutput {
 		if len(allDetails) > 0 {
-			_ = outputJSON(allDetails)
+			if in.rollup {
+				if err := attachShowRollups(ctx, allDetails, func(int) listjson.DescendantLookup {
+					return proxiedDescendantLookup(uw)
+				}); err != nil {
+					return HandleErrorRespectJSON("computing rollup: %v", err)
+				}
+			}
+			_ = outputCanonicalJSON(allDetails)
 		} else {
 			return HandleErrorRespectJSON("no issues found matching the provided IDs")
 		}
@@ -546,6 +556,21 @@ func proxiedBuildDetails(ctx context.Context, uw uow.UnitOfWork, issue *types.Is
 			break
 		}
 	}
+	for _, dep := range details.Dependencies {
+		if dep.DependencyType == types.DepDuplicates {
+			canonicalID := dep.ID
+			details.DuplicateOf = &canonicalID
+			break
+		}
+	}
+	if dupes, err := proxiedListDeps(ctx, uw, issue.ID, isWisp, domain.DepListFilter{
+		Types:     []types.DependencyType{types.DepDuplicates},
+		Direction: domain.DepDirectionIn,
+	}); err == nil {
+		for _, dupe := range dupes {
+			details.DuplicatedBy = append(details.DuplicatedBy, dupe.ID)
+		}
+	}
 	return details
 }
 
@@ -40,7 +40,7 @@ func applyLabelUpdates(ctx context.Context, st storage.DoltStorage, issueID, act
 			return err
 		}
 		for _, label := range currentLabels {
-			if err := st.RemoveLabel(ctx, issueID, label, actor); err != nil {
+			if _, err := st.RemoveLabel(ctx, issueID, label, actor); err != nil {
 				return err
 			}
 		}
@@ -60,7 +60,7 @@ func applyLabelUpdates(ctx context.Context, st storage.DoltStorage, issueID, act
 
 	// Remove labels
 	for _, label := range removeLabels {
-		if err := st.RemoveLabel(ctx, issueID, label, actor); err != nil {
+		if _, err := st.RemoveLabel(ctx, issueID, label, actor); err != nil {
 			return err
 		}
 	}
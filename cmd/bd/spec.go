@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/listjson"
+	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// specStatusOrder is the order bd spec lists the built-in statuses in; any
+// custom status follows in name order.
+var specStatusOrder = []string{"open", "in_progress", "blocked", "deferred", "pinned", "hooked", "closed"}
+
+// SpecRollup is the delivery status of a spec: the issues whose spec_id
+// matches, counted by status, with the same closed-over-total completion
+// bd epic status reports and the estimate rollup of bd list --rollup.
+type SpecRollup struct {
+	SpecID    string               `json:"spec_id"`
+	Prefix    bool                 `json:"prefix,omitempty"`
+	Total     int                  `json:"total"`
+	Closed    int                  `json:"closed"`
+	Percent   int                  `json:"percent_complete"`
+	ByStatus  map[string]int       `json:"by_status"`
+	Estimates *listjson.EpicRollup `json:"estimates"`
+	Issues    []*types.Issue       `json:"issues"`
+}
+
+var specCmd = &cobra.Command{
+	Use:     "spec <spec-id>",
+	GroupID: "views",
+	Short:   "Show the delivery status of a spec",
+	Long: `List every issue implementing a spec (issues whose spec_id matches), with a
+count per status, the completion percent (closed issues over all issues) and
+the estimate rollup of the issues, closed ones included.
+
+Examples:
+  bd spec RFC-007
+  bd spec RFC- --prefix    # every spec starting with RFC-
+  bd spec RFC-007 --json`,
+	Args:          cobra.ExactArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		evt := metrics.NewCommandEvent("spec")
+		defer func() {
+			if c := metrics.Global(); c != nil {
+				c.CloseEventAndAdd(evt)
+			}
+		}()
+
+		specID := strings.TrimSpace(args[0])
+		if specID == "" {
+			return HandleErrorRespectJSON("spec ID must not be empty")
+		}
+		prefix, _ := cmd.Flags().GetBool("prefix")
+		filter := specFilter(specID, prefix)
+
+		if usesProxiedServer() {
+			return runSpecProxiedServer(rootCtx, specID, prefix, filter)
+		}
+
+		issues, err := store.SearchIssues(rootCtx, "", filter)
+		if err != nil {
+			return HandleErrorRespectJSON("searching issues for spec %s: %v", specID, err)
+		}
+		return renderSpecRollup(computeSpecRollup(specID, prefix, issues))
+	},
+}
+
+// specFilter selects the issues of a spec, closed ones included.
+func specFilter(specID string, prefix bool) types.IssueFilter {
+	if prefix {
+		return types.IssueFilter{SpecIDPrefix: specID}
+	}
+	return types.IssueFilter{SpecID: specID}
+}
+
+// computeSpecRollup counts the issues of a spec by status. Issues come out
+// in ID order.
+func computeSpecRollup(specID string, prefix bool, issues []*types.Issue) *SpecRollup {
+	rollup := &SpecRollup{
+		SpecID:    specID,
+		Prefix:    prefix,
+		Total:     len(issues),
+		ByStatus:  map[string]int{},
+		Estimates: listjson.ComputeEpicRollup(issues),
+		Issues:    slices.Clone(issues),
+	}
+	if rollup.Issues == nil {
+		rollup.Issues = []*types.Issue{}
+	}
+	slices.SortFunc(rollup.Issues, func(a, b *types.Issue) int { return strings.Compare(a.ID, b.ID) })
+	for _, issue := range issues {
+		rollup.ByStatus[string(issue.Status)]++
+		if issue.Status == types.StatusClosed {
+			rollup.Closed++
+		}
+	}
+	rollup.Percent = completionPercent(rollup.Closed, rollup.Total)
+	return rollup
+}
+
+// completionPercent is closed over total as a whole percentage, rounded down
+// so 100% means everything is closed.
+func completionPercent(closed, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return closed * 100 / total
+}
+
+// specStatuses returns the statuses present in byStatus in display order.
+func specStatuses(byStatus map[string]int) []string {
+	var statuses []string
+	for _, status := range specStatusOrder {
+		if byStatus[status] > 0 {
+			statuses = append(statuses, status)
+		}
+	}
+	var custom []string
+	for status := range byStatus {
+		if !slices.Contains(specStatusOrder, status) {
+			custom = append(custom, status)
+		}
+	}
+	slices.Sort(custom)
+	return append(statuses, custom...)
+}
+
+func renderSpecRollup(rollup *SpecRollup) error {
+	if jsonOutput {
+		return outputJSON(rollup)
+	}
+	label := rollup.SpecID
+	if rollup.Prefix {
+		label += "*"
+	}
+	if rollup.Total == 0 {
+		fmt.Printf("No issues reference spec %s\n", label)
+		return nil
+	}
+
+	fmt.Printf("%s: %d/%d issues closed (%d%%)\n", ui.RenderBold(label), rollup.Closed, rollup.Total, rollup.Percent)
+	counts := make([]string, 0, len(rollup.ByStatus))
+	for _, status := range specStatuses(rollup.ByStatus) {
+		counts = append(counts, fmt.Sprintf("%s: %d", status, rollup.ByStatus[status]))
+	}
+	fmt.Printf("  %s\n", strings.Join(counts, "  "))
+	if est := rollup.Estimates; est.EstimateTotal > 0 {
+		fmt.Printf("  Estimate: %dm total, %dm done, %dm remaining", est.EstimateTotal, est.ActualTotal, est.Remaining)
+		if est.Unestimated > 0 {
+			fmt.Printf(" (%d unestimated)", est.Unestimated)
+		}
+		fmt.Println()
+	}
+	fmt.Println()
+	for _, issue := range rollup.Issues {
+		line := fmt.Sprintf("  %s %s %s", ui.RenderStatusIcon(string(issue.Status)), ui.RenderAccent(issue.ID), issue.Title)
+		if rollup.Prefix {
+			line += fmt.Sprintf(" [%s]", issue.SpecID)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+func init() {
+	specCmd.Flags().Bool("prefix", false, "Match every spec_id starting with <spec-id>")
+	rootCmd.AddCommand(specCmd)
+}
@@ -0,0 +1,104 @@
+//go:build cgo
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// bdSpecJSON runs "bd spec --json" and parses the rollup.
+func bdSpecJSON(t *testing.T, bd, dir string, args ...string) SpecRollup {
+	t.Helper()
+	cmd := exec.Command(bd, append([]string{"spec", "--json"}, args...)...)
+	cmd.Dir = dir
+	cmd.Env = bdEnv(dir)
+	stdout, stderr, err := runCommandBuffers(t, cmd)
+	if err != nil {
+		t.Fatalf("bd spec --json %s failed: %v\nstdout:\n%s\nstderr:\n%s", strings.Join(args, " "), err, stdout.String(), stderr.String())
+	}
+	var rollup SpecRollup
+	if err := json.Unmarshal(stdout.Bytes(), &rollup); err != nil {
+		t.Fatalf("parse bd spec JSON: %v\n%s", err, stdout.String())
+	}
+	return rollup
+}
+
+func TestEmbeddedSpec(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+	dir, _, _ := bdInit(t, bd, "--prefix", "sp")
+
+	done := bdCreate(t, bd, dir, "Parse the header", "--type", "task", "--spec-id", "RFC-007", "--estimate", "60")
+	doing := bdCreate(t, bd, dir, "Validate the header", "--type", "task", "--spec-id", "RFC-007", "--estimate", "30")
+	todo := bdCreate(t, bd, dir, "Document the header", "--type", "task", "--spec-id", "RFC-007")
+	done2 := bdCreate(t, bd, dir, "Reject bad headers", "--type", "bug", "--spec-id", "RFC-007", "--estimate", "15")
+	other := bdCreate(t, bd, dir, "Other spec", "--type", "task", "--spec-id", "RFC-0070")
+	bdCreate(t, bd, dir, "No spec", "--type", "task")
+	bdClose(t, bd, dir, done.ID)
+	bdClose(t, bd, dir, done2.ID)
+	bdUpdate(t, bd, dir, doing.ID, "--claim")
+
+	t.Run("rollup_counts", func(t *testing.T) {
+		rollup := bdSpecJSON(t, bd, dir, "RFC-007")
+		if rollup.Total != 4 || rollup.Closed != 2 || rollup.Percent != 50 {
+			t.Errorf("total/closed/percent = %d/%d/%d, want 4/2/50", rollup.Total, rollup.Closed, rollup.Percent)
+		}
+		want := map[string]int{"closed": 2, "in_progress": 1, "open": 1}
+		if len(rollup.ByStatus) != len(want) {
+			t.Errorf("by_status = %v, want %v", rollup.ByStatus, want)
+		}
+		for status, n := range want {
+			if rollup.ByStatus[status] != n {
+				t.Errorf("by_status[%s] = %d, want %d", status, rollup.ByStatus[status], n)
+			}
+		}
+		var ids []string
+		for _, issue := range rollup.Issues {
+			ids = append(ids, issue.ID)
+		}
+		wantIDs := []string{done.ID, doing.ID, todo.ID, done2.ID}
+		slices.Sort(wantIDs)
+		if !slices.Equal(ids, wantIDs) {
+			t.Errorf("issues = %v, want %v", ids, wantIDs)
+		}
+		est := rollup.Estimates
+		if est == nil || est.EstimateTotal != 105 || est.ActualTotal != 75 || est.Remaining != 30 || est.Unestimated != 1 {
+			t.Errorf("estimates = %+v, want total 105, actual 75, remaining 30, 1 unestimated", est)
+		}
+	})
+
+	t.Run("prefix", func(t *testing.T) {
+		rollup := bdSpecJSON(t, bd, dir, "RFC-00", "--prefix")
+		if rollup.Total != 5 || rollup.ByStatus[string(types.StatusOpen)] != 2 {
+			t.Errorf("prefix rollup total=%d by_status=%v, want 5 with 2 open", rollup.Total, rollup.ByStatus)
+		}
+		if !slices.ContainsFunc(rollup.Issues, func(i *types.Issue) bool { return i.ID == other.ID }) {
+			t.Errorf("prefix rollup should include %s", other.ID)
+		}
+	})
+
+	t.Run("unknown_spec", func(t *testing.T) {
+		rollup := bdSpecJSON(t, bd, dir, "RFC-999")
+		if rollup.Total != 0 || rollup.Percent != 0 || len(rollup.Issues) != 0 {
+			t.Errorf("unknown spec rollup = %+v, want empty", rollup)
+		}
+		cmd := exec.Command(bd, "spec", "RFC-999")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		out, err := cmd.CombinedOutput()
+		if err != nil || !strings.Contains(string(out), "No issues reference spec RFC-999") {
+			t.Errorf("bd spec RFC-999: err=%v out=%s", err, out)
+		}
+	})
+}
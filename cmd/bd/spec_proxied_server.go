@@ -0,0 +1,21 @@
+package main
+
+import (
+	"context"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func runSpecProxiedServer(ctx context.Context, specID string, prefix bool, filter types.IssueFilter) error {
+	uw, err := openProxiedListUOW(ctx)
+	if err != nil {
+		return HandleError("%v", err)
+	}
+	defer uw.Close(ctx)
+
+	page, err := uw.IssueUseCase().SearchIssues(ctx, "", filter)
+	if err != nil {
+		return HandleErrorRespectJSON("searching issues for spec %s: %v", specID, err)
+	}
+	return renderSpecRollup(computeSpecRollup(specID, prefix, page.Items))
+}
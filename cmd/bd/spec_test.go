@@ -0,0 +1,52 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestComputeSpecRollup(t *testing.T) {
+	minutes := func(n int) *int { return &n }
+	issues := []*types.Issue{
+		{ID: "bd-3", Status: types.StatusClosed, EstimatedMinutes: minutes(60)},
+		{ID: "bd-1", Status: types.StatusOpen, EstimatedMinutes: minutes(30)},
+		{ID: "bd-2", Status: types.StatusInProgress},
+		{ID: "bd-4", Status: "review"},
+	}
+	rollup := computeSpecRollup("RFC-007", false, issues)
+	if rollup.Total != 4 || rollup.Closed != 1 || rollup.Percent != 25 {
+		t.Errorf("total/closed/percent = %d/%d/%d, want 4/1/25", rollup.Total, rollup.Closed, rollup.Percent)
+	}
+	if got := specStatuses(rollup.ByStatus); !slices.Equal(got, []string{"open", "in_progress", "closed", "review"}) {
+		t.Errorf("statuses = %v, want built-ins in order, then custom", got)
+	}
+	var ids []string
+	for _, issue := range rollup.Issues {
+		ids = append(ids, issue.ID)
+	}
+	if !slices.Equal(ids, []string{"bd-1", "bd-2", "bd-3", "bd-4"}) {
+		t.Errorf("issues = %v, want ID order", ids)
+	}
+	if issues[0].ID != "bd-3" {
+		t.Error("computeSpecRollup reordered the caller's slice")
+	}
+	if est := rollup.Estimates; est.EstimateTotal != 90 || est.ActualTotal != 60 || est.Remaining != 30 || est.Unestimated != 2 {
+		t.Errorf("estimates = %+v", est)
+	}
+
+	empty := computeSpecRollup("RFC-999", false, nil)
+	if empty.Total != 0 || empty.Percent != 0 || empty.Issues == nil {
+		t.Errorf("empty rollup = %+v, want zero counts and a non-nil issue list", empty)
+	}
+}
+
+func TestSpecFilter(t *testing.T) {
+	if f := specFilter("RFC-007", false); f.SpecID != "RFC-007" || f.SpecIDPrefix != "" {
+		t.Errorf("exact filter = %+v", f)
+	}
+	if f := specFilter("RFC-", true); f.SpecIDPrefix != "RFC-" || f.SpecID != "" {
+		t.Errorf("prefix filter = %+v", f)
+	}
+}
@@ -214,6 +214,7 @@ WARNING: Direct database access bypasses the storage layer. Use with caution.`,
 		CheckReadonly("sql")
 
 		result, err := db.ExecContext(ctx, query)
+		storage.InvalidateBlockedCache(store)
 		if err != nil {
 			return HandleErrorRespectJSON("exec error: %v", err)
 		}
@@ -32,6 +32,9 @@ This helps identify:
 		days, _ := cmd.Flags().GetInt("days")
 		status, _ := cmd.Flags().GetString("status")
 		limit, _ := cmd.Flags().GetInt("limit")
+		if err := limitFlagError(limit); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
 		if days < 1 {
 			return HandleErrorRespectJSON("--days must be at least 1")
 		}
@@ -223,6 +223,22 @@ func TestEmbeddedStale(t *testing.T) {
 		}
 	})
 
+	t.Run("invalid_days_negative", func(t *testing.T) {
+		out := bdStaleFail(t, bd, dir, "--days", "-1")
+		if !strings.Contains(out, "at least 1") {
+			t.Errorf("expected 'at least 1' error: %s", out)
+		}
+	})
+
+	t.Run("fresh_issue_not_stale_at_one_day", func(t *testing.T) {
+		just := bdCreate(t, bd, dir, "Just created", "--type", "task")
+		for _, e := range bdStaleJSON(t, bd, dir, "--days", "1") {
+			if e["id"] == just.ID || e["id"] == fresh1.ID {
+				t.Errorf("issue %v was updated within the last day and should not be stale", e["id"])
+			}
+		}
+	})
+
 	t.Run("invalid_status", func(t *testing.T) {
 		out := bdStaleFail(t, bd, dir, "--status", "bogus")
 		if !strings.Contains(out, "invalid status") {
@@ -228,7 +228,7 @@ The --reason flag provides context for the event bead (recommended).`,
 		eventID = childID
 
 		if oldLabel != "" {
-			if err := store.RemoveLabel(ctx, fullID, oldLabel, actor); err != nil {
+			if _, err := store.RemoveLabel(ctx, fullID, oldLabel, actor); err != nil {
 				WarnError("failed to remove old label %s: %v", oldLabel, err)
 			}
 		}
@@ -156,9 +156,9 @@ func runSetStateProxiedServer(ctx context.Context, issueID, dimension, newValue,
 		if oldLabel != "" {
 			var rerr error
 			if isWisp {
-				rerr = uw.LabelUseCase().RemoveWispLabel(ctx, fullID, oldLabel, actor)
+				_, rerr = uw.LabelUseCase().RemoveWispLabel(ctx, fullID, oldLabel, actor)
 			} else {
-				rerr = uw.LabelUseCase().RemoveLabel(ctx, fullID, oldLabel, actor)
+				_, rerr = uw.LabelUseCase().RemoveLabel(ctx, fullID, oldLabel, actor)
 			}
 			if rerr != nil {
 				removeWarn = fmt.Sprintf("failed to remove old label %s: %v", oldLabel, rerr)
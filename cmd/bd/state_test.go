@@ -39,7 +39,7 @@ func (h *stateTestHelper) addLabel(issueID, label string) {
 }
 
 func (h *stateTestHelper) removeLabel(issueID, label string) {
-	if err := h.s.RemoveLabel(h.ctx, issueID, label, "test-user"); err != nil {
+	if _, err := h.s.RemoveLabel(h.ctx, issueID, label, "test-user"); err != nil {
 		h.t.Fatalf("Failed to remove label '%s': %v", label, err)
 	}
 }
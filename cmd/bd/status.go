@@ -1,11 +1,18 @@
 package main
 
 import (
+	"cmp"
+	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 )
@@ -14,9 +21,43 @@ import (
 type StatusOutput struct {
 	Summary             *types.Statistics      `json:"summary"`
 	BlockedCountSkipped bool                   `json:"blocked_count_skipped,omitempty"`
+	ByPriority          []PriorityReadiness    `json:"by_priority,omitempty"`
+	OldestOpen          *IssueHighlight        `json:"oldest_open,omitempty"`
+	Stalest             *IssueHighlight        `json:"stalest,omitempty"`
 	RecentActivity      *RecentActivitySummary `json:"recent_activity,omitempty"`
 }
 
+// PriorityReadiness is one row of the --by-priority cross-tab. Ready counts
+// open issues that are not blocked; Blocked uses the summary's definition
+// (is_blocked and neither closed nor pinned), so the Blocked and Closed
+// columns sum to the summary totals.
+type PriorityReadiness struct {
+	Priority int `json:"priority"`
+	Ready    int `json:"ready"`
+	Blocked  int `json:"blocked"`
+	Closed   int `json:"closed"`
+}
+
+// IssueHighlight names one active issue singled out for triage. Age is whole
+// seconds since created_at and Idle whole seconds since updated_at, the same
+// units bd list --annotate reports.
+type IssueHighlight struct {
+	ID        string       `json:"id"`
+	Title     string       `json:"title"`
+	Status    types.Status `json:"status"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+	Age       int64        `json:"age"`
+	Idle      int64        `json:"idle"`
+}
+
+// statusHighlights holds the oldest_open and stalest picks; both are nil
+// when there is no active work.
+type statusHighlights struct {
+	oldestOpen *IssueHighlight
+	stalest    *IssueHighlight
+}
+
 // RecentActivitySummary represents activity from git history
 type RecentActivitySummary struct {
 	HoursTracked   int `json:"hours_tracked"`
@@ -37,7 +78,14 @@ var statusCmd = &cobra.Command{
 
 This command provides a summary of issue counts by state (open, in_progress,
 blocked, closed), ready work, extended statistics (pinned issues,
-average lead time), and recent activity over the last 24 hours from git history.
+average lead time), triage highlights, and recent activity over the last 24
+hours from git history.
+
+The highlights name the oldest open issue (earliest created) and the stalest
+one (longest since its last update) among open, in_progress and blocked
+issues. The JSON output reports them as oldest_open and stalest, each with
+its id, title, status, timestamps, age (seconds since created) and idle
+(seconds since updated).
 
 Similar to how 'git status' shows working tree state, 'bd status' gives you
 a quick overview of your issue database without needing multiple queries.
@@ -56,6 +104,9 @@ Examples:
   bd stats --no-blocked --json # JSON output without blocked count
   bd status --json             # JSON format output
   bd status --assigned         # Show issues assigned to current user
+  bd stats --by-priority       # Ready/blocked/closed counts per priority
+  bd stats --assignee-load     # Open work and remaining estimate per assignee
+  bd stats --assignee-load --target 3d --tolerance 25
   bd stats                     # Alias for bd status`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -70,17 +121,35 @@ Examples:
 		showAssigned, _ := cmd.Flags().GetBool("assigned")
 		noActivity, _ := cmd.Flags().GetBool("no-activity")
 		noBlocked, _ := cmd.Flags().GetBool("no-blocked")
+		byPriority, _ := cmd.Flags().GetBool("by-priority")
+		assigneeLoad, _ := cmd.Flags().GetBool("assignee-load")
 		jsonFormat, _ := cmd.Flags().GetBool("json")
 
 		if jsonFormat {
 			jsonOutput = true
 		}
+		if assigneeLoad {
+			if byPriority || showAssigned {
+				return HandleErrorRespectJSON("--assignee-load cannot be combined with --by-priority or --assigned")
+			}
+			in, err := readAssigneeLoadInput(cmd)
+			if err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
+			if usesProxiedServer() {
+				return runAssigneeLoadProxiedServer(rootCtx, in)
+			}
+			return runAssigneeLoad(rootCtx, store.SearchIssues, in)
+		}
+		if byPriority && (showAssigned || noBlocked) {
+			return HandleErrorRespectJSON("--by-priority cannot be combined with --assigned or --no-blocked")
+		}
 
 		if usesProxiedServer() {
 			if noBlocked {
 				fmt.Fprintln(os.Stderr, "warning: --no-blocked is not supported in proxied-server mode; running the full blocked-count query")
 			}
-			return runStatusProxiedServer(rootCtx, showAssigned, noActivity)
+			return runStatusProxiedServer(rootCtx, showAssigned, noActivity, byPriority)
 		}
 
 		ctx := rootCtx
@@ -103,19 +172,173 @@ Examples:
 			}
 		}
 
+		var breakdown []PriorityReadiness
+		if byPriority {
+			if breakdown, err = countByPriorityReadiness(ctx, store, storeReadyCounter(store)); err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
+		}
+
+		assignee := ""
+		if showAssigned {
+			assignee = actor
+		}
+		highlights, err := findStatusHighlights(ctx, store.SearchIssues, assignee, time.Now())
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+
 		var recentActivity *RecentActivitySummary
 		if !noActivity {
 			recentActivity = getGitActivity(24)
 		}
 
-		return renderStatus(stats, recentActivity)
+		return renderStatusWithBreakdown(stats, breakdown, highlights, recentActivity)
 	},
 }
 
+// findStatusHighlights picks the oldest open issue (earliest created_at) and
+// the stalest one (earliest updated_at) among active work: open, in_progress
+// and blocked issues, restricted to assignee when it is set. Each pick is a
+// single sorted search limited to one row, so the cost does not grow with the
+// backlog; ties break by id like every other sorted search.
+func findStatusHighlights(ctx context.Context, search func(context.Context, string, types.IssueFilter) ([]*types.Issue, error), assignee string, now time.Time) (statusHighlights, error) {
+	pick := func(sortBy string) (*IssueHighlight, error) {
+		filter := types.IssueFilter{
+			Statuses:  []types.Status{types.StatusOpen, types.StatusInProgress, types.StatusBlocked},
+			SkipWisps: true,
+			SortBy:    sortBy,
+			SortDesc:  true, // created/updated sort newest first by default
+			Limit:     1,
+		}
+		if assignee != "" {
+			filter.Assignee = &assignee
+		}
+		issues, err := search(ctx, "", filter)
+		if err != nil {
+			return nil, fmt.Errorf("finding %s highlight: %w", sortBy, err)
+		}
+		if len(issues) == 0 {
+			return nil, nil
+		}
+		return newIssueHighlight(issues[0], now), nil
+	}
+
+	var h statusHighlights
+	var err error
+	if h.oldestOpen, err = pick("created"); err != nil {
+		return h, err
+	}
+	if h.stalest, err = pick("updated"); err != nil {
+		return h, err
+	}
+	return h, nil
+}
+
+// newIssueHighlight measures age and idle in UTC; clock skew never yields a
+// negative value.
+func newIssueHighlight(issue *types.Issue, now time.Time) *IssueHighlight {
+	since := func(t time.Time) int64 {
+		return max(int64(now.UTC().Sub(t.UTC())/time.Second), 0)
+	}
+	return &IssueHighlight{
+		ID:        issue.ID,
+		Title:     issue.Title,
+		Status:    issue.Status,
+		CreatedAt: issue.CreatedAt,
+		UpdatedAt: issue.UpdatedAt,
+		Age:       since(issue.CreatedAt),
+		Idle:      since(issue.UpdatedAt),
+	}
+}
+
+// readyCounter sizes the bd ready set for a work filter.
+type readyCounter func(ctx context.Context, filter types.WorkFilter) (int, error)
+
+// storeReadyCounter counts ready work with the store's COUNT(*) fast path,
+// falling back to the unbounded ready query when the store lacks one.
+func storeReadyCounter(st storage.DoltStorage) readyCounter {
+	return func(ctx context.Context, filter types.WorkFilter) (int, error) {
+		if counter, ok := storage.UnwrapStore(st).(storage.ReadyWorkCounter); ok {
+			return counter.CountReadyWork(ctx, filter)
+		}
+		issues, err := st.GetReadyWork(ctx, filter)
+		return len(issues), err
+	}
+}
+
+// countByPriorityReadiness builds the priority × readiness cross-tab. The
+// ready column sizes bd ready's own set once per priority, so each row agrees
+// with bd ready --priority N and the column sums to the summary's Ready count;
+// blocked and closed are grouped counts, the same grouping bd count
+// --by-priority uses. Like the summary it counts the durable issues table only.
+func countByPriorityReadiness(ctx context.Context, backend countBackend, countReady readyCounter) ([]PriorityReadiness, error) {
+	closedStatus := types.StatusClosed
+	blocked := true
+	columns := []struct {
+		filter types.IssueFilter
+		set    func(row *PriorityReadiness, n int)
+	}{
+		{types.IssueFilter{IsBlocked: &blocked, ExcludeStatus: []types.Status{types.StatusClosed, types.StatusPinned}, SkipWisps: true}, func(row *PriorityReadiness, n int) { row.Blocked = n }},
+		{types.IssueFilter{Status: &closedStatus, SkipWisps: true}, func(row *PriorityReadiness, n int) { row.Closed = n }},
+	}
+
+	rows := make(map[int]*PriorityReadiness)
+	rowFor := func(priority int) *PriorityReadiness {
+		row, ok := rows[priority]
+		if !ok {
+			row = &PriorityReadiness{Priority: priority}
+			rows[priority] = row
+		}
+		return row
+	}
+
+	// Priorities are validated to 0-4 on write.
+	for priority := 0; priority <= 4; priority++ {
+		p := priority
+		n, err := countReady(ctx, types.WorkFilter{Status: types.StatusOpen, Priority: &p})
+		if err != nil {
+			return nil, fmt.Errorf("counting ready work for P%d: %w", priority, err)
+		}
+		if n > 0 {
+			rowFor(priority).Ready = n
+		}
+	}
+
+	for _, col := range columns {
+		counts, err := backend.CountIssuesByGroup(ctx, col.filter, "priority")
+		if err != nil {
+			return nil, fmt.Errorf("counting by priority: %w", err)
+		}
+		for group, n := range counts {
+			// Groups carry bd count's display form, e.g. "P2".
+			priority, err := strconv.Atoi(strings.TrimPrefix(group, "P"))
+			if err != nil {
+				return nil, fmt.Errorf("unexpected priority group %q", group)
+			}
+			col.set(rowFor(priority), n)
+		}
+	}
+
+	out := make([]PriorityReadiness, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, *row)
+	}
+	slices.SortFunc(out, func(a, b PriorityReadiness) int { return cmp.Compare(a.Priority, b.Priority) })
+	return out, nil
+}
+
 func renderStatus(stats *types.Statistics, recentActivity *RecentActivitySummary) error {
+	return renderStatusWithBreakdown(stats, nil, statusHighlights{}, recentActivity)
+}
+
+func renderStatusWithBreakdown(stats *types.Statistics, byPriority []PriorityReadiness, highlights statusHighlights, recentActivity *RecentActivitySummary) error {
 	output := &StatusOutput{
 		Summary:             stats,
 		BlockedCountSkipped: stats.BlockedIssues == nil,
+		ByPriority:          byPriority,
+		OldestOpen:          highlights.oldestOpen,
+		Stalest:             highlights.stalest,
 		RecentActivity:      recentActivity,
 	}
 
@@ -163,6 +386,23 @@ func renderStatus(stats *types.Statistics, recentActivity *RecentActivitySummary
 		}
 	}
 
+	if len(byPriority) > 0 {
+		fmt.Printf("\nBy Priority:%11s %8s %8s %8s\n", "", "Ready", "Blocked", "Closed")
+		for _, row := range byPriority {
+			fmt.Printf("  P%-20d %8d %8d %8d\n", row.Priority, row.Ready, row.Blocked, row.Closed)
+		}
+	}
+
+	if highlights.oldestOpen != nil || highlights.stalest != nil {
+		fmt.Printf("\nHighlights:\n")
+		if h := highlights.oldestOpen; h != nil {
+			fmt.Printf("  Oldest Open:            %s (created %s)\n", h.ID, formatTimeAgo(h.CreatedAt))
+		}
+		if h := highlights.stalest; h != nil {
+			fmt.Printf("  Stalest:                %s (updated %s)\n", h.ID, formatTimeAgo(h.UpdatedAt))
+		}
+	}
+
 	if recentActivity != nil {
 		fmt.Printf("\nRecent Activity (last %d hours):\n", recentActivity.HoursTracked)
 		fmt.Printf("  Commits:                %d\n", recentActivity.CommitCount)
@@ -239,6 +479,10 @@ func init() {
 	statusCmd.Flags().Bool("all", false, "Show all issues (default behavior)")
 	statusCmd.Flags().Bool("assigned", false, "Show issues assigned to current user")
 	statusCmd.Flags().Bool("no-activity", false, "Skip git activity summary (faster)")
+	statusCmd.Flags().Bool("by-priority", false, "Break ready/blocked/closed counts down by priority")
+	statusCmd.Flags().Bool("assignee-load", false, "Report open/in-progress counts and remaining estimate per assignee, flagging over/under-loaded ones")
+	statusCmd.Flags().String("target", "", "Target remaining work per assignee for --assignee-load, e.g. 3d or 20h (default: the mean across assignees)")
+	statusCmd.Flags().Int("tolerance", 20, "Percent either side of --target an assignee may be before being flagged (--assignee-load)")
 	statusCmd.Flags().Bool("no-blocked", false, "Skip blocked-count computation (faster on large rigs; not supported in proxied-server mode)")
 	// Note: --json flag is defined as a persistent flag in main.go, not here
 	rootCmd.AddCommand(statusCmd)
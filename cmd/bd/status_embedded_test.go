@@ -10,6 +10,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 )
 
 // bdStatus runs "bd status" with the given args and returns raw stdout.
@@ -118,6 +119,46 @@ func TestEmbeddedStatus(t *testing.T) {
 		}
 	})
 
+	// ===== --by-priority =====
+
+	t.Run("by_priority_sums_match_summary", func(t *testing.T) {
+		bpDir, _, _ := bdInit(t, bd, "--prefix", "sbp")
+		blocker := bdCreate(t, bd, bpDir, "P0 blocker", "--type", "task", "--priority", "0")
+		blocked := bdCreate(t, bd, bpDir, "P2 blocked", "--type", "task", "--priority", "2")
+		bdCreate(t, bd, bpDir, "P2 ready", "--type", "task", "--priority", "2")
+		bdCreate(t, bd, bpDir, "P1 ready", "--type", "bug", "--priority", "1")
+		done := bdCreate(t, bd, bpDir, "P1 done", "--type", "task", "--priority", "1")
+		bdDepAdd(t, bd, bpDir, blocked.ID, blocker.ID)
+		bdClose(t, bd, bpDir, done.ID)
+		// An open child of a deferred epic is open and unblocked but not in
+		// bd ready, so it must not count toward any priority's ready column.
+		epic := bdCreate(t, bd, bpDir, "P3 parked epic", "--type", "epic", "--priority", "3")
+		bdCreate(t, bd, bpDir, "P3 parked child", "--type", "task", "--priority", "3", "--parent", epic.ID)
+		bdDefer(t, bd, bpDir, epic.ID, "--until", "+2d")
+
+		m := bdStatusJSON(t, bd, bpDir, "--by-priority")
+		summary := m["summary"].(map[string]interface{})
+		rows, ok := m["by_priority"].([]interface{})
+		if !ok || len(rows) == 0 {
+			t.Fatalf("expected by_priority rows, got %v", m["by_priority"])
+		}
+		sums := map[string]int{}
+		for _, r := range rows {
+			row := r.(map[string]interface{})
+			for _, col := range []string{"ready", "blocked", "closed"} {
+				sums[col] += int(row[col].(float64))
+			}
+		}
+		for col, key := range map[string]string{"ready": "ready_issues", "blocked": "blocked_issues", "closed": "closed_issues"} {
+			if want := int(summary[key].(float64)); sums[col] != want {
+				t.Errorf("sum of by_priority %s = %d, want summary %s = %d", col, sums[col], key, want)
+			}
+		}
+		if sums["ready"] != 3 {
+			t.Errorf("expected 3 ready issues across priorities, got %d", sums["ready"])
+		}
+	})
+
 	// ===== --assigned =====
 
 	t.Run("assigned_filter", func(t *testing.T) {
@@ -206,6 +247,120 @@ func TestEmbeddedStatus(t *testing.T) {
 			t.Errorf("expected 'Ready to Work:' line: %s", out)
 		}
 	})
+
+	// ===== oldest_open / stalest highlights =====
+
+	t.Run("json_highlights", func(t *testing.T) {
+		hlDir, _, _ := bdInit(t, bd, "--prefix", "sh")
+		done := bdCreate(t, bd, hlDir, "Closed before the rest", "--type", "task")
+		bdClose(t, bd, hlDir, done.ID)
+		// Timestamps have second resolution; sleep so each step is distinct.
+		time.Sleep(1100 * time.Millisecond)
+		oldest := bdCreate(t, bd, hlDir, "Oldest open", "--type", "task")
+		time.Sleep(1100 * time.Millisecond)
+		stale := bdCreate(t, bd, hlDir, "Never touched", "--type", "task")
+		time.Sleep(1100 * time.Millisecond)
+		bdUpdate(t, bd, hlDir, oldest.ID, "--priority", "1")
+
+		var out StatusOutput
+		raw := bdStatus(t, bd, hlDir, "--json")
+		if err := json.Unmarshal([]byte(raw), &out); err != nil {
+			t.Fatalf("parse status JSON: %v\n%s", err, raw)
+		}
+		if out.OldestOpen == nil || out.OldestOpen.ID != oldest.ID || out.OldestOpen.Age < 2 {
+			t.Errorf("oldest_open = %+v, want %s aged at least 2s", out.OldestOpen, oldest.ID)
+		}
+		if out.Stalest == nil || out.Stalest.ID != stale.ID || out.Stalest.Idle < 1 {
+			t.Errorf("stalest = %+v, want %s idle at least 1s", out.Stalest, stale.ID)
+		}
+
+		if human := bdStatus(t, bd, hlDir); !strings.Contains(human, "Oldest Open:") || !strings.Contains(human, stale.ID) {
+			t.Errorf("human output should list the highlights:\n%s", human)
+		}
+	})
+
+	// ===== --assignee-load =====
+
+	t.Run("ready_matches_bd_ready", func(t *testing.T) {
+		// A mixed graph where "open minus blocked" and bd ready disagree.
+		rdDir, _, _ := bdInit(t, bd, "--prefix", "sr")
+		blocker := bdCreate(t, bd, rdDir, "Blocker", "--type", "task")
+		blocked := bdCreate(t, bd, rdDir, "Blocked", "--type", "task")
+		bdDepAdd(t, bd, rdDir, blocked.ID, blocker.ID)
+		started := bdCreate(t, bd, rdDir, "Started but blocked", "--type", "task")
+		bdUpdate(t, bd, rdDir, started.ID, "--status", "in_progress")
+		bdDepAdd(t, bd, rdDir, started.ID, blocker.ID)
+		deferred := bdCreate(t, bd, rdDir, "Deferred", "--type", "task")
+		bdDefer(t, bd, rdDir, deferred.ID, "--until", time.Now().AddDate(0, 0, 7).Format("2006-01-02"))
+		pinned := bdCreate(t, bd, rdDir, "Pinned", "--type", "task")
+		bdUpdate(t, bd, rdDir, pinned.ID, "--status", "pinned")
+		epic := bdCreate(t, bd, rdDir, "Epic", "--type", "epic")
+		bdCreate(t, bd, rdDir, "Epic child", "--type", "task", "--parent", epic.ID)
+		parked := bdCreate(t, bd, rdDir, "Deferred epic", "--type", "epic")
+		bdCreate(t, bd, rdDir, "Deferred epic child one", "--type", "task", "--parent", parked.ID)
+		bdCreate(t, bd, rdDir, "Deferred epic child two", "--type", "task", "--parent", parked.ID)
+		bdDefer(t, bd, rdDir, parked.ID, "--until", time.Now().AddDate(0, 0, 7).Format("2006-01-02"))
+
+		cmd := exec.Command(bd, "ready", "-n", "0", "--json")
+		cmd.Dir = rdDir
+		cmd.Env = bdEnv(rdDir)
+		stdout, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd ready failed: %v\n%s", err, stderr.String())
+		}
+		var ready []json.RawMessage
+		if err := json.Unmarshal([]byte(strings.TrimSpace(stdout.String())), &ready); err != nil {
+			t.Fatalf("parse ready JSON: %v\n%s", err, stdout.String())
+		}
+
+		summary := bdStatusJSON(t, bd, rdDir)["summary"].(map[string]interface{})
+		if got := int(summary["ready_issues"].(float64)); got != len(ready) {
+			t.Errorf("bd stats ready_issues = %d, but bd ready returned %d issues", got, len(ready))
+		}
+	})
+
+	t.Run("assignee_load", func(t *testing.T) {
+		loadDir, _, _ := bdInit(t, bd, "--prefix", "sl")
+		bdCreate(t, bd, loadDir, "Alice big", "--assignee", "alice", "--estimate", "5h")
+		aliceIP := bdCreate(t, bd, loadDir, "Alice started", "--assignee", "alice", "--estimate", "4h")
+		bdUpdate(t, bd, loadDir, aliceIP.ID, "--status", "in_progress")
+		bdCreate(t, bd, loadDir, "Bob small", "--assignee", "bob", "--estimate", "2h")
+		bdCreate(t, bd, loadDir, "Bob unestimated", "--assignee", "bob")
+		bobDone := bdCreate(t, bd, loadDir, "Bob done", "--assignee", "bob", "--estimate", "10h")
+		bdClose(t, bd, loadDir, bobDone.ID, "--force") // close refuses an issue assigned to someone else
+		bdCreate(t, bd, loadDir, "Nobody's", "--estimate", "30m")
+
+		var report AssigneeLoadReport
+		out := bdStatus(t, bd, loadDir, "--assignee-load", "--json")
+		if err := json.Unmarshal([]byte(out), &report); err != nil {
+			t.Fatalf("parse --assignee-load --json: %v\n%s", err, out)
+		}
+		if report.TargetMinutes != 330 || report.TargetSource != "mean" {
+			t.Errorf("target = %d (%s), want the 330 minute mean", report.TargetMinutes, report.TargetSource)
+		}
+		want := []AssigneeLoad{
+			{Assignee: "alice", Open: 1, InProgress: 1, RemainingMinutes: 540, Load: "over"},
+			{Assignee: "bob", Open: 2, RemainingMinutes: 120, Unestimated: 1, Load: "under"},
+		}
+		if fmt.Sprint(report.Assignees) != fmt.Sprint(want) {
+			t.Errorf("assignees = %+v, want %+v", report.Assignees, want)
+		}
+		if u := report.Unassigned; u.Open != 1 || u.RemainingMinutes != 30 {
+			t.Errorf("unassigned = %+v, want 1 open with 30 minutes", u)
+		}
+
+		out = bdStatus(t, bd, loadDir, "--assignee-load", "--json", "--target", "8h", "--tolerance", "50")
+		if err := json.Unmarshal([]byte(out), &report); err != nil {
+			t.Fatalf("parse --assignee-load --target --json: %v\n%s", err, out)
+		}
+		if report.TargetMinutes != 480 || report.Assignees[0].Load != "ok" || report.Assignees[1].Load != "under" {
+			t.Errorf("--target 8h --tolerance 50 = %+v, want alice ok and bob under", report)
+		}
+
+		if human := bdStatus(t, bd, loadDir, "--assignee-load"); !strings.Contains(human, "alice") || !strings.Contains(human, "over") {
+			t.Errorf("human output should list alice as over:\n%s", human)
+		}
+	})
 }
 
 // TestEmbeddedStatusConcurrent exercises status operations concurrently.
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/timeparsing"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// Load flags of bd stats --assignee-load.
+const (
+	assigneeLoadOver  = "over"
+	assigneeLoadUnder = "under"
+	assigneeLoadOK    = "ok"
+)
+
+// AssigneeLoad is one assignee's share of active work: open and in_progress
+// issues and the estimated minutes still to do. Issues without an estimate
+// add nothing to RemainingMinutes and are counted in Unestimated.
+type AssigneeLoad struct {
+	Assignee         string `json:"assignee,omitempty"`
+	Open             int    `json:"open"`
+	InProgress       int    `json:"in_progress"`
+	RemainingMinutes int    `json:"remaining_minutes"`
+	Unestimated      int    `json:"unestimated"`
+	Load             string `json:"load,omitempty"`
+}
+
+// AssigneeLoadReport is the --assignee-load output. Without --target the
+// target is the mean remaining minutes across assignees, so the flags show
+// how far work is from an even split. An assignee is over- or under-loaded
+// when RemainingMinutes is outside the target by more than the tolerance.
+type AssigneeLoadReport struct {
+	TargetMinutes    int            `json:"target_minutes"`
+	TargetSource     string         `json:"target_source"`
+	TolerancePercent int            `json:"tolerance_percent"`
+	Assignees        []AssigneeLoad `json:"assignees"`
+	Unassigned       AssigneeLoad   `json:"unassigned"`
+}
+
+// assigneeLoadInput is the validated flag set of --assignee-load.
+type assigneeLoadInput struct {
+	target    int // minutes; 0 means the mean across assignees
+	tolerance int // percent
+}
+
+func readAssigneeLoadInput(cmd *cobra.Command) (assigneeLoadInput, error) {
+	in := assigneeLoadInput{}
+	if raw, _ := cmd.Flags().GetString("target"); strings.TrimSpace(raw) != "" {
+		target, err := timeparsing.ParseEstimate(raw, config.GetInt("estimate.workday_hours"))
+		if err != nil {
+			return in, fmt.Errorf("invalid --target: %w", err)
+		}
+		if target <= 0 {
+			return in, fmt.Errorf("--target must be greater than zero")
+		}
+		in.target = target
+	}
+	in.tolerance, _ = cmd.Flags().GetInt("tolerance")
+	if in.tolerance < 0 || in.tolerance > 100 {
+		return in, fmt.Errorf("--tolerance must be between 0 and 100, got %d", in.tolerance)
+	}
+	return in, nil
+}
+
+// assigneeLoadFilter selects the active work the report counts. Wisps are
+// transient and never part of an assignee's load.
+func assigneeLoadFilter() types.IssueFilter {
+	return types.IssueFilter{
+		Statuses:  []types.Status{types.StatusOpen, types.StatusInProgress},
+		SkipWisps: true,
+	}
+}
+
+// buildAssigneeLoadReport groups active issues by assignee and flags each
+// assignee against the target.
+func buildAssigneeLoadReport(issues []*types.Issue, in assigneeLoadInput) *AssigneeLoadReport {
+	report := &AssigneeLoadReport{TolerancePercent: in.tolerance, Assignees: []AssigneeLoad{}}
+	byAssignee := make(map[string]*AssigneeLoad)
+	for _, issue := range issues {
+		if issue.Status != types.StatusOpen && issue.Status != types.StatusInProgress {
+			continue
+		}
+		row := &report.Unassigned
+		if issue.Assignee != "" {
+			row = byAssignee[issue.Assignee]
+			if row == nil {
+				row = &AssigneeLoad{Assignee: issue.Assignee}
+				byAssignee[issue.Assignee] = row
+			}
+		}
+		if issue.Status == types.StatusInProgress {
+			row.InProgress++
+		} else {
+			row.Open++
+		}
+		if issue.EstimatedMinutes == nil {
+			row.Unestimated++
+		} else {
+			row.RemainingMinutes += *issue.EstimatedMinutes
+		}
+	}
+
+	total := 0
+	for _, row := range byAssignee {
+		report.Assignees = append(report.Assignees, *row)
+		total += row.RemainingMinutes
+	}
+	slices.SortFunc(report.Assignees, func(a, b AssigneeLoad) int {
+		return cmp.Or(cmp.Compare(b.RemainingMinutes, a.RemainingMinutes), cmp.Compare(a.Assignee, b.Assignee))
+	})
+
+	report.TargetMinutes, report.TargetSource = in.target, "flag"
+	if in.target == 0 {
+		report.TargetSource = "mean"
+		if n := len(report.Assignees); n > 0 {
+			report.TargetMinutes = total / n
+		}
+	}
+	slack := report.TargetMinutes * in.tolerance / 100
+	for i := range report.Assignees {
+		row := &report.Assignees[i]
+		switch {
+		case row.RemainingMinutes > report.TargetMinutes+slack:
+			row.Load = assigneeLoadOver
+		case row.RemainingMinutes < report.TargetMinutes-slack:
+			row.Load = assigneeLoadUnder
+		default:
+			row.Load = assigneeLoadOK
+		}
+	}
+	return report
+}
+
+// runAssigneeLoad reads active issues through search and renders the report.
+func runAssigneeLoad(ctx context.Context, search func(context.Context, string, types.IssueFilter) ([]*types.Issue, error), in assigneeLoadInput) error {
+	issues, err := search(ctx, "", assigneeLoadFilter())
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+	return renderAssigneeLoad(buildAssigneeLoadReport(issues, in))
+}
+
+func renderAssigneeLoad(report *AssigneeLoadReport) error {
+	if jsonOutput {
+		return outputJSON(report)
+	}
+
+	fmt.Printf("\n%s Assignee Load (target %s ±%d%%, %s)\n\n", ui.RenderAccent("⚖"),
+		formatMinutes(report.TargetMinutes), report.TolerancePercent, report.TargetSource)
+	if len(report.Assignees) == 0 {
+		fmt.Printf("  No open or in-progress issues are assigned.\n")
+	}
+	for _, row := range report.Assignees {
+		load := ui.RenderPass(row.Load)
+		switch row.Load {
+		case assigneeLoadOver:
+			load = ui.RenderFail(row.Load)
+		case assigneeLoadUnder:
+			load = ui.RenderWarn(row.Load)
+		}
+		fmt.Printf("  %-24s %3d open %3d in progress %8s remaining  %s", row.Assignee,
+			row.Open, row.InProgress, formatMinutes(row.RemainingMinutes), load)
+		if row.Unestimated > 0 {
+			fmt.Printf("  (%d unestimated)", row.Unestimated)
+		}
+		fmt.Println()
+	}
+	if u := report.Unassigned; u.Open+u.InProgress > 0 {
+		fmt.Printf("\n  Unassigned: %d open, %d in progress, %s remaining\n", u.Open, u.InProgress, formatMinutes(u.RemainingMinutes))
+	}
+	fmt.Println()
+	return nil
+}
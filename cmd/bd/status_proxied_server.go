@@ -2,12 +2,13 @@ package main
 
 import (
 	"context"
+	"time"
 
 	"github.com/steveyegge/beads/internal/storage/uow"
 	"github.com/steveyegge/beads/internal/types"
 )
 
-func runStatusProxiedServer(ctx context.Context, showAssigned, noActivity bool) error {
+func runStatusProxiedServer(ctx context.Context, showAssigned, noActivity, byPriority bool) error {
 	uw, err := openProxiedListUOW(ctx)
 	if err != nil {
 		return HandleError("%v", err)
@@ -26,12 +27,28 @@ func runStatusProxiedServer(ctx context.Context, showAssigned, noActivity bool)
 		}
 	}
 
+	var breakdown []PriorityReadiness
+	if byPriority {
+		if breakdown, err = countByPriorityReadiness(ctx, uw.IssueUseCase(), proxiedReadyCounter(uw)); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+	}
+
+	assignee := ""
+	if showAssigned {
+		assignee = actor
+	}
+	highlights, err := findStatusHighlights(ctx, proxiedSearchIssues(uw), assignee, time.Now())
+	if err != nil {
+		return HandleErrorRespectJSON("%v", err)
+	}
+
 	var recentActivity *RecentActivitySummary
 	if !noActivity {
 		recentActivity = getGitActivity(24)
 	}
 
-	return renderStatus(stats, recentActivity)
+	return renderStatusWithBreakdown(stats, breakdown, highlights, recentActivity)
 }
 
 func proxiedAssignedStatistics(ctx context.Context, uw uow.UnitOfWork, assignee string) (*types.Statistics, error) {
@@ -49,3 +66,34 @@ func proxiedAssignedStatistics(ctx context.Context, uw uow.UnitOfWork, assignee
 
 	return buildAssignedStats(page.Items, readyCount), nil
 }
+
+func runAssigneeLoadProxiedServer(ctx context.Context, in assigneeLoadInput) error {
+	uw, err := openProxiedListUOW(ctx)
+	if err != nil {
+		return HandleError("%v", err)
+	}
+	defer uw.Close(ctx)
+
+	return runAssigneeLoad(ctx, proxiedSearchIssues(uw), in)
+}
+
+// proxiedSearchIssues adapts the unit of work's paged search to the plain
+// search function the status reports take.
+func proxiedSearchIssues(uw uow.UnitOfWork) func(context.Context, string, types.IssueFilter) ([]*types.Issue, error) {
+	return func(ctx context.Context, query string, filter types.IssueFilter) ([]*types.Issue, error) {
+		page, err := uw.IssueUseCase().SearchIssues(ctx, query, filter)
+		if err != nil {
+			return nil, err
+		}
+		return page.Items, nil
+	}
+}
+
+// proxiedReadyCounter sizes the ready set from the unbounded ready page; the
+// proxied use case has no COUNT(*) fast path.
+func proxiedReadyCounter(uw uow.UnitOfWork) readyCounter {
+	return func(ctx context.Context, filter types.WorkFilter) (int, error) {
+		page, err := uw.IssueUseCase().GetReadyWork(ctx, filter)
+		return len(page.Items), err
+	}
+}
@@ -5,8 +5,11 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -418,3 +421,173 @@ func TestGetStatisticsNoBlocked(t *testing.T) {
 		t.Fatal("expected ReadyIssues populated by full GetStatistics, got nil")
 	}
 }
+
+// fakePriorityCounter answers CountIssuesByGroup by the readiness column the
+// filter selects, and ready counts per priority as a readyCounter.
+type fakePriorityCounter struct {
+	ready           map[int]int
+	blocked, closed map[string]int
+}
+
+func (f *fakePriorityCounter) countReady(_ context.Context, filter types.WorkFilter) (int, error) {
+	if filter.Status != types.StatusOpen || filter.Priority == nil {
+		return 0, fmt.Errorf("unexpected ready filter %+v", filter)
+	}
+	return f.ready[*filter.Priority], nil
+}
+
+func (f *fakePriorityCounter) CountIssues(context.Context, string, types.IssueFilter) (int64, error) {
+	return 0, nil
+}
+
+func (f *fakePriorityCounter) CountIssuesByGroup(_ context.Context, filter types.IssueFilter, groupBy string) (map[string]int, error) {
+	if groupBy != "priority" {
+		return nil, fmt.Errorf("unexpected groupBy %q", groupBy)
+	}
+	switch {
+	case filter.IsBlocked != nil && *filter.IsBlocked:
+		return f.blocked, nil
+	case filter.Status != nil && *filter.Status == types.StatusClosed:
+		return f.closed, nil
+	default:
+		return nil, fmt.Errorf("unexpected grouped filter %+v", filter)
+	}
+}
+
+func TestCountByPriorityReadiness(t *testing.T) {
+	backend := &fakePriorityCounter{
+		ready:   map[int]int{0: 2, 2: 1},
+		blocked: map[string]int{"P2": 3},
+		closed:  map[string]int{"P1": 4, "P0": 1},
+	}
+	got, err := countByPriorityReadiness(context.Background(), backend, backend.countReady)
+	if err != nil {
+		t.Fatalf("countByPriorityReadiness: %v", err)
+	}
+	want := []PriorityReadiness{
+		{Priority: 0, Ready: 2, Closed: 1},
+		{Priority: 1, Closed: 4},
+		{Priority: 2, Ready: 1, Blocked: 3},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("countByPriorityReadiness = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildAssigneeLoadReport(t *testing.T) {
+	minutes := func(n int) *int { return &n }
+	issues := []*types.Issue{
+		{ID: "bd-1", Status: types.StatusOpen, Assignee: "alice", EstimatedMinutes: minutes(300)},
+		{ID: "bd-2", Status: types.StatusInProgress, Assignee: "alice", EstimatedMinutes: minutes(240)},
+		{ID: "bd-3", Status: types.StatusOpen, Assignee: "bob", EstimatedMinutes: minutes(120)},
+		{ID: "bd-4", Status: types.StatusOpen, Assignee: "bob"},
+		{ID: "bd-5", Status: types.StatusInProgress, Assignee: "carol", EstimatedMinutes: minutes(60)},
+		{ID: "bd-6", Status: types.StatusClosed, Assignee: "carol", EstimatedMinutes: minutes(600)},
+		{ID: "bd-7", Status: types.StatusOpen, EstimatedMinutes: minutes(30)},
+	}
+
+	t.Run("mean_target", func(t *testing.T) {
+		got := buildAssigneeLoadReport(issues, assigneeLoadInput{tolerance: 20})
+		want := &AssigneeLoadReport{
+			TargetMinutes:    240, // (540 + 120 + 60) / 3
+			TargetSource:     "mean",
+			TolerancePercent: 20,
+			Assignees: []AssigneeLoad{
+				{Assignee: "alice", Open: 1, InProgress: 1, RemainingMinutes: 540, Load: "over"},
+				{Assignee: "bob", Open: 2, RemainingMinutes: 120, Unestimated: 1, Load: "under"},
+				{Assignee: "carol", InProgress: 1, RemainingMinutes: 60, Load: "under"},
+			},
+			Unassigned: AssigneeLoad{Open: 1, RemainingMinutes: 30},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("report = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("explicit_target", func(t *testing.T) {
+		got := buildAssigneeLoadReport(issues, assigneeLoadInput{target: 100, tolerance: 50})
+		if got.TargetMinutes != 100 || got.TargetSource != "flag" {
+			t.Errorf("target = %d (%s), want 100 (flag)", got.TargetMinutes, got.TargetSource)
+		}
+		loads := map[string]string{}
+		for _, row := range got.Assignees {
+			loads[row.Assignee] = row.Load
+		}
+		want := map[string]string{"alice": "over", "bob": "ok", "carol": "ok"}
+		if !reflect.DeepEqual(loads, want) {
+			t.Errorf("loads = %v, want %v", loads, want)
+		}
+	})
+
+	t.Run("no_assignees", func(t *testing.T) {
+		got := buildAssigneeLoadReport(nil, assigneeLoadInput{tolerance: 20})
+		if got.TargetMinutes != 0 || len(got.Assignees) != 0 || got.Assignees == nil {
+			t.Errorf("empty report = %+v, want zero target and an empty assignee list", got)
+		}
+	})
+}
+
+func TestFindStatusHighlights(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(n int) time.Time { return now.Add(-time.Duration(n) * 24 * time.Hour) }
+	issues := []*types.Issue{
+		{ID: "bd-1", Status: types.StatusClosed, CreatedAt: daysAgo(90), UpdatedAt: daysAgo(80)},
+		{ID: "bd-2", Status: types.StatusOpen, CreatedAt: daysAgo(30), UpdatedAt: daysAgo(1)},
+		{ID: "bd-3", Status: types.StatusInProgress, CreatedAt: daysAgo(20), UpdatedAt: daysAgo(15), Assignee: "alice"},
+		{ID: "bd-4", Status: types.StatusDeferred, CreatedAt: daysAgo(60), UpdatedAt: daysAgo(60)},
+		{ID: "bd-5", Status: types.StatusBlocked, CreatedAt: daysAgo(10), UpdatedAt: daysAgo(5), Assignee: "alice"},
+	}
+	// search mirrors the storage contract the highlights rely on: a status
+	// filter, created/updated sorted oldest first under SortDesc, and a limit.
+	search := func(_ context.Context, _ string, filter types.IssueFilter) ([]*types.Issue, error) {
+		if !filter.SortDesc || filter.Limit != 1 {
+			t.Fatalf("filter = %+v, want an oldest-first search limited to one row", filter)
+		}
+		var out []*types.Issue
+		for _, issue := range issues {
+			if slices.Contains(filter.Statuses, issue.Status) && (filter.Assignee == nil || *filter.Assignee == issue.Assignee) {
+				out = append(out, issue)
+			}
+		}
+		slices.SortFunc(out, func(a, b *types.Issue) int {
+			if filter.SortBy == "updated" {
+				return a.UpdatedAt.Compare(b.UpdatedAt)
+			}
+			return a.CreatedAt.Compare(b.CreatedAt)
+		})
+		return out[:min(len(out), filter.Limit)], nil
+	}
+
+	t.Run("all", func(t *testing.T) {
+		got, err := findStatusHighlights(context.Background(), search, "", now)
+		if err != nil {
+			t.Fatalf("findStatusHighlights: %v", err)
+		}
+		if got.oldestOpen == nil || got.oldestOpen.ID != "bd-2" || got.oldestOpen.Age != 30*24*3600 {
+			t.Errorf("oldest_open = %+v, want bd-2 aged 30 days", got.oldestOpen)
+		}
+		if got.stalest == nil || got.stalest.ID != "bd-3" || got.stalest.Idle != 15*24*3600 {
+			t.Errorf("stalest = %+v, want bd-3 idle 15 days", got.stalest)
+		}
+	})
+
+	t.Run("assigned", func(t *testing.T) {
+		got, err := findStatusHighlights(context.Background(), search, "alice", now)
+		if err != nil {
+			t.Fatalf("findStatusHighlights: %v", err)
+		}
+		if got.oldestOpen == nil || got.oldestOpen.ID != "bd-3" || got.stalest == nil || got.stalest.ID != "bd-3" {
+			t.Errorf("alice's highlights = %+v / %+v, want bd-3 for both", got.oldestOpen, got.stalest)
+		}
+	})
+
+	t.Run("no_active_work", func(t *testing.T) {
+		got, err := findStatusHighlights(context.Background(), search, "nobody", now)
+		if err != nil {
+			t.Fatalf("findStatusHighlights: %v", err)
+		}
+		if got.oldestOpen != nil || got.stalest != nil {
+			t.Errorf("highlights = %+v / %+v, want none", got.oldestOpen, got.stalest)
+		}
+	})
+}
@@ -9,12 +9,14 @@ import (
 // wireStorageDecorators composes the storage chain in the order the rest of
 // bd expects:
 //
-//	caller → HookFiringStore (outer) → InstrumentedStorage → raw DoltStorage
+//	caller → HookFiringStore (outer) → InstrumentedStorage → BlockedCacheStore → raw DoltStorage
 //
 // telemetry.WrapStorage is a no-op when telemetry is disabled, so the
 // instrumentation layer is only present when BD_OTEL_ENABLED=true (or a
 // legacy BD_OTEL_* selector is set). The hook layer sits outermost so
-// storage spans measure pure DB time without hook-firing overhead.
+// storage spans measure pure DB time without hook-firing overhead. The
+// blocked-set cache sits innermost so every mutation, whichever layer issued
+// it, passes through it and invalidates the memoized blocked set.
 //
 // Extracted from main.go's PersistentPreRunE so the chain composition is
 // unit-testable — the bug this PR fixes was a missing WrapStorage call,
@@ -23,6 +25,7 @@ func wireStorageDecorators(store storage.DoltStorage, hookRunner *hooks.Runner,
 	if store == nil {
 		return nil
 	}
+	store = storage.NewBlockedCacheStore(store)
 	store = telemetry.WrapStorage(store)
 	if hookRunner != nil && !hooksDisabled {
 		store = storage.NewHookFiringStore(store, hookRunner)
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -30,6 +31,19 @@ func clearTelemetryEnv(t *testing.T) {
 	}
 }
 
+// assertBlockedCache checks that s is the innermost BlockedCacheStore layer
+// wrapping raw directly.
+func assertBlockedCache(t *testing.T, s storage.DoltStorage, raw *stubChainStore) {
+	t.Helper()
+	bc, ok := s.(*storage.BlockedCacheStore)
+	if !ok {
+		t.Fatalf("innermost decorator: got %T; want *storage.BlockedCacheStore", s)
+	}
+	if inner := bc.Unwrap(); inner.(*stubChainStore) != raw {
+		t.Errorf("BlockedCacheStore.Unwrap() should return raw store; got %T", inner)
+	}
+}
+
 func TestWireStorageDecorators_NilStorePassesThrough(t *testing.T) {
 	if got := wireStorageDecorators(nil, hooks.NewRunner("/nonexistent"), false); got != nil {
 		t.Errorf("wireStorageDecorators(nil, ...) = %v; want nil", got)
@@ -45,9 +59,7 @@ func TestWireStorageDecorators_TelemetryOff_HookOn(t *testing.T) {
 	if !ok {
 		t.Fatalf("outer decorator: got %T; want *storage.HookFiringStore", got)
 	}
-	if inner := hf.Unwrap(); inner.(*stubChainStore) != raw {
-		t.Errorf("HookFiringStore.Unwrap() should return raw store directly when telemetry off; got %T", inner)
-	}
+	assertBlockedCache(t, hf.Unwrap(), raw)
 }
 
 // Asserts the full HookFiringStore → InstrumentedStorage → raw chain that the
@@ -68,12 +80,10 @@ func TestWireStorageDecorators_TelemetryOn_HookOn(t *testing.T) {
 	if !ok {
 		t.Fatalf("middle decorator: got %T; want *telemetry.InstrumentedStorage", hf.Unwrap())
 	}
-	if inner := inst.Unwrap(); inner.(*stubChainStore) != raw {
-		t.Errorf("InstrumentedStorage.Unwrap() should return raw store; got %T", inner)
-	}
+	assertBlockedCache(t, inst.Unwrap(), raw)
 
 	if peeled := storage.UnwrapStore(got); peeled.(*stubChainStore) != raw {
-		t.Errorf("storage.UnwrapStore should peel both decorator layers; got %T", peeled)
+		t.Errorf("storage.UnwrapStore should peel every decorator layer; got %T", peeled)
 	}
 }
 
@@ -87,25 +97,49 @@ func TestWireStorageDecorators_TelemetryOn_HookDisabled(t *testing.T) {
 	if !ok {
 		t.Fatalf("expected *telemetry.InstrumentedStorage when hooks disabled; got %T", got)
 	}
-	if inner := inst.Unwrap(); inner.(*stubChainStore) != raw {
-		t.Errorf("InstrumentedStorage.Unwrap() should return raw store; got %T", inner)
-	}
+	assertBlockedCache(t, inst.Unwrap(), raw)
 }
 
 func TestWireStorageDecorators_TelemetryOff_HookDisabled(t *testing.T) {
 	clearTelemetryEnv(t)
 	raw := &stubChainStore{}
 	got := wireStorageDecorators(raw, hooks.NewRunner("/nonexistent"), true)
-	if got.(*stubChainStore) != raw {
-		t.Errorf("with telemetry off and hooks disabled, expected raw store back; got %T", got)
-	}
+	assertBlockedCache(t, got, raw)
 }
 
 func TestWireStorageDecorators_NilHookRunner(t *testing.T) {
 	clearTelemetryEnv(t)
 	raw := &stubChainStore{}
 	got := wireStorageDecorators(raw, nil, false)
-	if got.(*stubChainStore) != raw {
-		t.Errorf("with telemetry off and nil hookRunner, expected raw store back; got %T", got)
+	assertBlockedCache(t, got, raw)
+}
+
+// mergeRecomputeStore is a concrete store that records the post-merge
+// is_blocked recompute.
+type mergeRecomputeStore struct {
+	stubChainStore
+	recomputedFrom string
+}
+
+func (s *mergeRecomputeStore) RecomputeBlockedAfterMerge(_ context.Context, fromCommit string) error {
+	s.recomputedFrom = fromCommit
+	return nil
+}
+
+// TestRecomputeBlockedAfterMerge_ThroughChain pins that the post-merge
+// recompute reaches the concrete store under every decorator: none of them
+// expose RecomputeBlockedAfterMerge, so asserting on the wrapped store
+// silently skipped it.
+func TestRecomputeBlockedAfterMerge_ThroughChain(t *testing.T) {
+	clearTelemetryEnv(t)
+	t.Setenv("BD_OTEL_STDOUT", "true")
+	raw := &mergeRecomputeStore{}
+	chain := wireStorageDecorators(raw, hooks.NewRunner("/nonexistent"), false)
+
+	if err := recomputeBlockedAfterMerge(context.Background(), chain, "abc123"); err != nil {
+		t.Fatalf("recomputeBlockedAfterMerge: %v", err)
+	}
+	if raw.recomputedFrom != "abc123" {
+		t.Errorf("recompute reached the concrete store with %q; want abc123", raw.recomputedFrom)
 	}
 }
@@ -90,6 +90,33 @@ func TestEmbeddedSupersede(t *testing.T) {
 		}
 	})
 
+	// ===== --keep-open links without closing =====
+
+	t.Run("keep_open", func(t *testing.T) {
+		oldIssue := bdCreate(t, bd, dir, "Phased old", "--type", "task")
+		newIssue := bdCreate(t, bd, dir, "Phased new", "--type", "task")
+		out := bdSupersede(t, bd, dir, oldIssue.ID, "--with", newIssue.ID, "--keep-open")
+		if !strings.Contains(out, "kept open") {
+			t.Errorf("expected 'kept open' in output: %s", out)
+		}
+
+		s := openStore(t, beadsDir, "ss")
+		issue, err := s.GetIssue(t.Context(), oldIssue.ID)
+		if err != nil {
+			t.Fatalf("GetIssue: %v", err)
+		}
+		if issue.Status != "open" {
+			t.Errorf("expected status=open with --keep-open, got %s", issue.Status)
+		}
+		deps, err := s.GetDependencyRecords(t.Context(), oldIssue.ID)
+		if err != nil {
+			t.Fatalf("GetDependencyRecords: %v", err)
+		}
+		if len(deps) != 1 || deps[0].DependsOnID != newIssue.ID || deps[0].Type != "supersedes" {
+			t.Errorf("expected one supersedes edge to %s, got %+v", newIssue.ID, deps)
+		}
+	})
+
 	// ===== JSON output =====
 
 	t.Run("json_output", func(t *testing.T) {
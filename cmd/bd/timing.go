@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Phase labels reported by --timing. They are coarse on purpose: the goal is
+// to show whether a slow command is paying for the Dolt connection
+// (server-ensure), the SQL work (query), or output formatting (render).
+const (
+	timingPhaseServerEnsure = "server-ensure"
+	timingPhaseQuery        = "query"
+	timingPhaseRender       = "render"
+)
+
+// timingFlag enables per-phase wall-clock reporting on stderr (--timing).
+var timingFlag bool
+
+// cmdTimer is the stopwatch for the current command. Nil when --timing is off;
+// every method is nil-safe so call sites never need to check.
+var cmdTimer *commandTimer
+
+// commandPhase is one completed phase of a timed command.
+type commandPhase struct {
+	Name    string
+	Elapsed time.Duration
+}
+
+// commandTimer is a lightweight sequential stopwatch. Beginning a phase ends
+// the previous one, so phases never overlap and their sum approximates the
+// command's total wall-clock time. Re-entering a phase accumulates into the
+// existing entry rather than adding a duplicate line.
+type commandTimer struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	start   time.Time
+	current string
+	since   time.Time
+	phases  []commandPhase
+	done    bool
+}
+
+func newCommandTimer() *commandTimer {
+	t := &commandTimer{now: time.Now}
+	t.start = t.now()
+	return t
+}
+
+// begin ends the running phase (if any) and starts name. Beginning the phase
+// that is already running is a no-op so nested helpers can mark "render"
+// without resetting the clock.
+func (t *commandTimer) begin(name string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done || t.current == name {
+		return
+	}
+	now := t.now()
+	t.closeCurrentLocked(now)
+	t.current = name
+	t.since = now
+}
+
+// finish ends the running phase and returns the completed phases plus the
+// total elapsed time. Subsequent calls return nil so the report prints once.
+func (t *commandTimer) finish() ([]commandPhase, time.Duration) {
+	if t == nil {
+		return nil, 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.done {
+		return nil, 0
+	}
+	now := t.now()
+	t.closeCurrentLocked(now)
+	t.done = true
+	return t.phases, now.Sub(t.start)
+}
+
+func (t *commandTimer) closeCurrentLocked(now time.Time) {
+	if t.current == "" {
+		return
+	}
+	elapsed := now.Sub(t.since)
+	for i := range t.phases {
+		if t.phases[i].Name == t.current {
+			t.phases[i].Elapsed += elapsed
+			t.current = ""
+			return
+		}
+	}
+	t.phases = append(t.phases, commandPhase{Name: t.current, Elapsed: elapsed})
+	t.current = ""
+}
+
+// beginTimingPhase marks the start of a phase on the current command's timer.
+func beginTimingPhase(name string) {
+	cmdTimer.begin(name)
+}
+
+// writeTimingReport prints one "timing:" line per phase followed by the total.
+func writeTimingReport(w io.Writer, phases []commandPhase, total time.Duration) {
+	for _, p := range phases {
+		fmt.Fprintf(w, "timing: %-14s %s\n", p.Name, formatTimingDuration(p.Elapsed))
+	}
+	fmt.Fprintf(w, "timing: %-14s %s\n", "total", formatTimingDuration(total))
+}
+
+func formatTimingDuration(d time.Duration) string {
+	return fmt.Sprintf("%.1fms", float64(d)/float64(time.Millisecond))
+}
+
+// flushCommandTiming writes the --timing report to stderr. Idempotent: called
+// from PersistentPostRunE and again from main() as a backstop for error paths
+// where Cobra skips PostRun.
+func flushCommandTiming() {
+	phases, total := cmdTimer.finish()
+	if phases == nil && total == 0 {
+		return
+	}
+	writeTimingReport(os.Stderr, phases, total)
+}
@@ -0,0 +1,57 @@
+//go:build cgo
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestEmbeddedTimingFlag(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+	dir, _, _ := bdInit(t, bd, "--prefix", "tm")
+	bdCreateSilent(t, bd, dir, "Timed issue")
+
+	for _, args := range [][]string{
+		{"list", "--timing"},
+		{"list", "--json", "--timing"},
+	} {
+		t.Run(strings.Join(args, "_"), func(t *testing.T) {
+			cmd := exec.Command(bd, args...)
+			cmd.Dir = dir
+			cmd.Env = bdEnv(dir)
+			stdout, stderr, err := runCommandBuffers(t, cmd)
+			if err != nil {
+				t.Fatalf("bd %s failed: %v\nstderr:\n%s", strings.Join(args, " "), err, stderr.String())
+			}
+			for _, label := range []string{"timing: server-ensure", "timing: query", "timing: render", "timing: total"} {
+				if !strings.Contains(stderr.String(), label) {
+					t.Errorf("stderr missing %q:\n%s", label, stderr.String())
+				}
+			}
+			if strings.Contains(stdout.String(), "timing:") {
+				t.Errorf("timing report must go to stderr, found on stdout:\n%s", stdout.String())
+			}
+		})
+	}
+
+	t.Run("off_by_default", func(t *testing.T) {
+		cmd := exec.Command(bd, "list")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		_, stderr, err := runCommandBuffers(t, cmd)
+		if err != nil {
+			t.Fatalf("bd list failed: %v\n%s", err, stderr.String())
+		}
+		if strings.Contains(stderr.String(), "timing:") {
+			t.Errorf("timing report printed without --timing:\n%s", stderr.String())
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeClock returns a now func that advances by step on every call.
+func fakeClock(step time.Duration) func() time.Time {
+	t := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	return func() time.Time {
+		t = t.Add(step)
+		return t
+	}
+}
+
+func TestCommandTimer_SequentialPhases(t *testing.T) {
+	timer := &commandTimer{now: fakeClock(10 * time.Millisecond)}
+	timer.start = timer.now()
+
+	timer.begin(timingPhaseServerEnsure)
+	timer.begin(timingPhaseQuery)
+	timer.begin(timingPhaseRender)
+	timer.begin(timingPhaseRender) // re-entering the running phase is a no-op
+
+	phases, total := timer.finish()
+	if len(phases) != 3 {
+		t.Fatalf("got %d phases, want 3: %+v", len(phases), phases)
+	}
+	want := []string{timingPhaseServerEnsure, timingPhaseQuery, timingPhaseRender}
+	for i, p := range phases {
+		if p.Name != want[i] {
+			t.Errorf("phase %d = %q, want %q", i, p.Name, want[i])
+		}
+		if p.Elapsed != 10*time.Millisecond {
+			t.Errorf("phase %q elapsed = %v, want 10ms", p.Name, p.Elapsed)
+		}
+	}
+	if total != 40*time.Millisecond {
+		t.Errorf("total = %v, want 40ms", total)
+	}
+
+	if again, _ := timer.finish(); again != nil {
+		t.Errorf("second finish returned %+v, want nil", again)
+	}
+}
+
+func TestCommandTimer_ReenteredPhaseAccumulates(t *testing.T) {
+	timer := &commandTimer{now: fakeClock(5 * time.Millisecond)}
+	timer.start = timer.now()
+
+	timer.begin(timingPhaseQuery)
+	timer.begin(timingPhaseRender)
+	timer.begin(timingPhaseQuery)
+
+	phases, _ := timer.finish()
+	if len(phases) != 2 {
+		t.Fatalf("got %d phases, want 2: %+v", len(phases), phases)
+	}
+	if phases[0].Name != timingPhaseQuery || phases[0].Elapsed != 10*time.Millisecond {
+		t.Errorf("query phase = %+v, want 10ms accumulated", phases[0])
+	}
+}
+
+func TestCommandTimer_NilSafe(t *testing.T) {
+	var timer *commandTimer
+	timer.begin(timingPhaseQuery)
+	if phases, total := timer.finish(); phases != nil || total != 0 {
+		t.Errorf("nil timer finish = (%v, %v), want zero values", phases, total)
+	}
+}
+
+func TestWriteTimingReport_PhaseLabels(t *testing.T) {
+	var buf bytes.Buffer
+	writeTimingReport(&buf, []commandPhase{
+		{Name: timingPhaseServerEnsure, Elapsed: 120 * time.Millisecond},
+		{Name: timingPhaseQuery, Elapsed: 3500 * time.Microsecond},
+		{Name: timingPhaseRender, Elapsed: time.Millisecond},
+	}, 125*time.Millisecond)
+
+	out := buf.String()
+	for _, label := range []string{"server-ensure", "query", "render", "total"} {
+		if !strings.Contains(out, "timing: "+label) {
+			t.Errorf("report missing %q line:\n%s", label, out)
+		}
+	}
+	if !strings.Contains(out, "3.5ms") {
+		t.Errorf("report should format durations in milliseconds:\n%s", out)
+	}
+}
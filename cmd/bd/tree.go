@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+var treeCmd = &cobra.Command{
+	Use:     "tree",
+	GroupID: "issues",
+	Short:   "Show the whole project's parent/child hierarchy",
+	Long: `Render every issue as an indented epic → task outline, nested by
+parent-child dependencies (the parent_id field of bd list --json), with a
+status icon on each line.
+
+--status and --type narrow the issues shown. An issue whose parent is
+filtered out is shown as a root. With --json the hierarchy is emitted as a
+forest: an array of root nodes, each with a nested "children" array.
+
+Examples:
+  bd tree                          # everything
+  bd tree --status open,in_progress
+  bd tree --type epic,task --json`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if usesProxiedServer() {
+			return HandleErrorRespectJSON("'bd tree' is not supported in proxied-server mode; use 'bd list --tree'")
+		}
+		status, _ := cmd.Flags().GetString("status")
+		rawType, _ := cmd.Flags().GetString("type")
+
+		ctx := rootCtx
+		cfg, err := loadDirectListFilterConfig(ctx, store)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		var filter types.IssueFilter
+		if status != "" && status != "all" {
+			if err := applyStatusFilter(&filter, status, cfg.customStatusNames()); err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
+		}
+		var typeSet map[types.IssueType]bool
+		if rawType != "" {
+			typeSet = make(map[types.IssueType]bool)
+			for _, part := range strings.Split(rawType, ",") {
+				t := types.IssueType(utils.NormalizeIssueType(strings.TrimSpace(part)))
+				if !t.IsValidWithCustom(cfg.customTypes) {
+					return HandleErrorRespectJSON("invalid issue type %q", part)
+				}
+				typeSet[t] = true
+			}
+		}
+
+		iwc, err := store.SearchIssuesWithCounts(ctx, "", filter)
+		if err != nil {
+			if capErr := handleMaxRowsError(err); capErr != nil {
+				return capErr
+			}
+			return HandleErrorRespectJSON("querying issues: %v", err)
+		}
+		if typeSet != nil {
+			iwc = slices.DeleteFunc(iwc, func(i *types.IssueWithCounts) bool { return !typeSet[i.IssueType] })
+		}
+
+		roots, children := buildParentForest(iwc)
+		if jsonOutput {
+			return outputJSON(treeForestJSON(roots, children, iwc))
+		}
+		if len(roots) == 0 {
+			fmt.Println("No issues found.")
+			return nil
+		}
+		for _, root := range roots {
+			fmt.Println(formatPrettyIssue(root))
+			printPrettyTree(children, root.ID, "")
+		}
+		fmt.Printf("\n%d issue(s) in %d tree(s)\n", len(iwc), len(roots))
+		return nil
+	},
+}
+
+// buildParentForest nests issues by their parent_id. An issue whose parent is
+// not in the set is a root; so is every member of a parent cycle, which would
+// otherwise never be reached from a root.
+func buildParentForest(issues []*types.IssueWithCounts) (roots []*types.Issue, children map[string][]*types.Issue) {
+	inSet := make(map[string]bool, len(issues))
+	for _, iwc := range issues {
+		inSet[iwc.ID] = true
+	}
+	children = make(map[string][]*types.Issue)
+	for _, iwc := range issues {
+		if iwc.Parent != nil && inSet[*iwc.Parent] && *iwc.Parent != iwc.ID {
+			children[*iwc.Parent] = append(children[*iwc.Parent], iwc.Issue)
+		} else {
+			roots = append(roots, iwc.Issue)
+		}
+	}
+
+	reached := make(map[string]bool, len(issues))
+	var walk func(id string)
+	walk = func(id string) {
+		if reached[id] {
+			return
+		}
+		reached[id] = true
+		for _, child := range children[id] {
+			walk(child.ID)
+		}
+	}
+	for _, root := range roots {
+		walk(root.ID)
+	}
+	for _, iwc := range issues {
+		if !reached[iwc.ID] {
+			// Cycle member: detach it from its parent and show it as a root.
+			parent := *iwc.Parent
+			children[parent] = slices.DeleteFunc(children[parent], func(i *types.Issue) bool { return i.ID == iwc.ID })
+			roots = append(roots, iwc.Issue)
+			walk(iwc.ID)
+		}
+	}
+
+	slices.SortFunc(roots, compareIssuesByPriority)
+	for id := range children {
+		slices.SortFunc(children[id], compareIssuesByPriority)
+	}
+	return roots, children
+}
+
+// treeNodeJSON is one node of the `bd tree --json` forest.
+type treeNodeJSON struct {
+	ID        string          `json:"id"`
+	Title     string          `json:"title"`
+	Status    types.Status    `json:"status"`
+	Priority  int             `json:"priority"`
+	IssueType types.IssueType `json:"issue_type"`
+	ParentID  *string         `json:"parent_id"`
+	Children  []*treeNodeJSON `json:"children"`
+}
+
+func treeForestJSON(roots []*types.Issue, children map[string][]*types.Issue, issues []*types.IssueWithCounts) []*treeNodeJSON {
+	parents := make(map[string]*string, len(issues))
+	for _, iwc := range issues {
+		parents[iwc.ID] = iwc.Parent
+	}
+	var node func(issue *types.Issue) *treeNodeJSON
+	node = func(issue *types.Issue) *treeNodeJSON {
+		n := &treeNodeJSON{
+			ID:        issue.ID,
+			Title:     issue.Title,
+			Status:    issue.Status,
+			Priority:  issue.Priority,
+			IssueType: issue.IssueType,
+			ParentID:  parents[issue.ID],
+			Children:  []*treeNodeJSON{},
+		}
+		for _, child := range children[issue.ID] {
+			n.Children = append(n.Children, node(child))
+		}
+		return n
+	}
+	forest := make([]*treeNodeJSON, 0, len(roots))
+	for _, root := range roots {
+		forest = append(forest, node(root))
+	}
+	return forest
+}
+
+func init() {
+	treeCmd.Flags().StringP("status", "s", "", "Only show issues with these statuses (comma-separated)")
+	treeCmd.Flags().StringP("type", "t", "", "Only show issues of these types (comma-separated)")
+	rootCmd.AddCommand(treeCmd)
+}
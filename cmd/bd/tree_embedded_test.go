@@ -0,0 +1,97 @@
+//go:build cgo
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// bdTreeJSON runs "bd tree --json" and parses the forest.
+func bdTreeJSON(t *testing.T, bd, dir string, args ...string) []*treeNodeJSON {
+	t.Helper()
+	cmd := exec.Command(bd, append([]string{"tree", "--json"}, args...)...)
+	cmd.Dir = dir
+	cmd.Env = bdEnv(dir)
+	stdout, stderr, err := runCommandBuffers(t, cmd)
+	if err != nil {
+		t.Fatalf("bd tree --json %s failed: %v\nstdout:\n%s\nstderr:\n%s", strings.Join(args, " "), err, stdout.String(), stderr.String())
+	}
+	var forest []*treeNodeJSON
+	if err := json.Unmarshal(stdout.Bytes(), &forest); err != nil {
+		t.Fatalf("parse bd tree --json: %v\nraw: %s", err, stdout.String())
+	}
+	return forest
+}
+
+// renderForest renders nodes as "title(child,child)" with siblings sorted by
+// title, so the shape can be compared regardless of generated IDs.
+func renderForest(nodes []*treeNodeJSON) string {
+	parts := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		s := n.Title
+		if len(n.Children) > 0 {
+			s += "(" + renderForest(n.Children) + ")"
+		}
+		parts = append(parts, s)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func TestEmbeddedTree(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+	dir, _, _ := bdInit(t, bd, "--prefix", "tr")
+
+	epicA := bdCreate(t, bd, dir, "A", "--type", "epic", "--priority", "1")
+	epicB := bdCreate(t, bd, dir, "B", "--type", "epic", "--priority", "2")
+	a1 := bdCreate(t, bd, dir, "a1", "--type", "task", "--parent", epicA.ID)
+	bdCreate(t, bd, dir, "a2", "--type", "task", "--parent", epicA.ID)
+	bdCreate(t, bd, dir, "a1x", "--type", "bug", "--parent", a1.ID)
+	b1 := bdCreate(t, bd, dir, "b1", "--type", "task", "--parent", epicB.ID)
+	bdCreate(t, bd, dir, "loose", "--type", "task", "--priority", "3")
+
+	t.Run("forest", func(t *testing.T) {
+		forest := bdTreeJSON(t, bd, dir)
+		if got, want := renderForest(forest), "A(a1(a1x),a2),B(b1),loose"; got != want {
+			t.Fatalf("forest = %s, want %s", got, want)
+		}
+		if forest[0].ID != epicA.ID || forest[1].ID != epicB.ID {
+			t.Errorf("roots not in priority order: %s, %s", forest[0].ID, forest[1].ID)
+		}
+		if forest[0].ParentID != nil {
+			t.Errorf("root parent_id = %v, want null", *forest[0].ParentID)
+		}
+		child := forest[1].Children[0]
+		if child.ID != b1.ID || child.ParentID == nil || *child.ParentID != epicB.ID {
+			t.Errorf("B's child = %+v, want %s with parent_id %s", child, b1.ID, epicB.ID)
+		}
+	})
+
+	t.Run("type_filter", func(t *testing.T) {
+		if got, want := renderForest(bdTreeJSON(t, bd, dir, "--type", "epic,bug")), "A,B,a1x"; got != want {
+			t.Errorf("--type epic,bug forest = %s, want %s (a1x's parent filtered out)", got, want)
+		}
+	})
+
+	t.Run("status_filter", func(t *testing.T) {
+		cmd := exec.Command(bd, "close", b1.ID, "--reason", "done")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("bd close %s: %v\n%s", b1.ID, err, out)
+		}
+		if got, want := renderForest(bdTreeJSON(t, bd, dir, "--status", "open")), "A(a1(a1x),a2),B,loose"; got != want {
+			t.Errorf("--status open forest = %s, want %s", got, want)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestBuildParentForest(t *testing.T) {
+	parent := func(id string) *string { return &id }
+	issue := func(id string, priority int, parentID *string) *types.IssueWithCounts {
+		return &types.IssueWithCounts{Issue: &types.Issue{ID: id, Priority: priority}, Parent: parentID}
+	}
+	issues := []*types.IssueWithCounts{
+		issue("bd-2", 2, nil),
+		issue("bd-1", 1, nil),
+		issue("bd-1.2", 2, parent("bd-1")),
+		issue("bd-1.1", 2, parent("bd-1")),
+		issue("bd-3", 3, parent("bd-gone")), // parent filtered out
+		issue("bd-c1", 4, parent("bd-c2")),  // parent cycle
+		issue("bd-c2", 4, parent("bd-c1")),
+	}
+
+	roots, children := buildParentForest(issues)
+	var rootIDs []string
+	for _, r := range roots {
+		rootIDs = append(rootIDs, r.ID)
+	}
+	if len(rootIDs) != 4 || rootIDs[0] != "bd-1" || rootIDs[1] != "bd-2" || rootIDs[2] != "bd-3" {
+		t.Fatalf("roots = %v, want [bd-1 bd-2 bd-3 <cycle member>]", rootIDs)
+	}
+	if kids := children["bd-1"]; len(kids) != 2 || kids[0].ID != "bd-1.1" || kids[1].ID != "bd-1.2" {
+		t.Errorf("bd-1 children = %v, want [bd-1.1 bd-1.2]", kids)
+	}
+
+	// Every issue appears exactly once in the forest, cycle members included.
+	forest := treeForestJSON(roots, children, issues)
+	seen := make(map[string]int)
+	var count func(nodes []*treeNodeJSON)
+	count = func(nodes []*treeNodeJSON) {
+		for _, n := range nodes {
+			seen[n.ID]++
+			count(n.Children)
+		}
+	}
+	count(forest)
+	for _, iwc := range issues {
+		if seen[iwc.ID] != 1 {
+			t.Errorf("%s appears %d times in the forest, want 1", iwc.ID, seen[iwc.ID])
+		}
+	}
+	if forest[2].ParentID == nil || *forest[2].ParentID != "bd-gone" {
+		t.Errorf("orphan root keeps its real parent_id, got %v", forest[2].ParentID)
+	}
+}
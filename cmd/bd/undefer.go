@@ -3,12 +3,14 @@ package main
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/metrics"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/utils"
+	"github.com/steveyegge/beads/internal/validation"
 )
 
 var undeferCmd = &cobra.Command{
@@ -17,11 +19,18 @@ var undeferCmd = &cobra.Command{
 	Long: `Undefer issues to restore them to open status.
 
 This brings issues back from the icebox so they can be worked on again.
-Issues will appear in 'bd ready' if they have no blockers.
+Issues will appear in 'bd ready' if they have no blockers. An issue that is
+not deferred (neither in deferred status nor holding a future defer date) is
+reported as an error.
+
+An issue deferred with 'bd defer --every' is not reopened: its defer date
+moves forward by the interval, at least once, until it lies in the future.
+--end-recurrence drops the recurrence and reopens it.
 
 Examples:
   bd undefer bd-abc        # Undefer a single issue
-  bd undefer bd-abc bd-def # Undefer multiple issues`,
+  bd undefer bd-abc bd-def # Undefer multiple issues
+  bd undefer bd-abc --end-recurrence  # Stop a recurring deferral`,
 	Args:          cobra.MinimumNArgs(1),
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -34,9 +43,10 @@ Examples:
 		}()
 
 		CheckReadonly("undefer")
+		endRecurrence, _ := cmd.Flags().GetBool("end-recurrence")
 
 		if usesProxiedServer() {
-			return runUndeferProxiedServer(rootCtx, args)
+			return runUndeferProxiedServer(rootCtx, args, endRecurrence)
 		}
 
 		ctx := rootCtx
@@ -47,6 +57,7 @@ Examples:
 		}
 
 		undeferredIssues := []*types.Issue{}
+		hasError := false
 
 		if store == nil {
 			return HandleErrorWithHint("database not initialized", diagHint())
@@ -56,26 +67,33 @@ Examples:
 			fullID, err := utils.ResolvePartialID(ctx, store, id)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", id, err)
+				hasError = true
 				continue
 			}
 
 			issue, err := store.GetIssue(ctx, fullID)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error getting %s: %v\n", fullID, err)
+				hasError = true
 				continue
 			}
-			if issue.Status != types.StatusDeferred {
-				fmt.Fprintf(os.Stderr, "%s is not deferred (status: %s)\n", fullID, string(issue.Status))
+			now := time.Now()
+			if err := validation.ForUndefer(now)(fullID, issue); err != nil {
+				fmt.Fprintf(os.Stderr, "Error undeferring %s: %v\n", fullID, err)
+				hasError = true
 				continue
 			}
 
-			updates := map[string]interface{}{
-				"status":      string(types.StatusOpen),
-				"defer_until": nil,
+			updates, next, err := undeferUpdates(issue, endRecurrence, now)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error undeferring %s: %v\n", fullID, err)
+				hasError = true
+				continue
 			}
 
 			if err := store.UpdateIssue(ctx, fullID, updates, actor); err != nil {
 				fmt.Fprintf(os.Stderr, "Error undeferring %s: %v\n", fullID, err)
+				hasError = true
 				continue
 			}
 
@@ -84,6 +102,8 @@ Examples:
 				if issue != nil {
 					undeferredIssues = append(undeferredIssues, issue)
 				}
+			} else if next != nil {
+				printRedeferred(fullID, *next)
 			} else {
 				fmt.Printf("%s Undeferred %s (now open)\n", ui.RenderPass("*"), fullID)
 			}
@@ -94,14 +114,25 @@ Examples:
 		}
 
 		if jsonOutput && len(undeferredIssues) > 0 {
-			return outputJSON(undeferredIssues)
+			if err := outputJSON(undeferredIssues); err != nil {
+				return err
+			}
 		}
 
+		if hasError {
+			return SilentExit()
+		}
 		return nil
 	},
 }
 
+// printRedeferred reports a recurring issue moved to its next occurrence.
+func printRedeferred(id string, next time.Time) {
+	fmt.Printf("%s Re-deferred %s until %s (recurring)\n", ui.RenderAccent("*"), id, next.Local().Format("2006-01-02 15:04"))
+}
+
 func init() {
+	undeferCmd.Flags().Bool("end-recurrence", false, "Drop a recurrence set by bd defer --every and reopen the issue")
 	undeferCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(undeferCmd)
 }
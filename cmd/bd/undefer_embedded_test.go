@@ -60,13 +60,19 @@ func TestEmbeddedUndefer(t *testing.T) {
 
 	t.Run("undefer_not_deferred", func(t *testing.T) {
 		issue := bdCreate(t, bd, dir, "Undefer not deferred", "--type", "task")
-		// Issue is open, not deferred — undefer should print error but not crash
+		// Issue is open, not deferred: undefer reports it and exits non-zero.
 		cmd := exec.Command(bd, "undefer", issue.ID)
 		cmd.Dir = dir
 		cmd.Env = bdEnv(dir)
-		out, _ := cmd.CombinedOutput()
-		if !strings.Contains(string(out), "not deferred") {
-			t.Errorf("expected 'not deferred' message: %s", out)
+		out, err := cmd.CombinedOutput()
+		if err == nil {
+			t.Errorf("expected undefer of an open issue to fail: %s", out)
+		}
+		if !strings.Contains(string(out), "is not deferred") {
+			t.Errorf("expected 'is not deferred' message: %s", out)
+		}
+		if strings.Contains(string(out), "Undeferred") {
+			t.Errorf("an open issue must not be reported as undeferred: %s", out)
 		}
 	})
 }
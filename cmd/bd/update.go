@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -13,7 +15,6 @@ import (
 	"github.com/steveyegge/beads/internal/metrics"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/issueops"
-	"github.com/steveyegge/beads/internal/timeparsing"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/utils"
@@ -31,7 +32,37 @@ create, update, show, or close operation).
 
 Updates are applied per issue ID, not atomically across IDs: when some IDs
 fail, the remaining issues are still updated, every failed ID is reported on
-stderr, and the command exits nonzero.`,
+stderr, and the command exits nonzero.
+
+--add-label and --remove-label (repeatable) change labels in the same command
+as scalar fields, leaving dependencies and comments untouched. Blank labels
+are rejected.
+
+--add-dep <id>[:<type>] makes the issue depend on <id> (type defaults to
+blocks) and --remove-dep <id> drops that dependency. Targets must exist, and
+all dependency edits for an issue apply in one transaction that is rolled back
+if any new edge would create a cycle.
+
+--status closed closes the issue the way bd close does, after the other
+field updates: pinned issues, issues assigned to someone else, open children,
+unsatisfied gates and open blockers are refused, and nothing is written for
+that issue. --force overrides them, and --reason records the close reason.
+--status open on a closed issue reopens it the way bd reopen does.
+--status deferred passes the same date check as bd defer: it needs a future
+date to come back on, so pass --defer with it unless the issue already has
+one. A past --defer date is rejected alongside --status deferred. An issue
+already past its defer date is reopened by the update (or ahead of --claim),
+unless the update sets --status or --defer itself.
+
+--clear sets optional fields to null in the same update as the other changes:
+assignee, due, estimate, external-ref and spec-id. A field cannot be both set
+and cleared by one command.
+
+Examples:
+  bd update bd-42 --priority 1 --add-label urgent --remove-label triage
+  bd update bd-42 --status in_progress --add-label backend --add-label api
+  bd update bd-42 --add-dep bd-41:blocks --remove-dep bd-40
+  bd update bd-42 --clear due,assignee,estimate`,
 	Args:          cobra.MinimumNArgs(0),
 	SilenceUsage:  true,
 	SilenceErrors: true,
@@ -63,6 +94,11 @@ stderr, and the command exits nonzero.`,
 		// was given without an explicit --status, to flip status=deferred back
 		// to open (matches the help text's "show in bd ready immediately").
 		var clearDeferStatus bool
+		force, _ := cmd.Flags().GetBool("force")
+		transitionReason, err := updateTransitionReason(cmd)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
 
 		if cmd.Flags().Changed("status") {
 			status, _ := cmd.Flags().GetString("status")
@@ -166,9 +202,9 @@ stderr, and the command exits nonzero.`,
 			updates["spec_id"] = specID
 		}
 		if cmd.Flags().Changed("estimate") {
-			estimate, _ := cmd.Flags().GetInt("estimate")
-			if estimate < 0 {
-				return HandleErrorRespectJSON("estimate must be a non-negative number of minutes")
+			estimate, err := parseEstimateFlag(cmd)
+			if err != nil {
+				return HandleErrorRespectJSON("%v", err)
 			}
 			updates["estimated_minutes"] = estimate
 		}
@@ -181,11 +217,17 @@ stderr, and the command exits nonzero.`,
 			updates["issue_type"] = issueType
 		}
 		if cmd.Flags().Changed("add-label") {
-			addLabels, _ := cmd.Flags().GetStringSlice("add-label")
+			addLabels, err := readLabelFlag(cmd, "add-label")
+			if err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
 			updates["add_labels"] = addLabels
 		}
 		if cmd.Flags().Changed("remove-label") {
-			removeLabels, _ := cmd.Flags().GetStringSlice("remove-label")
+			removeLabels, err := readLabelFlag(cmd, "remove-label")
+			if err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
 			updates["remove_labels"] = removeLabels
 		}
 		if cmd.Flags().Changed("set-labels") {
@@ -196,6 +238,11 @@ stderr, and the command exits nonzero.`,
 			parent, _ := cmd.Flags().GetString("parent")
 			updates["parent"] = parent
 		}
+		addDeps, removeDeps, err := readUpdateDepFlags(cmd)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		hasDepEdits := len(addDeps) > 0 || len(removeDeps) > 0
 		// Gate fields (bd-z6kw)
 		if cmd.Flags().Changed("await-id") {
 			awaitID, _ := cmd.Flags().GetString("await-id")
@@ -208,9 +255,9 @@ stderr, and the command exits nonzero.`,
 				// Empty string clears the due date
 				updates["due_at"] = nil
 			} else {
-				t, err := timeparsing.ParseRelativeTime(dueStr, time.Now())
+				t, err := parseTimeFlag(dueStr)
 				if err != nil {
-					return HandleErrorRespectJSON("invalid --due format %q. Examples: +6h, tomorrow, next monday, 2025-01-15", dueStr)
+					return HandleErrorRespectJSON("invalid --due format %q. Examples: %s", dueStr, timeFlagExamples)
 				}
 				updates["due_at"] = t
 			}
@@ -225,15 +272,15 @@ stderr, and the command exits nonzero.`,
 					clearDeferStatus = true
 				}
 			} else {
-				t, err := timeparsing.ParseRelativeTime(deferStr, time.Now())
+				t, err := parseTimeFlag(deferStr)
 				if err != nil {
-					return HandleErrorRespectJSON("invalid --defer format %q. Examples: +1h, tomorrow, next monday, 2025-01-15", deferStr)
+					return HandleErrorRespectJSON("invalid --defer format %q. Examples: %s", deferStr, timeFlagExamples)
 				}
 				// Warn if defer date is in the past (user probably meant future)
 				inPast := t.Before(time.Now())
 				if inPast && !jsonOutput {
 					fmt.Fprintf(os.Stderr, "%s Defer date %q is in the past. Issue will appear in bd ready immediately.\n",
-						ui.RenderWarn("!"), t.Format("2006-01-02 15:04"))
+						ui.RenderWarn("!"), t.Local().Format("2006-01-02 15:04"))
 					fmt.Fprintf(os.Stderr, "  Did you mean a future date? Use --defer=+1h or --defer=tomorrow\n")
 				}
 				updates["defer_until"] = t
@@ -311,11 +358,20 @@ stderr, and the command exits nonzero.`,
 		if len(unsetMetadataFlags) > 0 {
 			updates[issueops.OpUnsetMetadata] = unsetMetadataFlags
 		}
+		if err := applyUpdateClear(cmd, updates); err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
 
 		// Get claim flag
 		claimFlag, _ := cmd.Flags().GetBool("claim")
+		if claimFlag {
+			if err := claimStatusConflict(cmd, updates); err != nil {
+				return HandleErrorRespectJSON("%v", err)
+			}
+			clearDeferStatus = false
+		}
 
-		if len(updates) == 0 && !claimFlag {
+		if len(updates) == 0 && !claimFlag && !hasDepEdits {
 			fmt.Println("No updates specified")
 			return nil
 		}
@@ -385,19 +441,26 @@ stderr, and the command exits nonzero.`,
 				closeIfUnmutated(result)
 				continue
 			}
+			if err := checkDeferredHasDate(id, updates, issue); err != nil {
+				fmt.Fprintf(os.Stderr, "%s\n", err)
+				recordFailure(id, err.Error())
+				closeIfUnmutated(result)
+				continue
+			}
+			reopen := expiredDeferralReopen(issue, updates)
 
-			// Handle claim operation atomically using compare-and-swap semantics
-			if claimFlag {
-				if err := issueStore.ClaimIssue(ctx, result.ResolvedID, actor); err != nil {
-					reportClaimFailure(id, err)
-					recordFailure(id, fmt.Sprintf("claiming issue: %v", err))
+			// --status closed passes the same guards as bd close, before any
+			// write for this issue.
+			if closesIssue(updates, issue) {
+				if err := checkUpdateClose(ctx, issueStore, result.ResolvedID, issue, force); err != nil {
+					fmt.Fprintf(os.Stderr, "%s\n", err)
+					recordFailure(id, err.Error())
 					closeIfUnmutated(result)
 					continue
 				}
-				trackMutation(result)
 			}
 
-			// Apply regular field updates if any. Metadata edits (--metadata,
+			// Collect the regular field updates. Metadata edits (--metadata,
 			// --set-metadata, --unset-metadata) and --append-notes pass through
 			// as merge OPERATIONS: the storage layer resolves them against the
 			// row re-read inside the mutation transaction. Merging here against
@@ -416,37 +479,70 @@ stderr, and the command exits nonzero.`,
 			if clearDeferStatus && issue.Status == types.StatusDeferred {
 				regularUpdates["status"] = string(types.StatusOpen)
 			}
+			// An issue past its defer date is reopened with this update. A
+			// claim reopens it first instead, since it only takes open issues.
+			if reopen != nil && !claimFlag {
+				for k, v := range reopen {
+					regularUpdates[k] = v
+				}
+				reopen = nil
+			}
+			// A close or reopen goes through the bd close / bd reopen path
+			// below, after the other field updates.
+			transition, closeSession := splitStatusTransition(regularUpdates, issue)
 			notesOverwritten := replacesExistingNotes(issue.Notes, updates)
 
-			if len(regularUpdates) > 0 {
-				res, err := writeWithSpool(ctx, "update",
-					spoolPayload(map[string]interface{}{
-						"id":      result.ResolvedID,
-						"updates": regularUpdates,
-						"actor":   actor,
-					}),
-					func() error {
-						return issueStore.UpdateIssue(ctx, result.ResolvedID, regularUpdates, actor)
-					},
-				)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "Error updating %s: %v\n", id, err)
-					recordFailure(id, fmt.Sprintf("updating issue: %v", err))
-					closeIfUnmutated(result)
-					continue
-				}
-				if res.Spooled {
-					// The update is QUEUED, not applied: skip the audit log
-					// (it would record a change that has not happened yet)
-					// and the label/success bookkeeping for this id; the
-					// replay lands it later. Label ops are skipped too --
-					// they are unspooled direct writes that would fail
-					// against the same unreachable server anyway.
-					fmt.Printf("%s Queued update for replay (server unreachable): %s\n", ui.RenderWarn("!"), result.ResolvedID)
+			// Claim, dependency edits and field updates commit in one
+			// transaction, so a lost claim race or a rejected edge (missing
+			// target, cycle) leaves the issue untouched.
+			atomicUpdate := claimFlag || hasDepEdits
+			if atomicUpdate {
+				if err := updateIssueAtomically(ctx, issueStore, result.ResolvedID, claimFlag, reopen, addDeps, removeDeps, regularUpdates); err != nil {
+					var ce claimError
+					if errors.As(err, &ce) {
+						reportClaimFailure(id, ce.err)
+						recordFailure(id, fmt.Sprintf("claiming issue: %v", ce.err))
+					} else {
+						fmt.Fprintf(os.Stderr, "Error updating %s: %v\n", id, err)
+						recordFailure(id, fmt.Sprintf("updating issue: %v", err))
+					}
 					closeIfUnmutated(result)
 					continue
 				}
 				trackMutation(result)
+			}
+
+			if len(regularUpdates) > 0 {
+				if !atomicUpdate {
+					res, err := writeWithSpool(ctx, "update",
+						spoolPayload(map[string]interface{}{
+							"id":      result.ResolvedID,
+							"updates": regularUpdates,
+							"actor":   actor,
+						}),
+						func() error {
+							return issueStore.UpdateIssue(ctx, result.ResolvedID, regularUpdates, actor)
+						},
+					)
+					if err != nil {
+						fmt.Fprintf(os.Stderr, "Error updating %s: %v\n", id, err)
+						recordFailure(id, fmt.Sprintf("updating issue: %v", err))
+						closeIfUnmutated(result)
+						continue
+					}
+					if res.Spooled {
+						// The update is QUEUED, not applied: skip the audit log
+						// (it would record a change that has not happened yet)
+						// and the label/success bookkeeping for this id; the
+						// replay lands it later. Label ops are skipped too --
+						// they are unspooled direct writes that would fail
+						// against the same unreachable server anyway.
+						fmt.Printf("%s Queued update for replay (server unreachable): %s\n", ui.RenderWarn("!"), result.ResolvedID)
+						closeIfUnmutated(result)
+						continue
+					}
+					trackMutation(result)
+				}
 				if notesOverwritten {
 					notesOverwriteWarnings[issueStore] = append(notesOverwriteWarnings[issueStore], id)
 				}
@@ -454,14 +550,32 @@ stderr, and the command exits nonzero.`,
 				if s, ok := regularUpdates["status"].(string); ok {
 					audit.LogFieldChange(result.ResolvedID, "status", string(issue.Status), s, actor, "")
 				}
-				if a, ok := regularUpdates["assignee"].(string); ok {
-					audit.LogFieldChange(result.ResolvedID, "assignee", issue.Assignee, a, actor, "")
+				if a, ok := regularUpdates["assignee"]; ok {
+					// --clear assignee sends nil.
+					newAssignee, _ := a.(string)
+					audit.LogFieldChange(result.ResolvedID, "assignee", issue.Assignee, newAssignee, actor, "")
 				}
 				if p, ok := regularUpdates["priority"].(int); ok {
 					audit.LogFieldChange(result.ResolvedID, "priority", fmt.Sprintf("%d", issue.Priority), fmt.Sprintf("%d", p), actor, "")
 				}
 			}
 
+			if transition != transitionNone {
+				spooled, err := applyUpdateTransition(ctx, issueStore, result.ResolvedID, issue, transition, transitionReason, closeSession, force)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error updating %s: %v\n", id, err)
+					recordFailure(id, err.Error())
+					closeIfUnmutated(result)
+					continue
+				}
+				if spooled {
+					fmt.Printf("%s Queued close for replay (server unreachable): %s\n", ui.RenderWarn("!"), result.ResolvedID)
+					closeIfUnmutated(result)
+					continue
+				}
+				trackMutation(result)
+			}
+
 			// Handle label operations
 			var setLabels, addLabels, removeLabels []string
 			if v, ok := updates["set_labels"].([]string); ok {
@@ -597,6 +711,9 @@ stderr, and the command exits nonzero.`,
 		}
 
 		if jsonOutput && len(updatedIssues) > 0 {
+			for _, issue := range updatedIssues {
+				issue.NormalizeOrder()
+			}
 			if jerr := outputJSON(updatedIssues); jerr != nil {
 				return jerr
 			}
@@ -622,6 +739,80 @@ func warnNotesReplacement(id string) {
 	fmt.Fprintf(os.Stderr, "warning: %s: --notes replaced existing notes (use --append-notes to preserve history)\n", id) //nolint:gosec // G705: stderr, not a browser context
 }
 
+// claimStatusConflict rejects --claim combined with a status other than
+// in_progress: the status write would silently overwrite the status the
+// claim just set. A redundant in_progress is dropped, the claim sets it.
+func claimStatusConflict(cmd *cobra.Command, fields map[string]interface{}) error {
+	status, ok := fields["status"].(string)
+	if !ok {
+		return nil
+	}
+	if status == string(types.StatusInProgress) {
+		delete(fields, "status")
+		return nil
+	}
+	if cmd.Flags().Changed("status") {
+		return fmt.Errorf("cannot combine --claim with --status %s: --claim sets the status to in_progress", status)
+	}
+	return fmt.Errorf("cannot combine --claim with --defer: --claim sets the status to in_progress, --defer sets it to %s", status)
+}
+
+// claimError marks a claim failure inside updateIssueAtomically so it is
+// reported like a standalone claim.
+type claimError struct{ err error }
+
+func (e claimError) Error() string { return e.err.Error() }
+func (e claimError) Unwrap() error { return e.err }
+
+// updateIssueAtomically applies the claim, the --add-dep/--remove-dep edits
+// and the field updates in one transaction, so no reader sees part of the
+// update and any rejection writes nothing. reopen, when non-nil, reopens an
+// issue past its defer date ahead of the claim. Dependency targets are
+// resolved before the transaction opens.
+func updateIssueAtomically(ctx context.Context, st storage.DoltStorage, id string, claim bool, reopen map[string]interface{}, addDeps []updateDepEdit, removeDeps []string, updates map[string]interface{}) error {
+	var deps *resolvedUpdateDeps
+	if len(addDeps) > 0 || len(removeDeps) > 0 {
+		resolved, cleanup, err := resolveUpdateDeps(ctx, id, addDeps, removeDeps)
+		if err != nil {
+			return fmt.Errorf("updating dependencies: %w", err)
+		}
+		defer cleanup()
+		deps = resolved
+	}
+	if claim && reopen == nil && deps == nil && len(updates) == 0 {
+		if err := st.ClaimIssue(ctx, id, actor); err != nil {
+			return claimError{err}
+		}
+		return nil
+	}
+
+	commitMsg := fmt.Sprintf("bd: update %s", id)
+	if claim {
+		commitMsg = fmt.Sprintf("bd: claim and update %s", id)
+	}
+	return transactHonoringAutoCommit(ctx, st, commitMsg, func(tx storage.Transaction) error {
+		if reopen != nil {
+			if err := tx.UpdateIssue(ctx, id, reopen, actor); err != nil {
+				return err
+			}
+		}
+		if claim {
+			if err := tx.ClaimIssue(ctx, id, actor); err != nil {
+				return claimError{err}
+			}
+		}
+		if deps != nil {
+			if err := applyUpdateDepsInTx(ctx, tx, id, deps); err != nil {
+				return fmt.Errorf("updating dependencies: %w", err)
+			}
+		}
+		if len(updates) > 0 {
+			return tx.UpdateIssue(ctx, id, updates, actor)
+		}
+		return nil
+	})
+}
+
 // updateIDFailure records one issue ID that could not be updated and why.
 type updateIDFailure struct {
 	ID    string `json:"id"`
@@ -699,13 +890,18 @@ func init() {
 	updateCmd.Flags().String("spec-id", "", "Link to specification document")
 	updateCmd.Flags().String("acceptance-criteria", "", "DEPRECATED: use --acceptance")
 	_ = updateCmd.Flags().MarkHidden("acceptance-criteria") // Only fails if flag missing (caught in tests)
-	updateCmd.Flags().IntP("estimate", "e", 0, "Time estimate in minutes (e.g., 60 for 1 hour)")
-	updateCmd.Flags().StringSlice("add-label", nil, "Add labels (repeatable)")
-	updateCmd.Flags().StringSlice("remove-label", nil, "Remove labels (repeatable)")
+	updateCmd.Flags().StringP("estimate", "e", "", estimateFlagUsage)
+	updateCmd.Flags().StringSlice("add-label", nil, "Add labels (repeatable, non-empty)")
+	updateCmd.Flags().StringSlice("remove-label", nil, "Remove labels (repeatable, non-empty)")
 	updateCmd.Flags().StringSlice("set-labels", nil, "Set labels, replacing all existing (repeatable)")
+	updateCmd.Flags().StringSlice("add-dep", nil, "Add a dependency on another issue: id or id:type, default blocks (repeatable)")
+	updateCmd.Flags().StringSlice("remove-dep", nil, "Remove the dependency on another issue (repeatable)")
 	updateCmd.Flags().String("parent", "", "New parent issue ID (reparents the issue, use empty string to remove parent)")
-	updateCmd.Flags().Bool("claim", false, "Atomically claim the issue (sets assignee to you, status to in_progress; idempotent if already claimed by you; issues assigned to a pool alias listed in the claim.pools config are claimable too)")
+	updateCmd.Flags().Bool("claim", false, "Atomically claim the issue (sets assignee to you, status to in_progress; idempotent if already claimed by you; issues assigned to a pool alias listed in the claim.pools config are claimable too; cannot be combined with a --status other than in_progress)")
 	updateCmd.Flags().String("session", "", "Claude Code session ID for status=closed (or set CLAUDE_SESSION_ID env var)")
+	updateCmd.Flags().String("reason", "", "Close or reopen reason, with --status closed or --status open (close default: Closed)")
+	updateCmd.Flags().BoolP("force", "f", false, "With --status closed, override the bd close guards (pinned, open children, unsatisfied gates, blockers)")
+	updateCmd.Flags().StringSlice("clear", nil, "Set optional fields to null (comma-separated or repeatable): "+strings.Join(clearableUpdateFieldNames(), ", "))
 	// Time-based scheduling flags (GH#820)
 	// Examples:
 	//   --due=+6h           Due in 6 hours
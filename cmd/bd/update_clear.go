@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// clearableUpdateFields maps each name bd update --clear accepts to the
+// optional column it sets to NULL. The names are the flags that set those
+// columns.
+var clearableUpdateFields = map[string]string{
+	"assignee":     "assignee",
+	"due":          "due_at",
+	"estimate":     "estimated_minutes",
+	"external-ref": "external_ref",
+	"spec-id":      "spec_id",
+}
+
+// clearableUpdateFieldNames lists the --clear names in sorted order for help
+// and error text.
+func clearableUpdateFieldNames() []string {
+	names := make([]string, 0, len(clearableUpdateFields))
+	for name := range clearableUpdateFields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// applyUpdateClear reads --clear and sets each named column to nil in fields,
+// so the clears land in the same update as every other field change. Naming a
+// field that the same command also sets (or --claim, for the assignee) is an
+// error rather than a silent precedence rule.
+func applyUpdateClear(cmd *cobra.Command, fields map[string]interface{}) error {
+	names, _ := cmd.Flags().GetStringSlice("clear")
+	for _, raw := range names {
+		name := strings.ToLower(strings.TrimSpace(raw))
+		column, ok := clearableUpdateFields[name]
+		if !ok {
+			return fmt.Errorf("cannot clear %q (clearable fields: %s)", raw, strings.Join(clearableUpdateFieldNames(), ", "))
+		}
+		if cmd.Flags().Changed(name) {
+			return fmt.Errorf("cannot both set and clear %s", name)
+		}
+		if claim, _ := cmd.Flags().GetBool("claim"); claim && name == "assignee" {
+			return fmt.Errorf("cannot both claim and clear assignee")
+		}
+		fields[column] = nil
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newUpdateClearTestCmd(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{Use: "update"}
+	cmd.Flags().StringSlice("clear", nil, "")
+	cmd.Flags().String("assignee", "", "")
+	cmd.Flags().String("due", "", "")
+	cmd.Flags().Bool("claim", false, "")
+	if err := cmd.Flags().Parse(args); err != nil {
+		t.Fatalf("parse %v: %v", args, err)
+	}
+	return cmd
+}
+
+func TestApplyUpdateClear(t *testing.T) {
+	fields := map[string]interface{}{"priority": 1}
+	if err := applyUpdateClear(newUpdateClearTestCmd(t, "--clear", "due,Assignee", "--clear", "estimate"), fields); err != nil {
+		t.Fatalf("applyUpdateClear: %v", err)
+	}
+	for _, col := range []string{"due_at", "assignee", "estimated_minutes"} {
+		if v, ok := fields[col]; !ok || v != nil {
+			t.Errorf("fields[%q] = %v (present %v), want nil", col, v, ok)
+		}
+	}
+	if fields["priority"] != 1 || len(fields) != 4 {
+		t.Errorf("other fields should be left alone, got %v", fields)
+	}
+
+	for _, tc := range []struct {
+		args    []string
+		wantErr string
+	}{
+		{[]string{"--clear", "title"}, `cannot clear "title"`},
+		{[]string{"--clear", "due", "--due", "tomorrow"}, "cannot both set and clear due"},
+		{[]string{"--clear", "assignee", "--claim"}, "cannot both claim and clear assignee"},
+	} {
+		err := applyUpdateClear(newUpdateClearTestCmd(t, tc.args...), map[string]interface{}{})
+		if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+			t.Errorf("%v: error = %v, want one containing %q", tc.args, err, tc.wantErr)
+		}
+	}
+}
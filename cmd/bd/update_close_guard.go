@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/audit"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/domain"
+	"github.com/steveyegge/beads/internal/storage/uow"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// statusTransition is a status change bd update hands to the bd close or
+// bd reopen path instead of writing the status column itself, so closing and
+// reopening have one code path whichever command asks for them.
+type statusTransition int
+
+const (
+	transitionNone statusTransition = iota
+	transitionClose
+	transitionReopen
+)
+
+// splitStatusTransition removes a close or reopen from an update's fields and
+// reports which it was, along with the closing session. Any other status
+// change stays in fields as an ordinary update.
+func splitStatusTransition(fields map[string]interface{}, issue *types.Issue) (statusTransition, string) {
+	status, _ := fields["status"].(string)
+	switch {
+	case status == string(types.StatusClosed):
+		session, _ := fields["closed_by_session"].(string)
+		delete(fields, "status")
+		delete(fields, "closed_by_session")
+		return transitionClose, session
+	case status == string(types.StatusOpen) && issue != nil && issue.Status == types.StatusClosed:
+		delete(fields, "status")
+		return transitionReopen, ""
+	}
+	return transitionNone, ""
+}
+
+// updateTransitionReason reads --reason, the close or reopen reason for
+// --status closed or --status open. A close without one records bd close's
+// default reason, and is checked against validation.on-close as bd close is.
+func updateTransitionReason(cmd *cobra.Command) (string, error) {
+	status, _ := cmd.Flags().GetString("status")
+	reason, _ := cmd.Flags().GetString("reason")
+	if cmd.Flags().Changed("reason") && status != string(types.StatusClosed) && status != string(types.StatusOpen) {
+		return "", fmt.Errorf("--reason requires --status closed or --status open")
+	}
+	if status != string(types.StatusClosed) {
+		return reason, nil
+	}
+	if reason == "" {
+		reason = "Closed"
+	}
+	if err := validateCloseReasons([]string{reason}); err != nil {
+		return "", err
+	}
+	return reason, nil
+}
+
+// updateCloseGuardError applies bd close's guards to bd update --status closed,
+// so closing through update cannot skip what bd close refuses: pinned,
+// template and someone-else's-assignment validation, open parent-child
+// children, an unsatisfied gate, and live blockers. force bypasses them as
+// bd close --force does, still warning about the open children it leaves.
+func updateCloseGuardError(id string, issue *types.Issue, openChildren int, blockers []string, force bool) error {
+	if err := validateIssueClosable(id, issue, actor, force); err != nil {
+		return err
+	}
+	if force {
+		if openChildren > 0 {
+			fmt.Fprintf(os.Stderr, "warning: closing %s with %d open child issue(s) still active\n", id, openChildren)
+		}
+		return nil
+	}
+	if openChildren > 0 {
+		return fmt.Errorf("cannot close %s: %d open child issue(s); close children first or use --force to override", id, openChildren)
+	}
+	if err := checkGateSatisfaction(issue); err != nil {
+		return fmt.Errorf("cannot close %s: %w", id, err)
+	}
+	if len(blockers) > 0 {
+		return fmt.Errorf("%w: %s is blocked by %v (use --force to override)", storage.ErrCloseBlocked, id, blockers)
+	}
+	return nil
+}
+
+// closesIssue reports whether an update's fields move issue to closed. A
+// re-close of an already-closed issue changes nothing and is not guarded.
+func closesIssue(fields map[string]interface{}, issue *types.Issue) bool {
+	status, _ := fields["status"].(string)
+	return status == string(types.StatusClosed) && issue != nil && issue.Status != types.StatusClosed
+}
+
+// checkUpdateClose runs the close guards for the direct-store update path.
+func checkUpdateClose(ctx context.Context, st storage.DoltStorage, id string, issue *types.Issue, force bool) error {
+	_, blockers, err := st.IsBlocked(ctx, id)
+	if err != nil {
+		return fmt.Errorf("checking blockers of %s: %w", id, err)
+	}
+	return updateCloseGuardError(id, issue, countOpenChildren(ctx, st, id), blockers, force)
+}
+
+// checkUpdateCloseProxied runs the close guards inside the proxied update's
+// unit of work.
+func checkUpdateCloseProxied(ctx context.Context, uw uow.UnitOfWork, issue *types.Issue, isWisp, force bool) error {
+	var (
+		openChildren int
+		blockers     []string
+		err          error
+	)
+	if isWisp {
+		openChildren, err = uw.IssueUseCase().CountOpenWispChildren(ctx, issue.ID)
+	} else {
+		openChildren, err = uw.IssueUseCase().CountOpenChildren(ctx, issue.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("counting open children of %s: %w", issue.ID, err)
+	}
+	if isWisp {
+		_, blockers, err = uw.DependencyUseCase().IsWispBlocked(ctx, issue.ID)
+	} else {
+		_, blockers, err = uw.DependencyUseCase().IsBlocked(ctx, issue.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("checking blockers of %s: %w", issue.ID, err)
+	}
+	return updateCloseGuardError(issue.ID, issue, openChildren, blockers, force)
+}
+
+// applyUpdateTransition runs a close or reopen split off an update on the
+// direct-store path: a close goes through bd close's closeIssueChecked and
+// finishClose, so it audits and auto-closes a completed molecule the same
+// way, and a reopen through the same store call as bd reopen.
+// spooled reports a close queued for replay because the server was
+// unreachable.
+func applyUpdateTransition(ctx context.Context, st storage.DoltStorage, id string, issue *types.Issue, transition statusTransition, reason, session string, force bool) (spooled bool, err error) {
+	switch transition {
+	case transitionClose:
+		res, spoolRes, err := closeIssueChecked(ctx, st, id, storage.CloseIssueOptions{
+			Reason:  reason,
+			Session: session,
+			Force:   force,
+		})
+		if err != nil {
+			if errors.Is(err, storage.ErrCloseBlocked) {
+				return false, fmt.Errorf("%w (use --force to override)", err)
+			}
+			return false, fmt.Errorf("closing %s: %w", id, err)
+		}
+		if spoolRes.Spooled {
+			return true, nil
+		}
+		finishClose(ctx, st, id, issue, reason, session, res.Unchanged)
+	case transitionReopen:
+		if err := st.ReopenIssue(ctx, id, reason, actor); err != nil {
+			return false, fmt.Errorf("reopening %s: %w", id, err)
+		}
+		audit.LogFieldChange(id, "status", string(issue.Status), string(types.StatusOpen), actor, reason)
+	}
+	return false, nil
+}
+
+// applyUpdateTransitionProxied runs a close or reopen split off an update
+// inside the proxied update's unit of work, through the same use cases as
+// bd close and bd reopen; a close also auto-closes a molecule it completes. It returns the issue as the transition left it.
+func applyUpdateTransitionProxied(ctx context.Context, uw uow.UnitOfWork, issue *types.Issue, isWisp bool, transition statusTransition, reason, session string, force bool) (*types.Issue, error) {
+	issueUC := uw.IssueUseCase()
+	switch transition {
+	case transitionClose:
+		params := domain.CloseIssueParams{Reason: reason, Session: session}
+		res, err := closeProxiedChecked(ctx, uw, issue.ID, isWisp, params, force)
+		if err != nil {
+			if errors.Is(err, storage.ErrCloseBlocked) {
+				return nil, fmt.Errorf("%w (use --force to override)", err)
+			}
+			return nil, err
+		}
+		var warnings []string
+		autoCloseProxiedCompletedMolecule(ctx, uw, issue.ID, actor, session, &warnings)
+		for _, w := range warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+		}
+		if res.Issue != nil {
+			return res.Issue, nil
+		}
+	case transitionReopen:
+		params := domain.ReopenIssueParams{Reason: reason}
+		var (
+			res domain.ReopenIssueResult
+			err error
+		)
+		if isWisp {
+			res, err = issueUC.ReopenWisp(ctx, issue.ID, params, actor)
+		} else {
+			res, err = issueUC.ReopenIssue(ctx, issue.ID, params, actor)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if res.Issue != nil {
+			return res.Issue, nil
+		}
+	}
+	return issue, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestUpdateCloseGuardError(t *testing.T) {
+	open := &types.Issue{ID: "bd-1", Status: types.StatusOpen}
+	pinned := &types.Issue{ID: "bd-2", Status: types.StatusPinned}
+
+	tests := []struct {
+		name         string
+		issue        *types.Issue
+		openChildren int
+		blockers     []string
+		force        bool
+		wantErr      string
+	}{
+		{name: "clear", issue: open},
+		{name: "open children", issue: open, openChildren: 2, wantErr: "2 open child issue(s)"},
+		{name: "blockers", issue: open, blockers: []string{"bd-9"}, wantErr: "blocked by [bd-9]"},
+		{name: "pinned", issue: pinned, wantErr: "pinned"},
+		{name: "force overrides children and blockers", issue: open, openChildren: 1, blockers: []string{"bd-9"}, force: true},
+		{name: "force overrides pinned", issue: pinned, force: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := updateCloseGuardError(tt.issue.ID, tt.issue, tt.openChildren, tt.blockers, tt.force)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	err := updateCloseGuardError(open.ID, open, 0, []string{"bd-9"}, false)
+	if !errors.Is(err, storage.ErrCloseBlocked) {
+		t.Errorf("blocker refusal should wrap storage.ErrCloseBlocked, got %v", err)
+	}
+}
+
+func TestClosesIssue(t *testing.T) {
+	open := &types.Issue{Status: types.StatusOpen}
+	closed := &types.Issue{Status: types.StatusClosed}
+	if !closesIssue(map[string]interface{}{"status": "closed"}, open) {
+		t.Error("open -> closed should be guarded")
+	}
+	if closesIssue(map[string]interface{}{"status": "closed"}, closed) {
+		t.Error("re-closing a closed issue should not be guarded")
+	}
+	if closesIssue(map[string]interface{}{"status": "deferred"}, open) {
+		t.Error("only a close is guarded")
+	}
+	if closesIssue(map[string]interface{}{"title": "x"}, open) {
+		t.Error("an update without a status is not a close")
+	}
+}
+
+func TestSplitStatusTransition(t *testing.T) {
+	open := &types.Issue{Status: types.StatusOpen}
+	closed := &types.Issue{Status: types.StatusClosed}
+
+	fields := map[string]interface{}{"status": "closed", "closed_by_session": "s1", "title": "t"}
+	transition, session := splitStatusTransition(fields, open)
+	if transition != transitionClose || session != "s1" {
+		t.Errorf("close: got (%v, %q), want (transitionClose, s1)", transition, session)
+	}
+	if _, ok := fields["status"]; ok || fields["title"] != "t" || len(fields) != 1 {
+		t.Errorf("close should leave only the other fields, got %v", fields)
+	}
+
+	fields = map[string]interface{}{"status": "open"}
+	if transition, _ := splitStatusTransition(fields, closed); transition != transitionReopen || len(fields) != 0 {
+		t.Errorf("open on a closed issue should be a reopen, got %v with fields %v", transition, fields)
+	}
+
+	fields = map[string]interface{}{"status": "open"}
+	if transition, _ := splitStatusTransition(fields, &types.Issue{Status: types.StatusInProgress}); transition != transitionNone || fields["status"] != "open" {
+		t.Errorf("open on an unclosed issue is an ordinary update, got %v with fields %v", transition, fields)
+	}
+
+	fields = map[string]interface{}{"status": "deferred"}
+	if transition, _ := splitStatusTransition(fields, open); transition != transitionNone || fields["status"] != "deferred" {
+		t.Errorf("other statuses are ordinary updates, got %v with fields %v", transition, fields)
+	}
+}
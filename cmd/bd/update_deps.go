@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/domain"
+	"github.com/steveyegge/beads/internal/storage/uow"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// updateDepEdit is one --add-dep entry: the updated issue depends on
+// DependsOnID with the given type.
+type updateDepEdit struct {
+	DependsOnID string
+	Type        types.DependencyType
+}
+
+// readUpdateDepFlags reads --add-dep ("id" or "id:type", default blocks) and
+// --remove-dep ("id"). The type suffix is only split off when it names a
+// well-known dependency type, so external refs (external:<project>:<capability>)
+// pass through whole. Blank entries are rejected.
+func readUpdateDepFlags(cmd *cobra.Command) (add []updateDepEdit, remove []string, err error) {
+	if cmd.Flags().Changed("add-dep") {
+		raw, _ := cmd.Flags().GetStringSlice("add-dep")
+		if len(raw) == 0 {
+			return nil, nil, fmt.Errorf("--add-dep requires a non-empty issue ID")
+		}
+		for _, v := range raw {
+			edit := updateDepEdit{DependsOnID: strings.TrimSpace(v), Type: types.DepBlocks}
+			if i := strings.LastIndex(edit.DependsOnID, ":"); i >= 0 {
+				if dt := types.DependencyType(strings.TrimSpace(edit.DependsOnID[i+1:])); dt.IsWellKnown() {
+					edit.DependsOnID, edit.Type = strings.TrimSpace(edit.DependsOnID[:i]), dt
+				}
+			}
+			if edit.DependsOnID == "" {
+				return nil, nil, fmt.Errorf("--add-dep requires a non-empty issue ID")
+			}
+			add = append(add, edit)
+		}
+	}
+	if cmd.Flags().Changed("remove-dep") {
+		raw, _ := cmd.Flags().GetStringSlice("remove-dep")
+		if len(raw) == 0 {
+			return nil, nil, fmt.Errorf("--remove-dep requires a non-empty issue ID")
+		}
+		for _, v := range raw {
+			if v = strings.TrimSpace(v); v == "" {
+				return nil, nil, fmt.Errorf("--remove-dep requires a non-empty issue ID")
+			}
+			remove = append(remove, v)
+		}
+	}
+	return add, remove, nil
+}
+
+// checkUpdateDepEdit rejects an edge bd dep add would also refuse before
+// touching storage.
+func checkUpdateDepEdit(issueID string, edit updateDepEdit) error {
+	if strings.HasPrefix(edit.DependsOnID, "external:") {
+		if err := validateExternalRef(edit.DependsOnID); err != nil {
+			return err
+		}
+	}
+	if isDisallowedHierarchicalDependency(issueID, edit.DependsOnID, edit.Type) {
+		return fmt.Errorf("cannot add dependency: %s is already a child of %s. Children inherit dependency on parent completion via hierarchy. Adding an explicit dependency would create a deadlock", issueID, edit.DependsOnID)
+	}
+	return nil
+}
+
+// resolveUpdateDepTarget resolves a --add-dep/--remove-dep target to a full
+// ID, failing when the issue does not exist. External refs are not resolved.
+func resolveUpdateDepTarget(ctx context.Context, id string) (string, func(), error) {
+	if strings.HasPrefix(id, "external:") {
+		return id, func() {}, nil
+	}
+	resolved, _, cleanup, err := resolveIDWithRouting(ctx, store, id)
+	if err != nil {
+		return "", nil, fmt.Errorf("resolving dependency ID %s: %w", id, err)
+	}
+	return resolved, cleanup, nil
+}
+
+// resolvedUpdateDeps holds --add-dep/--remove-dep edits whose targets are
+// resolved to full IDs and pre-checked, ready to apply inside a transaction.
+type resolvedUpdateDeps struct {
+	add    []updateDepEdit
+	remove []string
+}
+
+// resolveUpdateDeps resolves and pre-checks the dependency edits for issueID
+// before any write. The returned cleanup releases stores opened by routing
+// and must be called once the edits are applied.
+func resolveUpdateDeps(ctx context.Context, issueID string, add []updateDepEdit, remove []string) (*resolvedUpdateDeps, func(), error) {
+	var cleanups []func()
+	cleanup := func() {
+		for _, c := range cleanups {
+			c()
+		}
+	}
+
+	deps := &resolvedUpdateDeps{
+		add:    make([]updateDepEdit, 0, len(add)),
+		remove: make([]string, 0, len(remove)),
+	}
+	for _, edit := range add {
+		toID, c, err := resolveUpdateDepTarget(ctx, edit.DependsOnID)
+		if err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+		cleanups = append(cleanups, c)
+		edit.DependsOnID = toID
+		if err := checkUpdateDepEdit(issueID, edit); err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+		deps.add = append(deps.add, edit)
+	}
+	for _, id := range remove {
+		toID, c, err := resolveUpdateDepTarget(ctx, id)
+		if err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+		cleanups = append(cleanups, c)
+		deps.remove = append(deps.remove, toID)
+	}
+	return deps, cleanup, nil
+}
+
+// applyUpdateDepsInTx applies resolved edits for issueID inside tx. Removals
+// run first so an edge can be retyped in a single update, and the same final
+// cycle gate as bulk dep add fails the transaction when a new edge would
+// close a scheduling cycle.
+func applyUpdateDepsInTx(ctx context.Context, tx storage.Transaction, issueID string, deps *resolvedUpdateDeps) error {
+	for _, toID := range deps.remove {
+		if _, err := tx.RemoveDependencyWithOptions(ctx, issueID, toID, actor, storage.DependencyRemoveOptions{EmitEvent: true}); err != nil {
+			return err
+		}
+	}
+	edges := make([]bulkDepEdge, 0, len(deps.add))
+	for _, edit := range deps.add {
+		dep := &types.Dependency{IssueID: issueID, DependsOnID: edit.DependsOnID, Type: edit.Type}
+		if err := tx.AddDependencyWithOptions(ctx, dep, actor, storage.DependencyAddOptions{EmitEvent: true}); err != nil {
+			return err
+		}
+		edges = append(edges, bulkDepEdge{IssueID: issueID, DependsOnID: edit.DependsOnID, Type: edit.Type})
+	}
+	cyclePath, err := newCycleThroughEdges(ctx, tx, edges)
+	if err != nil {
+		return fmt.Errorf("final cycle check failed (no dependencies changed): %w", err)
+	}
+	if cyclePath != "" {
+		return domain.NewCycleError("dependency cycle would be created: %s (no dependencies changed; run 'bd dep cycles' for analysis)", cyclePath)
+	}
+	return nil
+}
+
+// applyUpdateDepsProxied is applyUpdateDeps for the proxied server: the edits
+// join the update's unit of work, so they commit or roll back with it.
+// DependencyUseCase checks each new edge for cycles. wisp selects the wisp
+// dependency table for an ephemeral issueID.
+func applyUpdateDepsProxied(ctx context.Context, uw uow.UnitOfWork, issueID string, wisp bool, add []updateDepEdit, remove []string) error {
+	issueUC := uw.IssueUseCase()
+	exists := func(id string) error {
+		if strings.HasPrefix(id, "external:") {
+			return nil
+		}
+		if issue, err := issueUC.GetIssue(ctx, id); err == nil && issue != nil {
+			return nil
+		}
+		if w, err := issueUC.GetWisp(ctx, id); err == nil && w != nil {
+			return nil
+		}
+		return fmt.Errorf("resolving dependency ID %s: issue not found", id)
+	}
+
+	depUC := uw.DependencyUseCase()
+	addDep, removeDep := depUC.AddDependency, depUC.RemoveDependency
+	if wisp {
+		addDep, removeDep = depUC.AddWispDependency, depUC.RemoveWispDependency
+	}
+	for _, id := range remove {
+		if err := exists(id); err != nil {
+			return err
+		}
+		if _, err := removeDep(ctx, issueID, id, actor); err != nil {
+			return err
+		}
+	}
+	for _, edit := range add {
+		if err := exists(edit.DependsOnID); err != nil {
+			return err
+		}
+		if err := checkUpdateDepEdit(issueID, edit); err != nil {
+			return err
+		}
+		dep := &types.Dependency{IssueID: issueID, DependsOnID: edit.DependsOnID, Type: edit.Type}
+		if err := addDep(ctx, dep, actor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
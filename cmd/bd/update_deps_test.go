@@ -0,0 +1,63 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestReadUpdateDepFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		add     []string
+		remove  []string
+		want    []updateDepEdit
+		wantRm  []string
+		wantErr string
+	}{
+		{"bare id defaults to blocks", []string{"bd-1"}, nil, []updateDepEdit{{"bd-1", types.DepBlocks}}, nil, ""},
+		{"explicit type", []string{"bd-1:related"}, nil, []updateDepEdit{{"bd-1", types.DepRelated}}, nil, ""},
+		{"external ref kept whole", []string{"external:beads:mol-run"}, nil, []updateDepEdit{{"external:beads:mol-run", types.DepBlocks}}, nil, ""},
+		{"external ref with type", []string{"external:beads:mol-run:blocks"}, nil, []updateDepEdit{{"external:beads:mol-run", types.DepBlocks}}, nil, ""},
+		{"remove", nil, []string{"bd-2", " bd-3 "}, nil, []string{"bd-2", "bd-3"}, ""},
+		{"blank add", []string{" "}, nil, nil, nil, "--add-dep requires a non-empty issue ID"},
+		{"type without id", []string{":blocks"}, nil, nil, nil, "--add-dep requires a non-empty issue ID"},
+		{"blank remove", nil, []string{""}, nil, nil, "--remove-dep requires a non-empty issue ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			cmd.Flags().StringSlice("add-dep", nil, "")
+			cmd.Flags().StringSlice("remove-dep", nil, "")
+			for _, v := range tt.add {
+				if err := cmd.Flags().Set("add-dep", v); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, v := range tt.remove {
+				if err := cmd.Flags().Set("remove-dep", v); err != nil {
+					t.Fatal(err)
+				}
+			}
+			add, remove, err := readUpdateDepFlags(cmd)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readUpdateDepFlags: %v", err)
+			}
+			if !slices.Equal(add, tt.want) {
+				t.Errorf("add = %v, want %v", add, tt.want)
+			}
+			if !slices.Equal(remove, tt.wantRm) {
+				t.Errorf("remove = %v, want %v", remove, tt.wantRm)
+			}
+		})
+	}
+}
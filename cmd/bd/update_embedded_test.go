@@ -469,6 +469,52 @@ func TestEmbeddedUpdate(t *testing.T) {
 		}
 	})
 
+	t.Run("update_clear_due_leaves_other_fields", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Clear due test", "--type", "task",
+			"--assignee", "alice", "--estimate", "45", "--due", "2099-01-15", "--spec-id", "RFC-9")
+		bdUpdate(t, bd, dir, issue.ID, "--clear", "due")
+		got := bdShow(t, bd, dir, issue.ID)
+		if got.DueAt != nil {
+			t.Errorf("expected due_at to be null after --clear due, got %v", got.DueAt)
+		}
+		if got.Assignee != "alice" || got.EstimatedMinutes == nil || *got.EstimatedMinutes != 45 || got.SpecID != "RFC-9" {
+			t.Errorf("--clear due should leave other fields intact, got assignee=%q estimate=%v spec_id=%q",
+				got.Assignee, got.EstimatedMinutes, got.SpecID)
+		}
+		if raw := bdShowJSON(t, bd, dir, issue.ID); strings.Contains(raw, `"due_at"`) {
+			t.Errorf("expected due_at to be omitted from JSON after clear, got: %s", raw)
+		}
+	})
+
+	t.Run("update_clear_multiple", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Clear many test", "--type", "task",
+			"--assignee", "bob", "--estimate", "30", "--due", "2099-01-15")
+		bdUpdate(t, bd, dir, issue.ID, "--clear", "due,assignee", "--clear", "estimate", "--priority", "1")
+		got := bdShow(t, bd, dir, issue.ID)
+		if got.DueAt != nil || got.Assignee != "" || got.EstimatedMinutes != nil {
+			t.Errorf("expected due, assignee and estimate cleared, got due=%v assignee=%q estimate=%v",
+				got.DueAt, got.Assignee, got.EstimatedMinutes)
+		}
+		if got.Priority != 1 {
+			t.Errorf("expected priority 1 applied alongside --clear, got %d", got.Priority)
+		}
+	})
+
+	t.Run("update_clear_rejects_unknown_and_conflicting", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Clear reject test", "--type", "task", "--assignee", "carol")
+		out := bdUpdateFail(t, bd, dir, issue.ID, "--clear", "title")
+		if !strings.Contains(out, `cannot clear "title"`) || !strings.Contains(out, "assignee, due, estimate") {
+			t.Errorf("expected an error naming the clearable fields, got: %s", out)
+		}
+		out = bdUpdateFail(t, bd, dir, issue.ID, "--clear", "assignee", "--assignee", "dave")
+		if !strings.Contains(out, "cannot both set and clear assignee") {
+			t.Errorf("expected a set-and-clear conflict error, got: %s", out)
+		}
+		if got := bdShow(t, bd, dir, issue.ID); got.Assignee != "carol" {
+			t.Errorf("a rejected --clear must not change the issue, got assignee %q", got.Assignee)
+		}
+	})
+
 	t.Run("update_defer", func(t *testing.T) {
 		issue := bdCreate(t, bd, dir, "Defer test", "--type", "task")
 		bdUpdate(t, bd, dir, issue.ID, "--defer", "2099-01-15")
@@ -517,6 +563,31 @@ func TestEmbeddedUpdate(t *testing.T) {
 		}
 	})
 
+	t.Run("update_status_deferred_needs_date", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Deferred without date", "--type", "task")
+		out := bdUpdateFail(t, bd, dir, issue.ID, "--status", "deferred")
+		if !strings.Contains(out, "without a date to come back on") {
+			t.Errorf("expected a missing defer date error, got: %s", out)
+		}
+		if got := bdShow(t, bd, dir, issue.ID); got.Status != types.StatusOpen {
+			t.Errorf("rejected update changed the status to %s", got.Status)
+		}
+		// bd defer's past-date check applies too.
+		out = bdUpdateFail(t, bd, dir, issue.ID, "--status", "deferred", "--defer", "2000-01-01")
+		if !strings.Contains(out, "is in the past") {
+			t.Errorf("expected a past defer date error, got: %s", out)
+		}
+		if got := bdShow(t, bd, dir, issue.ID); got.Status != types.StatusOpen || got.DeferUntil != nil {
+			t.Errorf("rejected update wrote status %s defer_until %v", got.Status, got.DeferUntil)
+		}
+		// A future date given alongside, or one already set, is enough.
+		bdUpdate(t, bd, dir, issue.ID, "--status", "deferred", "--defer", "2099-01-15")
+		bdUpdate(t, bd, dir, issue.ID, "--status", "deferred", "--title", "Deferred with date")
+		if got := bdShow(t, bd, dir, issue.ID); got.Status != types.StatusDeferred || got.DeferUntil == nil {
+			t.Errorf("expected deferred with a date, got status %s defer_until %v", got.Status, got.DeferUntil)
+		}
+	})
+
 	t.Run("update_defer_clear_preserves_non_deferred_status", func(t *testing.T) {
 		// GH#3233: clearing defer_until shouldn't clobber a non-deferred status
 		// that was set independently (e.g. in_progress).
@@ -565,6 +636,59 @@ func TestEmbeddedUpdate(t *testing.T) {
 		}
 	})
 
+	t.Run("close_via_status_update_guards", func(t *testing.T) {
+		parent := bdCreate(t, bd, dir, "Guarded parent", "--type", "epic")
+		bdCreate(t, bd, dir, "Open child", "--parent", parent.ID)
+		out := bdUpdateFail(t, bd, dir, parent.ID, "--status", "closed")
+		if !strings.Contains(out, "open child") {
+			t.Errorf("expected the open-children guard, got: %s", out)
+		}
+
+		blocker := bdCreate(t, bd, dir, "Blocker", "--type", "task")
+		blocked := bdCreate(t, bd, dir, "Blocked", "--type", "task")
+		bdUpdate(t, bd, dir, blocked.ID, "--add-dep", blocker.ID)
+		out = bdUpdateFail(t, bd, dir, blocked.ID, "--status", "closed", "--title", "Renamed")
+		if !strings.Contains(out, "blocked by") || !strings.Contains(out, blocker.ID) {
+			t.Errorf("expected the blocker guard naming %s, got: %s", blocker.ID, out)
+		}
+		got := bdShow(t, bd, dir, blocked.ID)
+		if got.Status == types.StatusClosed || got.Title != "Blocked" {
+			t.Errorf("a refused close must leave the issue untouched, got status=%s title=%q", got.Status, got.Title)
+		}
+
+		for _, id := range []string{parent.ID, blocked.ID} {
+			bdUpdate(t, bd, dir, id, "--status", "closed", "--force")
+			if got := bdShow(t, bd, dir, id); got.Status != types.StatusClosed {
+				t.Errorf("--force should close %s, got status %s", id, got.Status)
+			}
+		}
+	})
+
+	t.Run("close_via_status_update_records_reason", func(t *testing.T) {
+		plain := bdCreate(t, bd, dir, "Default reason", "--type", "task")
+		bdUpdate(t, bd, dir, plain.ID, "--status", "closed")
+		if got := bdShow(t, bd, dir, plain.ID); got.CloseReason != "Closed" {
+			t.Errorf("close_reason = %q, want bd close's default %q", got.CloseReason, "Closed")
+		}
+
+		given := bdCreate(t, bd, dir, "Given reason", "--type", "task")
+		bdUpdate(t, bd, dir, given.ID, "--status", "closed", "--reason", "shipped in v2")
+		if got := bdShow(t, bd, dir, given.ID); got.CloseReason != "shipped in v2" {
+			t.Errorf("close_reason = %q, want %q", got.CloseReason, "shipped in v2")
+		}
+
+		bdUpdate(t, bd, dir, given.ID, "--status", "open", "--reason", "regressed")
+		got := bdShow(t, bd, dir, given.ID)
+		if got.Status != types.StatusOpen || got.ClosedAt != nil || got.CloseReason != "" {
+			t.Errorf("reopen should clear the close, got status=%s closed_at=%v close_reason=%q", got.Status, got.ClosedAt, got.CloseReason)
+		}
+
+		out := bdUpdateFail(t, bd, dir, given.ID, "--title", "x", "--reason", "why")
+		if !strings.Contains(out, "--reason requires --status closed or --status open") {
+			t.Errorf("expected --reason without a close or reopen to fail, got: %s", out)
+		}
+	})
+
 	t.Run("reopen_via_status_update", func(t *testing.T) {
 		issue := bdCreate(t, bd, dir, "Reopen test", "--type", "task")
 		bdUpdate(t, bd, dir, issue.ID, "--status", "closed")
@@ -649,6 +773,114 @@ func TestEmbeddedUpdate(t *testing.T) {
 		}
 	})
 
+	t.Run("update_add_label_with_scalars_keeps_deps_and_comments", func(t *testing.T) {
+		blocker := bdCreate(t, bd, dir, "Label keep blocker", "--type", "task")
+		issue := bdCreate(t, bd, dir, "Label keep test", "--type", "task", "--label", "old")
+		bdDepAdd(t, bd, dir, issue.ID, blocker.ID)
+		bdComment(t, bd, dir, issue.ID, "keep this comment")
+
+		bdUpdate(t, bd, dir, issue.ID, "--priority", "0", "--add-label", "new", "--remove-label", "old")
+
+		got := bdShow(t, bd, dir, issue.ID)
+		if got.Priority != 0 {
+			t.Errorf("expected priority 0, got %d", got.Priority)
+		}
+		labels := showLabels(t, bd, dir, issue.ID)
+		if len(labels) != 1 || labels[0] != "new" {
+			t.Errorf("expected labels [new], got %v", labels)
+		}
+		deps := showDeps(t, bd, dir, issue.ID)
+		if len(deps) != 1 || deps[0].ID != blocker.ID {
+			t.Errorf("expected dependency on %s to survive, got %v", blocker.ID, deps)
+		}
+		if comments := bdCommentList(t, bd, dir, issue.ID); !strings.Contains(comments, "keep this comment") {
+			t.Errorf("expected comment to survive, got:\n%s", comments)
+		}
+	})
+
+	t.Run("update_add_label_empty_rejected", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Label empty test", "--type", "task")
+		for _, args := range [][]string{
+			{"--add-label", ""},
+			{"--add-label", "a,,b"},
+			{"--remove-label", " "},
+		} {
+			out := bdUpdateFail(t, bd, dir, append([]string{issue.ID}, args...)...)
+			if !strings.Contains(out, "non-empty label") {
+				t.Errorf("%v: expected non-empty label error, got: %s", args, out)
+			}
+		}
+		if labels := showLabels(t, bd, dir, issue.ID); len(labels) != 0 {
+			t.Errorf("rejected update should not add labels, got %v", labels)
+		}
+	})
+
+	// ===== Inline Dependency Flags =====
+
+	t.Run("update_add_remove_dep", func(t *testing.T) {
+		a := bdCreate(t, bd, dir, "Inline dep A", "--type", "task")
+		b := bdCreate(t, bd, dir, "Inline dep B", "--type", "task")
+		c := bdCreate(t, bd, dir, "Inline dep C", "--type", "task")
+		bdDepAdd(t, bd, dir, a.ID, c.ID)
+
+		bdUpdate(t, bd, dir, a.ID, "--priority", "1", "--add-dep", b.ID+":blocks", "--remove-dep", c.ID)
+
+		deps := showDeps(t, bd, dir, a.ID)
+		if len(deps) != 1 || deps[0].ID != b.ID || deps[0].Type != "blocks" {
+			t.Errorf("expected only a blocks dependency on %s, got %v", b.ID, deps)
+		}
+		if got := bdShow(t, bd, dir, a.ID); got.Priority != 1 {
+			t.Errorf("expected priority 1, got %d", got.Priority)
+		}
+	})
+
+	t.Run("update_add_dep_rejects_cycle", func(t *testing.T) {
+		a := bdCreate(t, bd, dir, "Inline cycle A", "--type", "task")
+		b := bdCreate(t, bd, dir, "Inline cycle B", "--type", "task")
+		c := bdCreate(t, bd, dir, "Inline cycle C", "--type", "task")
+		bdDepAdd(t, bd, dir, a.ID, b.ID)
+
+		// b -> c is fine on its own, but b -> a closes a cycle; neither lands.
+		out := bdUpdateFail(t, bd, dir, b.ID, "--title", "Renamed", "--add-dep", c.ID, "--add-dep", a.ID)
+		if !strings.Contains(out, "cycle") {
+			t.Errorf("expected cycle error, got: %s", out)
+		}
+		if deps := showDeps(t, bd, dir, b.ID); len(deps) != 0 {
+			t.Errorf("rejected update should add no dependencies, got %v", deps)
+		}
+		if got := bdShow(t, bd, dir, b.ID); got.Title != "Inline cycle B" {
+			t.Errorf("rejected update should leave the title alone, got %q", got.Title)
+		}
+	})
+
+	t.Run("update_claim_with_rejected_dep_writes_nothing", func(t *testing.T) {
+		a := bdCreate(t, bd, dir, "Claim cycle A", "--type", "task")
+		b := bdCreate(t, bd, dir, "Claim cycle B", "--type", "task")
+		bdDepAdd(t, bd, dir, a.ID, b.ID)
+
+		// The claim, the title and the edge share one transaction, so the
+		// cycle rejection rolls back the claim and the title too.
+		out := bdUpdateFail(t, bd, dir, b.ID, "--claim", "--title", "Renamed", "--add-dep", a.ID)
+		if !strings.Contains(out, "cycle") {
+			t.Errorf("expected cycle error, got: %s", out)
+		}
+		got := bdShow(t, bd, dir, b.ID)
+		if got.Status != types.StatusOpen || got.Assignee != "" {
+			t.Errorf("rejected update should not claim, got status %s assignee %q", got.Status, got.Assignee)
+		}
+		if got.Title != "Claim cycle B" {
+			t.Errorf("rejected update should leave the title alone, got %q", got.Title)
+		}
+	})
+
+	t.Run("update_add_dep_missing_target", func(t *testing.T) {
+		a := bdCreate(t, bd, dir, "Inline missing target", "--type", "task")
+		out := bdUpdateFail(t, bd, dir, a.ID, "--add-dep", "tu-doesnotexist")
+		if !strings.Contains(out, "resolving dependency ID") {
+			t.Errorf("expected resolution error, got: %s", out)
+		}
+	})
+
 	// ===== Metadata Flags =====
 
 	t.Run("update_metadata_json", func(t *testing.T) {
@@ -761,6 +993,42 @@ func TestEmbeddedUpdate(t *testing.T) {
 		}
 	})
 
+	t.Run("update_claim_conflicting_status_rejected", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Claim status conflict", "--type", "task")
+		out := bdUpdateFail(t, bd, dir, issue.ID, "--claim", "--status", "open")
+		if !strings.Contains(out, "cannot combine --claim with --status open") {
+			t.Errorf("expected claim/status conflict error, got: %s", out)
+		}
+		// Neither write lands: the issue stays open and unassigned.
+		got := bdShow(t, bd, dir, issue.ID)
+		if got.Status != types.StatusOpen || got.Assignee != "" {
+			t.Errorf("rejected update changed %s: status=%s assignee=%q", issue.ID, got.Status, got.Assignee)
+		}
+		if out := bdUpdateFail(t, bd, dir, issue.ID, "--claim", "--defer", "+2d"); !strings.Contains(out, "cannot combine --claim with --defer") {
+			t.Errorf("expected claim/defer conflict error, got: %s", out)
+		}
+	})
+
+	t.Run("update_claim_with_fields_and_in_progress", func(t *testing.T) {
+		issue := bdCreate(t, bd, dir, "Claim with fields", "--type", "task")
+		bdUpdate(t, bd, dir, issue.ID, "--claim", "--status", "in_progress", "--priority", "0", "--title", "Claimed with fields")
+		got := bdShow(t, bd, dir, issue.ID)
+		if got.Status != types.StatusInProgress || got.Assignee == "" {
+			t.Errorf("claim: status=%s assignee=%q, want in_progress and assigned", got.Status, got.Assignee)
+		}
+		if got.Priority != 0 || got.Title != "Claimed with fields" {
+			t.Errorf("field updates with --claim: priority=%d title=%q", got.Priority, got.Title)
+		}
+
+		// A lost claim writes none of the field updates either.
+		taken := bdCreate(t, bd, dir, "Claimed elsewhere", "--type", "task")
+		bdUpdate(t, bd, dir, taken.ID, "--assignee", "alice")
+		bdUpdateFail(t, bd, dir, taken.ID, "--claim", "--title", "Should not land")
+		if got := bdShow(t, bd, dir, taken.ID); got.Title != "Claimed elsewhere" {
+			t.Errorf("lost claim still wrote the title: %q", got.Title)
+		}
+	})
+
 	// ===== Parent Reparenting =====
 
 	t.Run("update_parent_set", func(t *testing.T) {
@@ -882,6 +1150,23 @@ func TestEmbeddedUpdate(t *testing.T) {
 		}
 	})
 
+	t.Run("update_status_closed_auto_closes_completed_molecule", func(t *testing.T) {
+		// Isolated store so molecule progress is deterministic.
+		mdir, _, _ := bdInit(t, bd, "--prefix", "um")
+		root := bdCreate(t, bd, mdir, "Update molecule root", "--type", "epic", "--labels", "template")
+		step1 := bdCreate(t, bd, mdir, "Update molecule step one", "--type", "task", "--parent", root.ID)
+		step2 := bdCreate(t, bd, mdir, "Update molecule step two", "--type", "task", "--parent", root.ID)
+
+		bdUpdate(t, bd, mdir, step1.ID, "--status", "closed")
+		if got := bdShow(t, bd, mdir, root.ID); got.Status == types.StatusClosed {
+			t.Fatalf("molecule root %s closed before its last step", root.ID)
+		}
+		bdUpdate(t, bd, mdir, step2.ID, "--status", "closed")
+		if got := bdShow(t, bd, mdir, root.ID); got.Status != types.StatusClosed {
+			t.Errorf("expected molecule root %s auto-closed by bd update on its last step, got %s", root.ID, got.Status)
+		}
+	})
+
 	// ===== Behavioral / Edge Cases =====
 
 	t.Run("update_no_changes", func(t *testing.T) {
@@ -894,7 +1179,13 @@ func TestEmbeddedUpdate(t *testing.T) {
 
 	t.Run("update_invalid_status", func(t *testing.T) {
 		issue := bdCreate(t, bd, dir, "Bad status", "--type", "task")
-		bdUpdateFail(t, bd, dir, issue.ID, "--status", "bogus")
+		out := bdUpdateFail(t, bd, dir, issue.ID, "--status", "bogus")
+		if !strings.Contains(out, "in_progress") {
+			t.Errorf("expected the error to list valid statuses, got: %s", out)
+		}
+		if got := bdShow(t, bd, dir, issue.ID); got.Status != types.StatusOpen {
+			t.Errorf("a rejected status must not be written, got %s", got.Status)
+		}
 	})
 
 	t.Run("update_invalid_priority", func(t *testing.T) {
@@ -997,6 +1288,75 @@ func TestEmbeddedUpdate(t *testing.T) {
 	})
 }
 
+// TestEmbeddedUpdateMetadataSchema checks that metadata.schema makes
+// bd update reject metadata that does not conform to the JSON Schema.
+func TestEmbeddedUpdateMetadataSchema(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+	dir, _, _ := bdInit(t, bd, "--prefix", "ms")
+
+	schema := `{
+  "type": "object",
+  "required": ["team"],
+  "properties": {
+    "team": {"type": "string", "enum": ["platform", "frontend"]},
+    "points": {"type": "integer", "minimum": 0}
+  }
+}`
+	if err := os.WriteFile(filepath.Join(dir, "metadata.schema.json"), []byte(schema), 0o644); err != nil {
+		t.Fatalf("write schema: %v", err)
+	}
+
+	// Free-form until a schema is configured.
+	issue := bdCreate(t, bd, dir, "Schema test", "--type", "task")
+	bdUpdate(t, bd, dir, issue.ID, "--metadata", `{"team":"sales"}`)
+
+	bdConfig(t, bd, dir, "set", "metadata.schema", "metadata.schema.json")
+
+	t.Run("valid_metadata_passes", func(t *testing.T) {
+		bdUpdate(t, bd, dir, issue.ID, "--metadata", `{"team":"platform","points":3}`)
+		bdUpdate(t, bd, dir, issue.ID, "--set-metadata", "team=frontend")
+		got := bdShow(t, bd, dir, issue.ID)
+		if !strings.Contains(string(got.Metadata), `"frontend"`) {
+			t.Errorf("expected team=frontend in metadata, got %s", got.Metadata)
+		}
+	})
+
+	t.Run("invalid_metadata_rejected", func(t *testing.T) {
+		out := bdUpdateFail(t, bd, dir, issue.ID, "--metadata", `{"team":"platform","points":-1}`)
+		if !strings.Contains(out, "metadata.points") {
+			t.Errorf("expected a points violation, got: %s", out)
+		}
+		out = bdUpdateFail(t, bd, dir, issue.ID, "--set-metadata", "team=sales")
+		if !strings.Contains(out, "metadata.team") {
+			t.Errorf("expected a team violation, got: %s", out)
+		}
+		got := bdShow(t, bd, dir, issue.ID)
+		if strings.Contains(string(got.Metadata), `"sales"`) || strings.Contains(string(got.Metadata), "-1") {
+			t.Errorf("rejected metadata was written: %s", got.Metadata)
+		}
+	})
+
+	t.Run("empty_object_checked_against_required", func(t *testing.T) {
+		out := bdCreateFail(t, bd, dir, "Empty metadata", "--metadata", `{}`)
+		if !strings.Contains(out, "team") {
+			t.Errorf("expected a missing team violation, got: %s", out)
+		}
+		out = bdUpdateFail(t, bd, dir, issue.ID, "--unset-metadata", "team", "--unset-metadata", "points")
+		if !strings.Contains(out, "team") {
+			t.Errorf("expected a missing team violation, got: %s", out)
+		}
+		got := bdShow(t, bd, dir, issue.ID)
+		if !strings.Contains(string(got.Metadata), `"frontend"`) {
+			t.Errorf("rejected metadata was written: %s", got.Metadata)
+		}
+	})
+}
+
 // TestEmbeddedUpdateConcurrent exercises create, update, and list operations
 // concurrently to verify EmbeddedDoltStore handles concurrent CLI invocations
 // without panics, data corruption, or deadlocks.
@@ -10,7 +10,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/steveyegge/beads/internal/timeparsing"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/utils"
@@ -22,6 +21,8 @@ type updateInput struct {
 	addLabels        []string
 	removeLabels     []string
 	setLabels        *[]string
+	addDeps          []updateDepEdit
+	removeDeps       []string
 	reparent         *string
 	claim            bool
 	appendNotes      string
@@ -30,6 +31,8 @@ type updateInput struct {
 	unsetMetadata    []string
 	mergeMetadataIn  json.RawMessage
 	clearDeferStatus bool
+	force            bool   // --force: override the close guards for --status closed
+	reason           string // --reason: close or reopen reason for --status closed/open
 }
 
 func gatherUpdateInput(ctx context.Context, cmd *cobra.Command) (*updateInput, error) {
@@ -41,6 +44,12 @@ func gatherUpdateInput(ctx context.Context, cmd *cobra.Command) (*updateInput, e
 			return nil, err
 		}
 		in.fields["status"] = status
+		in.force, _ = cmd.Flags().GetBool("force")
+		reason, err := updateTransitionReason(cmd)
+		if err != nil {
+			return nil, HandleErrorRespectJSON("%v", err)
+		}
+		in.reason = reason
 		if status == "closed" {
 			session, _ := cmd.Flags().GetString("session")
 			if session == "" {
@@ -121,9 +130,9 @@ func gatherUpdateInput(ctx context.Context, cmd *cobra.Command) (*updateInput, e
 		in.fields["spec_id"] = specID
 	}
 	if cmd.Flags().Changed("estimate") {
-		estimate, _ := cmd.Flags().GetInt("estimate")
-		if estimate < 0 {
-			return nil, HandleErrorRespectJSON("estimate must be a non-negative number of minutes")
+		estimate, err := parseEstimateFlag(cmd)
+		if err != nil {
+			return nil, HandleErrorRespectJSON("%v", err)
 		}
 		in.fields["estimated_minutes"] = estimate
 	}
@@ -132,15 +141,28 @@ func gatherUpdateInput(ctx context.Context, cmd *cobra.Command) (*updateInput, e
 		in.fields["issue_type"] = utils.NormalizeIssueType(issueType)
 	}
 	if cmd.Flags().Changed("add-label") {
-		in.addLabels, _ = cmd.Flags().GetStringSlice("add-label")
+		labels, err := readLabelFlag(cmd, "add-label")
+		if err != nil {
+			return nil, HandleErrorRespectJSON("%v", err)
+		}
+		in.addLabels = labels
 	}
 	if cmd.Flags().Changed("remove-label") {
-		in.removeLabels, _ = cmd.Flags().GetStringSlice("remove-label")
+		labels, err := readLabelFlag(cmd, "remove-label")
+		if err != nil {
+			return nil, HandleErrorRespectJSON("%v", err)
+		}
+		in.removeLabels = labels
 	}
 	if cmd.Flags().Changed("set-labels") {
 		labels, _ := cmd.Flags().GetStringSlice("set-labels")
 		in.setLabels = &labels
 	}
+	addDeps, removeDeps, err := readUpdateDepFlags(cmd)
+	if err != nil {
+		return nil, HandleErrorRespectJSON("%v", err)
+	}
+	in.addDeps, in.removeDeps = addDeps, removeDeps
 	if cmd.Flags().Changed("parent") {
 		parent, _ := cmd.Flags().GetString("parent")
 		in.reparent = &parent
@@ -154,9 +176,9 @@ func gatherUpdateInput(ctx context.Context, cmd *cobra.Command) (*updateInput, e
 		if dueStr == "" {
 			in.fields["due_at"] = nil
 		} else {
-			t, err := timeparsing.ParseRelativeTime(dueStr, time.Now())
+			t, err := parseTimeFlag(dueStr)
 			if err != nil {
-				return nil, HandleErrorRespectJSON("invalid --due format %q. Examples: +6h, tomorrow, next monday, 2025-01-15", dueStr)
+				return nil, HandleErrorRespectJSON("invalid --due format %q. Examples: %s", dueStr, timeFlagExamples)
 			}
 			in.fields["due_at"] = t
 		}
@@ -170,14 +192,14 @@ func gatherUpdateInput(ctx context.Context, cmd *cobra.Command) (*updateInput, e
 				in.clearDeferStatus = true
 			}
 		} else {
-			t, err := timeparsing.ParseRelativeTime(deferStr, time.Now())
+			t, err := parseTimeFlag(deferStr)
 			if err != nil {
-				return nil, HandleErrorRespectJSON("invalid --defer format %q. Examples: +1h, tomorrow, next monday, 2025-01-15", deferStr)
+				return nil, HandleErrorRespectJSON("invalid --defer format %q. Examples: %s", deferStr, timeFlagExamples)
 			}
 			inPast := t.Before(time.Now())
 			if inPast && !jsonOut {
 				fmt.Fprintf(os.Stderr, "%s Defer date %q is in the past. Issue will appear in bd ready immediately.\n",
-					ui.RenderWarn("!"), t.Format("2006-01-02 15:04"))
+					ui.RenderWarn("!"), t.Local().Format("2006-01-02 15:04"))
 				fmt.Fprintf(os.Stderr, "  Did you mean a future date? Use --defer=+1h or --defer=tomorrow\n")
 			}
 			in.fields["defer_until"] = t
@@ -236,8 +258,17 @@ func gatherUpdateInput(ctx context.Context, cmd *cobra.Command) (*updateInput, e
 	}
 	in.setMetadata = setMetadataFlags
 	in.unsetMetadata = unsetMetadataFlags
+	if err := applyUpdateClear(cmd, in.fields); err != nil {
+		return nil, HandleErrorRespectJSON("%v", err)
+	}
 
 	in.claim, _ = cmd.Flags().GetBool("claim")
+	if in.claim {
+		if err := claimStatusConflict(cmd, in.fields); err != nil {
+			return nil, HandleErrorRespectJSON("%v", err)
+		}
+		in.clearDeferStatus = false
+	}
 	return in, nil
 }
 
@@ -262,6 +293,22 @@ func validateUpdateStatus(ctx context.Context, status string) error {
 	return HandleErrorRespectJSON("invalid status %q (allowed: %s)", status, strings.Join(names, ", "))
 }
 
+// readLabelFlag reads a repeatable label flag such as --add-label. Blank
+// labels are rejected rather than dropped, so --add-label "" or "a,,b" fails
+// instead of silently doing less than asked. Duplicates are collapsed.
+func readLabelFlag(cmd *cobra.Command, name string) ([]string, error) {
+	labels, _ := cmd.Flags().GetStringSlice(name)
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("--%s requires a non-empty label", name)
+	}
+	for _, label := range labels {
+		if strings.TrimSpace(label) == "" {
+			return nil, fmt.Errorf("--%s requires a non-empty label", name)
+		}
+	}
+	return utils.NormalizeLabels(labels), nil
+}
+
 func isUpdateInputNoop(in *updateInput) bool {
 	if in.claim {
 		return false
@@ -272,6 +319,9 @@ func isUpdateInputNoop(in *updateInput) bool {
 	if len(in.addLabels) > 0 || len(in.removeLabels) > 0 {
 		return false
 	}
+	if len(in.addDeps) > 0 || len(in.removeDeps) > 0 {
+		return false
+	}
 	if len(in.mergeMetadataIn) > 0 || len(in.setMetadata) > 0 || len(in.unsetMetadata) > 0 {
 		return false
 	}
@@ -63,6 +63,7 @@ func runUpdateProxiedServer(cmd *cobra.Command, ctx context.Context, args []stri
 			continue
 		}
 		if jsonOut {
+			issue.NormalizeOrder()
 			updated = append(updated, issue)
 		} else {
 			fmt.Printf("%s Updated issue: %s\n", ui.RenderPass("✓"), formatFeedbackID(issue.ID, issue.Title))
@@ -140,11 +141,13 @@ func applyUpdateProxiedAttempt(ctx context.Context, id string, in *updateInput)
 	defer uw.Close(ctx)
 
 	issueUC := uw.IssueUseCase()
+	isWisp := false
 	current, err := issueUC.GetIssue(ctx, id)
 	if err != nil || current == nil {
 		wispCurrent, wispErr := issueUC.GetWisp(ctx, id)
 		if wispErr == nil && wispCurrent != nil {
 			current = wispCurrent
+			isWisp = true
 		} else if err != nil {
 			fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", id, err)
 			return nil, fmt.Sprintf("resolving issue: %v", err), false, nil
@@ -157,8 +160,47 @@ func applyUpdateProxiedAttempt(ctx context.Context, id string, in *updateInput)
 		fmt.Fprintf(os.Stderr, "%s\n", err)
 		return nil, err.Error(), false, nil
 	}
+	if err := checkDeferredHasDate(id, in.fields, current); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		return nil, err.Error(), false, nil
+	}
+	// An issue past its defer date is reopened with this update, ahead of
+	// a claim, which only takes open issues.
+	if reopen := expiredDeferralReopen(current, in.fields); reopen != nil {
+		if err := proxiedUpdateByID(ctx, uw, current.ID, current.Ephemeral, reopen); err != nil {
+			if uow.IsSerializationError(err) {
+				return nil, "", true, err
+			}
+			fmt.Fprintf(os.Stderr, "Error updating %s: %v\n", id, err)
+			return nil, fmt.Sprintf("updating: %v", err), false, nil
+		}
+		current.Status = types.StatusOpen
+		current.DeferUntil = nil
+	}
+	if closesIssue(in.fields, current) {
+		if err := checkUpdateCloseProxied(ctx, uw, current, isWisp, in.force); err != nil {
+			if uow.IsSerializationError(err) {
+				return nil, "", true, err
+			}
+			fmt.Fprintf(os.Stderr, "%s\n", err)
+			return nil, err.Error(), false, nil
+		}
+	}
+
+	if len(in.addDeps) > 0 || len(in.removeDeps) > 0 {
+		if err := applyUpdateDepsProxied(ctx, uw, current.ID, current.Ephemeral, in.addDeps, in.removeDeps); err != nil {
+			if uow.IsSerializationError(err) {
+				return nil, "", true, err
+			}
+			fmt.Fprintf(os.Stderr, "Error updating dependencies for %s: %v\n", id, err)
+			return nil, fmt.Sprintf("updating dependencies: %v", err), false, nil
+		}
+	}
 
 	spec := buildUpdateSpecForIssue(current, in)
+	// A close or reopen goes through the bd close / bd reopen use cases
+	// after the other field updates, in this same unit of work.
+	transition, closeSession := splitStatusTransition(spec.Fields, current)
 	notesOverwritten := replacesExistingNotes(current.Notes, in.fields)
 
 	updated, err := issueUC.ApplyUpdate(ctx, id, spec, actor)
@@ -175,6 +217,19 @@ func applyUpdateProxiedAttempt(ctx context.Context, id string, in *updateInput)
 		fmt.Fprintf(os.Stderr, "Error updating %s: %v\n", id, err)
 		return nil, fmt.Sprintf("updating: %v", err), false, nil
 	}
+	if transition != transitionNone {
+		if updated == nil {
+			updated = current
+		}
+		updated, err = applyUpdateTransitionProxied(ctx, uw, updated, isWisp, transition, in.reason, closeSession, in.force)
+		if err != nil {
+			if uow.IsSerializationError(err) {
+				return nil, "", true, err
+			}
+			fmt.Fprintf(os.Stderr, "Error updating %s: %v\n", id, err)
+			return nil, fmt.Sprintf("updating: %v", err), false, nil
+		}
+	}
 
 	if err := uw.Commit(ctx, fmt.Sprintf("bd: update %s", id)); err != nil {
 		if uow.IsSerializationError(err) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/ui"
 )
 
@@ -88,12 +89,8 @@ Examples:
 				if err := store.CommitMergeResolution(ctx, fmt.Sprintf("Resolve merge conflicts from %s using %s strategy", branchName, vcMergeStrategy)); err != nil {
 					return HandleErrorRespectJSON("conflicts resolved but commit failed: %v", err)
 				}
-				if rs, ok := store.(interface {
-					RecomputeBlockedAfterMerge(ctx context.Context, fromCommit string) error
-				}); ok {
-					if err := rs.RecomputeBlockedAfterMerge(ctx, preHead); err != nil {
-						return HandleErrorRespectJSON("conflicts resolved but is_blocked recompute failed: %v", err)
-					}
+				if err := recomputeBlockedAfterMerge(ctx, store, preHead); err != nil {
+					return HandleErrorRespectJSON("conflicts resolved but is_blocked recompute failed: %v", err)
 				}
 				if jsonOutput {
 					return outputJSON(map[string]interface{}{
@@ -267,3 +264,21 @@ func init() {
 	vcCmd.AddCommand(vcStatusCmd)
 	rootCmd.AddCommand(vcCmd)
 }
+
+// recomputeBlockedAfterMerge refreshes is_blocked for the rows a merge
+// brought in since fromCommit. The method lives on the concrete store, under
+// the decorators wireStorageDecorators adds, and the recompute bypasses the
+// blocked-set cache, so the cache is dropped afterwards.
+func recomputeBlockedAfterMerge(ctx context.Context, s storage.DoltStorage, fromCommit string) error {
+	rs, ok := storage.UnwrapStore(s).(interface {
+		RecomputeBlockedAfterMerge(ctx context.Context, fromCommit string) error
+	})
+	if !ok {
+		return nil
+	}
+	if err := rs.RecomputeBlockedAfterMerge(ctx, fromCommit); err != nil {
+		return err
+	}
+	storage.InvalidateBlockedCache(s)
+	return nil
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/metrics"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/timeparsing"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var whatsnextCmd = &cobra.Command{
+	Use:     "whatsnext",
+	GroupID: "views",
+	Short:   "Plan a capacity-bounded list of ready work for one assignee",
+	Long: `Build a daily plan: ready work (open, no active blockers) that is assigned
+to the assignee or unassigned and claimable, in priority order, packed into a
+time budget.
+
+Each issue costs its estimate; issues without one cost --default-estimate.
+An issue that does not fit in the remaining capacity is skipped, and smaller,
+lower-priority work may still fill the time left. Durations use the --estimate
+syntax (90, 45m, 1h30m, 1d, 2w; a day is estimate.workday_hours, default 8).
+
+Use --claim-all to claim every planned issue in one transaction: either the
+whole plan is claimed or, if any issue was taken by someone else, none is.
+
+Examples:
+  bd whatsnext                          # one workday for the current actor
+  bd whatsnext --assignee me --capacity 6h
+  bd whatsnext --capacity 2h --default-estimate 30m --json
+  bd whatsnext --capacity 6h --claim-all`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		evt := metrics.NewCommandEvent("whatsnext")
+		defer func() {
+			if c := metrics.Global(); c != nil {
+				c.CloseEventAndAdd(evt)
+			}
+		}()
+
+		in, err := readWhatsnextInput(cmd)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+
+		if usesProxiedServer() {
+			return runWhatsnextProxiedServer(rootCtx, in)
+		}
+
+		ctx := rootCtx
+		if in.claimAll {
+			CheckReadonly("whatsnext --claim-all")
+		}
+		plan, err := planWhatsnext(ctx, store.GetReadyWork, in)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+
+		if in.claimAll && len(plan.Issues) > 0 {
+			ids := plan.issueIDs()
+			commitMsg := fmt.Sprintf("bd: whatsnext claim %d issue(s) for %s", len(ids), actor)
+			err := transactHonoringAutoCommit(ctx, store, commitMsg, func(tx storage.Transaction) error {
+				for _, id := range ids {
+					if err := tx.ClaimIssue(ctx, id, actor); err != nil {
+						return fmt.Errorf("claim %s: %w", id, err)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return HandleErrorRespectJSON("whatsnext --claim-all (nothing claimed): %v", err)
+			}
+			commandDidWrite.Store(true)
+			plan.Claimed = true
+		}
+		return renderWhatsnextPlan(plan)
+	},
+}
+
+// whatsnextInput is the validated flag set of bd whatsnext.
+type whatsnextInput struct {
+	assignee        string
+	capacity        int
+	defaultEstimate int
+	claimAll        bool
+}
+
+// readWhatsnextInput resolves the assignee ("me" or empty means the current
+// actor) and converts --capacity and --default-estimate to minutes.
+func readWhatsnextInput(cmd *cobra.Command) (whatsnextInput, error) {
+	workdayHours := config.GetInt("estimate.workday_hours")
+	in := whatsnextInput{}
+	in.assignee, _ = cmd.Flags().GetString("assignee")
+	if in.assignee = strings.TrimSpace(in.assignee); in.assignee == "" || in.assignee == "me" {
+		in.assignee = actor
+	}
+	in.claimAll, _ = cmd.Flags().GetBool("claim-all")
+	if in.claimAll && in.assignee != actor {
+		return in, fmt.Errorf("--claim-all claims as %q; it cannot plan for --assignee %q", actor, in.assignee)
+	}
+
+	raw, _ := cmd.Flags().GetString("capacity")
+	if strings.TrimSpace(raw) == "" {
+		raw = "1d"
+	}
+	capacity, err := timeparsing.ParseEstimate(raw, workdayHours)
+	if err != nil {
+		return in, fmt.Errorf("invalid --capacity: %w", err)
+	}
+	if capacity <= 0 {
+		return in, fmt.Errorf("--capacity must be greater than zero")
+	}
+	in.capacity = capacity
+
+	raw, _ = cmd.Flags().GetString("default-estimate")
+	defaultEstimate, err := timeparsing.ParseEstimate(raw, workdayHours)
+	if err != nil {
+		return in, fmt.Errorf("invalid --default-estimate: %w", err)
+	}
+	in.defaultEstimate = defaultEstimate
+	return in, nil
+}
+
+// whatsnextWorkFilter selects the candidates: all ready work, highest priority
+// first. Assignment is filtered afterwards because the plan mixes the
+// assignee's own issues with unassigned ones.
+func whatsnextWorkFilter() types.WorkFilter {
+	return types.WorkFilter{Status: types.StatusOpen, SortPolicy: types.SortPolicyPriority}
+}
+
+// whatsnextItem is one planned issue with the minutes it was charged.
+type whatsnextItem struct {
+	*types.Issue
+	PlannedMinutes int  `json:"planned_minutes"`
+	Estimated      bool `json:"estimated"`
+}
+
+// whatsnextPlan is the result of bd whatsnext, as emitted by --json.
+type whatsnextPlan struct {
+	Assignee        string          `json:"assignee"`
+	CapacityMinutes int             `json:"capacity_minutes"`
+	PlannedMinutes  int             `json:"planned_minutes"`
+	Issues          []whatsnextItem `json:"issues"`
+	Skipped         int             `json:"skipped"`
+	Claimed         bool            `json:"claimed"`
+}
+
+func (p whatsnextPlan) issueIDs() []string {
+	ids := make([]string, len(p.Issues))
+	for i, item := range p.Issues {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+// buildWhatsnextPlan packs ready issues, already in priority order, into
+// capacity minutes. Only issues assigned to assignee or unassigned are
+// considered; an issue that does not fit the remaining capacity is counted in
+// Skipped and the walk continues with the next one.
+func buildWhatsnextPlan(ready []*types.Issue, assignee string, capacity, defaultEstimate int) whatsnextPlan {
+	plan := whatsnextPlan{
+		Assignee:        assignee,
+		CapacityMinutes: capacity,
+		Issues:          []whatsnextItem{},
+	}
+	for _, issue := range ready {
+		if issue.Assignee != "" && issue.Assignee != assignee {
+			continue
+		}
+		item := whatsnextItem{Issue: issue, PlannedMinutes: defaultEstimate}
+		if issue.EstimatedMinutes != nil {
+			item.PlannedMinutes, item.Estimated = *issue.EstimatedMinutes, true
+		}
+		if plan.PlannedMinutes+item.PlannedMinutes > capacity {
+			plan.Skipped++
+			continue
+		}
+		plan.PlannedMinutes += item.PlannedMinutes
+		plan.Issues = append(plan.Issues, item)
+	}
+	return plan
+}
+
+func renderWhatsnextPlan(plan whatsnextPlan) error {
+	if jsonOutput {
+		return outputJSON(plan)
+	}
+	if len(plan.Issues) == 0 {
+		fmt.Printf("\n%s Nothing ready fits %s for %s\n\n", ui.RenderWarn("○"), formatMinutes(plan.CapacityMinutes), plan.Assignee)
+		return nil
+	}
+	verb := "Plan"
+	if plan.Claimed {
+		verb = "Claimed plan"
+	}
+	fmt.Printf("\n%s %s for %s: %d issue(s), %s of %s\n\n", ui.RenderAccent("📋"), verb, plan.Assignee,
+		len(plan.Issues), formatMinutes(plan.PlannedMinutes), formatMinutes(plan.CapacityMinutes))
+	for i, item := range plan.Issues {
+		estimate := formatMinutes(item.PlannedMinutes)
+		if !item.Estimated {
+			estimate += " (default)"
+		}
+		owner := "unassigned"
+		if item.Assignee != "" {
+			owner = item.Assignee
+		}
+		fmt.Printf("%d. [%s] %s: %s\n", i+1, ui.RenderPriority(item.Priority), ui.RenderID(item.ID), item.Title)
+		fmt.Printf("   Estimate: %s, Assignee: %s\n", estimate, owner)
+	}
+	if plan.Skipped > 0 {
+		fmt.Printf("\n%d ready issue(s) did not fit the remaining capacity\n", plan.Skipped)
+	}
+	fmt.Println()
+	return nil
+}
+
+// formatMinutes renders minutes as a compact duration: 45m, 6h, 1h30m.
+func formatMinutes(minutes int) string {
+	h, m := minutes/60, minutes%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	default:
+		return fmt.Sprintf("%dh%dm", h, m)
+	}
+}
+
+// planWhatsnext reads the candidates through getReady and builds the plan.
+func planWhatsnext(ctx context.Context, getReady func(context.Context, types.WorkFilter) ([]*types.Issue, error), in whatsnextInput) (whatsnextPlan, error) {
+	ready, err := getReady(ctx, whatsnextWorkFilter())
+	if err != nil {
+		return whatsnextPlan{}, err
+	}
+	return buildWhatsnextPlan(ready, in.assignee, in.capacity, in.defaultEstimate), nil
+}
+
+func init() {
+	whatsnextCmd.Flags().StringP("assignee", "a", "", "Plan for this assignee (default: the current actor; \"me\" is the current actor)")
+	whatsnextCmd.Flags().StringP("capacity", "c", "", "Time budget for the plan, e.g. 6h or 1d (default: one workday)")
+	whatsnextCmd.Flags().String("default-estimate", "1h", "Time charged for issues without an estimate")
+	whatsnextCmd.Flags().Bool("claim-all", false, "Atomically claim every planned issue (all or nothing)")
+	rootCmd.AddCommand(whatsnextCmd)
+}
@@ -0,0 +1,87 @@
+//go:build cgo
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestEmbeddedWhatsnext(t *testing.T) {
+	if os.Getenv("BEADS_TEST_EMBEDDED_DOLT") != "1" {
+		t.Skip("set BEADS_TEST_EMBEDDED_DOLT=1 to run embedded dolt integration tests")
+	}
+	t.Parallel()
+
+	bd := buildEmbeddedBD(t)
+	dir, _, _ := bdInit(t, bd, "--prefix", "wn")
+
+	mine := bdCreate(t, bd, dir, "Mine", "--priority", "0", "--estimate", "2h", "--assignee", "planner")
+	other := bdCreate(t, bd, dir, "Someone else's", "--priority", "0", "--estimate", "30m", "--assignee", "other")
+	big := bdCreate(t, bd, dir, "Too big", "--priority", "1", "--estimate", "1d")
+	small := bdCreate(t, bd, dir, "Small", "--priority", "2", "--estimate", "1h")
+	unestimated := bdCreate(t, bd, dir, "Unestimated", "--priority", "3")
+
+	whatsnext := func(t *testing.T, args ...string) whatsnextPlan {
+		t.Helper()
+		cmd := exec.Command(bd, append([]string{"whatsnext", "--actor", "planner", "--json"}, args...)...)
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("bd whatsnext %v failed: %v\n%s", args, err, out)
+		}
+		var plan whatsnextPlan
+		if err := json.Unmarshal(out, &plan); err != nil {
+			t.Fatalf("parse whatsnext JSON: %v\n%s", err, out)
+		}
+		return plan
+	}
+	ids := func(plan whatsnextPlan) string { return strings.Join(plan.issueIDs(), ",") }
+
+	t.Run("plan_respects_capacity_and_assignment", func(t *testing.T) {
+		plan := whatsnext(t, "--assignee", "me", "--capacity", "4h", "--default-estimate", "45m")
+		want := strings.Join([]string{mine.ID, small.ID, unestimated.ID}, ",")
+		if got := ids(plan); got != want {
+			t.Fatalf("planned = %s, want %s (not %s or %s)", got, want, other.ID, big.ID)
+		}
+		if plan.Assignee != "planner" || plan.CapacityMinutes != 240 || plan.PlannedMinutes != 225 {
+			t.Fatalf("plan = %+v, want planner 225/240 minutes", plan)
+		}
+		if plan.Skipped != 1 || plan.Claimed {
+			t.Fatalf("skipped = %d claimed = %v, want 1 false", plan.Skipped, plan.Claimed)
+		}
+	})
+
+	t.Run("claim_all_requires_own_assignee", func(t *testing.T) {
+		cmd := exec.Command(bd, "whatsnext", "--actor", "planner", "--assignee", "other", "--claim-all")
+		cmd.Dir = dir
+		cmd.Env = bdEnv(dir)
+		out, err := cmd.CombinedOutput()
+		if err == nil || !strings.Contains(string(out), "--claim-all") {
+			t.Fatalf("expected --claim-all rejection, got err=%v\n%s", err, out)
+		}
+	})
+
+	t.Run("claim_all_claims_plan", func(t *testing.T) {
+		plan := whatsnext(t, "--capacity", "3h", "--claim-all")
+		want := strings.Join([]string{mine.ID, small.ID}, ",")
+		if got := ids(plan); got != want || !plan.Claimed {
+			t.Fatalf("claimed plan = %s (claimed=%v), want %s", got, plan.Claimed, want)
+		}
+		for _, id := range plan.issueIDs() {
+			issue := bdShow(t, bd, dir, id)
+			if issue.Status != types.StatusInProgress || issue.Assignee != "planner" {
+				t.Errorf("%s: status=%s assignee=%q, want in_progress planner", id, issue.Status, issue.Assignee)
+			}
+		}
+		if issue := bdShow(t, bd, dir, unestimated.ID); issue.Status != types.StatusOpen || issue.Assignee != "" {
+			t.Errorf("unplanned %s changed: status=%s assignee=%q", unestimated.ID, issue.Status, issue.Assignee)
+		}
+	})
+}
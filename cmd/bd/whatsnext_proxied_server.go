@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/storage/uow"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// runWhatsnextProxiedServer is bd whatsnext under --proxied-server. With
+// --claim-all the plan is read and claimed in one unit of work, so a claim
+// that loses to another actor rolls the whole plan back.
+func runWhatsnextProxiedServer(ctx context.Context, in whatsnextInput) error {
+	if uowProvider == nil {
+		return HandleError("proxied-server UOW provider not initialized")
+	}
+	getReady := func(uw uow.UnitOfWork) func(context.Context, types.WorkFilter) ([]*types.Issue, error) {
+		return proxiedReadyDeltaSource{uw: uw}.GetReadyWork
+	}
+
+	if !in.claimAll {
+		uw, err := openProxiedListUOW(ctx)
+		if err != nil {
+			return HandleError("%v", err)
+		}
+		defer uw.Close(ctx)
+		plan, err := planWhatsnext(ctx, getReady(uw), in)
+		if err != nil {
+			return HandleErrorRespectJSON("%v", err)
+		}
+		return renderWhatsnextPlan(plan)
+	}
+
+	plan, err := uow.RunTxResult(ctx, uowProvider, func(ctx context.Context, uw uow.UnitOfWork) (whatsnextPlan, string, error) {
+		plan, err := planWhatsnext(ctx, getReady(uw), in)
+		if err != nil {
+			return plan, "", err
+		}
+		ids := plan.issueIDs()
+		for _, id := range ids {
+			if _, err := uw.IssueUseCase().ClaimIssue(ctx, id, actor); err != nil {
+				return plan, "", fmt.Errorf("claim %s: %w", id, err)
+			}
+		}
+		plan.Claimed = len(ids) > 0
+		return plan, fmt.Sprintf("bd: whatsnext claim %d issue(s) for %s", len(ids), actor), nil
+	})
+	if err != nil {
+		return HandleErrorRespectJSON("whatsnext --claim-all (nothing claimed): %v", err)
+	}
+	if plan.Claimed {
+		commandDidWrite.Store(true)
+	}
+	return renderWhatsnextPlan(plan)
+}
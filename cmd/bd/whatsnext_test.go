@@ -0,0 +1,69 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestBuildWhatsnextPlan(t *testing.T) {
+	minutes := func(m int) *int { return &m }
+	ready := []*types.Issue{
+		{ID: "bd-1", Priority: 0, EstimatedMinutes: minutes(120)},
+		{ID: "bd-2", Priority: 0, Assignee: "bob", EstimatedMinutes: minutes(30)},
+		{ID: "bd-3", Priority: 1, Assignee: "alice", EstimatedMinutes: minutes(240)},
+		{ID: "bd-4", Priority: 1, EstimatedMinutes: minutes(60)},
+		{ID: "bd-5", Priority: 2},
+		{ID: "bd-6", Priority: 3, EstimatedMinutes: minutes(90)},
+	}
+
+	tests := []struct {
+		name      string
+		capacity  int
+		wantIDs   []string
+		wantTotal int
+		wantSkip  int
+	}{
+		{"fills capacity in priority order", 360, []string{"bd-1", "bd-3"}, 360, 3},
+		{"smaller later work fills the gap", 240, []string{"bd-1", "bd-4", "bd-5"}, 240, 2},
+		{"nothing fits", 15, []string{}, 0, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := buildWhatsnextPlan(ready, "alice", tt.capacity, 60)
+			if got := plan.issueIDs(); !reflect.DeepEqual(got, tt.wantIDs) {
+				t.Errorf("planned = %v, want %v", got, tt.wantIDs)
+			}
+			if plan.PlannedMinutes != tt.wantTotal {
+				t.Errorf("planned minutes = %d, want %d", plan.PlannedMinutes, tt.wantTotal)
+			}
+			if plan.PlannedMinutes > tt.capacity {
+				t.Errorf("planned minutes %d exceed capacity %d", plan.PlannedMinutes, tt.capacity)
+			}
+			if plan.Skipped != tt.wantSkip {
+				t.Errorf("skipped = %d, want %d", plan.Skipped, tt.wantSkip)
+			}
+			for _, item := range plan.Issues {
+				if item.Assignee != "" && item.Assignee != "alice" {
+					t.Errorf("planned %s assigned to %q", item.ID, item.Assignee)
+				}
+			}
+		})
+	}
+
+	t.Run("unestimated issues use the default", func(t *testing.T) {
+		plan := buildWhatsnextPlan([]*types.Issue{{ID: "bd-5"}}, "alice", 60, 45)
+		if len(plan.Issues) != 1 || plan.Issues[0].PlannedMinutes != 45 || plan.Issues[0].Estimated {
+			t.Fatalf("plan = %+v, want bd-5 charged the 45m default", plan.Issues)
+		}
+	})
+}
+
+func TestFormatMinutes(t *testing.T) {
+	for minutes, want := range map[int]string{0: "0m", 45: "45m", 360: "6h", 90: "1h30m"} {
+		if got := formatMinutes(minutes); got != want {
+			t.Errorf("formatMinutes(%d) = %q, want %q", minutes, got, want)
+		}
+	}
+}
@@ -216,6 +216,10 @@ func Initialize() error {
 
 	// Create command defaults
 	v.SetDefault("create.require-description", false)
+	v.SetDefault("create.dup_check", false)
+
+	// Workday length for day/week estimates (bd create --estimate 1d)
+	v.SetDefault("estimate.workday_hours", 8)
 
 	// Validation configuration defaults (bd-t7jq)
 	// Values: "warn" | "error" | "none"
@@ -953,6 +957,23 @@ func MetadataSchemaFields() map[string]interface{} {
 	return nil
 }
 
+// MetadataSchemaFile returns the JSON Schema file set by metadata.schema, or
+// "" when none is configured (metadata stays free-form). Relative paths
+// resolve from the repo root (parent of .beads/), like external_projects.
+func MetadataSchemaFile() string {
+	if v == nil {
+		return ""
+	}
+	path := strings.TrimSpace(v.GetString("metadata.schema"))
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	if configFile := ConfigFileUsed(); configFile != "" {
+		return filepath.Join(filepath.Dir(filepath.Dir(configFile)), path)
+	}
+	return path
+}
+
 // DefaultAgentsFile is the default filename for agent instructions.
 const DefaultAgentsFile = "AGENTS.md"
 
@@ -50,6 +50,12 @@ var YamlOnlyKeys = map[string]bool{
 
 	// Create command settings
 	"create.require-description": true,
+	"create.default_priority":    true,
+	"create.default_type":        true,
+	"create.dup_check":           true,
+
+	// Estimate parsing (bd create/update --estimate 1d)
+	"estimate.workday_hours": true,
 
 	// Prime memory-injection caps (read at session start, possibly before
 	// the database is reachable, so they must live in yaml)
@@ -65,6 +71,9 @@ var YamlOnlyKeys = map[string]bool{
 	// Hierarchy settings (GH#995)
 	"hierarchy.max-depth": true,
 
+	// JSON Schema file for issue metadata, read by the storage layer
+	"metadata.schema": true,
+
 	// Backup settings (must be in yaml so GetValueSource can detect overrides)
 	"backup.enabled":  true,
 	"backup.interval": true,
@@ -830,6 +839,23 @@ func validateYamlConfigValue(key, value string) error {
 		if n < 0 {
 			return fmt.Errorf("prime.max-memories must be a non-negative integer (0 = unlimited), got %q", value)
 		}
+	case "create.default_priority":
+		// Same forms bd create --priority accepts: 0-4 or P0-P4.
+		v := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(value)), "P")
+		if n, err := strconv.Atoi(v); err != nil || n < 0 || n > 4 {
+			return fmt.Errorf("create.default_priority must be 0-4 or P0-P4, got %q", value)
+		}
+	case "create.default_type":
+		// Custom types live in the database, so only the shape is checked
+		// here; bd config set checks the name against built-in and custom types.
+		if strings.TrimSpace(value) == "" || strings.ContainsAny(value, ", \t") {
+			return fmt.Errorf("create.default_type must be a single issue type name, got %q", value)
+		}
+	case "estimate.workday_hours":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > 24 {
+			return fmt.Errorf("estimate.workday_hours must be an integer from 1 to 24, got %q", value)
+		}
 	case "prime.max-memory-chars":
 		n, err := strconv.Atoi(value)
 		if err != nil {
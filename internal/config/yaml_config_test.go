@@ -1368,3 +1368,37 @@ func TestMetricsNoticeShownResolvesUserGlobalOnly(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateYamlConfigValue_CreateDefaults(t *testing.T) {
+	for _, v := range []string{"0", "2", "4", "P1", "p3"} {
+		if err := validateYamlConfigValue("create.default_priority", v); err != nil {
+			t.Errorf("create.default_priority %q: unexpected error: %v", v, err)
+		}
+	}
+	for _, v := range []string{"", "5", "-1", "high", "P9"} {
+		if err := validateYamlConfigValue("create.default_priority", v); err == nil {
+			t.Errorf("create.default_priority %q: expected an error", v)
+		}
+	}
+	if err := validateYamlConfigValue("create.default_type", "bug"); err != nil {
+		t.Errorf("create.default_type bug: unexpected error: %v", err)
+	}
+	for _, v := range []string{"", "bug,task", "two words"} {
+		if err := validateYamlConfigValue("create.default_type", v); err == nil {
+			t.Errorf("create.default_type %q: expected an error", v)
+		}
+	}
+}
+
+func TestValidateYamlConfigValue_EstimateWorkdayHours(t *testing.T) {
+	for _, v := range []string{"1", "6", "8", "24"} {
+		if err := validateYamlConfigValue("estimate.workday_hours", v); err != nil {
+			t.Errorf("estimate.workday_hours %q: unexpected error: %v", v, err)
+		}
+	}
+	for _, v := range []string{"", "0", "25", "7.5", "eight"} {
+		if err := validateYamlConfigValue("estimate.workday_hours", v); err == nil {
+			t.Errorf("estimate.workday_hours %q: expected an error", v)
+		}
+	}
+}
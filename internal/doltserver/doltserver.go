@@ -308,12 +308,25 @@ func ResolveDoltDir(beadsDir string) string {
 
 // Config holds the server configuration.
 type Config struct {
-	BeadsDir string     // Path to .beads/ directory
-	Port     int        // MySQL protocol port (0 = allocate ephemeral port on Start)
-	Host     string     // Bind address (default: 127.0.0.1)
-	Mode     ServerMode // Server ownership mode (Owned, External, Embedded)
+	BeadsDir   string     // Path to .beads/ directory
+	Port       int        // MySQL protocol port (0 = allocate ephemeral port on Start)
+	PortSource string     // Where DefaultConfig found Port (one of the PortSource* constants)
+	Host       string     // Bind address (default: 127.0.0.1)
+	Mode       ServerMode // Server ownership mode (Owned, External, Embedded)
 }
 
+// Port sources reported in Config.PortSource, in DefaultConfig's order of
+// precedence.
+const (
+	PortSourceEnv           = "env"            // BEADS_DOLT_SERVER_PORT
+	PortSourcePortFile      = "port_file"      // .beads/dolt-server.port
+	PortSourceDoltConfig    = "dolt_config"    // listener.port in the server's config.yaml
+	PortSourceConfigYAML    = "config_yaml"    // dolt.port in bd's config.yaml
+	PortSourceMetadata      = "metadata_json"  // deprecated dolt_server_port
+	PortSourceSharedDefault = "shared_default" // DefaultSharedServerPort in shared mode
+	PortSourceNone          = "none"           // Start allocates an ephemeral port
+)
+
 // State holds runtime information about a managed server.
 type State struct {
 	Running bool   `json:"running"`
@@ -535,7 +548,7 @@ func DefaultConfig(beadsDir string) *Config {
 	// Check env var override first (used by tests and manual overrides)
 	if p := os.Getenv("BEADS_DOLT_SERVER_PORT"); p != "" {
 		if port, err := strconv.Atoi(p); err == nil {
-			cfg.Port = port
+			cfg.Port, cfg.PortSource = port, PortSourceEnv
 			return cfg
 		}
 	}
@@ -545,12 +558,12 @@ func DefaultConfig(beadsDir string) *Config {
 	// Elevated to top priority (after env var) to prevent git-tracked values
 	// from causing cross-project data leakage (GH#2372).
 	if p := readPortFile(beadsDir); 0 < p {
-		cfg.Port = p
+		cfg.Port, cfg.PortSource = p, PortSourcePortFile
 		return cfg
 	}
 
 	if p := configYamlPort(beadsDir); p > 0 {
-		cfg.Port = p
+		cfg.Port, cfg.PortSource = p, PortSourceDoltConfig
 		return cfg
 	}
 
@@ -560,7 +573,7 @@ func DefaultConfig(beadsDir string) *Config {
 	if cfg.Port == 0 {
 		if p := config.GetYamlConfig("dolt.port"); p != "" {
 			if port, err := strconv.Atoi(p); err == nil && port > 0 {
-				cfg.Port = port
+				cfg.Port, cfg.PortSource = port, PortSourceConfigYAML
 			}
 		}
 	}
@@ -575,7 +588,7 @@ func DefaultConfig(beadsDir string) *Config {
 				fmt.Fprintf(os.Stderr, "Warning: dolt_server_port in metadata.json is deprecated (can cause cross-project data leakage).\n")
 				fmt.Fprintf(os.Stderr, "  The port file (.beads/dolt-server.port) is now the primary source.\n")
 				fmt.Fprintf(os.Stderr, "  Remove dolt_server_port from .beads/metadata.json to silence this warning.\n")
-				cfg.Port = metaCfg.DoltServerPort
+				cfg.Port, cfg.PortSource = metaCfg.DoltServerPort, PortSourceMetadata
 			}
 		}
 	}
@@ -585,6 +598,10 @@ func DefaultConfig(beadsDir string) *Config {
 	// ephemeral port from the OS (GH#2098, GH#2372).
 	if cfg.Port == 0 && IsSharedServerMode() {
 		cfg.Port = DefaultSharedServerPort // 3308 - avoids orchestrator conflict on 3307
+		cfg.PortSource = PortSourceSharedDefault
+	}
+	if cfg.Port == 0 {
+		cfg.PortSource = PortSourceNone
 	}
 
 	return cfg
@@ -993,7 +1010,7 @@ func Start(beadsDir string) (*State, error) {
 	}
 
 	// Ensure dolt identity is configured
-	if err := ensureDoltIdentity(); err != nil {
+	if err := EnsureDoltIdentity(); err != nil {
 		return nil, fmt.Errorf("configuring dolt identity: %w", err)
 	}
 
@@ -1424,17 +1441,50 @@ func LockPath(beadsDir string) string {
 	return lockPath(beadsDir)
 }
 
-// killStaleServersForDir finds and kills orphan dolt sql-server processes for
-// the current repo's Dolt data directory that are not tracked by the canonical
+// StaleServer is an orphan dolt sql-server process eligible for cleanup.
+type StaleServer struct {
+	PID     int    `json:"pid"`
+	Port    int    `json:"port,omitempty"` // 0 when the listening port could not be determined
+	DataDir string `json:"data_dir"`
+}
+
+// KillScope narrows stale-server cleanup to servers matching every set field.
+// The zero value matches every orphan of the current repo.
+type KillScope struct {
+	Port    int    // only servers listening on this port
+	DataDir string // only servers whose Dolt data directory is this path
+}
+
+// matches reports whether srv is within the scope. A server whose port is
+// unknown is outside any port scope.
+func (s KillScope) matches(srv StaleServer) bool {
+	if s.Port != 0 && srv.Port != s.Port {
+		return false
+	}
+	if s.DataDir != "" {
+		want, err := filepath.Abs(s.DataDir)
+		if err != nil {
+			return false
+		}
+		got, _ := filepath.Abs(srv.DataDir)
+		if filepath.Clean(want) != filepath.Clean(got) {
+			return false
+		}
+	}
+	return true
+}
+
+// findStaleServersForDir finds orphan dolt sql-server processes for the
+// current repo's Dolt data directory that are not tracked by the canonical
 // PID file. Only processes that beads started (tracked via the PID file) are
-// eligible for cleanup. Externally-managed servers are never killed.
+// eligible for cleanup. Externally-managed servers are never returned.
 //
 // A process is considered "external" (never kill) when any of:
 //   - ResolveServerMode() returns ServerModeExternal (explicit port, shared server, etc.)
 //   - No PID file exists (beads has no record of starting a server)
-func killStaleServersForDir(beadsDir string, allPIDs []int, inDir func(int, string) bool, kill func(int) error) ([]int, error) {
+func findStaleServersForDir(beadsDir string, allPIDs []int, inDir func(int, string) bool, listenPort func(int) int) []StaleServer {
 	if len(allPIDs) == 0 {
-		return nil, nil
+		return nil
 	}
 
 	// If auto-start is disabled the server is externally managed (e.g., by
@@ -1443,7 +1493,7 @@ func killStaleServersForDir(beadsDir string, allPIDs []int, inDir func(int, stri
 	// dolt.auto-start config; ResolveServerMode covers explicit port/shared
 	// server/embedded configurations. Both indicate "not our server" (GH#2641).
 	if IsAutoStartDisabled() || ResolveServerMode(beadsDir) == ServerModeExternal {
-		return nil, nil
+		return nil
 	}
 
 	serverDir := resolveServerDir(beadsDir)
@@ -1461,7 +1511,7 @@ func killStaleServersForDir(beadsDir string, allPIDs []int, inDir func(int, stri
 	if canonicalPID == 0 {
 		// No valid PID file → no beads-owned server to compare against.
 		// Nothing is stale from our perspective.
-		return nil, nil
+		return nil
 	}
 
 	// The canonical PID itself is alive and tracked — never kill it.
@@ -1469,7 +1519,7 @@ func killStaleServersForDir(beadsDir string, allPIDs []int, inDir func(int, stri
 	// previous beads-started server that lost its PID file tracking).
 	ownedDoltDir := ResolveDoltDir(serverDir)
 
-	var killed []int
+	var stale []StaleServer
 	for _, pid := range allPIDs {
 		if pid == os.Getpid() {
 			continue
@@ -1480,13 +1530,33 @@ func killStaleServersForDir(beadsDir string, allPIDs []int, inDir func(int, stri
 		if !inDir(pid, ownedDoltDir) {
 			continue // preserve other repos' Dolt servers
 		}
-		if err := kill(pid); err == nil {
-			killed = append(killed, pid)
+		stale = append(stale, StaleServer{PID: pid, Port: listenPort(pid), DataDir: ownedDoltDir})
+	}
+	return stale
+}
+
+// killStaleServersForDir finds and kills orphan dolt sql-server processes for
+// the current repo's Dolt data directory. See findStaleServersForDir for which
+// processes are eligible.
+func killStaleServersForDir(beadsDir string, allPIDs []int, inDir func(int, string) bool, kill func(int) error) ([]int, error) {
+	var killed []int
+	for _, srv := range findStaleServersForDir(beadsDir, allPIDs, inDir, func(int) int { return 0 }) {
+		if err := kill(srv.PID); err == nil {
+			killed = append(killed, srv.PID)
 		}
 	}
 	return killed, nil
 }
 
+// killProcess hard-kills pid.
+func killProcess(pid int) error {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	return proc.Kill()
+}
+
 // KillStaleServers finds and kills orphan dolt sql-server processes for the
 // current repo's Dolt data directory that are not tracked by the canonical PID
 // file. Returns the PIDs of killed processes.
@@ -1498,19 +1568,48 @@ func KillStaleServers(beadsDir string) ([]int, error) {
 	if IsAutoStartDisabled() {
 		return nil, nil
 	}
-	allPIDs := listDoltProcessPIDs()
-	return killStaleServersForDir(
-		beadsDir,
-		allPIDs,
-		isProcessInDir,
-		func(pid int) error {
-			proc, err := os.FindProcess(pid)
-			if err != nil {
-				return err
-			}
-			return proc.Kill()
-		},
-	)
+	return killStaleServersForDir(beadsDir, listDoltProcessPIDs(), isProcessInDir, killProcess)
+}
+
+// FindStaleServers returns the orphan dolt sql-server processes that
+// KillStaleServers would kill, narrowed to scope, without killing anything.
+// Callers confirm with the user and then pass the result to KillServers.
+func FindStaleServers(beadsDir string, scope KillScope) []StaleServer {
+	if IsAutoStartDisabled() {
+		return nil
+	}
+	return scopeStaleServers(findStaleServersForDir(beadsDir, listDoltProcessPIDs(), isProcessInDir, processListenPort), scope)
+}
+
+// scopeStaleServers keeps the servers within scope.
+func scopeStaleServers(servers []StaleServer, scope KillScope) []StaleServer {
+	var out []StaleServer
+	for _, srv := range servers {
+		if scope.matches(srv) {
+			out = append(out, srv)
+		}
+	}
+	return out
+}
+
+// KillServers kills servers previously returned by FindStaleServers and
+// returns the ones it killed. Each process is re-checked against its data
+// directory first, so a PID reused since the lookup is left alone.
+func KillServers(servers []StaleServer) []StaleServer {
+	return killServers(servers, isProcessInDir, killProcess)
+}
+
+func killServers(servers []StaleServer, inDir func(int, string) bool, kill func(int) error) []StaleServer {
+	var killed []StaleServer
+	for _, srv := range servers {
+		if !inDir(srv.PID, srv.DataDir) {
+			continue
+		}
+		if err := kill(srv.PID); err == nil {
+			killed = append(killed, srv)
+		}
+	}
+	return killed
 }
 
 // waitForReady polls TCP until the server accepts connections.
@@ -1530,11 +1629,24 @@ func waitForReady(host string, port int, timeout time.Duration) error {
 	return fmt.Errorf("timeout after %s waiting for server at %s", timeout, addr)
 }
 
-// ensureDoltIdentity sets dolt global user identity from git config if not already set.
-func ensureDoltIdentity() error {
-	// Check if dolt identity is already configured
-	nameCmd := exec.Command("dolt", "config", "--global", "--get", "user.name")
-	if out, err := nameCmd.Output(); err == nil && strings.TrimSpace(string(out)) != "" {
+// DoltIdentity returns the dolt global user.name and user.email, "" for
+// either when it is not set or dolt cannot be run.
+func DoltIdentity() (name, email string) {
+	get := func(key string) string {
+		out, err := exec.Command("dolt", "config", "--global", "--get", key).Output()
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(out))
+	}
+	return get("user.name"), get("user.email")
+}
+
+// EnsureDoltIdentity sets whichever of the dolt global user.name and
+// user.email is missing, taking the value from git config when available.
+func EnsureDoltIdentity() error {
+	name, email := DoltIdentity()
+	if name != "" && email != "" {
 		return nil // Already configured
 	}
 
@@ -1543,21 +1655,25 @@ func ensureDoltIdentity() error {
 	gitEmail := "beads@localhost"
 
 	if out, err := execx.GitCommand("config", "user.name").Output(); err == nil {
-		if name := strings.TrimSpace(string(out)); name != "" {
-			gitName = name
+		if v := strings.TrimSpace(string(out)); v != "" {
+			gitName = v
 		}
 	}
 	if out, err := execx.GitCommand("config", "user.email").Output(); err == nil {
-		if email := strings.TrimSpace(string(out)); email != "" {
-			gitEmail = email
+		if v := strings.TrimSpace(string(out)); v != "" {
+			gitEmail = v
 		}
 	}
 
-	if out, err := exec.Command("dolt", "config", "--global", "--add", "user.name", gitName).CombinedOutput(); err != nil {
-		return fmt.Errorf("setting dolt user.name: %w\n%s", err, out)
+	if name == "" {
+		if out, err := exec.Command("dolt", "config", "--global", "--add", "user.name", gitName).CombinedOutput(); err != nil {
+			return fmt.Errorf("setting dolt user.name: %w\n%s", err, out)
+		}
 	}
-	if out, err := exec.Command("dolt", "config", "--global", "--add", "user.email", gitEmail).CombinedOutput(); err != nil {
-		return fmt.Errorf("setting dolt user.email: %w\n%s", err, out)
+	if email == "" {
+		if out, err := exec.Command("dolt", "config", "--global", "--add", "user.email", gitEmail).CombinedOutput(); err != nil {
+			return fmt.Errorf("setting dolt user.email: %w\n%s", err, out)
+		}
 	}
 
 	return nil
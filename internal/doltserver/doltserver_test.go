@@ -248,6 +248,9 @@ func TestDefaultConfig(t *testing.T) {
 		if cfg.Port != 0 {
 			t.Errorf("expected port 0 (ephemeral) when no port source configured, got %d", cfg.Port)
 		}
+		if cfg.PortSource != PortSourceNone {
+			t.Errorf("expected port source %q, got %q", PortSourceNone, cfg.PortSource)
+		}
 		if cfg.BeadsDir != freshDir {
 			t.Errorf("expected BeadsDir=%s, got %s", freshDir, cfg.BeadsDir)
 		}
@@ -275,8 +278,8 @@ func TestDefaultConfig(t *testing.T) {
 
 		freshDir := t.TempDir()
 		cfg := DefaultConfig(freshDir)
-		if cfg.Port != 3308 {
-			t.Errorf("expected port 3308 from config.yaml, got %d", cfg.Port)
+		if cfg.Port != 3308 || cfg.PortSource != PortSourceConfigYAML {
+			t.Errorf("expected port 3308 from config.yaml, got %d from %q", cfg.Port, cfg.PortSource)
 		}
 	})
 
@@ -306,8 +309,23 @@ func TestDefaultConfig(t *testing.T) {
 		}
 		cfg := DefaultConfig(freshDir)
 
-		if cfg.Port != 14000 {
-			t.Errorf("expected port file port 14000, got %d", cfg.Port)
+		if cfg.Port != 14000 || cfg.PortSource != PortSourcePortFile {
+			t.Errorf("expected port file port 14000, got %d from %q", cfg.Port, cfg.PortSource)
+		}
+	})
+
+	t.Run("env_takes_precedence_over_port_file", func(t *testing.T) {
+		t.Setenv("GT_ROOT", "")
+		t.Setenv("BEADS_DOLT_SERVER_PORT", "15000")
+
+		freshDir := t.TempDir()
+		if err := writePortFile(freshDir, 14000); err != nil {
+			t.Fatal(err)
+		}
+		cfg := DefaultConfig(freshDir)
+
+		if cfg.Port != 15000 || cfg.PortSource != PortSourceEnv {
+			t.Errorf("expected env port 15000, got %d from %q", cfg.Port, cfg.PortSource)
 		}
 	})
 }
@@ -792,6 +810,62 @@ func TestKillStaleServersPreservesOtherRepoServers(t *testing.T) {
 	}
 }
 
+func TestScopedKillallLeavesOutOfScopeServersAlone(t *testing.T) {
+	t.Setenv("BEADS_DOLT_AUTO_START", "")
+	dir := t.TempDir()
+	canonicalPID, orphanA, orphanB, orphanUnknownPort, otherRepoPID := 111, 222, 333, 444, 555
+	if err := os.WriteFile(pidPath(dir), []byte(strconv.Itoa(canonicalPID)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	ports := map[int]int{canonicalPID: 3307, orphanA: 3308, orphanB: 3309, otherRepoPID: 3308}
+	inDir := func(pid int, _ string) bool { return pid != otherRepoPID }
+
+	all := findStaleServersForDir(dir,
+		[]int{canonicalPID, orphanA, orphanB, orphanUnknownPort, otherRepoPID},
+		inDir, func(pid int) int { return ports[pid] })
+	if len(all) != 3 {
+		t.Fatalf("stale = %+v, want the three same-repo orphans", all)
+	}
+	for _, srv := range all {
+		if srv.DataDir != ResolveDoltDir(dir) {
+			t.Errorf("PID %d data dir = %q, want %q", srv.PID, srv.DataDir, ResolveDoltDir(dir))
+		}
+	}
+
+	var killedPIDs []int
+	kill := func(pid int) error {
+		killedPIDs = append(killedPIDs, pid)
+		return nil
+	}
+	killed := killServers(scopeStaleServers(all, KillScope{Port: 3308}), inDir, kill)
+	if len(killed) != 1 || killed[0].PID != orphanA || killed[0].Port != 3308 {
+		t.Fatalf("--port 3308 killed %+v, want only PID %d", killed, orphanA)
+	}
+	if len(killedPIDs) != 1 || killedPIDs[0] != orphanA {
+		t.Fatalf("kill callback got %v, want [%d] (other repo's server on 3308 must survive)", killedPIDs, orphanA)
+	}
+
+	if got := scopeStaleServers(all, KillScope{DataDir: t.TempDir()}); len(got) != 0 {
+		t.Errorf("--data-dir elsewhere matched %+v, want none", got)
+	}
+	if got := scopeStaleServers(all, KillScope{DataDir: ResolveDoltDir(dir)}); len(got) != 3 {
+		t.Errorf("--data-dir for this repo matched %d servers, want 3", len(got))
+	}
+
+	// A PID that left the data dir between lookup and kill is not killed.
+	killedPIDs = nil
+	gone := func(pid int, _ string) bool { return pid != orphanB }
+	killed = killServers(all, gone, kill)
+	for _, srv := range killed {
+		if srv.PID == orphanB {
+			t.Fatalf("killed PID %d after it left the data dir", orphanB)
+		}
+	}
+	if len(killedPIDs) != 2 {
+		t.Errorf("kill callback got %v, want the two remaining orphans", killedPIDs)
+	}
+}
+
 func TestKillStaleServersWithoutCanonicalPIDIsNoop(t *testing.T) {
 	// Without a PID file, beads has no record of starting a server.
 	// killStaleServersForDir should be a no-op to avoid killing
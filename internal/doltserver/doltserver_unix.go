@@ -113,6 +113,28 @@ func isProcessInDir(pid int, dir string) bool {
 	return false
 }
 
+// processListenPort returns the first TCP port pid is listening on, or 0 if
+// it cannot be determined. lsof -Fn prints listeners as "n*:3307",
+// "n127.0.0.1:3307" or "n[::1]:3307".
+func processListenPort(pid int) int {
+	out, err := exec.Command("lsof", "-a", "-p", strconv.Itoa(pid), "-iTCP", "-sTCP:LISTEN", "-P", "-n", "-Fn").Output()
+	if err != nil {
+		return 0
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		if !strings.HasPrefix(line, "n") {
+			continue
+		}
+		addr := strings.TrimSpace(line[1:])
+		if i := strings.LastIndex(addr, ":"); i >= 0 {
+			if port, err := strconv.Atoi(addr[i+1:]); err == nil && port > 0 {
+				return port
+			}
+		}
+	}
+	return 0
+}
+
 // isProcessAlive checks if a process with the given PID is running.
 // Uses signal 0 which doesn't send a signal but checks process existence.
 func isProcessAlive(pid int) bool {
@@ -98,6 +98,22 @@ func isProcessInDir(pid int, dir string) bool {
 	return false
 }
 
+// processListenPort returns the first TCP port pid is listening on, or 0 if
+// it cannot be determined.
+func processListenPort(pid int) int {
+	script := fmt.Sprintf(`Get-NetTCPConnection -State Listen -OwningProcess %d -ErrorAction SilentlyContinue | `+
+		`Select-Object -First 1 -ExpandProperty LocalPort`, pid)
+	out, err := exec.Command("powershell.exe", "-NoProfile", "-Command", script).Output()
+	if err != nil {
+		return 0
+	}
+	port, err := strconv.Atoi(strings.TrimSpace(string(out)))
+	if err != nil || port <= 0 {
+		return 0
+	}
+	return port
+}
+
 // isProcessAlive checks if a process with the given PID is running. Opening a
 // process is not sufficient: an exited process remains openable while another
 // handle refers to it. A zero-timeout wait distinguishes that signaled process
@@ -3,6 +3,7 @@
 package doltserver_test
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net"
@@ -498,3 +499,66 @@ func TestLifecycle_PIDReuseDetection(t *testing.T) {
 		t.Error("PID file not cleaned up after detecting non-dolt PID")
 	}
 }
+
+// TestLifecycle_WarmLeavesServerReachable verifies that Warm starts a stopped
+// server and leaves it accepting connections, so the next command skips
+// start-up, and that a held warm-up is capped by the idle timeout.
+func TestLifecycle_WarmLeavesServerReachable(t *testing.T) {
+	beadsDir := setupLifecycleTestDir(t)
+	reg := integration.NewProcessRegistry(t)
+	diag := integration.NewDiagnostics(t, beadsDir)
+	diag.CaptureOnFailure()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	result, err := doltserver.Warm(ctx, beadsDir, doltserver.WarmOptions{
+		Hold:        time.Hour,
+		Interval:    100 * time.Millisecond,
+		IdleTimeout: 500 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Warm: %v", err)
+	}
+	state, err := doltserver.IsRunning(beadsDir)
+	if err != nil {
+		t.Fatalf("IsRunning: %v", err)
+	}
+	if state.Running {
+		if p, err := os.FindProcess(state.PID); err == nil {
+			reg.Register(p)
+		}
+	}
+	if !result.StartedServer {
+		t.Error("Warm on a stopped server reported StartedServer=false")
+	}
+	if !result.Clamped || result.Held >= 5*time.Second {
+		t.Errorf("hold not capped by idle timeout: clamped=%v held=%v", result.Clamped, result.Held)
+	}
+	if result.Pings < 2 {
+		t.Errorf("Pings = %d, want keep-alive pings while held", result.Pings)
+	}
+
+	if !state.Running || state.Port != result.Port {
+		t.Fatalf("server not running after Warm: %+v (warm port %d)", state, result.Port)
+	}
+	db := connectMySQL(t, result.Port)
+	if err := db.PingContext(ctx); err != nil {
+		t.Fatalf("server unreachable after Warm: %v", err)
+	}
+	_ = db.Close()
+
+	// A second warm-up finds the server already running.
+	again, err := doltserver.Warm(ctx, beadsDir, doltserver.WarmOptions{})
+	if err != nil {
+		t.Fatalf("second Warm: %v", err)
+	}
+	if again.StartedServer || again.Pings != 1 {
+		t.Errorf("second Warm = %+v, want already-running single ping", again)
+	}
+
+	if err := doltserver.Stop(beadsDir); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	reg.Deregister(state.PID)
+	waitForPortClosed(t, result.Port, 5*time.Second)
+}
@@ -0,0 +1,155 @@
+package doltserver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage/doltutil"
+)
+
+// DefaultWarmMaxHold caps how long a warm-up may keep the server busy. bd
+// has no server idle timeout to defer to, and a held warm-up is the only
+// client keeping the connection alive, so this cap is what stops it from
+// pinning an otherwise idle server up forever.
+const DefaultWarmMaxHold = 30 * time.Minute
+
+// defaultWarmInterval is the pre-ping cadence while a warm-up is held. It is
+// well under the MySQL driver's and Dolt's idle-connection limits.
+const defaultWarmInterval = 30 * time.Second
+
+// WarmOptions configures Warm. Host/User/Password/TLS describe how to reach
+// the server; the zero value connects as root to 127.0.0.1.
+type WarmOptions struct {
+	Host     string
+	User     string
+	Password string
+	TLS      bool
+
+	// Hold keeps the connection open after the first ping, re-pinging every
+	// Interval, for this long. 0 pings once and returns.
+	Hold time.Duration
+	// Interval is the keep-alive ping cadence (default 30s).
+	Interval time.Duration
+	// MaxHold caps Hold (default DefaultWarmMaxHold).
+	MaxHold time.Duration
+}
+
+// WarmResult reports what a warm-up did.
+type WarmResult struct {
+	Port          int           `json:"port"`
+	StartedServer bool          `json:"started_server"`
+	Pings         int           `json:"pings"`
+	Held          time.Duration `json:"held_ns"`
+	Clamped       bool          `json:"clamped,omitempty"` // Hold exceeded MaxHold
+}
+
+// warmConn is the connection a warm-up keeps alive.
+type warmConn interface {
+	PingContext(ctx context.Context) error
+	Close() error
+}
+
+// Warm pays the first-command costs ahead of time: it ensures the server is
+// running (starting it when bd manages it), opens a connection and pings it.
+// With opts.Hold it keeps that connection alive, re-pinging, until Hold
+// elapses, ctx is cancelled or MaxHold is reached — whichever comes
+// first.
+func Warm(ctx context.Context, beadsDir string, opts WarmOptions) (*WarmResult, error) {
+	return warm(ctx, beadsDir, opts, EnsureRunningDetailed, openWarmConn)
+}
+
+func warm(ctx context.Context, beadsDir string, opts WarmOptions,
+	ensure func(beadsDir string) (int, bool, error),
+	open func(port int, opts WarmOptions) (warmConn, error),
+) (*WarmResult, error) {
+	if opts.Interval <= 0 {
+		opts.Interval = defaultWarmInterval
+	}
+	if opts.MaxHold <= 0 {
+		opts.MaxHold = DefaultWarmMaxHold
+	}
+
+	port, started, err := ensure(beadsDir)
+	if err != nil {
+		return nil, fmt.Errorf("warm: %w", err)
+	}
+	result := &WarmResult{Port: port, StartedServer: started}
+
+	conn, err := open(port, opts)
+	if err != nil {
+		return result, fmt.Errorf("warm: connect to port %d: %w", port, err)
+	}
+	defer conn.Close()
+
+	if err := conn.PingContext(ctx); err != nil {
+		return result, fmt.Errorf("warm: ping port %d: %w", port, err)
+	}
+	result.Pings++
+
+	hold := opts.Hold
+	if hold > opts.MaxHold {
+		hold = opts.MaxHold
+		result.Clamped = true
+	}
+	if hold <= 0 {
+		return result, nil
+	}
+
+	start := time.Now()
+	deadline := time.NewTimer(hold)
+	defer deadline.Stop()
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			result.Held = time.Since(start)
+			return result, nil
+		case <-deadline.C:
+			result.Held = time.Since(start)
+			return result, nil
+		case <-ticker.C:
+			if err := conn.PingContext(ctx); err != nil {
+				if ctx.Err() != nil {
+					result.Held = time.Since(start)
+					return result, nil
+				}
+				result.Held = time.Since(start)
+				return result, fmt.Errorf("warm: keep-alive ping port %d: %w", port, err)
+			}
+			result.Pings++
+		}
+	}
+}
+
+// openWarmConn opens a single-connection pool that never expires its
+// connection, so every ping reuses the same session.
+func openWarmConn(port int, opts WarmOptions) (warmConn, error) {
+	host := opts.Host
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	user := opts.User
+	if user == "" {
+		user = "root"
+	}
+	dsn := doltutil.ServerDSN{
+		Host:     host,
+		Port:     port,
+		User:     user,
+		Password: opts.Password,
+		TLS:      opts.TLS,
+		Timeout:  5 * time.Second,
+	}.String()
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+	return db, nil
+}
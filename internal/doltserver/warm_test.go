@@ -0,0 +1,149 @@
+package doltserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeWarmConn dials the listener on every ping, so a ping fails once the
+// "server" is gone.
+type fakeWarmConn struct {
+	addr   string
+	pings  atomic.Int32
+	closed atomic.Bool
+}
+
+func (c *fakeWarmConn) PingContext(ctx context.Context) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return err
+	}
+	c.pings.Add(1)
+	return conn.Close()
+}
+
+func (c *fakeWarmConn) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+func startFakeServer(t *testing.T) (net.Listener, int) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+	return ln, ln.Addr().(*net.TCPAddr).Port
+}
+
+func TestWarmLeavesServerReachable(t *testing.T) {
+	_, port := startFakeServer(t)
+	var conn *fakeWarmConn
+	ensure := func(string) (int, bool, error) { return port, true, nil }
+	open := func(p int, _ WarmOptions) (warmConn, error) {
+		conn = &fakeWarmConn{addr: net.JoinHostPort("127.0.0.1", strconv.Itoa(p))}
+		return conn, nil
+	}
+
+	result, err := warm(context.Background(), t.TempDir(), WarmOptions{}, ensure, open)
+	if err != nil {
+		t.Fatalf("warm: %v", err)
+	}
+	if result.Port != port || !result.StartedServer || result.Pings != 1 || result.Held != 0 {
+		t.Errorf("warm = %+v, want single ping on port %d", result, port)
+	}
+	if !conn.closed.Load() {
+		t.Error("warm connection not closed")
+	}
+
+	c, err := net.DialTimeout("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)), time.Second)
+	if err != nil {
+		t.Fatalf("server unreachable after warm: %v", err)
+	}
+	_ = c.Close()
+}
+
+func TestWarmHoldRespectsMaxHold(t *testing.T) {
+	_, port := startFakeServer(t)
+	var conn *fakeWarmConn
+	ensure := func(string) (int, bool, error) { return port, false, nil }
+	open := func(p int, _ WarmOptions) (warmConn, error) {
+		conn = &fakeWarmConn{addr: net.JoinHostPort("127.0.0.1", strconv.Itoa(p))}
+		return conn, nil
+	}
+
+	start := time.Now()
+	result, err := warm(context.Background(), t.TempDir(), WarmOptions{
+		Hold:     time.Hour,
+		Interval: 10 * time.Millisecond,
+		MaxHold:  100 * time.Millisecond,
+	}, ensure, open)
+	if err != nil {
+		t.Fatalf("warm: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("warm held for %v, want it capped by the idle timeout", elapsed)
+	}
+	if !result.Clamped {
+		t.Error("Clamped = false for a hold longer than the idle timeout")
+	}
+	if result.Pings < 2 || int(conn.pings.Load()) != result.Pings {
+		t.Errorf("Pings = %d (conn saw %d), want keep-alive pings", result.Pings, conn.pings.Load())
+	}
+}
+
+func TestWarmHoldStopsOnCancel(t *testing.T) {
+	_, port := startFakeServer(t)
+	ensure := func(string) (int, bool, error) { return port, false, nil }
+	open := func(p int, _ WarmOptions) (warmConn, error) {
+		return &fakeWarmConn{addr: net.JoinHostPort("127.0.0.1", strconv.Itoa(p))}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	time.AfterFunc(50*time.Millisecond, cancel)
+	result, err := warm(ctx, t.TempDir(), WarmOptions{Hold: time.Minute, Interval: 10 * time.Millisecond}, ensure, open)
+	if err != nil {
+		t.Fatalf("warm: %v", err)
+	}
+	if result.Clamped || result.Held <= 0 || result.Held > 5*time.Second {
+		t.Errorf("warm = %+v, want an unclamped hold ended by cancellation", result)
+	}
+}
+
+func TestWarmReportsErrors(t *testing.T) {
+	ensureErr := errors.New("auto-start disabled")
+	_, err := warm(context.Background(), t.TempDir(), WarmOptions{},
+		func(string) (int, bool, error) { return 0, false, ensureErr },
+		func(int, WarmOptions) (warmConn, error) { t.Fatal("open called after ensure failed"); return nil, nil })
+	if !errors.Is(err, ensureErr) {
+		t.Errorf("ensure failure: err = %v", err)
+	}
+
+	ln, port := startFakeServer(t)
+	_ = ln.Close()
+	_, err = warm(context.Background(), t.TempDir(), WarmOptions{},
+		func(string) (int, bool, error) { return port, false, nil },
+		func(p int, _ WarmOptions) (warmConn, error) {
+			return &fakeWarmConn{addr: net.JoinHostPort("127.0.0.1", strconv.Itoa(p))}, nil
+		})
+	if err == nil {
+		t.Error("warm against a closed port succeeded")
+	}
+}
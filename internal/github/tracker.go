@@ -29,6 +29,10 @@ var ghShorthandPattern = regexp.MustCompile(`^github:([1-9]\d*)$`)
 
 // Tracker implements tracker.IssueTracker for GitHub.
 type Tracker struct {
+	// Repository, when set to "owner/repo", overrides the configured
+	// github.owner/github.repo (bd export github --repo).
+	Repository string
+
 	client *Client
 	config *MappingConfig
 	store  storage.Storage
@@ -49,6 +53,14 @@ func (t *Tracker) Init(ctx context.Context, store storage.Storage) error {
 	owner := t.getConfig(ctx, "github.owner", "GITHUB_OWNER")
 	repo := t.getConfig(ctx, "github.repo", "GITHUB_REPO")
 
+	if t.Repository != "" {
+		parts := strings.SplitN(t.Repository, "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("invalid GitHub repository %q (want owner/repo)", t.Repository)
+		}
+		owner, repo = parts[0], parts[1]
+	}
+
 	// Try combined owner/repo format: "owner/repo"
 	if owner == "" || repo == "" {
 		ownerRepo := t.getConfig(ctx, "github.repository", "GITHUB_REPOSITORY")
@@ -680,8 +680,8 @@ func (s *configStore) AddDependencyWithOptions(_ context.Context, _ *types.Depen
 	return nil
 }
 func (s *configStore) RemoveDependency(_ context.Context, _, _, _ string) error { return nil }
-func (s *configStore) RemoveDependencyWithOptions(_ context.Context, _, _, _ string, _ storage.DependencyRemoveOptions) error {
-	return nil
+func (s *configStore) RemoveDependencyWithOptions(_ context.Context, _, _, _ string, _ storage.DependencyRemoveOptions) (int64, error) {
+	return 0, nil
 }
 func (s *configStore) GetDependencies(_ context.Context, _ string) ([]*types.Issue, error) {
 	return nil, nil
@@ -698,8 +698,8 @@ func (s *configStore) GetDependentsWithMetadata(_ context.Context, _ string) ([]
 func (s *configStore) GetDependencyTree(_ context.Context, _ string, _ int, _, _ bool) ([]*types.TreeNode, error) {
 	return nil, nil
 }
-func (s *configStore) AddLabel(_ context.Context, _, _, _ string) error    { return nil }
-func (s *configStore) RemoveLabel(_ context.Context, _, _, _ string) error { return nil }
+func (s *configStore) AddLabel(_ context.Context, _, _, _ string) error             { return nil }
+func (s *configStore) RemoveLabel(_ context.Context, _, _, _ string) (int64, error) { return 0, nil }
 func (s *configStore) GetLabels(_ context.Context, _ string) ([]string, error) {
 	return nil, nil
 }
@@ -0,0 +1,226 @@
+// Package listjson builds the elements of `bd list --json` as structs. bd
+// list, bd search and bd query all render their JSON through Items, so the
+// shape cannot drift between them, and anything serving issue lists outside
+// the CLI gets the same data without re-deriving it.
+package listjson
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// Item is one list element: the issue with counts plus a top-level parent_id
+// taken from its parent-child dependency (null for roots), so hierarchy
+// tooling need not scan the dependencies array. Comments are summarized by
+// comment_count; their bodies are only in bd show --json.
+type Item struct {
+	*types.IssueWithCounts
+	ParentID *string `json:"parent_id"`
+
+	// Comments shadows Issue.Comments and is never set, so comment bodies
+	// stay out of list output even when the store loaded them.
+	Comments []*types.Comment `json:"comments,omitempty"`
+
+	Age  *int64 `json:"age,omitempty"`  // Annotate: seconds since created_at
+	Idle *int64 `json:"idle,omitempty"` // Annotate: seconds since updated_at
+
+	// Annotate: seconds until due_at, or since it passed, for an issue that
+	// is not closed; null for the other one and for issues without a due date.
+	DueIn     Seconds `json:"due_in,omitzero"`
+	OverdueBy Seconds `json:"overdue_by,omitzero"`
+
+	// Annotate: IDs of the open issues blocking this one, and of the issues
+	// this one blocks.
+	Blockers []string `json:"blockers,omitzero"`
+	Blocking []string `json:"blocking,omitzero"`
+
+	Rollup *EpicRollup `json:"rollup,omitempty"` // Rollup: epic estimate totals
+
+	// Hierarchy: the number of parent-child hops to the issue's root, and the
+	// ancestor IDs from the root down to the immediate parent ([] for a root).
+	Depth *int     `json:"depth,omitzero"`
+	Path  []string `json:"path,omitzero"`
+}
+
+// Options selects the optional fields Items fills in.
+type Options struct {
+	Annotate  bool // age, idle, due_in, overdue_by, blockers and blocking
+	Hierarchy bool // depth and path
+	Rollup    bool // rollup on each epic
+}
+
+// ParentLookup returns the parent-child parent of each of ids that has one.
+type ParentLookup func(ctx context.Context, ids []string) (map[string]string, error)
+
+// BlockingLookup returns, for each of ids that has any, the open issues
+// blocking it and the issues it blocks.
+type BlockingLookup func(ctx context.Context, ids []string) (blockers, blocking map[string][]string, err error)
+
+// Lookups reads what Items needs beyond the listed issues. Only the lookups
+// the options call for are used.
+type Lookups struct {
+	Parents     ParentLookup     // Hierarchy
+	Blocking    BlockingLookup   // Annotate
+	Descendants DescendantLookup // Rollup
+}
+
+// NewItems wraps issues as plain items, without any optional field.
+func NewItems(issues []*types.IssueWithCounts) []Item {
+	items := make([]Item, len(issues))
+	for i, issue := range issues {
+		issue.NormalizeOrder()
+		items[i] = Item{IssueWithCounts: issue, ParentID: issue.Parent}
+	}
+	return items
+}
+
+// Items returns issues as list elements with the fields opts selects filled
+// in. It prints nothing; callers add their own key naming and projection.
+func Items(ctx context.Context, issues []*types.IssueWithCounts, opts Options, lookups Lookups) ([]Item, error) {
+	items := NewItems(issues)
+	if opts.Hierarchy {
+		if err := annotateHierarchy(ctx, items, lookups.Parents); err != nil {
+			return nil, fmt.Errorf("resolving hierarchy: %w", err)
+		}
+	}
+	if opts.Annotate {
+		ids := make([]string, len(items))
+		for i := range items {
+			ids[i] = items[i].ID
+		}
+		blockers, blocking, err := lookups.Blocking(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("resolving blockers: %w", err)
+		}
+		now := time.Now().UTC()
+		for i := range items {
+			items[i].annotateAge(now)
+			items[i].annotateDue(now)
+			items[i].annotateBlocking(blockers, blocking)
+		}
+	}
+	if opts.Rollup {
+		for i := range items {
+			rollup, err := EpicRollupFor(ctx, items[i].Issue, lookups.Descendants)
+			if err != nil {
+				return nil, fmt.Errorf("computing rollup: %w", err)
+			}
+			items[i].Rollup = rollup
+		}
+	}
+	return items, nil
+}
+
+// annotateHierarchy sets depth and path on each item. Ancestors outside the
+// listed set are resolved with lookup, one level per call, so a filtered
+// listing still reports full paths.
+func annotateHierarchy(ctx context.Context, items []Item, lookup ParentLookup) error {
+	parents := make(map[string]string)
+	resolved := make(map[string]bool, len(items))
+	for _, item := range items {
+		resolved[item.ID] = true
+		if item.Parent != nil {
+			parents[item.ID] = *item.Parent
+		}
+	}
+	unresolved := func(ids []string) []string {
+		var out []string
+		for _, id := range ids {
+			if !resolved[id] && !slices.Contains(out, id) {
+				out = append(out, id)
+			}
+		}
+		return out
+	}
+	pending := make([]string, 0, len(parents))
+	for _, p := range parents {
+		pending = append(pending, p)
+	}
+	for frontier := unresolved(pending); len(frontier) > 0; {
+		found, err := lookup(ctx, frontier)
+		if err != nil {
+			return err
+		}
+		pending = pending[:0]
+		for _, id := range frontier {
+			resolved[id] = true
+			if p, ok := found[id]; ok {
+				parents[id] = p
+				pending = append(pending, p)
+			}
+		}
+		frontier = unresolved(pending)
+	}
+
+	for i := range items {
+		path := []string{}
+		seen := map[string]bool{items[i].ID: true}
+		for p, ok := parents[items[i].ID]; ok && !seen[p]; p, ok = parents[p] {
+			seen[p] = true
+			path = append(path, p)
+		}
+		slices.Reverse(path)
+		depth := len(path)
+		items[i].Depth = &depth
+		items[i].Path = path
+	}
+	return nil
+}
+
+// annotateAge sets age (now - created_at) and idle (now - updated_at) in
+// whole seconds, both taken in UTC. Clock skew never yields a negative value.
+func (item *Item) annotateAge(now time.Time) {
+	since := func(t time.Time) *int64 {
+		secs := max(int64(now.UTC().Sub(t.UTC())/time.Second), 0)
+		return &secs
+	}
+	item.Age = since(item.CreatedAt)
+	item.Idle = since(item.UpdatedAt)
+}
+
+// Seconds is an annotated duration in whole seconds. It is left out of
+// unannotated output and encodes as null when annotated without a value.
+type Seconds struct {
+	annotated bool
+	secs      *int64
+}
+
+func (s Seconds) IsZero() bool { return !s.annotated }
+
+func (s Seconds) MarshalJSON() ([]byte, error) { return json.Marshal(s.secs) }
+
+// annotateDue sets due_in and overdue_by from due_at, compared in UTC the way
+// --overdue compares it: a due date before now on an issue that is not closed
+// is overdue. A closed issue or one with no due date gets null for both.
+func (item *Item) annotateDue(now time.Time) {
+	item.DueIn = Seconds{annotated: true}
+	item.OverdueBy = Seconds{annotated: true}
+	if item.DueAt == nil || item.Status == types.StatusClosed {
+		return
+	}
+	due, now := item.DueAt.UTC(), now.UTC()
+	if due.Before(now) {
+		secs := int64(now.Sub(due) / time.Second)
+		item.OverdueBy.secs = &secs
+		return
+	}
+	secs := int64(due.Sub(now) / time.Second)
+	item.DueIn.secs = &secs
+}
+
+// annotateBlocking sets blockers and blocking from the blocking index, as
+// sorted, never-nil ID lists.
+func (item *Item) annotateBlocking(blockers, blocking map[string][]string) {
+	ids := func(in []string) []string {
+		out := append([]string{}, in...)
+		slices.Sort(out)
+		return slices.Compact(out)
+	}
+	item.Blockers = ids(blockers[item.ID])
+	item.Blocking = ids(blocking[item.ID])
+}
@@ -0,0 +1,160 @@
+package listjson
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestItemsHierarchy(t *testing.T) {
+	parent := func(id string) *string { return &id }
+	issue := func(id string, parentID *string) *types.IssueWithCounts {
+		return &types.IssueWithCounts{Issue: &types.Issue{ID: id}, Parent: parentID}
+	}
+	// bd-epic → bd-task → bd-sub; only bd-sub and bd-root are listed, so
+	// bd-task and bd-epic must come from the lookup.
+	graph := map[string]string{"bd-sub": "bd-task", "bd-task": "bd-epic"}
+	var calls [][]string
+	lookup := func(_ context.Context, ids []string) (map[string]string, error) {
+		calls = append(calls, ids)
+		out := make(map[string]string)
+		for _, id := range ids {
+			if p, ok := graph[id]; ok {
+				out[id] = p
+			}
+		}
+		return out, nil
+	}
+
+	items, err := Items(context.Background(), []*types.IssueWithCounts{
+		issue("bd-root", nil),
+		issue("bd-sub", parent("bd-task")),
+	}, Options{Hierarchy: true}, Lookups{Parents: lookup})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *items[0].Depth != 0 || items[0].Path == nil || len(items[0].Path) != 0 {
+		t.Errorf("root: depth=%d path=%v, want 0 and []", *items[0].Depth, items[0].Path)
+	}
+	if *items[1].Depth != 2 || !slices.Equal(items[1].Path, []string{"bd-epic", "bd-task"}) {
+		t.Errorf("grandchild: depth=%d path=%v, want 2 and [bd-epic bd-task]", *items[1].Depth, items[1].Path)
+	}
+	if len(calls) != 2 {
+		t.Errorf("lookup called %d times (%v), want one call per missing level", len(calls), calls)
+	}
+}
+
+func TestItemsHierarchyCycle(t *testing.T) {
+	a, b := "bd-a", "bd-b"
+	items, err := Items(context.Background(), []*types.IssueWithCounts{
+		{Issue: &types.Issue{ID: a}, Parent: &b},
+		{Issue: &types.Issue{ID: b}, Parent: &a},
+	}, Options{Hierarchy: true}, Lookups{Parents: func(context.Context, []string) (map[string]string, error) {
+		t.Fatal("lookup called although every ancestor is listed")
+		return nil, nil
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *items[0].Depth != 1 || *items[1].Depth != 1 {
+		t.Errorf("cycle depths = %d, %d, want the walk to stop at the repeat", *items[0].Depth, *items[1].Depth)
+	}
+}
+
+func TestItemAnnotateAge(t *testing.T) {
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.FixedZone("CET", 3600))
+	updated := created.Add(30 * time.Minute)
+	item := Item{IssueWithCounts: &types.IssueWithCounts{Issue: &types.Issue{
+		ID: "bd-1", CreatedAt: created, UpdatedAt: updated,
+	}}}
+
+	item.annotateAge(updated)
+	if *item.Age != 1800 || *item.Idle != 0 {
+		t.Errorf("at update: age=%d idle=%d, want 1800 and 0", *item.Age, *item.Idle)
+	}
+	later := updated.UTC().Add(90 * time.Second)
+	item.annotateAge(later)
+	if *item.Age != 1890 || *item.Idle != 90 {
+		t.Errorf("90s later: age=%d idle=%d, want 1890 and 90", *item.Age, *item.Idle)
+	}
+	item.annotateAge(created.Add(-time.Minute))
+	if *item.Age != 0 || *item.Idle != 0 {
+		t.Errorf("clock skew: age=%d idle=%d, want 0 and 0", *item.Age, *item.Idle)
+	}
+}
+
+func TestItemAnnotateDue(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	at := func(t time.Time) *time.Time { return &t }
+	annotate := func(status types.Status, due *time.Time) string {
+		t.Helper()
+		item := Item{IssueWithCounts: &types.IssueWithCounts{Issue: &types.Issue{
+			ID: "bd-1", Status: status, DueAt: due,
+		}}}
+		item.annotateDue(now)
+		data, err := json.Marshal(item)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	// A due date two hours ahead, written in a zone west of UTC.
+	future := at(time.Date(2026, 10, 16, 9, 0, 0, 0, time.FixedZone("UTC-5", -5*60*60)))
+	if got := annotate(types.StatusOpen, future); !strings.Contains(got, `"due_in":7200`) || !strings.Contains(got, `"overdue_by":null`) {
+		t.Errorf("future due: %s", got)
+	}
+	past := at(now.Add(-90 * time.Minute))
+	if got := annotate(types.StatusInProgress, past); !strings.Contains(got, `"overdue_by":5400`) || !strings.Contains(got, `"due_in":null`) {
+		t.Errorf("past due: %s", got)
+	}
+	if got := annotate(types.StatusOpen, nil); !strings.Contains(got, `"due_in":null`) || !strings.Contains(got, `"overdue_by":null`) {
+		t.Errorf("no due date: %s", got)
+	}
+	if got := annotate(types.StatusClosed, past); !strings.Contains(got, `"overdue_by":null`) {
+		t.Errorf("a closed issue is never overdue: %s", got)
+	}
+
+	unannotated, err := json.Marshal(Item{IssueWithCounts: &types.IssueWithCounts{Issue: &types.Issue{ID: "bd-1", DueAt: past}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(unannotated), "due_in") || strings.Contains(string(unannotated), "overdue_by") {
+		t.Errorf("due_in and overdue_by must only appear when annotated: %s", unannotated)
+	}
+}
+
+func TestItemAnnotateBlocking(t *testing.T) {
+	item := Item{IssueWithCounts: &types.IssueWithCounts{Issue: &types.Issue{ID: "bd-2"}}}
+	blockers := map[string][]string{"bd-2": {"bd-9", "bd-1", "bd-9"}}
+	item.annotateBlocking(blockers, nil)
+	if !slices.Equal(item.Blockers, []string{"bd-1", "bd-9"}) {
+		t.Errorf("blockers = %v, want sorted and deduplicated [bd-1 bd-9]", item.Blockers)
+	}
+	if item.Blocking == nil || len(item.Blocking) != 0 {
+		t.Errorf("blocking = %#v, want an empty, non-nil list", item.Blocking)
+	}
+	if got := blockers["bd-2"]; !slices.Equal(got, []string{"bd-9", "bd-1", "bd-9"}) {
+		t.Errorf("annotateBlocking modified the index: %v", got)
+	}
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"blocking":[]`) {
+		t.Errorf("annotated item should emit an empty blocking array: %s", data)
+	}
+	data, err = json.Marshal(Item{IssueWithCounts: item.IssueWithCounts})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), `"blockers"`) {
+		t.Errorf("unannotated item should omit blockers: %s", data)
+	}
+}
@@ -0,0 +1,58 @@
+package listjson
+
+import (
+	"context"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// EpicRollup aggregates the estimates of an epic's descendant work, in
+// minutes. Issues carry no separate time-spent field, so actual_total is the
+// estimate of descendants already closed and remaining the estimate of those
+// still open. Nested epics are walked through but not counted themselves, so
+// a sub-epic's estimate never double-counts its children.
+type EpicRollup struct {
+	EstimateTotal int `json:"estimate_total"`
+	ActualTotal   int `json:"actual_total"`
+	Remaining     int `json:"remaining"`
+	Descendants   int `json:"descendants"`
+	Unestimated   int `json:"unestimated"`
+}
+
+// DescendantLookup returns every parent-child descendant of rootID.
+type DescendantLookup func(ctx context.Context, rootID string) ([]*types.Issue, error)
+
+// ComputeEpicRollup sums the estimates of descendants.
+func ComputeEpicRollup(descendants []*types.Issue) *EpicRollup {
+	rollup := &EpicRollup{}
+	for _, issue := range descendants {
+		if issue.IssueType == types.TypeEpic {
+			continue
+		}
+		rollup.Descendants++
+		if issue.EstimatedMinutes == nil {
+			rollup.Unestimated++
+			continue
+		}
+		minutes := *issue.EstimatedMinutes
+		rollup.EstimateTotal += minutes
+		if issue.Status == types.StatusClosed {
+			rollup.ActualTotal += minutes
+		} else {
+			rollup.Remaining += minutes
+		}
+	}
+	return rollup
+}
+
+// EpicRollupFor returns the rollup of issue when it is an epic, nil otherwise.
+func EpicRollupFor(ctx context.Context, issue *types.Issue, lookup DescendantLookup) (*EpicRollup, error) {
+	if issue.IssueType != types.TypeEpic {
+		return nil, nil
+	}
+	descendants, err := lookup(ctx, issue.ID)
+	if err != nil {
+		return nil, err
+	}
+	return ComputeEpicRollup(descendants), nil
+}
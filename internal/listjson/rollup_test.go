@@ -0,0 +1,43 @@
+package listjson
+
+import (
+	"context"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestComputeEpicRollup(t *testing.T) {
+	minutes := func(m int) *int { return &m }
+	descendants := []*types.Issue{
+		{ID: "bd-1", Status: types.StatusOpen, EstimatedMinutes: minutes(120)},
+		{ID: "bd-2", Status: types.StatusClosed, EstimatedMinutes: minutes(30)},
+		{ID: "bd-3", Status: types.StatusInProgress, EstimatedMinutes: minutes(45)},
+		{ID: "bd-4", Status: types.StatusOpen},
+		{ID: "bd-5", IssueType: types.TypeEpic, Status: types.StatusOpen, EstimatedMinutes: minutes(480)},
+	}
+	got := *ComputeEpicRollup(descendants)
+	want := EpicRollup{EstimateTotal: 195, ActualTotal: 30, Remaining: 165, Descendants: 4, Unestimated: 1}
+	if got != want {
+		t.Fatalf("rollup = %+v, want %+v", got, want)
+	}
+	if got.EstimateTotal != got.ActualTotal+got.Remaining {
+		t.Errorf("estimate_total %d != actual_total %d + remaining %d", got.EstimateTotal, got.ActualTotal, got.Remaining)
+	}
+}
+
+func TestEpicRollupForSkipsNonEpics(t *testing.T) {
+	called := false
+	lookup := func(context.Context, string) ([]*types.Issue, error) {
+		called = true
+		return nil, nil
+	}
+	rollup, err := EpicRollupFor(context.Background(), &types.Issue{ID: "bd-1", IssueType: types.TypeTask}, lookup)
+	if err != nil || rollup != nil || called {
+		t.Fatalf("task rollup = %+v, err = %v, lookup called = %v; want nil without a lookup", rollup, err, called)
+	}
+	rollup, err = EpicRollupFor(context.Background(), &types.Issue{ID: "bd-2", IssueType: types.TypeEpic}, lookup)
+	if err != nil || rollup == nil || *rollup != (EpicRollup{}) {
+		t.Fatalf("empty epic rollup = %+v, err = %v; want zero totals", rollup, err)
+	}
+}
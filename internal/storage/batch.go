@@ -42,6 +42,10 @@ type BatchCreateOptions struct {
 	// PR 4204 race). Set by `bd import` unless --allow-stale; create paths
 	// leave it false.
 	RejectStaleUpserts bool
+	// ReplaceAllColumns makes the UPSERT rewrite every stored column of an
+	// existing issue (owner, spec_id, due_at, defer_until, …), not just the
+	// core fields a regular import refreshes. Set by `bd import --replace`.
+	ReplaceAllColumns bool
 	// SkipDependencyValidationErrors skips dependency validation failures that
 	// legacy imports tolerated, such as cycles or self-dependencies.
 	SkipDependencyValidationErrors bool
@@ -0,0 +1,447 @@
+// Package storage — blocked_cache.go
+//
+// BlockedCacheStore is a decorator around DoltStorage that memoizes the
+// blocked-set computation (GetBlockedIssues) and the ready-work query built
+// on it (GetReadyWork) for the lifetime of a single command invocation.
+// Flows that consult the blocked set more than once — an annotated list,
+// `bd ready --explain`, close/reopen follow-ups — pay for the traversal once
+// instead of once per call.
+//
+// Any mutation routed through the decorator drops every cached entry, so a
+// command that writes and then re-reads always sees its own change. Writes
+// that reach the concrete store through UnwrapStore (raw SQL, backup
+// restore) must call InvalidateBlockedCache. Writes made by other processes
+// are not observed; the cache is scoped to one process and must not be
+// wired into long-lived servers.
+//
+// Hits are deep copies, so callers may mutate what they get back.
+//
+// Usage:
+//
+//	store = storage.NewBlockedCacheStore(rawStore)
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// BlockedCacheStore wraps a DoltStorage and memoizes GetBlockedIssues and
+// GetReadyWork per filter until the next mutation. All other reads pass
+// through unchanged.
+type BlockedCacheStore struct {
+	DoltStorage             // embed for passthrough of non-overridden methods
+	inner       DoltStorage // the real store
+
+	mu      sync.Mutex
+	blocked map[string][]*types.BlockedIssue
+	ready   map[string][]*types.Issue
+}
+
+// NewBlockedCacheStore wraps store with a per-invocation blocked-set cache.
+func NewBlockedCacheStore(store DoltStorage) *BlockedCacheStore {
+	return &BlockedCacheStore{
+		DoltStorage: store,
+		inner:       store,
+	}
+}
+
+// Unwrap returns the underlying store, satisfying Unwrapper.
+func (c *BlockedCacheStore) Unwrap() DoltStorage { return c.inner }
+
+// Invalidate drops every cached blocked set and ready list. Mutations call
+// it automatically; callers only need it after writing through a path that
+// bypasses the decorator (raw SQL, a second store handle).
+func (c *BlockedCacheStore) Invalidate() {
+	c.mu.Lock()
+	c.blocked = nil
+	c.ready = nil
+	c.mu.Unlock()
+}
+
+// InvalidateBlockedCache invalidates every BlockedCacheStore in s's
+// decorator chain. Call it after writing through UnwrapStore.
+func InvalidateBlockedCache(s DoltStorage) {
+	for s != nil {
+		if c, ok := s.(*BlockedCacheStore); ok {
+			c.Invalidate()
+		}
+		u, ok := s.(Unwrapper)
+		if !ok {
+			return
+		}
+		s = u.Unwrap()
+	}
+}
+
+// GetBlockedIssues returns the cached blocked set for filter, computing it
+// on first use. Every call gets its own deep copy, so callers may re-sort,
+// truncate or edit the result without disturbing later hits.
+func (c *BlockedCacheStore) GetBlockedIssues(ctx context.Context, filter types.WorkFilter) ([]*types.BlockedIssue, error) {
+	key, ok := blockedCacheKey(filter)
+	if !ok {
+		return c.inner.GetBlockedIssues(ctx, filter)
+	}
+
+	c.mu.Lock()
+	cached, hit := c.blocked[key]
+	c.mu.Unlock()
+	if hit {
+		return cloneBlockedIssues(cached), nil
+	}
+
+	result, err := c.inner.GetBlockedIssues(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	if c.blocked == nil {
+		c.blocked = make(map[string][]*types.BlockedIssue)
+	}
+	c.blocked[key] = cloneBlockedIssues(result)
+	c.mu.Unlock()
+	return result, nil
+}
+
+// GetReadyWork returns the cached ready list for filter, computing it on
+// first use. Like GetBlockedIssues, every call gets its own deep copy.
+func (c *BlockedCacheStore) GetReadyWork(ctx context.Context, filter types.WorkFilter) ([]*types.Issue, error) {
+	key, ok := blockedCacheKey(filter)
+	if !ok {
+		return c.inner.GetReadyWork(ctx, filter)
+	}
+
+	c.mu.Lock()
+	cached, hit := c.ready[key]
+	c.mu.Unlock()
+	if hit {
+		return cloneIssues(cached), nil
+	}
+
+	result, err := c.inner.GetReadyWork(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	if c.ready == nil {
+		c.ready = make(map[string][]*types.Issue)
+	}
+	c.ready[key] = cloneIssues(result)
+	c.mu.Unlock()
+	return result, nil
+}
+
+// blockedCacheKey derives a stable cache key from a filter. JSON follows the
+// filter's pointer fields, so two filters built independently with the same
+// values share an entry. A filter that cannot be encoded is not cached.
+func blockedCacheKey(filter types.WorkFilter) (string, bool) {
+	b, err := json.Marshal(filter)
+	if err != nil {
+		return "", false
+	}
+	return string(b), true
+}
+
+func cloneBlockedIssues(in []*types.BlockedIssue) []*types.BlockedIssue {
+	if in == nil {
+		return nil
+	}
+	out := make([]*types.BlockedIssue, len(in))
+	for i, b := range in {
+		if b == nil {
+			continue
+		}
+		cp := *b
+		cp.Issue = *cloneIssueForHook(&b.Issue)
+		cp.BlockedBy = slices.Clone(b.BlockedBy)
+		cp.Reason = slices.Clone(b.Reason)
+		out[i] = &cp
+	}
+	return out
+}
+
+func cloneIssues(in []*types.Issue) []*types.Issue {
+	if in == nil {
+		return nil
+	}
+	out := make([]*types.Issue, len(in))
+	for i, issue := range in {
+		out[i] = cloneIssueForHook(issue)
+	}
+	return out
+}
+
+// ── Mutations ───────────────────────────────────────────────────────
+//
+// Every method below forwards to the inner store and then invalidates,
+// regardless of outcome: a failed write may still have partially applied
+// (e.g. a batch that errors midway), and recomputing is always safe.
+
+func (c *BlockedCacheStore) CreateIssue(ctx context.Context, issue *types.Issue, actor string) error {
+	defer c.Invalidate()
+	return c.inner.CreateIssue(ctx, issue, actor)
+}
+
+func (c *BlockedCacheStore) CreateIssues(ctx context.Context, issues []*types.Issue, actor string) error {
+	defer c.Invalidate()
+	return c.inner.CreateIssues(ctx, issues, actor)
+}
+
+func (c *BlockedCacheStore) CreateIssuesWithFullOptions(ctx context.Context, issues []*types.Issue, actor string, opts BatchCreateOptions) error {
+	defer c.Invalidate()
+	return c.inner.CreateIssuesWithFullOptions(ctx, issues, actor, opts)
+}
+
+func (c *BlockedCacheStore) UpdateIssue(ctx context.Context, id string, updates map[string]interface{}, actor string) error {
+	defer c.Invalidate()
+	return c.inner.UpdateIssue(ctx, id, updates, actor)
+}
+
+func (c *BlockedCacheStore) UpdateIssueChecked(ctx context.Context, id string, updates map[string]interface{}, actor string, opts UpdateIssueOptions) error {
+	defer c.Invalidate()
+	return c.inner.UpdateIssueChecked(ctx, id, updates, actor, opts)
+}
+
+func (c *BlockedCacheStore) UpdateIssueType(ctx context.Context, id string, issueType string, actor string) error {
+	defer c.Invalidate()
+	return c.inner.UpdateIssueType(ctx, id, issueType, actor)
+}
+
+func (c *BlockedCacheStore) UpdateIssueID(ctx context.Context, oldID, newID string, issue *types.Issue, actor string) error {
+	defer c.Invalidate()
+	return c.inner.UpdateIssueID(ctx, oldID, newID, issue, actor)
+}
+
+func (c *BlockedCacheStore) ReopenIssue(ctx context.Context, id string, reason string, actor string) error {
+	defer c.Invalidate()
+	return c.inner.ReopenIssue(ctx, id, reason, actor)
+}
+
+func (c *BlockedCacheStore) CloseIssue(ctx context.Context, id string, reason string, actor string, session string) error {
+	defer c.Invalidate()
+	return c.inner.CloseIssue(ctx, id, reason, actor, session)
+}
+
+func (c *BlockedCacheStore) CloseIssueWithResult(ctx context.Context, id string, reason string, actor string, session string) (*CloseResult, error) {
+	defer c.Invalidate()
+	return c.inner.CloseIssueWithResult(ctx, id, reason, actor, session)
+}
+
+func (c *BlockedCacheStore) CloseIssueChecked(ctx context.Context, id string, actor string, opts CloseIssueOptions) (CloseIssueResult, error) {
+	defer c.Invalidate()
+	return c.inner.CloseIssueChecked(ctx, id, actor, opts)
+}
+
+func (c *BlockedCacheStore) DeleteIssue(ctx context.Context, id string) error {
+	defer c.Invalidate()
+	return c.inner.DeleteIssue(ctx, id)
+}
+
+func (c *BlockedCacheStore) DeleteIssues(ctx context.Context, ids []string, cascade bool, force bool, dryRun bool) (*types.DeleteIssuesResult, error) {
+	defer c.Invalidate()
+	return c.inner.DeleteIssues(ctx, ids, cascade, force, dryRun)
+}
+
+func (c *BlockedCacheStore) DeleteIssuesBySourceRepo(ctx context.Context, sourceRepo string) (int, error) {
+	defer c.Invalidate()
+	return c.inner.DeleteIssuesBySourceRepo(ctx, sourceRepo)
+}
+
+func (c *BlockedCacheStore) ClaimIssue(ctx context.Context, id string, actor string) error {
+	defer c.Invalidate()
+	return c.inner.ClaimIssue(ctx, id, actor)
+}
+
+func (c *BlockedCacheStore) ClaimReadyIssue(ctx context.Context, filter types.WorkFilter, actor string) (*types.Issue, error) {
+	defer c.Invalidate()
+	return c.inner.ClaimReadyIssue(ctx, filter, actor)
+}
+
+func (c *BlockedCacheStore) UnclaimIssue(ctx context.Context, id string, actor string, force bool) error {
+	defer c.Invalidate()
+	return c.inner.UnclaimIssue(ctx, id, actor, force)
+}
+
+func (c *BlockedCacheStore) UnclaimIssueIfAssignee(ctx context.Context, id string, actor string, expectedAssignee string) error {
+	defer c.Invalidate()
+	return c.inner.UnclaimIssueIfAssignee(ctx, id, actor, expectedAssignee)
+}
+
+func (c *BlockedCacheStore) HeartbeatIssue(ctx context.Context, id string, actor string) error {
+	defer c.Invalidate()
+	return c.inner.HeartbeatIssue(ctx, id, actor)
+}
+
+func (c *BlockedCacheStore) ReclaimExpiredLeases(ctx context.Context, olderThan time.Duration, actor string) ([]types.ReclaimedLease, error) {
+	defer c.Invalidate()
+	return c.inner.ReclaimExpiredLeases(ctx, olderThan, actor)
+}
+
+func (c *BlockedCacheStore) PromoteFromEphemeral(ctx context.Context, id string, actor string) error {
+	defer c.Invalidate()
+	return c.inner.PromoteFromEphemeral(ctx, id, actor)
+}
+
+func (c *BlockedCacheStore) RestoreFromSnapshot(ctx context.Context, issueID string) (*types.IssueSnapshot, error) {
+	defer c.Invalidate()
+	return c.inner.RestoreFromSnapshot(ctx, issueID)
+}
+
+func (c *BlockedCacheStore) AddDependency(ctx context.Context, dep *types.Dependency, actor string) error {
+	defer c.Invalidate()
+	return c.inner.AddDependency(ctx, dep, actor)
+}
+
+func (c *BlockedCacheStore) AddDependencyWithOptions(ctx context.Context, dep *types.Dependency, actor string, opts DependencyAddOptions) error {
+	defer c.Invalidate()
+	return c.inner.AddDependencyWithOptions(ctx, dep, actor, opts)
+}
+
+func (c *BlockedCacheStore) RemoveDependency(ctx context.Context, issueID, dependsOnID string, actor string) error {
+	defer c.Invalidate()
+	return c.inner.RemoveDependency(ctx, issueID, dependsOnID, actor)
+}
+
+func (c *BlockedCacheStore) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, opts DependencyRemoveOptions) (int64, error) {
+	defer c.Invalidate()
+	return c.inner.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, opts)
+}
+
+func (c *BlockedCacheStore) AddLabel(ctx context.Context, issueID, label, actor string) error {
+	defer c.Invalidate()
+	return c.inner.AddLabel(ctx, issueID, label, actor)
+}
+
+func (c *BlockedCacheStore) RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error) {
+	defer c.Invalidate()
+	return c.inner.RemoveLabel(ctx, issueID, label, actor)
+}
+
+func (c *BlockedCacheStore) SlotSet(ctx context.Context, issueID, key, value, actor string) error {
+	defer c.Invalidate()
+	return c.inner.SlotSet(ctx, issueID, key, value, actor)
+}
+
+func (c *BlockedCacheStore) SlotClear(ctx context.Context, issueID, key, actor string) error {
+	defer c.Invalidate()
+	return c.inner.SlotClear(ctx, issueID, key, actor)
+}
+
+func (c *BlockedCacheStore) MergeMetadata(ctx context.Context, issueID, key string, value json.RawMessage, actor string) error {
+	defer c.Invalidate()
+	return c.inner.MergeMetadata(ctx, issueID, key, value, actor)
+}
+
+func (c *BlockedCacheStore) ApplyCompaction(ctx context.Context, issueID string, tier int, originalSize int, compactedSize int, commitHash string) error {
+	defer c.Invalidate()
+	return c.inner.ApplyCompaction(ctx, issueID, tier, originalSize, compactedSize, commitHash)
+}
+
+func (c *BlockedCacheStore) AddComment(ctx context.Context, issueID, actor, comment string) error {
+	defer c.Invalidate()
+	return c.inner.AddComment(ctx, issueID, actor, comment)
+}
+
+func (c *BlockedCacheStore) AddIssueComment(ctx context.Context, issueID, author, text string) (*types.Comment, error) {
+	defer c.Invalidate()
+	return c.inner.AddIssueComment(ctx, issueID, author, text)
+}
+
+func (c *BlockedCacheStore) ImportIssueComment(ctx context.Context, issueID, author, text string, createdAt time.Time) (*types.Comment, error) {
+	defer c.Invalidate()
+	return c.inner.ImportIssueComment(ctx, issueID, author, text, createdAt)
+}
+
+func (c *BlockedCacheStore) MergeSlotCreate(ctx context.Context, actor string) (*types.Issue, error) {
+	defer c.Invalidate()
+	return c.inner.MergeSlotCreate(ctx, actor)
+}
+
+func (c *BlockedCacheStore) MergeSlotAcquire(ctx context.Context, holder string, actor string, wait bool) (*MergeSlotResult, error) {
+	defer c.Invalidate()
+	return c.inner.MergeSlotAcquire(ctx, holder, actor, wait)
+}
+
+func (c *BlockedCacheStore) MergeSlotRelease(ctx context.Context, holder string, actor string) error {
+	defer c.Invalidate()
+	return c.inner.MergeSlotRelease(ctx, holder, actor)
+}
+
+// ── Config and metadata ─────────────────────────────────────────────
+//
+// Custom statuses and types live in config and change what counts as
+// blocked or ready, so config writes invalidate too.
+
+func (c *BlockedCacheStore) SetConfig(ctx context.Context, key, value string) error {
+	defer c.Invalidate()
+	return c.inner.SetConfig(ctx, key, value)
+}
+
+func (c *BlockedCacheStore) DeleteConfig(ctx context.Context, key string) error {
+	defer c.Invalidate()
+	return c.inner.DeleteConfig(ctx, key)
+}
+
+func (c *BlockedCacheStore) SetMetadata(ctx context.Context, key, value string) error {
+	defer c.Invalidate()
+	return c.inner.SetMetadata(ctx, key, value)
+}
+
+func (c *BlockedCacheStore) SetLocalMetadata(ctx context.Context, key, value string) error {
+	defer c.Invalidate()
+	return c.inner.SetLocalMetadata(ctx, key, value)
+}
+
+// RunInTransaction invalidates after the transaction finishes, committed or
+// not. Reads inside fn go through the transaction and never hit the cache.
+func (c *BlockedCacheStore) RunInTransaction(ctx context.Context, commitMsg string, fn func(tx Transaction) error) error {
+	defer c.Invalidate()
+	return c.inner.RunInTransaction(ctx, commitMsg, fn)
+}
+
+// ── Working-set replacements ────────────────────────────────────────
+//
+// Branch switches, merges and pulls swap in a different working set, so
+// every cached blocked set is stale afterwards.
+
+func (c *BlockedCacheStore) Checkout(ctx context.Context, branch string) error {
+	defer c.Invalidate()
+	return c.inner.Checkout(ctx, branch)
+}
+
+func (c *BlockedCacheStore) Merge(ctx context.Context, branch string) ([]Conflict, error) {
+	defer c.Invalidate()
+	return c.inner.Merge(ctx, branch)
+}
+
+func (c *BlockedCacheStore) ResolveConflicts(ctx context.Context, table string, strategy string) error {
+	defer c.Invalidate()
+	return c.inner.ResolveConflicts(ctx, table, strategy)
+}
+
+func (c *BlockedCacheStore) Pull(ctx context.Context) error {
+	defer c.Invalidate()
+	return c.inner.Pull(ctx)
+}
+
+func (c *BlockedCacheStore) PullRemote(ctx context.Context, remote string) error {
+	defer c.Invalidate()
+	return c.inner.PullRemote(ctx, remote)
+}
+
+func (c *BlockedCacheStore) PullFrom(ctx context.Context, peer string) ([]Conflict, error) {
+	defer c.Invalidate()
+	return c.inner.PullFrom(ctx, peer)
+}
+
+func (c *BlockedCacheStore) Sync(ctx context.Context, peer string, strategy string) (*SyncResult, error) {
+	defer c.Invalidate()
+	return c.inner.Sync(ctx, peer, strategy)
+}
+
+var _ DoltStorage = (*BlockedCacheStore)(nil)
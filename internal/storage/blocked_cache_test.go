@@ -0,0 +1,182 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// countingBlockedStore counts blocked-set computations. Only the methods the
+// cache tests touch are implemented; anything else panics on the nil embed.
+type countingBlockedStore struct {
+	storage.DoltStorage
+	blockedCalls int
+	blocked      []*types.BlockedIssue
+	readyCalls   int
+}
+
+func (s *countingBlockedStore) GetBlockedIssues(_ context.Context, _ types.WorkFilter) ([]*types.BlockedIssue, error) {
+	s.blockedCalls++
+	return s.blocked, nil
+}
+
+func (s *countingBlockedStore) GetReadyWork(_ context.Context, _ types.WorkFilter) ([]*types.Issue, error) {
+	s.readyCalls++
+	return []*types.Issue{{ID: "bd-3", Title: "Ready"}}, nil
+}
+
+func (s *countingBlockedStore) SetConfig(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (s *countingBlockedStore) UpdateIssue(_ context.Context, _ string, _ map[string]interface{}, _ string) error {
+	return nil
+}
+
+func (s *countingBlockedStore) RunInTransaction(_ context.Context, _ string, _ func(tx storage.Transaction) error) error {
+	return nil
+}
+
+func newCountingBlockedStore() *countingBlockedStore {
+	return &countingBlockedStore{blocked: []*types.BlockedIssue{
+		{Issue: types.Issue{ID: "bd-1"}, BlockedByCount: 1, BlockedBy: []string{"bd-2"}},
+	}}
+}
+
+// An annotated list consults the blocked set once per row; with the cache
+// that is one computation for the whole invocation, not one per row.
+func TestBlockedCacheStore_RepeatedCallsComputeOnce(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingBlockedStore()
+	store := storage.NewBlockedCacheStore(inner)
+
+	for i := 0; i < 25; i++ {
+		got, err := store.GetBlockedIssues(ctx, types.WorkFilter{})
+		if err != nil {
+			t.Fatalf("GetBlockedIssues: %v", err)
+		}
+		if len(got) != 1 || got[0].ID != "bd-1" {
+			t.Fatalf("GetBlockedIssues = %+v, want [bd-1]", got)
+		}
+	}
+	if inner.blockedCalls != 1 {
+		t.Errorf("blocked computations = %d, want 1", inner.blockedCalls)
+	}
+}
+
+func TestBlockedCacheStore_FiltersCachedSeparately(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingBlockedStore()
+	store := storage.NewBlockedCacheStore(inner)
+
+	parentA, parentB := "bd-a", "bd-b"
+	sameAsA := "bd-a"
+	for _, f := range []types.WorkFilter{{ParentID: &parentA}, {ParentID: &parentB}, {ParentID: &sameAsA}} {
+		if _, err := store.GetBlockedIssues(ctx, f); err != nil {
+			t.Fatalf("GetBlockedIssues: %v", err)
+		}
+	}
+	// Distinct pointers with equal values share an entry.
+	if inner.blockedCalls != 2 {
+		t.Errorf("blocked computations = %d, want 2", inner.blockedCalls)
+	}
+}
+
+func TestBlockedCacheStore_MutationInvalidates(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingBlockedStore()
+	store := storage.NewBlockedCacheStore(inner)
+
+	if _, err := store.GetBlockedIssues(ctx, types.WorkFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.UpdateIssue(ctx, "bd-2", map[string]interface{}{"status": "closed"}, "tester"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.GetBlockedIssues(ctx, types.WorkFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.RunInTransaction(ctx, "test", func(storage.Transaction) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.GetBlockedIssues(ctx, types.WorkFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	if inner.blockedCalls != 3 {
+		t.Errorf("blocked computations = %d, want 3 (one per mutation boundary)", inner.blockedCalls)
+	}
+}
+
+func TestBlockedCacheStore_ReturnsCopy(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewBlockedCacheStore(newCountingBlockedStore())
+
+	first, _ := store.GetBlockedIssues(ctx, types.WorkFilter{})
+	first[0] = nil
+	second, _ := store.GetBlockedIssues(ctx, types.WorkFilter{})
+	if second[0] == nil {
+		t.Fatal("caller mutation of the returned slice leaked into the cache")
+	}
+}
+
+func TestBlockedCacheStore_Unwrap(t *testing.T) {
+	inner := newCountingBlockedStore()
+	store := storage.NewBlockedCacheStore(inner)
+	if storage.UnwrapStore(store) != storage.DoltStorage(inner) {
+		t.Errorf("UnwrapStore did not reach the inner store")
+	}
+}
+
+func TestBlockedCacheStore_HitsAreDeepCopies(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewBlockedCacheStore(newCountingBlockedStore())
+
+	first, _ := store.GetBlockedIssues(ctx, types.WorkFilter{})
+	first[0].Title = "edited"
+	first[0].BlockedBy[0] = "bd-x"
+	second, _ := store.GetBlockedIssues(ctx, types.WorkFilter{})
+	if second[0].Title == "edited" || second[0].BlockedBy[0] != "bd-2" {
+		t.Fatalf("caller mutation of a returned issue leaked into the cache: %+v", second[0])
+	}
+}
+
+func TestBlockedCacheStore_ReadyWorkMemoized(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingBlockedStore()
+	store := storage.NewBlockedCacheStore(inner)
+
+	for i := 0; i < 3; i++ {
+		got, err := store.GetReadyWork(ctx, types.WorkFilter{})
+		if err != nil {
+			t.Fatalf("GetReadyWork: %v", err)
+		}
+		got[0].Title = "edited"
+	}
+	if err := store.SetConfig(ctx, "status.custom", "review"); err != nil {
+		t.Fatal(err)
+	}
+	got, _ := store.GetReadyWork(ctx, types.WorkFilter{})
+	if got[0].Title != "Ready" {
+		t.Errorf("title = %q, want the stored value", got[0].Title)
+	}
+	if inner.readyCalls != 2 {
+		t.Errorf("ready computations = %d, want 2 (one per mutation boundary)", inner.readyCalls)
+	}
+}
+
+// Writes that reach the concrete store through UnwrapStore bypass the
+// decorator's mutators, so callers invalidate explicitly.
+func TestInvalidateBlockedCache(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingBlockedStore()
+	store := storage.NewBlockedCacheStore(inner)
+
+	_, _ = store.GetBlockedIssues(ctx, types.WorkFilter{})
+	storage.InvalidateBlockedCache(store)
+	_, _ = store.GetBlockedIssues(ctx, types.WorkFilter{})
+	if inner.blockedCalls != 2 {
+		t.Errorf("blocked computations = %d, want 2", inner.blockedCalls)
+	}
+}
@@ -2,6 +2,7 @@ package conformance
 
 import (
 	"encoding/json"
+	"fmt"
 	"slices"
 	"strings"
 	"testing"
@@ -25,6 +26,7 @@ func RunAudit_dependencies_readiness(t *testing.T, f Factory) {
 	t.Run("SelfDependencyRejected", func(t *testing.T) { testAuditSelfDependencyRejected(t, f) })
 	t.Run("CycleRejection", func(t *testing.T) { testAuditCycleRejection(t, f) })
 	t.Run("CycleScopeByDependencyType", func(t *testing.T) { testAuditCycleScopeByDependencyType(t, f) })
+	t.Run("CycleRelevantTypeMatrix", func(t *testing.T) { testAuditCycleRelevantTypeMatrix(t, f) })
 	t.Run("IdempotencyVsTypeConflict", func(t *testing.T) { testAuditIdempotencyVsTypeConflict(t, f) })
 	t.Run("CrossTypeEpicTaskBlocking", func(t *testing.T) { testAuditCrossTypeEpicTaskBlocking(t, f) })
 	t.Run("MissingSourceTarget", func(t *testing.T) { testAuditMissingSourceTarget(t, f) })
@@ -148,6 +150,47 @@ func testAuditCycleScopeByDependencyType(t *testing.T, f Factory) {
 	}
 }
 
+// testAuditCycleRelevantTypeMatrix closes a two-node loop for every ordered
+// pair of cycle-relevant types and expects each closing edge to be refused.
+// A parent that blocks on its own child trips the hierarchy guard before the
+// cycle probe, so those pairs only need to fail. A loop closed by a type
+// outside the set (related, tracks) stays legal.
+func testAuditCycleRelevantTypeMatrix(t *testing.T, f Factory) {
+	s := f(t)
+	n := 0
+	newPair := func() (string, string) {
+		n++
+		a, b := fmt.Sprintf("m%da", n), fmt.Sprintf("m%db", n)
+		must(t, s.CreateIssue(ctx(), withDefaults(&types.Issue{ID: a, Title: a}), "a"))
+		must(t, s.CreateIssue(ctx(), withDefaults(&types.Issue{ID: b, Title: b}), "a"))
+		return a, b
+	}
+	relevant := types.CycleRelevantDependencyTypes()
+	for _, first := range relevant {
+		for _, closing := range relevant {
+			a, b := newPair()
+			must(t, s.AddDependency(ctx(), &types.Dependency{IssueID: a, DependsOnID: b, Type: first}, "a"))
+			err := s.AddDependency(ctx(), &types.Dependency{IssueID: b, DependsOnID: a, Type: closing}, "a")
+			hierarchyGuard := first == types.DepParentChild && (closing == types.DepBlocks || closing == types.DepConditionalBlocks)
+			if err == nil || (!hierarchyGuard && !strings.Contains(err.Error(), "cycle")) {
+				t.Errorf("%s then %s: closing edge err = %v, want a 'cycle' error", first, closing, err)
+			}
+		}
+	}
+	for _, closing := range []types.DependencyType{types.DepRelated, types.DepTracks} {
+		a, b := newPair()
+		must(t, s.AddDependency(ctx(), &types.Dependency{IssueID: a, DependsOnID: b, Type: types.DepBlocks}, "a"))
+		if err := s.AddDependency(ctx(), &types.Dependency{IssueID: b, DependsOnID: a, Type: closing}, "a"); err != nil {
+			t.Errorf("blocks then %s: %v, want the non-cycle-relevant edge accepted", closing, err)
+		}
+	}
+	cycles, err := s.DetectCycles(ctx())
+	must(t, err)
+	if len(cycles) != 0 {
+		t.Errorf("DetectCycles = %d cycles, want none (every relevant loop was refused)", len(cycles))
+	}
+}
+
 func testAuditIdempotencyVsTypeConflict(t *testing.T, f Factory) {
 	s := f(t)
 	must(t, s.CreateIssue(ctx(), withDefaults(&types.Issue{ID: "ia", Title: "A"}), "a"))
@@ -73,8 +73,9 @@ func testAuditEventValueNullability(t *testing.T, f Factory) {
 }
 
 // testAuditLabelEventNonIdempotent pins that label mutations are idempotent at the
-// row level (INSERT IGNORE / DELETE) yet NON-idempotent at the event level: the
-// label_added / label_removed event is emitted unconditionally.
+// row level (INSERT IGNORE / DELETE) yet NON-idempotent at the event level for
+// adds: label_added is emitted unconditionally. label_removed is emitted only
+// when a row was actually deleted.
 func testAuditLabelEventNonIdempotent(t *testing.T, f Factory) {
 	s := f(t)
 	c := ctx()
@@ -99,17 +100,18 @@ func testAuditLabelEventNonIdempotent(t *testing.T, f Factory) {
 		}
 	}
 
-	// Removing a never-present label: no error, but a spurious label_removed event.
+	// Removing a never-present label: no error, nothing removed, and no
+	// label_removed event.
 	must(t, s.CreateIssue(c, withDefaults(&types.Issue{ID: "test-j", Title: "J"}), "a"))
-	must(t, s.RemoveLabel(c, "test-j", "never-added", "a"))
-	evsJ, err := s.GetEvents(c, "test-j", 0)
+	removed, err := s.RemoveLabel(c, "test-j", "never-added", "a")
 	must(t, err)
-	lr := auditEventsOfType(evsJ, types.EventLabelRemoved)
-	if len(lr) != 1 {
-		t.Fatalf("label_removed events after remove-of-absent = %d, want 1 (spurious event)", len(lr))
+	if removed != 0 {
+		t.Errorf("RemoveLabel of absent label removed = %d, want 0", removed)
 	}
-	if lr[0].Comment == nil || *lr[0].Comment != "Removed label: never-added" {
-		t.Errorf("label_removed Comment = %v, want \"Removed label: never-added\"", lr[0].Comment)
+	evsJ, err := s.GetEvents(c, "test-j", 0)
+	must(t, err)
+	if lr := auditEventsOfType(evsJ, types.EventLabelRemoved); len(lr) != 0 {
+		t.Fatalf("label_removed events after remove-of-absent = %d, want 0", len(lr))
 	}
 }
 
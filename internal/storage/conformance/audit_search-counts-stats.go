@@ -259,7 +259,7 @@ func testAuditCountByAssigneeAndType(t *testing.T, f Factory) {
 
 // GetStatistics computes six status counts plus BlockedIssues (is_blocked=1 and
 // status not closed/pinned) and PinnedIssues (the pinned=1 column flag, distinct
-// from status='pinned'). ReadyIssues = OpenIssues - BlockedIssues clamped at 0.
+// from status='pinned'). ReadyIssues is the size of the default ready-work set.
 func testAuditStatistics(t *testing.T, f Factory) {
 	s := f(t)
 	c := ctx()
@@ -300,18 +300,24 @@ func testAuditStatistics(t *testing.T, f Factory) {
 		}
 		t.Errorf("BlockedIssues = %d, want 1", got)
 	}
-	// Ready = Open(2) - Blocked(1) = 1 (the blocked issue is in_progress, not open).
-	if stats.ReadyIssues == nil || *stats.ReadyIssues != 1 {
+	// Ready = both open issues. The blocked issue is in_progress, so it never
+	// was in the ready set; subtracting it from Open used to report 1.
+	if stats.ReadyIssues == nil || *stats.ReadyIssues != 2 {
 		got := -1
 		if stats.ReadyIssues != nil {
 			got = *stats.ReadyIssues
 		}
-		t.Errorf("ReadyIssues = %d, want 1", got)
+		t.Errorf("ReadyIssues = %d, want 2", got)
+	}
+	ready, err := s.GetReadyWork(c, types.WorkFilter{Status: types.StatusOpen})
+	must(t, err)
+	if stats.ReadyIssues != nil && *stats.ReadyIssues != len(ready) {
+		t.Errorf("ReadyIssues = %d, but GetReadyWork returned %d issues", *stats.ReadyIssues, len(ready))
 	}
 }
 
-// The Ready clamp is load-bearing: when BlockedIssues exceeds OpenIssues,
-// OpenIssues - BlockedIssues goes negative and is clamped to 0.
+// When BlockedIssues exceeds OpenIssues, ReadyIssues is still 0 rather than
+// the negative OpenIssues - BlockedIssues.
 func testAuditStatisticsReadyClamp(t *testing.T, f Factory) {
 	s := f(t)
 	c := ctx()
@@ -334,13 +340,13 @@ func testAuditStatisticsReadyClamp(t *testing.T, f Factory) {
 		}
 		t.Errorf("BlockedIssues = %d, want 2", got)
 	}
-	// 0 - 2 = -2, clamped to 0.
+	// No open issues, so nothing is ready.
 	if stats.ReadyIssues == nil || *stats.ReadyIssues != 0 {
 		got := -1
 		if stats.ReadyIssues != nil {
 			got = *stats.ReadyIssues
 		}
-		t.Errorf("ReadyIssues = %d, want 0 (clamped)", got)
+		t.Errorf("ReadyIssues = %d, want 0", got)
 	}
 }
 
@@ -675,7 +675,11 @@ func testLabels(t *testing.T, f Factory) {
 	if len(labels) != 2 {
 		t.Errorf("labels = %v", labels)
 	}
-	must(t, s.RemoveLabel(ctx(), "lb-1", "bug", "a"))
+	removed, err := s.RemoveLabel(ctx(), "lb-1", "bug", "a")
+	must(t, err)
+	if removed != 1 {
+		t.Errorf("RemoveLabel removed = %d, want 1", removed)
+	}
 	labels, _ = s.GetLabels(ctx(), "lb-1")
 	if len(labels) != 1 || labels[0] != "urgent" {
 		t.Errorf("after remove: labels = %v", labels)
@@ -854,6 +858,20 @@ func testStaleIssues(t *testing.T, f Factory) {
 	if len(none) != 0 {
 		t.Fatalf("GetStaleIssues(Days=36500) = %v, want none", orderedIDs(none))
 	}
+
+	// A one-day horizon never reaches the issue created moments ago.
+	oneDay, err := s.GetStaleIssues(c, types.StaleFilter{Days: 1})
+	must(t, err)
+	if slices.Contains(orderedIDs(oneDay), "sl-fresh") {
+		t.Fatalf("GetStaleIssues(Days=1) = %v, must not include the fresh issue", orderedIDs(oneDay))
+	}
+
+	// Zero or negative days would make everything (or the future) stale.
+	for _, days := range []int{0, -1} {
+		if got, err := s.GetStaleIssues(c, types.StaleFilter{Days: days}); err == nil {
+			t.Fatalf("GetStaleIssues(Days=%d) = %v, want an error", days, orderedIDs(got))
+		}
+	}
 }
 
 // --- Iterators ---
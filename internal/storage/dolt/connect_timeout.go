@@ -0,0 +1,102 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/config"
+)
+
+// DefaultConnectTimeout bounds the MySQL handshake and first ping when
+// Config.ConnectTimeout is unset. Without it a port that accepts TCP but
+// never answers the handshake would hold a command for the 120s read timeout.
+const DefaultConnectTimeout = 10 * time.Second
+
+// defaultProbeTimeout is the TCP reachability probe run before auto-start
+// when Config.ConnectTimeout is unset. A local server answers in microseconds;
+// the probe only has to be long enough for a remote one.
+const defaultProbeTimeout = 500 * time.Millisecond
+
+// autoStartRedialTimeout is the minimum redial window after auto-start, since
+// a just-started server may still be binding its listener.
+const autoStartRedialTimeout = 2 * time.Second
+
+// ErrServerUnreachable marks a store open that failed because the Dolt
+// server could not be reached within the connect timeout.
+var ErrServerUnreachable = errors.New("dolt server not reachable")
+
+// probeTimeout is the TCP reachability probe window.
+func (c *Config) probeTimeout() time.Duration {
+	if c.ConnectTimeout > 0 {
+		return c.ConnectTimeout
+	}
+	return defaultProbeTimeout
+}
+
+// handshakeTimeout bounds the MySQL handshake and first ping.
+func (c *Config) handshakeTimeout() time.Duration {
+	if c.ConnectTimeout > 0 {
+		return c.ConnectTimeout
+	}
+	return DefaultConnectTimeout
+}
+
+// resolveConnectTimeout reads the connect timeout: BEADS_DOLT_CONNECT_TIMEOUT
+// env > dolt.connect-timeout in config.yaml. Values are Go durations
+// ("3s", "750ms"); invalid or non-positive values are ignored with a warning.
+func resolveConnectTimeout(beadsDir string) time.Duration {
+	source, v := "BEADS_DOLT_CONNECT_TIMEOUT", strings.TrimSpace(os.Getenv("BEADS_DOLT_CONNECT_TIMEOUT"))
+	if v == "" {
+		source, v = "dolt.connect-timeout", strings.TrimSpace(config.GetString("dolt.connect-timeout"))
+	}
+	if v == "" {
+		v = strings.TrimSpace(config.GetStringFromDir(beadsDir, "dolt.connect-timeout"))
+	}
+	if v == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		fmt.Fprintf(os.Stderr, "Warning: %s=%q is not a positive duration (e.g. 3s); using the default\n", source, v)
+		return 0
+	}
+	return d
+}
+
+// serverUnreachableError is the fast-fail error for a server that did not
+// answer within the connect timeout. It names the endpoint, keeps cause in
+// the chain (callers classify connection errors by text and errors.Is), and
+// points at the diagnostics.
+func serverUnreachableError(cfg *Config, cause error, hint string) error {
+	var where string
+	switch {
+	case cfg.ServerSocket != "":
+		where = "on socket " + cfg.ServerSocket
+	case isLocalHost(cfg.ServerHost):
+		where = fmt.Sprintf("on port %d", cfg.ServerPort)
+	default:
+		where = fmt.Sprintf("at %s:%d", cfg.ServerHost, cfg.ServerPort)
+	}
+	if hint != "" {
+		hint = "\n\n" + hint
+	}
+	return fmt.Errorf("%w %s; run 'bd dolt doctor' to diagnose: %w%s", ErrServerUnreachable, where, cause, hint)
+}
+
+// pingWithin pings db, giving up after timeout.
+func pingWithin(ctx context.Context, db *sql.DB, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("no handshake within %s: %w", timeout, err)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package dolt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// unreachableTestConfig returns a server-mode config for port with auto-start
+// off, removing the circuit breaker state the failed open leaves behind.
+func unreachableTestConfig(t *testing.T, port int) *Config {
+	t.Helper()
+	cfg := &Config{
+		Path:       t.TempDir(),
+		ServerHost: "127.0.0.1",
+		ServerPort: port,
+		ServerUser: "root",
+		Database:   "beads_unreachable",
+	}
+	t.Cleanup(func() {
+		_ = os.Remove(newCircuitBreaker(cfg.ServerHost, port, cfg.Database).filePath)
+	})
+	return cfg
+}
+
+func TestNewServerModeDeadPortFailsFast(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+	_ = ln.Close()
+
+	cfg := unreachableTestConfig(t, port)
+	start := time.Now()
+	store, err := newServerMode(context.Background(), cfg)
+	elapsed := time.Since(start)
+	if err == nil {
+		_ = store.Close()
+		t.Fatal("newServerMode on a dead port succeeded")
+	}
+	if elapsed > 3*time.Second {
+		t.Errorf("dead port took %v to fail, want a fast failure", elapsed)
+	}
+	if !errors.Is(err, ErrServerUnreachable) {
+		t.Errorf("err = %v, want ErrServerUnreachable", err)
+	}
+	msg := err.Error()
+	for _, want := range []string{fmt.Sprintf("not reachable on port %d", port), "bd dolt doctor", "bd dolt start"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error missing guidance %q:\n%s", want, msg)
+		}
+	}
+	if !isConnectionError(err) {
+		t.Errorf("unreachable error no longer classifies as a connection error:\n%s", msg)
+	}
+}
+
+func TestNewServerModeHandshakeTimeout(t *testing.T) {
+	// A listener that accepts but never speaks MySQL.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		mu       sync.Mutex
+		accepted []net.Conn
+	)
+	t.Cleanup(func() {
+		_ = ln.Close()
+		mu.Lock()
+		defer mu.Unlock()
+		for _, conn := range accepted {
+			_ = conn.Close()
+		}
+	})
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			accepted = append(accepted, conn)
+			mu.Unlock()
+		}
+	}()
+
+	cfg := unreachableTestConfig(t, ln.Addr().(*net.TCPAddr).Port)
+	cfg.ConnectTimeout = 300 * time.Millisecond
+	start := time.Now()
+	store, err := newServerMode(context.Background(), cfg)
+	elapsed := time.Since(start)
+	if err == nil {
+		_ = store.Close()
+		t.Fatal("newServerMode against a silent listener succeeded")
+	}
+	if elapsed > 5*time.Second {
+		t.Errorf("silent listener took %v to fail, want the %v connect timeout", elapsed, cfg.ConnectTimeout)
+	}
+	if !errors.Is(err, ErrServerUnreachable) || !strings.Contains(err.Error(), "handshake") {
+		t.Errorf("err = %v, want an unreachable handshake error", err)
+	}
+}
+
+func TestResolveConnectTimeout(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Setenv("BEADS_DOLT_CONNECT_TIMEOUT", "750ms")
+	if got := resolveConnectTimeout(dir); got != 750*time.Millisecond {
+		t.Errorf("resolveConnectTimeout = %v, want 750ms", got)
+	}
+	for _, bad := range []string{"soon", "-1s", "0"} {
+		t.Setenv("BEADS_DOLT_CONNECT_TIMEOUT", bad)
+		if got := resolveConnectTimeout(dir); got != 0 {
+			t.Errorf("resolveConnectTimeout(%q) = %v, want 0 (default)", bad, got)
+		}
+	}
+
+	cfg := &Config{}
+	if cfg.probeTimeout() != defaultProbeTimeout || cfg.handshakeTimeout() != DefaultConnectTimeout {
+		t.Errorf("zero ConnectTimeout: probe=%v handshake=%v", cfg.probeTimeout(), cfg.handshakeTimeout())
+	}
+	cfg.ConnectTimeout = 2 * time.Second
+	if cfg.probeTimeout() != cfg.ConnectTimeout || cfg.handshakeTimeout() != cfg.ConnectTimeout {
+		t.Errorf("ConnectTimeout=2s: probe=%v handshake=%v", cfg.probeTimeout(), cfg.handshakeTimeout())
+	}
+}
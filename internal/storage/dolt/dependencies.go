@@ -33,7 +33,7 @@ func (s *DoltStore) AddDependencyWithOptions(ctx context.Context, dep *types.Dep
 
 	// Route to wisp_dependencies if the source is an active wisp.
 	if s.isActiveWisp(ctx, dep.IssueID) {
-		return s.addWispDependency(ctx, dep, actor, isCrossPrefix, addOpts.EmitEvent)
+		return s.addWispDependency(ctx, dep, actor, isCrossPrefix, addOpts)
 	}
 
 	targetTable := "issues"
@@ -51,12 +51,14 @@ func (s *DoltStore) AddDependencyWithOptions(ctx context.Context, dep *types.Dep
 	var eventWritten bool
 	if err := s.withRetryTx(ctx, func(tx *sql.Tx) error {
 		opts := issueops.AddDependencyOpts{
-			SourceTable:   "issues",
-			TargetTable:   targetTable,
-			WriteTable:    "dependencies",
-			IsCrossPrefix: isCrossPrefix,
-			TargetKind:    &kind,
-			EmitEvent:     addOpts.EmitEvent,
+			SourceTable:     "issues",
+			TargetTable:     targetTable,
+			WriteTable:      "dependencies",
+			IsCrossPrefix:   isCrossPrefix,
+			TargetKind:      &kind,
+			EmitEvent:       addOpts.EmitEvent,
+			RejectDuplicate: addOpts.RejectDuplicate,
+			SkipCycleCheck:  addOpts.SkipCycleCheck,
 		}
 		var e error
 		eventWritten, e = issueops.AddDependencyInTx(ctx, tx, dep, actor, opts)
@@ -81,39 +83,41 @@ func (s *DoltStore) AddDependencyWithOptions(ctx context.Context, dep *types.Dep
 // delete, reparent, batch, duplicate cleanup). The explicit bd dep remove verb
 // calls RemoveDependencyWithOptions with EmitEvent set.
 func (s *DoltStore) RemoveDependency(ctx context.Context, issueID, dependsOnID string, actor string) error {
-	return s.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, storage.DependencyRemoveOptions{})
+	_, err := s.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, storage.DependencyRemoveOptions{})
+	return err
 }
 
 // RemoveDependencyWithOptions removes a dependency between two issues.
 // Delegates SQL work to issueops.RemoveDependencyInTx which handles wisp routing.
 // EmitEvent records a dependency_removed history event for the explicit dep verb.
-func (s *DoltStore) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, rmOpts storage.DependencyRemoveOptions) error {
+func (s *DoltStore) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, rmOpts storage.DependencyRemoveOptions) (int64, error) {
 	// Wisps live in dolt_ignored tables — skip Dolt versioning entirely.
 	if s.isActiveWisp(ctx, issueID) {
 		tx, err := s.db.BeginTx(ctx, nil)
 		if err != nil {
-			return fmt.Errorf("failed to begin transaction: %w", err)
+			return 0, fmt.Errorf("failed to begin transaction: %w", err)
 		}
 		defer func() { _ = tx.Rollback() }()
-		if _, err := issueops.RemoveDependencyInTx(ctx, tx, issueID, dependsOnID, actor, rmOpts.EmitEvent); err != nil {
-			return err
+		removed, err := issueops.RemoveDependencyInTx(ctx, tx, issueID, dependsOnID, actor, rmOpts.EmitEvent)
+		if err != nil {
+			return 0, err
 		}
-		return wrapTransactionError("commit remove wisp dependency", tx.Commit())
+		return removed, wrapTransactionError("commit remove wisp dependency", tx.Commit())
 	}
 
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	eventWritten, err := issueops.RemoveDependencyInTx(ctx, tx, issueID, dependsOnID, actor, rmOpts.EmitEvent)
+	removed, err := issueops.RemoveDependencyInTx(ctx, tx, issueID, dependsOnID, actor, rmOpts.EmitEvent)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("sql commit: %w", err)
+		return 0, fmt.Errorf("sql commit: %w", err)
 	}
 	// GH#2455: Use explicit DOLT_ADD to avoid sweeping up stale config changes.
 	// Stage events only when RemoveDependencyInTx actually recorded a
@@ -121,19 +125,19 @@ func (s *DoltStore) RemoveDependencyWithOptions(ctx context.Context, issueID, de
 	// structural or missing-edge remove writes no event, so staging events would
 	// sweep unrelated pending event rows into this dependency commit.
 	tables := []string{"dependencies"}
-	if eventWritten {
+	if removed > 0 && rmOpts.EmitEvent {
 		tables = append(tables, "events")
 	}
 	if err := s.doltAddAndCommit(ctx, tables, "dependency: remove "+issueID+" -> "+dependsOnID); err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	return removed, nil
 }
 
 // GetDependencies retrieves issues that this issue depends on
 func (s *DoltStore) GetDependencies(ctx context.Context, issueID string) ([]*types.Issue, error) {
 	var result []*types.Issue
-	err := s.withReadTx(ctx, func(tx *sql.Tx) error {
+	err := s.withPreparedReadTx(ctx, func(tx issueops.DBTX) error {
 		var err error
 		result, err = issueops.GetDependenciesInTx(ctx, tx, issueID)
 		return err
@@ -1,10 +1,13 @@
 package dolt
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/domain"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -1565,16 +1568,17 @@ func TestAddDependencyCycleCheckTerminatesOnExistingCycleAndDiamond(t *testing.T
 	}
 }
 
-func TestDetectCycles_WaitsForDoesNotCreateCycle(t *testing.T) {
+func TestDetectCycles_WaitsForCycleRejected(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
 	ctx, cancel := testContext(t)
 	defer cancel()
 
-	// waits-for should NOT trigger cycle detection (gate semantics)
-	issueA := &types.Issue{ID: "wf-nocycle-a", Title: "A", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask}
-	issueB := &types.Issue{ID: "wf-nocycle-b", Title: "B", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask}
+	// waits-for affects readiness, so a waits-for loop is a cycle like a
+	// blocks loop: neither gate could ever open.
+	issueA := &types.Issue{ID: "wf-cycle-a", Title: "A", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask}
+	issueB := &types.Issue{ID: "wf-cycle-b", Title: "B", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask}
 
 	for _, issue := range []*types.Issue{issueA, issueB} {
 		if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
@@ -1588,19 +1592,19 @@ func TestDetectCycles_WaitsForDoesNotCreateCycle(t *testing.T) {
 		t.Fatalf("failed to add A waits-for B: %v", err)
 	}
 
-	// B waits-for A should succeed (waits-for doesn't create blocking cycles)
+	// B waits-for A closes the loop and must be rejected
 	dep2 := &types.Dependency{IssueID: issueB.ID, DependsOnID: issueA.ID, Type: types.DepWaitsFor}
-	if err := store.AddDependency(ctx, dep2, "tester"); err != nil {
-		t.Fatalf("expected waits-for cycle to be allowed, got error: %v", err)
+	err := store.AddDependency(ctx, dep2, "tester")
+	if err == nil || !strings.Contains(err.Error(), "cycle") {
+		t.Fatalf("expected waits-for cycle to be rejected, got: %v", err)
 	}
 
-	// DetectCycles should find nothing (waits-for excluded from cycle detection)
 	cycles, err := store.DetectCycles(ctx)
 	if err != nil {
 		t.Fatalf("DetectCycles failed: %v", err)
 	}
 	if len(cycles) != 0 {
-		t.Errorf("expected no cycles (waits-for excluded), found %d", len(cycles))
+		t.Errorf("expected no cycles since the closing edge was refused, found %d", len(cycles))
 	}
 }
 
@@ -1643,6 +1647,83 @@ func TestAddDependency_SelfDependencyAllTypes(t *testing.T) {
 	}
 }
 
+// guardedDepTypes are the edge kinds the self-dep and duplicate guards must
+// cover: a scheduling edge, a loose knowledge edge, and the hierarchy edge.
+var guardedDepTypes = []types.DependencyType{types.DepBlocks, types.DepRelated, types.DepParentChild}
+
+func TestAddDependency_SelfDependencyRejectedWithoutCycleCheck(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	issue := &types.Issue{ID: "self-dep-skip", Title: "Self Dep Skip", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("failed to create issue: %v", err)
+	}
+
+	// Bulk wiring skips the per-edge cycle probe; the self-dep guard must not
+	// ride on it.
+	for _, depType := range guardedDepTypes {
+		dep := &types.Dependency{IssueID: issue.ID, DependsOnID: issue.ID, Type: depType}
+		err := store.RunInTransaction(ctx, "test: self dep", func(tx storage.Transaction) error {
+			return tx.AddDependencyWithOptions(ctx, dep, "tester", storage.DependencyAddOptions{SkipCycleCheck: true})
+		})
+		if !errors.Is(err, domain.ErrSelfDependency) {
+			t.Errorf("type %q: err = %v, want ErrSelfDependency", depType, err)
+		}
+	}
+
+	records, err := store.GetDependencyRecords(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetDependencyRecords failed: %v", err)
+	}
+	if len(records) != 0 {
+		t.Errorf("expected no edges after rejected self-deps, got %d", len(records))
+	}
+}
+
+func TestAddDependency_RejectDuplicate(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	for _, depType := range guardedDepTypes {
+		from := &types.Issue{ID: "dup-from-" + string(depType), Title: "From", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		to := &types.Issue{ID: "dup-to-" + string(depType), Title: "To", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeEpic}
+		for _, issue := range []*types.Issue{from, to} {
+			if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+				t.Fatalf("failed to create issue: %v", err)
+			}
+		}
+
+		dep := &types.Dependency{IssueID: from.ID, DependsOnID: to.ID, Type: depType}
+		opts := storage.DependencyAddOptions{RejectDuplicate: true}
+		if err := store.AddDependencyWithOptions(ctx, dep, "tester", opts); err != nil {
+			t.Fatalf("type %q: first add failed: %v", depType, err)
+		}
+		err := store.AddDependencyWithOptions(ctx, dep, "tester", opts)
+		if !errors.Is(err, domain.ErrDuplicateDependency) {
+			t.Errorf("type %q: duplicate add err = %v, want ErrDuplicateDependency", depType, err)
+		}
+		// Without the option a re-add stays idempotent (protocol G1.3).
+		if err := store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Errorf("type %q: idempotent re-add failed: %v", depType, err)
+		}
+
+		records, err := store.GetDependencyRecords(ctx, from.ID)
+		if err != nil {
+			t.Fatalf("GetDependencyRecords failed: %v", err)
+		}
+		if len(records) != 1 {
+			t.Errorf("type %q: expected exactly one edge, got %d", depType, len(records))
+		}
+	}
+}
+
 // =============================================================================
 // Ready Work + Blocked Issues Integration Tests
 // =============================================================================
@@ -117,7 +117,7 @@ func TestDependencyEventEmission(t *testing.T) {
 			t.Fatalf("AddDependency: %v", err)
 		}
 		// Only the explicit bd dep remove verb (EmitEvent) records history.
-		if err := store.RemoveDependencyWithOptions(ctx, src, tgt, "remover", storage.DependencyRemoveOptions{EmitEvent: true}); err != nil {
+		if _, err := store.RemoveDependencyWithOptions(ctx, src, tgt, "remover", storage.DependencyRemoveOptions{EmitEvent: true}); err != nil {
 			t.Fatalf("RemoveDependency: %v", err)
 		}
 
@@ -150,7 +150,7 @@ func TestDependencyEventEmission(t *testing.T) {
 		createPerm(t, ctx, store, tgt)
 		// No edge was ever added; a no-op remove must not record an event even
 		// when the explicit verb asks to emit — there is no edge to report.
-		if err := store.RemoveDependencyWithOptions(ctx, src, tgt, "remover", storage.DependencyRemoveOptions{EmitEvent: true}); err != nil {
+		if _, err := store.RemoveDependencyWithOptions(ctx, src, tgt, "remover", storage.DependencyRemoveOptions{EmitEvent: true}); err != nil {
 			t.Fatalf("RemoveDependency (no-op): %v", err)
 		}
 		if n := len(depEventsOfType(t, ctx, store, src, types.EventDependencyRemoved)); n != 0 {
@@ -262,7 +262,8 @@ func TestRunInTransactionAddRemoveDependencyCommitsEvents(t *testing.T) {
 	assertCommittedEventCount(ctx, t, store.db, src, types.EventDependencyAdded, 1)
 
 	if err := store.RunInTransaction(ctx, "test: tx remove dependency emits event", func(tx storage.Transaction) error {
-		return tx.RemoveDependencyWithOptions(ctx, src, tgt, "remover", storage.DependencyRemoveOptions{EmitEvent: true})
+		_, err := tx.RemoveDependencyWithOptions(ctx, src, tgt, "remover", storage.DependencyRemoveOptions{EmitEvent: true})
+		return err
 	}); err != nil {
 		t.Fatalf("RunInTransaction RemoveDependency: %v", err)
 	}
@@ -333,7 +334,8 @@ func TestRunInTransactionWispSourceDependencyRoutesToWispEvents(t *testing.T) {
 	assertEventCountInTable(ctx, t, store.db, "events", src, types.EventDependencyAdded, 0)
 
 	if err := store.RunInTransaction(ctx, "test: tx wisp remove dependency", func(tx storage.Transaction) error {
-		return tx.RemoveDependencyWithOptions(ctx, src, tgt, "remover", storage.DependencyRemoveOptions{EmitEvent: true})
+		_, err := tx.RemoveDependencyWithOptions(ctx, src, tgt, "remover", storage.DependencyRemoveOptions{EmitEvent: true})
+		return err
 	}); err != nil {
 		t.Fatalf("RunInTransaction wisp RemoveDependency: %v", err)
 	}
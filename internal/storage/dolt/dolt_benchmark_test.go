@@ -416,6 +416,51 @@ func BenchmarkBulkCreate1000Issues(b *testing.B) {
 	b.ReportMetric(float64(batchSize), "issues/op")
 }
 
+// BenchmarkCreateBatchCommitVsPerIssue compares creating 50 issues one at a
+// time (one DOLT_COMMIT each, as repeated `bd create` calls do) with a single
+// batched create and commit (`bd create --batch-commit`).
+func BenchmarkCreateBatchCommitVsPerIssue(b *testing.B) {
+	store, cleanup := setupBenchStore(b)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	const batchSize = 50
+	newIssues := func(prefix string, i int) []*types.Issue {
+		issues := make([]*types.Issue, batchSize)
+		for j := range issues {
+			issues[j] = &types.Issue{
+				ID:        fmt.Sprintf("%s-%d-%d", prefix, i, j),
+				Title:     fmt.Sprintf("Batch commit issue %d-%d", i, j),
+				Status:    types.StatusOpen,
+				Priority:  2,
+				IssueType: types.TypeTask,
+			}
+		}
+		return issues
+	}
+
+	b.Run("per-issue", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, issue := range newIssues("per", i) {
+				if err := store.CreateIssue(ctx, issue, "bench"); err != nil {
+					b.Fatalf("failed to create issue: %v", err)
+				}
+			}
+		}
+		b.ReportMetric(float64(batchSize), "issues/op")
+	})
+
+	b.Run("batch-commit", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := store.CreateIssues(ctx, newIssues("batch", i), "bench"); err != nil {
+				b.Fatalf("failed to create issues: %v", err)
+			}
+		}
+		b.ReportMetric(float64(batchSize), "issues/op")
+	})
+}
+
 // =============================================================================
 // Search Benchmarks
 // =============================================================================
@@ -492,6 +537,52 @@ func BenchmarkSearchWithFilter(b *testing.B) {
 	}
 }
 
+// BenchmarkPreparedStatements compares the create-then-read loop the
+// concurrent repro drives (create an issue, list open work, read the new
+// issue's dependencies) with the statement cache on and off. Compare the
+// ns/op of the two sub-benchmarks; "prepared" should be lower once the hot
+// texts are cached after the first iteration.
+func BenchmarkPreparedStatements(b *testing.B) {
+	for _, prepared := range []bool{true, false} {
+		name := "unprepared"
+		if prepared {
+			name = "prepared"
+		}
+		b.Run(name, func(b *testing.B) {
+			store, cleanup := setupBenchStore(b)
+			defer cleanup()
+			if !prepared {
+				_ = store.stmts.close()
+				store.stmts = nil
+			}
+
+			ctx := context.Background()
+			openStatus := types.StatusOpen
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				issue := &types.Issue{
+					Title:     fmt.Sprintf("Prepared Bench %d", i),
+					Status:    types.StatusOpen,
+					Priority:  (i % 4) + 1,
+					IssueType: types.TypeTask,
+				}
+				if err := store.CreateIssue(ctx, issue, "bench"); err != nil {
+					b.Fatalf("failed to create issue: %v", err)
+				}
+				if _, err := store.SearchIssues(ctx, "", types.IssueFilter{Status: &openStatus, Limit: 20}); err != nil {
+					b.Fatalf("failed to search: %v", err)
+				}
+				if _, err := store.GetDependencies(ctx, issue.ID); err != nil {
+					b.Fatalf("failed to get dependencies: %v", err)
+				}
+			}
+			b.StopTimer()
+			b.ReportMetric(float64(store.stmts.len()), "stmts")
+		})
+	}
+}
+
 // =============================================================================
 // Dependency Benchmarks
 // =============================================================================
@@ -1337,6 +1428,87 @@ func BenchmarkPerfAddDependencyCycleCheck_DiamondDAG(b *testing.B) {
 	}
 }
 
+// BenchmarkPerfDependencyTree_10kEdges compares `bd dep tree` on a
+// synthetic 10k-edge graph (issue i blocks on 2i+1 and 2i+2) built from the
+// in-memory dependency index against the per-node walk it replaced, which
+// issued one issue read and one dependency query for every visited node.
+func BenchmarkPerfDependencyTree_10kEdges(b *testing.B) {
+	store, cleanup := setupBenchStore(b)
+	defer cleanup()
+
+	const issueCount = 10001
+	id := func(i int) string { return fmt.Sprintf("bench-perf-tree-%05d", i) }
+	issues := make([]*types.Issue, 0, issueCount)
+	for i := 0; i < issueCount; i++ {
+		issue := &types.Issue{
+			ID:        id(i),
+			Title:     fmt.Sprintf("Tree node %05d", i),
+			Status:    types.StatusOpen,
+			Priority:  2,
+			IssueType: types.TypeTask,
+		}
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < issueCount {
+				issue.Dependencies = append(issue.Dependencies, &types.Dependency{DependsOnID: id(child), Type: types.DepBlocks})
+			}
+		}
+		issues = append(issues, issue)
+	}
+	createBenchIssueBatch(b, store, issues)
+
+	ctx := context.Background()
+	const maxDepth = 50
+	b.Run("per-node", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			visited := make(map[string]bool)
+			var walk func(issueID string, depth int) error
+			walk = func(issueID string, depth int) error {
+				if depth >= maxDepth || visited[issueID] {
+					return nil
+				}
+				visited[issueID] = true
+				if _, err := store.GetIssue(ctx, issueID); err != nil {
+					return err
+				}
+				deps, err := store.GetDependenciesWithMetadata(ctx, issueID)
+				if err != nil {
+					return err
+				}
+				for _, dep := range deps {
+					if err := walk(dep.ID, depth+1); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			if err := walk(id(0), 0); err != nil {
+				b.Fatalf("per-node walk: %v", err)
+			}
+			if len(visited) != issueCount {
+				b.Fatalf("per-node walk visited %d issues, want %d", len(visited), issueCount)
+			}
+		}
+	})
+	b.Run("index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tree, err := store.GetDependencyTree(ctx, id(0), maxDepth, false, false)
+			if err != nil {
+				b.Fatalf("GetDependencyTree: %v", err)
+			}
+			if len(tree) != issueCount {
+				b.Fatalf("GetDependencyTree returned %d nodes, want %d", len(tree), issueCount)
+			}
+		}
+	})
+	b.Run("cycles", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := store.DetectCycles(ctx); err != nil {
+				b.Fatalf("DetectCycles: %v", err)
+			}
+		}
+	})
+}
+
 func BenchmarkPerfReadyWorkLimited_LargeBlockedGraph(b *testing.B) {
 	store, cleanup := setupBenchStore(b)
 	defer cleanup()
@@ -1392,6 +1564,44 @@ func BenchmarkPerfReadyWorkLimited_LargeBlockedGraph(b *testing.B) {
 	}
 }
 
+// BenchmarkPerfReadyWorkSmallLimit_LargeDB shows `bd ready -n 5` on a large
+// backlog costs a page, not the backlog: the LIMIT (plus the one-row probe
+// bd ready adds) is applied in SQL after the ORDER BY, so the unlimited
+// sub-benchmark is the baseline the limited one should be far below.
+func BenchmarkPerfReadyWorkSmallLimit_LargeDB(b *testing.B) {
+	store, cleanup := setupBenchStore(b)
+	defer cleanup()
+
+	const issueCount = 10000
+	issues := make([]*types.Issue, 0, issueCount)
+	for i := 0; i < issueCount; i++ {
+		issues = append(issues, &types.Issue{
+			ID:        fmt.Sprintf("bench-perf-small-limit-%05d", i),
+			Title:     fmt.Sprintf("Ready issue %05d", i),
+			Status:    types.StatusOpen,
+			Priority:  i % 5,
+			IssueType: types.TypeTask,
+		})
+	}
+	createBenchIssueBatch(b, store, issues)
+
+	ctx := context.Background()
+	for _, limit := range []int{6, 0} {
+		b.Run(fmt.Sprintf("limit=%d", limit), func(b *testing.B) {
+			filter := types.WorkFilter{Status: types.StatusOpen, Limit: limit, SortPolicy: types.SortPolicyPriority}
+			for i := 0; i < b.N; i++ {
+				results, err := store.GetReadyWork(ctx, filter)
+				if err != nil {
+					b.Fatalf("GetReadyWork: %v", err)
+				}
+				if limit > 0 && len(results) != limit {
+					b.Fatalf("GetReadyWork returned %d issues, want %d", len(results), limit)
+				}
+			}
+		})
+	}
+}
+
 func BenchmarkPerfReadyWorkLimited_ExampleOrgWispHeavy(b *testing.B) {
 	const (
 		wispCount    = 8000
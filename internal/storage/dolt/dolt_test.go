@@ -770,7 +770,7 @@ func TestDoltStoreLabels(t *testing.T) {
 	}
 
 	// Remove label
-	if err := store.RemoveLabel(ctx, issue.ID, "bug", "tester"); err != nil {
+	if _, err := store.RemoveLabel(ctx, issue.ID, "bug", "tester"); err != nil {
 		t.Fatalf("failed to remove label: %v", err)
 	}
 
@@ -25,17 +25,20 @@ func (s *DoltStore) AddLabel(ctx context.Context, issueID, label, actor string)
 
 // RemoveLabel removes a label from an issue.
 // Delegates SQL work to issueops.RemoveLabelInTx which handles wisp routing.
-func (s *DoltStore) RemoveLabel(ctx context.Context, issueID, label, actor string) error {
+func (s *DoltStore) RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error) {
 	isWisp := s.isActiveWisp(ctx, issueID)
+	var removed int64
 	if err := s.withRetryTx(ctx, func(tx *sql.Tx) error {
-		return issueops.RemoveLabelInTx(ctx, tx, "", "", issueID, label, actor)
-	}); err != nil {
+		var err error
+		removed, err = issueops.RemoveLabelInTx(ctx, tx, "", "", issueID, label, actor)
 		return err
+	}); err != nil {
+		return 0, err
 	}
-	if isWisp {
-		return nil
+	if isWisp || removed == 0 {
+		return removed, nil
 	}
-	return s.doltAddAndCommit(ctx, []string{"events", "labels"}, fmt.Sprintf("bd: label remove %s", issueID))
+	return removed, s.doltAddAndCommit(ctx, []string{"events", "labels"}, fmt.Sprintf("bd: label remove %s", issueID))
 }
 
 // GetLabels retrieves all labels for an issue
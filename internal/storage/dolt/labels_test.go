@@ -262,7 +262,7 @@ func TestAddAndRemoveLabel(t *testing.T) {
 	}
 
 	// Remove label
-	if err := store.RemoveLabel(ctx, issue.ID, "test-label", "tester"); err != nil {
+	if _, err := store.RemoveLabel(ctx, issue.ID, "test-label", "tester"); err != nil {
 		t.Fatalf("failed to remove label: %v", err)
 	}
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/issueops"
 )
 
 // loadMetadataSchema reads the metadata validation config from YAML and
@@ -108,7 +109,12 @@ func toFloat64(v interface{}) (float64, bool) {
 // In "warn" mode, prints warnings to stderr and returns nil.
 // In "error" mode, returns the first validation error.
 // In "none" mode (or if config is not initialized), does nothing.
+// A metadata.schema JSON Schema file is checked first and always rejects.
 func validateMetadataIfConfigured(metadata json.RawMessage) error {
+	if err := issueops.ValidateMetadataJSONSchemaIfConfigured(metadata); err != nil {
+		return err
+	}
+
 	schema := loadMetadataSchema()
 	if schema.Mode == "none" {
 		return nil
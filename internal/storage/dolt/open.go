@@ -301,6 +301,10 @@ func applyResolvedConfig(ctx context.Context, beadsDir string, fileCfg *configfi
 		}
 	}
 
+	if cfg.ConnectTimeout == 0 {
+		cfg.ConnectTimeout = resolveConnectTimeout(beadsDir)
+	}
+
 	return nil
 }
 
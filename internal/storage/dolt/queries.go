@@ -13,7 +13,7 @@ import (
 // Delegates to issueops.SearchIssuesInTx for shared query logic.
 func (s *DoltStore) SearchIssues(ctx context.Context, query string, filter types.IssueFilter) ([]*types.Issue, error) {
 	var result []*types.Issue
-	err := s.withReadTx(ctx, func(tx *sql.Tx) error {
+	err := s.withPreparedReadTx(ctx, func(tx issueops.DBTX) error {
 		var err error
 		result, err = issueops.SearchIssuesInTx(ctx, tx, query, filter)
 		return err
@@ -45,7 +45,7 @@ func (s *DoltStore) SearchIssuesWithCounts(ctx context.Context, query string, fi
 
 func (s *DoltStore) GetReadyWork(ctx context.Context, filter types.WorkFilter) ([]*types.Issue, error) {
 	var result []*types.Issue
-	err := s.withReadTx(ctx, func(tx *sql.Tx) error {
+	err := s.withPreparedReadTx(ctx, func(tx issueops.DBTX) error {
 		var err error
 		result, err = issueops.GetReadyWorkInTx(ctx, tx, filter)
 		return err
@@ -79,7 +79,7 @@ func (s *DoltStore) CountReadyWork(ctx context.Context, filter types.WorkFilter)
 
 func (s *DoltStore) GetBlockedIssues(ctx context.Context, filter types.WorkFilter) ([]*types.BlockedIssue, error) {
 	var result []*types.BlockedIssue
-	err := s.withReadTx(ctx, func(tx *sql.Tx) error {
+	err := s.withPreparedReadTx(ctx, func(tx issueops.DBTX) error {
 		var err error
 		result, err = issueops.GetBlockedIssuesInTx(ctx, tx, filter)
 		return err
@@ -111,32 +111,15 @@ func (s *DoltStore) GetStaleIssues(ctx context.Context, filter types.StaleFilter
 
 // GetStatistics returns summary statistics
 func (s *DoltStore) GetStatistics(ctx context.Context) (*types.Statistics, error) {
-	stats := &types.Statistics{}
-
+	var stats *types.Statistics
 	err := s.withReadTx(ctx, func(tx *sql.Tx) error {
-		return issueops.ScanIssueCountsInTx(ctx, tx, stats)
+		var err error
+		stats, err = issueops.GetStatisticsInTx(ctx, tx)
+		return err
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get statistics: %w", err)
 	}
-
-	var blockedCount int
-	if err := s.withReadTx(ctx, func(tx *sql.Tx) error {
-		return tx.QueryRowContext(ctx, `
-			SELECT COUNT(*) FROM issues
-			WHERE is_blocked = 1 AND status <> 'closed' AND status <> 'pinned'
-		`).Scan(&blockedCount)
-	}); err != nil {
-		return nil, fmt.Errorf("failed to count blocked issues: %w", err)
-	}
-	stats.BlockedIssues = &blockedCount
-
-	ready := stats.OpenIssues - blockedCount
-	if ready < 0 {
-		ready = 0
-	}
-	stats.ReadyIssues = &ready
-
 	return stats, nil
 }
 
@@ -2475,13 +2475,13 @@ func TestGetStatistics_ReadyIssuesExcludesBlocked(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	// 3 open issues, 1 blocked => ready = 3 - 1 = 2
+	// 3 open issues, 1 blocked => the blocker and the free issue are ready
 	if stats.ReadyIssues == nil || *stats.ReadyIssues != 2 {
 		got := -1
 		if stats.ReadyIssues != nil {
 			got = *stats.ReadyIssues
 		}
-		t.Errorf("expected 2 ready issues (3 open - 1 blocked), got %d", got)
+		t.Errorf("expected 2 ready issues (blocker and free issue), got %d", got)
 	}
 }
 
@@ -0,0 +1,198 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+)
+
+// maxCachedStmts bounds the prepared-statement cache. The hot read paths
+// build SQL dynamically (filter predicates, IN-list arity), so the set of
+// distinct texts is open-ended; once the cap is reached further texts run
+// unprepared rather than evicting statements another goroutine may be using.
+const maxCachedStmts = 256
+
+// stmtCache holds prepared statements for the store's hot read queries,
+// keyed by SQL text. Each entry is a *sql.Stmt on the pool, so database/sql
+// prepares it lazily on every connection that runs it and reuses that
+// connection-level handle afterwards — with the MySQL driver this replaces
+// the prepare/execute/close round trips a parameterized QueryContext costs
+// on every call.
+//
+// Texts the server refuses to prepare are remembered and always run
+// unprepared. The cache is closed with the store; nil is a valid, disabled
+// cache.
+type stmtCache struct {
+	db *sql.DB
+
+	mu         sync.Mutex
+	stmts      map[string]*sql.Stmt
+	unprepared map[string]bool
+	closed     bool
+}
+
+func newStmtCache(db *sql.DB) *stmtCache {
+	return &stmtCache{
+		db:         db,
+		stmts:      make(map[string]*sql.Stmt),
+		unprepared: make(map[string]bool),
+	}
+}
+
+// get returns the prepared statement for query, or nil when it should run
+// unprepared this time. get never prepares: a transaction holds its
+// connection, and with a single-connection pool (embedded mode) preparing
+// on the pool from inside the transaction would wait for that connection
+// forever. Misses are prepared by prepare once the transaction has ended.
+func (c *stmtCache) get(query string) *sql.Stmt {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil
+	}
+	return c.stmts[query]
+}
+
+// prepare prepares each query not yet cached. It stops early when the cache
+// is disabled, closed or full. Texts the server rejects are remembered so
+// they are not re-prepared on every call. The lock is not held across
+// PrepareContext: another goroutine may own the pool's only connection and
+// be waiting in get.
+func (c *stmtCache) prepare(ctx context.Context, queries []string) {
+	if c == nil {
+		return
+	}
+	for _, query := range queries {
+		c.mu.Lock()
+		if c.closed || len(c.stmts) >= maxCachedStmts {
+			c.mu.Unlock()
+			return
+		}
+		_, cached := c.stmts[query]
+		skip := cached || c.unprepared[query]
+		c.mu.Unlock()
+		if skip {
+			continue
+		}
+
+		stmt, err := c.db.PrepareContext(ctx, query)
+		if err != nil {
+			// A cancelled context says nothing about the statement; only
+			// remember genuine prepare refusals.
+			if ctx.Err() != nil {
+				return
+			}
+			c.mu.Lock()
+			c.unprepared[query] = true
+			c.mu.Unlock()
+			continue
+		}
+
+		c.mu.Lock()
+		_, raced := c.stmts[query]
+		keep := !c.closed && !raced
+		if keep {
+			c.stmts[query] = stmt
+		}
+		c.mu.Unlock()
+		if !keep {
+			_ = stmt.Close()
+		}
+	}
+}
+
+// len reports how many statements are currently prepared.
+func (c *stmtCache) len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.stmts)
+}
+
+// close releases every prepared statement. It must run before the pool is
+// closed; later get calls return nil.
+func (c *stmtCache) close() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var err error
+	for query, stmt := range c.stmts {
+		err = errors.Join(err, stmt.Close())
+		delete(c.stmts, query)
+	}
+	c.closed = true
+	return err
+}
+
+// preparedTx runs a transaction's statements through the store's statement
+// cache. It satisfies issueops.DBTX, so the shared query code is unchanged:
+// only the execution path differs. Statements bound with Tx.StmtContext are
+// released when the transaction ends; the pool-level statement survives.
+// Texts that missed the cache are collected in missed for preparing after
+// the transaction.
+type preparedTx struct {
+	*sql.Tx
+	cache  *stmtCache
+	missed *[]string
+}
+
+var _ issueops.DBTX = preparedTx{}
+
+func (p preparedTx) stmt(ctx context.Context, query string) *sql.Stmt {
+	if p.cache == nil {
+		return nil
+	}
+	if stmt := p.cache.get(query); stmt != nil {
+		return p.Tx.StmtContext(ctx, stmt)
+	}
+	if p.missed != nil {
+		*p.missed = append(*p.missed, query)
+	}
+	return nil
+}
+
+func (p preparedTx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if stmt := p.stmt(ctx, query); stmt != nil {
+		return stmt.ExecContext(ctx, args...)
+	}
+	return p.Tx.ExecContext(ctx, query, args...)
+}
+
+func (p preparedTx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if stmt := p.stmt(ctx, query); stmt != nil {
+		return stmt.QueryContext(ctx, args...)
+	}
+	return p.Tx.QueryContext(ctx, query, args...)
+}
+
+func (p preparedTx) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	if stmt := p.stmt(ctx, query); stmt != nil {
+		return stmt.QueryRowContext(ctx, args...)
+	}
+	return p.Tx.QueryRowContext(ctx, query, args...)
+}
+
+// withPreparedReadTx is withReadTx for the hot read paths: fn receives a
+// DBTX that reuses cached prepared statements, and texts seen for the first
+// time are prepared once the read transaction has released its connection.
+func (s *DoltStore) withPreparedReadTx(ctx context.Context, fn func(tx issueops.DBTX) error) error {
+	var missed []string
+	err := s.withReadTx(ctx, func(tx *sql.Tx) error {
+		missed = missed[:0]
+		return fn(preparedTx{Tx: tx, cache: s.stmts, missed: &missed})
+	})
+	if err == nil && len(missed) > 0 {
+		s.stmts.prepare(ctx, missed)
+	}
+	return err
+}
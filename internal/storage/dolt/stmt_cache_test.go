@@ -0,0 +1,116 @@
+package dolt
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// TestStmtCache_PreparesOncePerText runs the same query through three
+// transactions: the first runs unprepared and queues the text, the PREPARE
+// happens between transactions, and later runs reuse it.
+func TestStmtCache_PreparesOncePerText(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	ctx := context.Background()
+	cache := newStmtCache(db)
+
+	const q = "SELECT id FROM issues WHERE status = ?"
+	mock.ExpectBegin()
+	mock.ExpectQuery(q).WithArgs("open").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("bd-1"))
+	mock.ExpectRollback()
+	prep := mock.ExpectPrepare(q)
+	for i := 0; i < 2; i++ {
+		mock.ExpectBegin()
+		prep.ExpectQuery().WithArgs("open").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("bd-1"))
+		mock.ExpectRollback()
+	}
+	prep.WillBeClosed()
+
+	for i := 0; i < 3; i++ {
+		var missed []string
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ptx := preparedTx{Tx: tx, cache: cache, missed: &missed}
+		rows, err := ptx.QueryContext(ctx, q, "open")
+		if err != nil {
+			t.Fatalf("query %d: %v", i, err)
+		}
+		for rows.Next() {
+		}
+		_ = rows.Close()
+		_ = tx.Rollback()
+		cache.prepare(ctx, missed)
+	}
+	if got := cache.len(); got != 1 {
+		t.Errorf("cached statements = %d, want 1", got)
+	}
+	if err := cache.close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestStmtCache_FallsBackWhenPrepareRejected checks a text the server will
+// not prepare runs unprepared and is not retried.
+func TestStmtCache_FallsBackWhenPrepareRejected(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	ctx := context.Background()
+	cache := newStmtCache(db)
+
+	const q = "CALL DOLT_STATUS()"
+	mock.ExpectPrepare(q).WillReturnError(errors.New("not supported"))
+	cache.prepare(ctx, []string{q})
+	if stmt := cache.get(q); stmt != nil {
+		t.Fatal("expected nil statement after prepare failure")
+	}
+	// No second ExpectPrepare: a retry would fail ExpectationsWereMet.
+	cache.prepare(ctx, []string{q})
+	if stmt := cache.get(q); stmt != nil {
+		t.Fatal("expected rejected text to stay unprepared")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStmtCache_NilAndClosedAreDisabled(t *testing.T) {
+	var nilCache *stmtCache
+	nilCache.prepare(context.Background(), []string{"SELECT 1"})
+	if nilCache.get("SELECT 1") != nil {
+		t.Error("nil cache returned a statement")
+	}
+	if err := nilCache.close(); err != nil {
+		t.Errorf("nil close: %v", err)
+	}
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	cache := newStmtCache(db)
+	if err := cache.close(); err != nil {
+		t.Fatal(err)
+	}
+	cache.prepare(context.Background(), []string{"SELECT 1"})
+	if cache.get("SELECT 1") != nil {
+		t.Error("closed cache returned a statement")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -244,6 +244,7 @@ func shouldStopAutoStartedServerOnClose(cfg *Config) bool {
 var _ storage.DoltStorage = (*DoltStore)(nil)
 var _ storage.RawDBAccessor = (*DoltStore)(nil)
 var _ storage.StoreLocator = (*DoltStore)(nil)
+var _ storage.BackendVersionReporter = (*DoltStore)(nil)
 var _ storage.LifecycleManager = (*DoltStore)(nil)
 var _ storage.PendingCommitter = (*DoltStore)(nil)
 var _ storage.GarbageCollector = (*DoltStore)(nil)
@@ -280,6 +281,9 @@ type DoltStore struct {
 	// Circuit breaker for Dolt server connections
 	breaker *circuitBreaker
 
+	// Prepared statements for the hot read paths (nil = disabled)
+	stmts *stmtCache
+
 	// Version control config
 	committerName  string
 	committerEmail string
@@ -386,6 +390,11 @@ type Config struct {
 	// benefit when the server is local and stable.
 	ConnMaxLifetime time.Duration
 
+	// ConnectTimeout bounds reaching the server: the TCP reachability probe
+	// and the MySQL handshake (0 = 500ms probe, DefaultConnectTimeout
+	// handshake). Set via dolt.connect-timeout or BEADS_DOLT_CONNECT_TIMEOUT.
+	ConnectTimeout time.Duration
+
 	// ConnMaxIdleTime overrides how long a connection may sit idle in the pool
 	// before the pool retires it (0 = default 20s). This must stay below the
 	// dolt sql-server wait_timeout (currently 30s) so the pool retires an idle
@@ -1300,10 +1309,10 @@ func newServerMode(ctx context.Context, cfg *Config) (*DoltStore, error) {
 	var dialErr error
 	if cfg.ServerSocket != "" {
 		addr = cfg.ServerSocket
-		conn, dialErr = net.DialTimeout("unix", cfg.ServerSocket, 500*time.Millisecond)
+		conn, dialErr = net.DialTimeout("unix", cfg.ServerSocket, cfg.probeTimeout())
 	} else {
 		addr = net.JoinHostPort(cfg.ServerHost, fmt.Sprintf("%d", cfg.ServerPort))
-		conn, dialErr = net.DialTimeout("tcp", addr, 500*time.Millisecond)
+		conn, dialErr = net.DialTimeout("tcp", addr, cfg.probeTimeout())
 	}
 	if dialErr != nil {
 		// Auto-start: if enabled and connecting locally via TCP, start a server.
@@ -1315,10 +1324,9 @@ func newServerMode(ctx context.Context, cfg *Config) (*DoltStore, error) {
 		if canAutoStart {
 			port, startedByUs, startErr := doltserver.EnsureRunningDetailed(resolvedBeadsDir)
 			if startErr != nil {
-				return nil, fmt.Errorf("Dolt server unreachable at %s and auto-start failed: %w\n\n"+
+				return nil, serverUnreachableError(cfg, fmt.Errorf("auto-start failed: %w", startErr),
 					"To start manually: bd dolt start\n"+
-					"To disable auto-start: set dolt.auto-start: false in .beads/config.yaml",
-					addr, startErr)
+						"To disable auto-start: set dolt.auto-start: false in .beads/config.yaml")
 			}
 			// Only tests should stop auto-started servers on Close(). In normal
 			// repo-local server mode, leaving the server up avoids endpoint churn
@@ -1339,7 +1347,7 @@ func newServerMode(ctx context.Context, cfg *Config) (*DoltStore, error) {
 				breaker = maybeNewCircuitBreaker(cfg.ServerHost, cfg.ServerPort, cfg.Database)
 			}
 			// Retry connection with longer timeout (server just started)
-			conn, dialErr = net.DialTimeout("tcp", addr, 2*time.Second)
+			conn, dialErr = net.DialTimeout("tcp", addr, max(autoStartRedialTimeout, cfg.ConnectTimeout))
 			if dialErr != nil {
 				// Release auto-start ref on connection failure
 				if autoStartedDir != "" {
@@ -1348,8 +1356,9 @@ func newServerMode(ctx context.Context, cfg *Config) (*DoltStore, error) {
 				if breaker != nil {
 					breaker.RecordFailure()
 				}
-				return nil, fmt.Errorf("Dolt server auto-started but still unreachable at %s: %w\n\n"+
-					"Check logs: %s", addr, dialErr, doltserver.LogPath(resolvedBeadsDir))
+				return nil, serverUnreachableError(cfg, dialErr,
+					"The server was auto-started but is not accepting connections.\n"+
+						"Check logs: "+doltserver.LogPath(resolvedBeadsDir))
 			}
 		} else {
 			if breaker != nil {
@@ -1368,8 +1377,7 @@ func newServerMode(ctx context.Context, cfg *Config) (*DoltStore, error) {
 			} else {
 				hint = "The Dolt server may not be running. Try:\n  bd dolt start"
 			}
-			return nil, fmt.Errorf("Dolt server unreachable at %s: %w\n\n%s",
-				addr, dialErr, hint)
+			return nil, serverUnreachableError(cfg, dialErr, hint)
 		}
 	}
 	_ = conn.Close()
@@ -1417,6 +1425,7 @@ func newServerMode(ctx context.Context, cfg *Config) (*DoltStore, error) {
 		database:             cfg.Database,
 		connStr:              connStr,
 		breaker:              breaker,
+		stmts:                newStmtCache(db),
 		committerName:        cfg.CommitterName,
 		committerEmail:       cfg.CommitterEmail,
 		remote:               cfg.Remote,
@@ -1596,6 +1605,7 @@ func buildServerDSN(cfg *Config, database string) string {
 		User:     cfg.ServerUser,
 		Password: cfg.ServerPassword,
 		Database: database,
+		Timeout:  cfg.ConnectTimeout,
 		TLS:      cfg.ServerTLS,
 	}
 	// Parse the base DSN and add pool-specific timeouts.
@@ -1734,7 +1744,7 @@ func openServerConnection(ctx context.Context, cfg *Config) (*sql.DB, string, er
 	// the existence proof. connReady must be set before returning the pool, or the defer
 	// above would close the *sql.DB we just handed the caller.
 	if cfg.Gateway {
-		if err := db.PingContext(ctx); err != nil {
+		if err := pingWithin(ctx, db, cfg.handshakeTimeout()); err != nil {
 			return nil, "", fmt.Errorf("failed to connect to gateway server %s:%d (database %q): %w",
 				cfg.ServerHost, cfg.ServerPort, cfg.Database, err)
 		}
@@ -1751,6 +1761,14 @@ func openServerConnection(ctx context.Context, cfg *Config) (*sql.DB, string, er
 	}
 	defer func() { _ = initDB.Close() }()
 
+	// Bound the handshake separately from later queries: a listener that
+	// accepts TCP but never answers would otherwise hang until the read timeout.
+	if err := pingWithin(ctx, initDB, cfg.handshakeTimeout()); err != nil {
+		return nil, "", serverUnreachableError(cfg, err,
+			"The port accepts connections but did not complete a MySQL handshake.\n"+
+				"Is another process listening there? Check: bd dolt status")
+	}
+
 	// Validate database name to prevent SQL injection via backtick escaping
 	if err := ValidateDatabaseName(cfg.Database); err != nil {
 		return nil, "", fmt.Errorf("invalid database name %q: %w", cfg.Database, err)
@@ -2012,6 +2030,10 @@ func (s *DoltStore) Close() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	var err error
+	// Prepared statements pin pool connections; release them first.
+	if cerr := s.stmts.close(); cerr != nil {
+		err = errors.Join(err, cerr)
+	}
 	if s.db != nil {
 		if cerr := doltutil.CloseWithTimeout("db", s.db.Close); cerr != nil {
 			// Timeout is non-fatal for cleanup - just log it
@@ -2131,6 +2153,11 @@ func (s *DoltStore) UnderlyingDB() *sql.DB {
 	return s.db
 }
 
+// BackendVersions returns the Dolt server version and the applied schema version.
+func (s *DoltStore) BackendVersions(ctx context.Context) (string, int, error) {
+	return schema.BackendVersions(ctx, s.db)
+}
+
 // =============================================================================
 // Version Control Operations (Dolt-specific extensions)
 // =============================================================================
@@ -328,6 +328,24 @@ func (t *doltTransaction) SearchIssues(ctx context.Context, query string, filter
 			args = append(args, pattern, pattern)
 		}
 	}
+	if len(filter.SearchTerms) > 0 {
+		var termClauses []string
+		for _, term := range filter.SearchTerms {
+			if term = strings.TrimSpace(term); term == "" {
+				continue
+			}
+			termClauses = append(termClauses, "(LOWER(title) LIKE ? OR id LIKE ?)")
+			pattern := "%" + strings.ToLower(term) + "%"
+			args = append(args, pattern, pattern)
+		}
+		if len(termClauses) > 0 {
+			joiner := " AND "
+			if filter.SearchAny {
+				joiner = " OR "
+			}
+			whereClauses = append(whereClauses, "("+strings.Join(termClauses, joiner)+")")
+		}
+	}
 
 	if filter.TitleSearch != "" {
 		whereClauses = append(whereClauses, "LOWER(title) LIKE ?")
@@ -349,16 +367,32 @@ func (t *doltTransaction) SearchIssues(ctx context.Context, query string, filter
 		whereClauses = append(whereClauses, "LOWER(external_ref) LIKE ?")
 		args = append(args, "%"+strings.ToLower(filter.ExternalRefContains)+"%")
 	}
+	if filter.CloseReasonContains != "" {
+		whereClauses = append(whereClauses, "LOWER(close_reason) LIKE ?")
+		args = append(args, "%"+strings.ToLower(filter.CloseReasonContains)+"%")
+	}
 	if filter.ExternalRef != nil {
 		whereClauses = append(whereClauses, "external_ref = ?")
 		args = append(args, *filter.ExternalRef)
 	}
+	if filter.CreatedBy != nil {
+		whereClauses = append(whereClauses, "created_by = ?")
+		args = append(args, *filter.CreatedBy)
+	}
 
 	// Status
 	if filter.Status != nil {
 		whereClauses = append(whereClauses, "status = ?")
 		args = append(args, *filter.Status)
 	}
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, len(filter.Statuses))
+		for i, s := range filter.Statuses {
+			placeholders[i] = "?"
+			args = append(args, string(s))
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf("status IN (%s)", strings.Join(placeholders, ",")))
+	}
 	if len(filter.ExcludeStatus) > 0 {
 		placeholders := make([]string, len(filter.ExcludeStatus))
 		for i, s := range filter.ExcludeStatus {
@@ -397,6 +431,15 @@ func (t *doltTransaction) SearchIssues(ctx context.Context, query string, filter
 		whereClauses = append(whereClauses, fmt.Sprintf("id IN (SELECT id FROM %s WHERE issue_type = ?)", table))
 		args = append(args, *filter.IssueType)
 	}
+	if len(filter.Types) > 0 {
+		placeholders := make([]string, len(filter.Types))
+		for i, t := range filter.Types {
+			placeholders[i] = "?"
+			args = append(args, string(t))
+		}
+		//nolint:gosec // G201: table is hardcoded to "issues" or "wisps"
+		whereClauses = append(whereClauses, fmt.Sprintf("id IN (SELECT id FROM %s WHERE issue_type IN (%s))", table, strings.Join(placeholders, ",")))
+	}
 
 	// Assignee
 	if filter.Assignee != nil {
@@ -492,6 +535,10 @@ func (t *doltTransaction) SearchIssues(ctx context.Context, query string, filter
 		whereClauses = append(whereClauses, "id LIKE ?")
 		args = append(args, filter.IDPrefix+"%")
 	}
+	if filter.SpecID != "" {
+		whereClauses = append(whereClauses, "spec_id = ?")
+		args = append(args, filter.SpecID)
+	}
 	if filter.SpecIDPrefix != "" {
 		whereClauses = append(whereClauses, "spec_id LIKE ?")
 		args = append(args, filter.SpecIDPrefix+"%")
@@ -678,6 +725,22 @@ func (t *doltTransaction) CloseIssue(ctx context.Context, id string, reason stri
 	return nil
 }
 
+func (t *doltTransaction) ClaimIssue(ctx context.Context, id string, actor string) error {
+	table := "issues"
+	eventTable := "events"
+	if t.isActiveWisp(ctx, id) {
+		table = "wisps"
+		eventTable = "wisp_events"
+	}
+
+	if _, err := issueops.ClaimIssueInTx(ctx, t.txFor(table), id, actor); err != nil {
+		return err
+	}
+	t.dirty.MarkDirty(table)
+	t.dirty.MarkDirty(eventTable)
+	return nil
+}
+
 func (t *doltTransaction) DeleteIssue(ctx context.Context, id string) error {
 	table := "issues"
 	if t.isActiveWisp(ctx, id) {
@@ -720,13 +783,14 @@ func (t *doltTransaction) AddDependencyWithOptions(ctx context.Context, dep *typ
 	}
 
 	opts := issueops.AddDependencyOpts{
-		SourceTable:    sourceTable,
-		TargetTable:    targetTable,
-		WriteTable:     table,
-		IsCrossPrefix:  isCrossPrefix,
-		SkipCycleCheck: addOpts.SkipCycleCheck,
-		TargetKind:     &kind,
-		EmitEvent:      addOpts.EmitEvent,
+		SourceTable:     sourceTable,
+		TargetTable:     targetTable,
+		WriteTable:      table,
+		IsCrossPrefix:   isCrossPrefix,
+		SkipCycleCheck:  addOpts.SkipCycleCheck,
+		TargetKind:      &kind,
+		EmitEvent:       addOpts.EmitEvent,
+		RejectDuplicate: addOpts.RejectDuplicate,
 	}
 
 	// Regular and dolt-ignored tables run on separate SQL sessions, so when
@@ -843,16 +907,13 @@ func (t *doltTransaction) readDepTargetForPrecheck(ctx context.Context, targetTa
 	return &p, nil
 }
 
-// checkCrossTierSchedulingCycle rejects a scheduling edge (blocks,
-// conditional-blocks, parent-child — the same set issueops.CheckDependencyCycleInTx
-// gates) that would close a cycle, using the merged view of both sessions'
+// checkCrossTierSchedulingCycle rejects a cycle-relevant edge (the same set
+// issueops.CheckDependencyCycleInTx gates) that would close a cycle, using the merged view of both sessions'
 // dependency tables. The in-tx cycle check scans both tables on the write tx
 // and so misses edges added on the other session earlier in this logical
 // transaction.
 func (t *doltTransaction) checkCrossTierSchedulingCycle(ctx context.Context, dep *types.Dependency) error {
-	switch dep.Type {
-	case types.DepBlocks, types.DepConditionalBlocks, types.DepParentChild:
-	default:
+	if !dep.Type.IsCycleRelevant() {
 		return nil
 	}
 	cycle, err := t.CycleThroughEdges(ctx, [][2]string{{dep.IssueID, dep.DependsOnID}})
@@ -918,29 +979,30 @@ func (t *doltTransaction) GetDependencyRecords(ctx context.Context, issueID stri
 }
 
 func (t *doltTransaction) RemoveDependency(ctx context.Context, issueID, dependsOnID string, actor string) error {
-	return t.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, storage.DependencyRemoveOptions{})
+	_, err := t.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, storage.DependencyRemoveOptions{})
+	return err
 }
 
-func (t *doltTransaction) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, rmOpts storage.DependencyRemoveOptions) error {
+func (t *doltTransaction) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, rmOpts storage.DependencyRemoveOptions) (int64, error) {
 	table := "dependencies"
 	eventTable := "events"
 	if t.isActiveWisp(ctx, issueID) {
 		table = "wisp_dependencies"
 		eventTable = "wisp_events"
 	}
-	eventWritten, err := issueops.RemoveDependencyInTx(ctx, t.txFor(table), issueID, dependsOnID, actor, rmOpts.EmitEvent)
+	removed, err := issueops.RemoveDependencyInTx(ctx, t.txFor(table), issueID, dependsOnID, actor, rmOpts.EmitEvent)
 	if err != nil {
-		return wrapExecError("remove dependency in tx", err)
+		return 0, wrapExecError("remove dependency in tx", err)
 	}
 	t.dirty.MarkDirty(table)
 	// RemoveDependencyInTx records a dependency_removed event on the source's
 	// event table only for a genuine emit (explicit verb + edge removal); stage
 	// that table so it commits with the edge. A structural or missing-edge remove
 	// writes no event, so leave eventTable unstaged.
-	if eventWritten {
+	if removed > 0 && rmOpts.EmitEvent {
 		t.dirty.MarkDirty(eventTable)
 	}
-	return nil
+	return removed, nil
 }
 
 // AddLabel adds a label within the transaction
@@ -984,7 +1046,7 @@ func (t *doltTransaction) GetLabels(ctx context.Context, issueID string) ([]stri
 }
 
 // RemoveLabel removes a label within the transaction
-func (t *doltTransaction) RemoveLabel(ctx context.Context, issueID, label, actor string) error {
+func (t *doltTransaction) RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error) {
 	table := "labels"
 	eventTable := "events"
 	if t.isActiveWisp(ctx, issueID) {
@@ -992,12 +1054,15 @@ func (t *doltTransaction) RemoveLabel(ctx context.Context, issueID, label, actor
 		eventTable = "wisp_events"
 	}
 
-	if err := issueops.RemoveLabelInTx(ctx, t.txFor(table), table, eventTable, issueID, label, actor); err != nil {
-		return wrapExecError("remove label in tx", err)
+	removed, err := issueops.RemoveLabelInTx(ctx, t.txFor(table), table, eventTable, issueID, label, actor)
+	if err != nil {
+		return 0, wrapExecError("remove label in tx", err)
 	}
-	t.dirty.MarkDirty(table)
-	t.dirty.MarkDirty(eventTable)
-	return nil
+	if removed > 0 {
+		t.dirty.MarkDirty(table)
+		t.dirty.MarkDirty(eventTable)
+	}
+	return removed, nil
 }
 
 // SetConfig sets a config value within the transaction
@@ -125,6 +125,45 @@ func TestRunInTransactionCloseIssueEmitsEvent(t *testing.T) {
 	assertCommittedEventCount(ctx, t, store.db, issue.ID, types.EventClosed, 1)
 }
 
+func TestRunInTransactionSearchIssuesStatuses(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	for _, status := range []types.Status{types.StatusOpen, types.StatusInProgress, types.StatusDeferred} {
+		issue := &types.Issue{
+			ID:        "test-tx-statuses-" + string(status),
+			Title:     "transaction statuses " + string(status),
+			Status:    status,
+			Priority:  2,
+			IssueType: types.TypeTask,
+		}
+		if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+	}
+
+	var got []*types.Issue
+	if err := store.RunInTransaction(ctx, "test: search statuses", func(tx storage.Transaction) error {
+		var err error
+		got, err = tx.SearchIssues(ctx, "transaction statuses", types.IssueFilter{
+			Statuses: []types.Status{types.StatusOpen, types.StatusDeferred},
+		})
+		return err
+	}); err != nil {
+		t.Fatalf("RunInTransaction SearchIssues: %v", err)
+	}
+	ids := make(map[string]bool, len(got))
+	for _, issue := range got {
+		ids[issue.ID] = true
+	}
+	if len(ids) != 2 || !ids["test-tx-statuses-open"] || !ids["test-tx-statuses-deferred"] {
+		t.Errorf("Statuses [open deferred] matched %v", ids)
+	}
+}
+
 func TestRunInTransactionAlreadyClosedDoesNotCommitUnrelatedEvent(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
@@ -225,7 +264,8 @@ func TestRunInTransactionRemoveLabelEmitsEvent(t *testing.T) {
 	}
 
 	if err := store.RunInTransaction(ctx, "test: remove label emits event", func(tx storage.Transaction) error {
-		return tx.RemoveLabel(ctx, issue.ID, "triaged", "tester")
+		_, err := tx.RemoveLabel(ctx, issue.ID, "triaged", "tester")
+		return err
 	}); err != nil {
 		t.Fatalf("RunInTransaction RemoveLabel: %v", err)
 	}
@@ -87,7 +87,7 @@ func (r *dependencySQLRepositoryImpl) Insert(ctx context.Context, dep *types.Dep
 			return err
 		}
 	}
-	if !opts.CycleValidated && isSchedulingDependency(dep.Type) {
+	if !opts.CycleValidated && dep.Type.IsCycleRelevant() {
 		cycle, err := r.HasCycle(ctx, dep.IssueID, dep.DependsOnID)
 		if err != nil {
 			return fmt.Errorf("db: DependencySQLRepository.Insert: cycle check: %w", err)
@@ -107,6 +107,9 @@ func (r *dependencySQLRepositoryImpl) Insert(ctx context.Context, dep *types.Dep
 	switch {
 	case err == nil:
 		if existingType == string(dep.Type) {
+			if opts.RejectDuplicate {
+				return domain.DuplicateDependencyError(dep)
+			}
 			//nolint:gosec // G201: table and depTargetExpr are hardcoded constants
 			if _, err := r.runner.ExecContext(ctx,
 				fmt.Sprintf("UPDATE %s SET metadata = ? WHERE issue_id = ? AND %s = ?", table, depTargetExpr),
@@ -272,16 +275,23 @@ func (r *dependencySQLRepositoryImpl) Delete(ctx context.Context, issueID, depen
 	}
 
 	//nolint:gosec // G201: table and depTargetExpr are hardcoded constants
-	if _, err := r.runner.ExecContext(ctx,
+	res, err := r.runner.ExecContext(ctx,
 		fmt.Sprintf("DELETE FROM %s WHERE issue_id = ? AND %s = ?", table, depTargetExpr),
 		issueID, dependsOnID,
-	); err != nil {
+	)
+	if err != nil {
 		return domain.DepDeleteResult{}, fmt.Errorf("db: DependencySQLRepository.Delete: %s -> %s: %w", issueID, dependsOnID, err)
 	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return domain.DepDeleteResult{}, fmt.Errorf("db: DependencySQLRepository.Delete: %s -> %s: rows affected: %w", issueID, dependsOnID, err)
+	}
+	if removed == 0 {
+		return domain.DepDeleteResult{Found: false}, nil
+	}
 
-	// The type lookup above returned Found:false when no edge existed, so reaching
-	// here means a row was deleted — record the dependency_removed event on the
-	// source's event table, matching the embedded/issueops RemoveDependencyInTx path.
+	// A row was deleted — record the dependency_removed event on the source's
+	// event table, matching the embedded/issueops RemoveDependencyInTx path.
 	// Gated on EmitEvent so only the explicit `bd dep remove` verb emits.
 	if opts.EmitEvent {
 		if err := r.events.Record(ctx, domain.Event{
@@ -324,10 +334,6 @@ func (r *dependencySQLRepositoryImpl) HasCycle(ctx context.Context, issueID, dep
 	return cycle, nil
 }
 
-func isSchedulingDependency(t types.DependencyType) bool {
-	return t == types.DepBlocks || t == types.DepConditionalBlocks || t == types.DepParentChild
-}
-
 func (r *dependencySQLRepositoryImpl) ListByIssueIDs(ctx context.Context, issueIDs []string, opts domain.DepListOpts) (domain.DepBulkResult, error) {
 	result := domain.DepBulkResult{
 		Outgoing: make(map[string][]*types.Dependency),
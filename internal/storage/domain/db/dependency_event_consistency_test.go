@@ -50,7 +50,8 @@ func (s *testSuite) consistencyExplicitRemoveEmits() {
 		[]*types.Dependency{newDep("bd-cons-rm-a", "bd-cons-rm-b", types.DepBlocks)},
 		"tester", domain.BulkAddDepsOpts{})
 	s.Require().NoError(err)
-	s.Require().NoError(s.depUseCase().RemoveDependency(s.Ctx(), "bd-cons-rm-a", "bd-cons-rm-b", "remover"))
+	_, err = s.depUseCase().RemoveDependency(s.Ctx(), "bd-cons-rm-a", "bd-cons-rm-b", "remover")
+	s.Require().NoError(err)
 
 	var count int
 	s.Require().NoError(s.Runner().QueryRowContext(s.Ctx(),
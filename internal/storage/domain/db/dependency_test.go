@@ -12,6 +12,7 @@ func (s *testSuite) TestDependencySQLRepository() {
 		s.Run("RejectsSelfDependency", s.depInsertSelfDep)
 		s.Run("RejectsEmptyIDs", s.depInsertEmptyIDs)
 		s.Run("SameTypeIsIdempotentMetadataRefresh", s.depInsertIdempotentSameType)
+		s.Run("RejectDuplicateRefusesSameType", s.depInsertRejectDuplicate)
 		s.Run("UsesDeterministicID", s.depInsertUsesDeterministicID)
 		s.Run("DifferentTypeIsRejected", s.depInsertConflictingType)
 		s.Run("MissingTargetIssueFailsFK", s.depInsertFKViolation)
@@ -121,6 +122,25 @@ func (s *testSuite) depInsertEmptyIDs() {
 	s.Require().Error(r.Insert(s.Ctx(), newDep("bd-x", "", types.DepBlocks), "tester", domain.DepInsertOpts{}))
 }
 
+func (s *testSuite) depInsertRejectDuplicate() {
+	s.seedIssueRow("bd-dep-dup-1")
+	s.seedIssueRow("bd-dep-dup-2")
+	r := s.depRepo()
+
+	for _, depType := range []types.DependencyType{types.DepBlocks, types.DepRelated, types.DepParentChild} {
+		dep := newDep("bd-dep-dup-1", "bd-dep-dup-2", depType)
+		s.Require().NoError(r.Insert(s.Ctx(), dep, "tester", domain.DepInsertOpts{}))
+		err := r.Insert(s.Ctx(), dep, "tester", domain.DepInsertOpts{RejectDuplicate: true})
+		s.Require().ErrorIs(err, domain.ErrDuplicateDependency, "type %s", depType)
+
+		out, err := r.ListByIssueIDs(s.Ctx(), []string{"bd-dep-dup-1"}, domain.DepListOpts{Direction: domain.DepDirectionOut})
+		s.Require().NoError(err)
+		s.Require().Len(out.Outgoing["bd-dep-dup-1"], 1, "type %s", depType)
+		_, err = r.Delete(s.Ctx(), "bd-dep-dup-1", "bd-dep-dup-2", "tester", domain.DepInsertOpts{})
+		s.Require().NoError(err)
+	}
+}
+
 func (s *testSuite) depInsertIdempotentSameType() {
 	s.seedIssueRow("bd-dep-idem-1")
 	s.seedIssueRow("bd-dep-idem-2")
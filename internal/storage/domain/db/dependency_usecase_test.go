@@ -45,8 +45,10 @@ func (s *testSuite) currentParent(childID string) string {
 
 func (s *testSuite) ducRemoveDependencyEmptyIDs() {
 	uc := s.depUseCase()
-	s.Require().Error(uc.RemoveDependency(s.Ctx(), "", "bd-x", "tester"))
-	s.Require().Error(uc.RemoveDependency(s.Ctx(), "bd-x", "", "tester"))
+	_, err := uc.RemoveDependency(s.Ctx(), "", "bd-x", "tester")
+	s.Require().Error(err)
+	_, err = uc.RemoveDependency(s.Ctx(), "bd-x", "", "tester")
+	s.Require().Error(err)
 }
 
 func (s *testSuite) ducRemoveDependencyDelegates() {
@@ -56,7 +58,9 @@ func (s *testSuite) ducRemoveDependencyDelegates() {
 	s.Require().NoError(depRepo.Insert(s.Ctx(),
 		newDep("bd-duc-rd-1", "bd-duc-rd-2", types.DepBlocks), "tester", domain.DepInsertOpts{}))
 
-	s.Require().NoError(s.depUseCase().RemoveDependency(s.Ctx(), "bd-duc-rd-1", "bd-duc-rd-2", "tester"))
+	removed, err := s.depUseCase().RemoveDependency(s.Ctx(), "bd-duc-rd-1", "bd-duc-rd-2", "tester")
+	s.Require().NoError(err)
+	s.Equal(int64(1), removed)
 
 	res, err := s.depUseCase().ListByIssueIDs(s.Ctx(), []string{"bd-duc-rd-1"},
 		domain.DepListFilter{Direction: domain.DepDirectionOut})
@@ -67,7 +71,9 @@ func (s *testSuite) ducRemoveDependencyDelegates() {
 func (s *testSuite) ducRemoveDependencyMissingNoop() {
 	s.seedIssueRow("bd-duc-rd-miss-a")
 	s.seedIssueRow("bd-duc-rd-miss-b")
-	s.Require().NoError(s.depUseCase().RemoveDependency(s.Ctx(), "bd-duc-rd-miss-a", "bd-duc-rd-miss-b", "tester"))
+	removed, err := s.depUseCase().RemoveDependency(s.Ctx(), "bd-duc-rd-miss-a", "bd-duc-rd-miss-b", "tester")
+	s.Require().NoError(err)
+	s.Zero(removed, "removing a missing edge removes nothing")
 }
 
 func (s *testSuite) ducReparentEmptyChild() {
@@ -150,7 +156,8 @@ func (s *testSuite) ducRemoveWispDependencyRoutes() {
 	s.Require().NoError(wispDepRepo.Insert(s.Ctx(),
 		newDep("bd-duc-rwd-a", "bd-duc-rwd-b", types.DepBlocks), "tester", domain.DepInsertOpts{UseWispsTable: true}))
 
-	s.Require().NoError(s.depUseCase().RemoveWispDependency(s.Ctx(), "bd-duc-rwd-a", "bd-duc-rwd-b", "tester"))
+	_, err := s.depUseCase().RemoveWispDependency(s.Ctx(), "bd-duc-rwd-a", "bd-duc-rwd-b", "tester")
+	s.Require().NoError(err)
 
 	wispRes, err := s.depUseCase().ListByWispIDs(s.Ctx(), []string{"bd-duc-rwd-a"},
 		domain.DepListFilter{Direction: domain.DepDirectionOut})
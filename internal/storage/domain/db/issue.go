@@ -877,7 +877,11 @@ func (r *issueSQLRepositoryImpl) AsOf(ctx context.Context, id, ref string) (*typ
 }
 
 func (r *issueSQLRepositoryImpl) Close(ctx context.Context, id string, params domain.CloseRowParams, actor string, opts domain.IssueTableOpts) (domain.CloseRowResult, error) {
-	res, err := issueops.CloseIssueInTx(ctx, r.runner, id, params.Reason, actor, params.Session)
+	closeInTx := issueops.CloseIssueInTx
+	if params.RequireUnblocked {
+		closeInTx = issueops.CloseIssueIfUnblockedInTx
+	}
+	res, err := closeInTx(ctx, r.runner, id, params.Reason, actor, params.Session)
 	if err != nil {
 		return domain.CloseRowResult{}, fmt.Errorf("db: IssueSQLRepository.Close %s: %w", id, err)
 	}
@@ -933,23 +937,10 @@ func (r *issueSQLRepositoryImpl) GetBlockedIssues(ctx context.Context, filter ty
 }
 
 func (r *issueSQLRepositoryImpl) GetStatistics(ctx context.Context) (*types.Statistics, error) {
-	stats := &types.Statistics{}
-	if err := issueops.ScanIssueCountsInTx(ctx, r.runner, stats); err != nil {
-		return nil, fmt.Errorf("db: IssueSQLRepository.GetStatistics: scan counts: %w", err)
-	}
-	var blocked int
-	if err := r.runner.QueryRowContext(ctx, `
-		SELECT COUNT(*) FROM issues
-		WHERE is_blocked = 1 AND status <> 'closed' AND status <> 'pinned'
-	`).Scan(&blocked); err != nil {
-		return nil, fmt.Errorf("db: IssueSQLRepository.GetStatistics: count blocked: %w", err)
-	}
-	stats.BlockedIssues = &blocked
-	ready := stats.OpenIssues - blocked
-	if ready < 0 {
-		ready = 0
-	}
-	stats.ReadyIssues = &ready
+	stats, err := issueops.GetStatisticsInTx(ctx, r.runner)
+	if err != nil {
+		return nil, fmt.Errorf("db: IssueSQLRepository.GetStatistics: %w", err)
+	}
 	return stats, nil
 }
 
@@ -229,7 +229,9 @@ func (s *testSuite) labelDeleteRemoves() {
 	r := s.labelRepo()
 	s.Require().NoError(r.Insert(s.Ctx(), "bd-lbl-del-1", "tech-debt", "tester", domain.LabelOpts{}))
 
-	s.Require().NoError(r.Delete(s.Ctx(), "bd-lbl-del-1", "tech-debt", "tester", domain.LabelOpts{}))
+	removed, err := r.Delete(s.Ctx(), "bd-lbl-del-1", "tech-debt", "tester", domain.LabelOpts{})
+	s.Require().NoError(err)
+	s.Equal(int64(1), removed)
 
 	out, err := r.List(s.Ctx(), "bd-lbl-del-1", domain.LabelOpts{})
 	s.Require().NoError(err)
@@ -240,7 +242,8 @@ func (s *testSuite) labelDeleteRecordsEvent() {
 	s.seedIssueRow("bd-lbl-del-evt")
 	r := s.labelRepo()
 	s.Require().NoError(r.Insert(s.Ctx(), "bd-lbl-del-evt", "perf", "alice", domain.LabelOpts{}))
-	s.Require().NoError(r.Delete(s.Ctx(), "bd-lbl-del-evt", "perf", "bob", domain.LabelOpts{}))
+	_, err := r.Delete(s.Ctx(), "bd-lbl-del-evt", "perf", "bob", domain.LabelOpts{})
+	s.Require().NoError(err)
 
 	var actor, oldValue string
 	s.Require().NoError(s.Runner().QueryRowContext(s.Ctx(),
@@ -254,7 +257,9 @@ func (s *testSuite) labelDeleteRecordsEvent() {
 func (s *testSuite) labelDeleteMissingNoop() {
 	s.seedIssueRow("bd-lbl-del-miss")
 	r := s.labelRepo()
-	s.Require().NoError(r.Delete(s.Ctx(), "bd-lbl-del-miss", "never-there", "tester", domain.LabelOpts{}))
+	removed, err := r.Delete(s.Ctx(), "bd-lbl-del-miss", "never-there", "tester", domain.LabelOpts{})
+	s.Require().NoError(err)
+	s.Zero(removed, "deleting a label the issue lacks removes nothing")
 
 	out, err := r.List(s.Ctx(), "bd-lbl-del-miss", domain.LabelOpts{})
 	s.Require().NoError(err)
@@ -268,7 +273,8 @@ func (s *testSuite) labelDeleteSpecificLabel() {
 	s.Require().NoError(r.Insert(s.Ctx(), "bd-lbl-del-specific", "drop", "tester", domain.LabelOpts{}))
 	s.Require().NoError(r.Insert(s.Ctx(), "bd-lbl-del-specific", "stay", "tester", domain.LabelOpts{}))
 
-	s.Require().NoError(r.Delete(s.Ctx(), "bd-lbl-del-specific", "drop", "tester", domain.LabelOpts{}))
+	_, err := r.Delete(s.Ctx(), "bd-lbl-del-specific", "drop", "tester", domain.LabelOpts{})
+	s.Require().NoError(err)
 
 	out, err := r.List(s.Ctx(), "bd-lbl-del-specific", domain.LabelOpts{})
 	s.Require().NoError(err)
@@ -276,12 +282,12 @@ func (s *testSuite) labelDeleteSpecificLabel() {
 }
 
 func (s *testSuite) labelDeleteEmptyIssueID() {
-	err := s.labelRepo().Delete(s.Ctx(), "", "x", "tester", domain.LabelOpts{})
+	_, err := s.labelRepo().Delete(s.Ctx(), "", "x", "tester", domain.LabelOpts{})
 	s.Require().Error(err)
 }
 
 func (s *testSuite) labelDeleteEmptyLabel() {
-	err := s.labelRepo().Delete(s.Ctx(), "bd-lbl-del-x", "", "tester", domain.LabelOpts{})
+	_, err := s.labelRepo().Delete(s.Ctx(), "bd-lbl-del-x", "", "tester", domain.LabelOpts{})
 	s.Require().Error(err)
 }
 
@@ -292,7 +298,8 @@ func (s *testSuite) labelDeleteWispRouting() {
 	s.Require().NoError(r.Insert(s.Ctx(), "bd-lbl-del-cross-perm", "shared", "tester", domain.LabelOpts{}))
 	s.Require().NoError(r.Insert(s.Ctx(), "bd-lbl-del-cross-wisp", "shared", "tester", domain.LabelOpts{UseWispsTable: true}))
 
-	s.Require().NoError(r.Delete(s.Ctx(), "bd-lbl-del-cross-wisp", "shared", "tester", domain.LabelOpts{UseWispsTable: true}))
+	_, err := r.Delete(s.Ctx(), "bd-lbl-del-cross-wisp", "shared", "tester", domain.LabelOpts{UseWispsTable: true})
+	s.Require().NoError(err)
 
 	var wispCount, permCount int
 	s.Require().NoError(s.Runner().QueryRowContext(s.Ctx(),
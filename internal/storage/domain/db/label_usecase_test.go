@@ -39,12 +39,12 @@ func (s *testSuite) labelUseCase() domain.LabelUseCase {
 }
 
 func (s *testSuite) lucRemoveLabelEmptyID() {
-	err := s.labelUseCase().RemoveLabel(s.Ctx(), "", "x", "tester")
+	_, err := s.labelUseCase().RemoveLabel(s.Ctx(), "", "x", "tester")
 	s.Require().Error(err)
 }
 
 func (s *testSuite) lucRemoveLabelEmptyLabel() {
-	err := s.labelUseCase().RemoveLabel(s.Ctx(), "bd-luc-rl", "", "tester")
+	_, err := s.labelUseCase().RemoveLabel(s.Ctx(), "bd-luc-rl", "", "tester")
 	s.Require().Error(err)
 }
 
@@ -53,7 +53,9 @@ func (s *testSuite) lucRemoveLabelDelegates() {
 	uc := s.labelUseCase()
 	s.Require().NoError(uc.AddLabel(s.Ctx(), "bd-luc-rl-1", "drop-me", "tester"))
 
-	s.Require().NoError(uc.RemoveLabel(s.Ctx(), "bd-luc-rl-1", "drop-me", "tester"))
+	removed, err := uc.RemoveLabel(s.Ctx(), "bd-luc-rl-1", "drop-me", "tester")
+	s.Require().NoError(err)
+	s.Equal(int64(1), removed)
 
 	out, err := uc.GetLabels(s.Ctx(), "bd-luc-rl-1")
 	s.Require().NoError(err)
@@ -166,7 +168,8 @@ func (s *testSuite) lucRemoveWispLabelRoutes() {
 	uc := s.labelUseCase()
 	s.Require().NoError(uc.AddWispLabel(s.Ctx(), "bd-lwc-rwl", "drop", "tester"))
 
-	s.Require().NoError(uc.RemoveWispLabel(s.Ctx(), "bd-lwc-rwl", "drop", "tester"))
+	_, err := uc.RemoveWispLabel(s.Ctx(), "bd-lwc-rwl", "drop", "tester")
+	s.Require().NoError(err)
 
 	wispLabels, err := uc.GetWispLabels(s.Ctx(), "bd-lwc-rwl")
 	s.Require().NoError(err)
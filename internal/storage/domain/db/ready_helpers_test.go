@@ -412,7 +412,7 @@ func (s *testSuite) statsReadyDerived() {
 	s.Require().NotNil(out.BlockedIssues)
 	s.Equal(1, *out.BlockedIssues)
 	s.Require().NotNil(out.ReadyIssues)
-	s.Equal(2, *out.ReadyIssues, "ready = open - blocked")
+	s.Equal(2, *out.ReadyIssues, "ready = the unblocked open issues")
 }
 
 func (s *testSuite) statsReadyClamped() {
@@ -235,7 +235,7 @@ func (s *testSuite) ucStatsReadyDerived() {
 	s.Require().NotNil(stats.BlockedIssues)
 	s.Equal(1, *stats.BlockedIssues)
 	s.Require().NotNil(stats.ReadyIssues)
-	s.Equal(1, *stats.ReadyIssues, "UC must surface ready = open - blocked")
+	s.Equal(1, *stats.ReadyIssues, "UC must surface the ready-work count")
 }
 
 // ---------- DetectCycles UC ----------
@@ -15,6 +15,27 @@ import (
 // can errors.Is it while the human-readable text is preserved byte-for-byte.
 var ErrSelfDependency = errors.New("cannot add self-dependency")
 
+// ErrDuplicateDependency is returned by adds that opt in with RejectDuplicate
+// when the exact edge (issue, target, type) already exists. Without the option
+// a same-type re-add stays idempotent (protocol clause G1.3).
+var ErrDuplicateDependency = errors.New("dependency already exists")
+
+// CheckSelfDependency rejects an edge that points an issue at itself. It
+// applies to every dependency type and runs before any hierarchy or cycle
+// probe, so every add path reports the same self-dep message.
+func CheckSelfDependency(dep *types.Dependency) error {
+	if dep.IssueID == dep.DependsOnID {
+		return fmt.Errorf("%w: %s cannot depend on itself", ErrSelfDependency, dep.IssueID)
+	}
+	return nil
+}
+
+// DuplicateDependencyError formats the RejectDuplicate rejection for dep,
+// errors.Is-matchable as ErrDuplicateDependency.
+func DuplicateDependencyError(dep *types.Dependency) error {
+	return fmt.Errorf("%w: %s already depends on %s (%s)", ErrDuplicateDependency, dep.IssueID, dep.DependsOnID, dep.Type)
+}
+
 // ErrDependencyCycle is returned when adding a dependency edge would introduce a
 // scheduling cycle. It is scoped to the dependency-add family — the single and
 // bulk add paths (add/addBulk) and the dolt cross-tier check — so callers can
@@ -110,6 +131,9 @@ type DepInsertOpts struct {
 	// issueops.AddDependencyInTx EmitEvent gate is unset, while only the explicit
 	// bd dep add / bd link / bd dep remove verbs pass EmitEvent.
 	EmitEvent bool
+	// RejectDuplicate makes a same-type re-add fail with
+	// ErrDuplicateDependency instead of refreshing metadata in place.
+	RejectDuplicate bool
 }
 
 type DepListOpts struct {
@@ -152,6 +176,13 @@ type DepTreeOpts struct {
 
 type BulkAddDepsOpts struct {
 	SkipPerEdgeCycleCheck bool
+	// ForceCycles lets edges whose type IsCycleForceable close a cycle: both
+	// the per-edge and the final cycle checks skip them (bd dep add --force).
+	// Ready-gating edges are checked as usual.
+	ForceCycles bool
+	// RejectDuplicate fails the batch with ErrDuplicateDependency when an
+	// exact edge already exists (see DepInsertOpts.RejectDuplicate).
+	RejectDuplicate bool
 }
 
 type BulkAddDepsResult struct {
@@ -185,7 +216,8 @@ type DependencySQLRepository interface {
 
 type DependencyUseCase interface {
 	AddDependency(ctx context.Context, dep *types.Dependency, actor string) error
-	RemoveDependency(ctx context.Context, issueID, dependsOnID, actor string) error
+	// RemoveDependency returns how many edges it removed; 0 means no such edge.
+	RemoveDependency(ctx context.Context, issueID, dependsOnID, actor string) (int64, error)
 	Reparent(ctx context.Context, childID, newParentID, actor string) error
 	ListByIssueIDs(ctx context.Context, issueIDs []string, filter DepListFilter) (DepBulkResult, error)
 	ListWithIssueMetadata(ctx context.Context, issueID string, filter DepListFilter) ([]*types.IssueWithDependencyMetadata, error)
@@ -205,7 +237,7 @@ type DependencyUseCase interface {
 	GetWispDependencyRecords(ctx context.Context, wispIDs []string) (map[string][]*types.Dependency, error)
 
 	AddWispDependency(ctx context.Context, dep *types.Dependency, actor string) error
-	RemoveWispDependency(ctx context.Context, wispID, dependsOnID, actor string) error
+	RemoveWispDependency(ctx context.Context, wispID, dependsOnID, actor string) (int64, error)
 	ReparentWisp(ctx context.Context, childWispID, newParentID, actor string) error
 	ListByWispIDs(ctx context.Context, wispIDs []string, filter DepListFilter) (DepBulkResult, error)
 	ListWispWithIssueMetadata(ctx context.Context, wispID string, filter DepListFilter) ([]*types.IssueWithDependencyMetadata, error)
@@ -245,8 +277,8 @@ func (u *dependencyUseCaseImpl) add(ctx context.Context, dep *types.Dependency,
 	// checked BEFORE the cycle probe and for ALL dep types, and emits the
 	// dedicated self-dep message. A blocking self-edge otherwise trips HasCycle
 	// and would report the wrong (cycle) error (#4547 F-1).
-	if dep.IssueID == dep.DependsOnID {
-		return fmt.Errorf("%w: %s cannot depend on itself", ErrSelfDependency, dep.IssueID)
+	if err := CheckSelfDependency(dep); err != nil {
+		return err
 	}
 	if err := u.depRepo.ValidateBlockingHierarchy(ctx, dep); err != nil {
 		var hierarchyConflict *DependencyHierarchyConflictError
@@ -256,7 +288,7 @@ func (u *dependencyUseCaseImpl) add(ctx context.Context, dep *types.Dependency,
 		return fmt.Errorf("add dep: hierarchy check: %w", err)
 	}
 
-	if isSchedulingDep(dep.Type) {
+	if dep.Type.IsCycleRelevant() {
 		cycle, err := u.depRepo.HasCycle(ctx, dep.IssueID, dep.DependsOnID)
 		if err != nil {
 			return fmt.Errorf("add dep: cycle check: %w", err)
@@ -286,22 +318,26 @@ func (u *dependencyUseCaseImpl) add(ctx context.Context, dep *types.Dependency,
 	return nil
 }
 
-func (u *dependencyUseCaseImpl) RemoveDependency(ctx context.Context, issueID, dependsOnID, actor string) error {
+func (u *dependencyUseCaseImpl) RemoveDependency(ctx context.Context, issueID, dependsOnID, actor string) (int64, error) {
 	return u.removeDep(ctx, issueID, dependsOnID, actor, false)
 }
 
-func (u *dependencyUseCaseImpl) RemoveWispDependency(ctx context.Context, wispID, dependsOnID, actor string) error {
+func (u *dependencyUseCaseImpl) RemoveWispDependency(ctx context.Context, wispID, dependsOnID, actor string) (int64, error) {
 	return u.removeDep(ctx, wispID, dependsOnID, actor, true)
 }
 
-func (u *dependencyUseCaseImpl) removeDep(ctx context.Context, sourceID, dependsOnID, actor string, useWisp bool) error {
+func (u *dependencyUseCaseImpl) removeDep(ctx context.Context, sourceID, dependsOnID, actor string, useWisp bool) (int64, error) {
 	if sourceID == "" || dependsOnID == "" {
-		return fmt.Errorf("remove dep: sourceID and dependsOnID must not be empty")
+		return 0, fmt.Errorf("remove dep: sourceID and dependsOnID must not be empty")
+	}
+	res, err := u.depRepo.Delete(ctx, sourceID, dependsOnID, actor, DepInsertOpts{UseWispsTable: useWisp, EmitEvent: true})
+	if err != nil {
+		return 0, fmt.Errorf("remove dep %s -> %s: %w", sourceID, dependsOnID, err)
 	}
-	if _, err := u.depRepo.Delete(ctx, sourceID, dependsOnID, actor, DepInsertOpts{UseWispsTable: useWisp, EmitEvent: true}); err != nil {
-		return fmt.Errorf("remove dep %s -> %s: %w", sourceID, dependsOnID, err)
+	if !res.Found {
+		return 0, nil
 	}
-	return nil
+	return 1, nil
 }
 
 func (u *dependencyUseCaseImpl) Reparent(ctx context.Context, childID, newParentID, actor string) error {
@@ -512,14 +548,6 @@ func (u *dependencyUseCaseImpl) GetBlockingInfo(ctx context.Context, issueIDs []
 	return out, nil
 }
 
-func isBlockingDep(t types.DependencyType) bool {
-	return t == types.DepBlocks || t == types.DepConditionalBlocks
-}
-
-func isSchedulingDep(t types.DependencyType) bool {
-	return isBlockingDep(t) || t == types.DepParentChild
-}
-
 func (u *dependencyUseCaseImpl) IsBlocked(ctx context.Context, issueID string) (bool, []string, error) {
 	return u.isBlocked(ctx, issueID, false)
 }
@@ -574,7 +602,7 @@ func (u *dependencyUseCaseImpl) addBulk(ctx context.Context, deps []*types.Depen
 	// proxied server (cmd/bd/dep_proxied_server.go, link_proxied_server.go), so
 	// each genuine new edge records a dependency_added event — unlike
 	// create-with-deps, which calls depRepo.Insert directly without EmitEvent.
-	insertOpts := DepInsertOpts{UseWispsTable: useWisp, HierarchyValidated: true, CycleValidated: true, EmitEvent: true}
+	insertOpts := DepInsertOpts{UseWispsTable: useWisp, HierarchyValidated: true, CycleValidated: true, EmitEvent: true, RejectDuplicate: opts.RejectDuplicate}
 	// Validate the entire input shape before the first write. Multi-edge callers
 	// run in a UOW, but this also avoids an avoidable partial prefix for direct
 	// use-case consumers.
@@ -592,8 +620,8 @@ func (u *dependencyUseCaseImpl) addBulk(ctx context.Context, deps []*types.Depen
 		// CycleThroughEdges gate) and surfacing as a cycle. The message is
 		// byte-identical to every other self-dep site so the proxied bulk CLI
 		// (bd dep add / bd link) shows one consistent self-dependency error.
-		if dep.IssueID == dep.DependsOnID {
-			return BulkAddDepsResult{}, fmt.Errorf("%w: %s cannot depend on itself", ErrSelfDependency, dep.IssueID)
+		if err := CheckSelfDependency(dep); err != nil {
+			return BulkAddDepsResult{}, err
 		}
 	}
 	// Parent-child edges must be visible before blocking edges in the same
@@ -612,7 +640,7 @@ func (u *dependencyUseCaseImpl) addBulk(ctx context.Context, deps []*types.Depen
 				}
 				return BulkAddDepsResult{}, fmt.Errorf("add deps[%d]: hierarchy check: %w", i, err)
 			}
-			if !opts.SkipPerEdgeCycleCheck && isSchedulingDep(dep.Type) {
+			if !opts.SkipPerEdgeCycleCheck && dep.Type.IsCycleRelevant() && !(opts.ForceCycles && dep.Type.IsCycleForceable()) {
 				cycle, err := u.depRepo.HasCycle(ctx, dep.IssueID, dep.DependsOnID)
 				if err != nil {
 					return BulkAddDepsResult{}, fmt.Errorf("add deps[%d]: cycle check: %w", i, err)
@@ -632,7 +660,7 @@ func (u *dependencyUseCaseImpl) addBulk(ctx context.Context, deps []*types.Depen
 	}
 	var pairs [][2]string
 	for _, dep := range deps {
-		if !isSchedulingDep(dep.Type) {
+		if !dep.Type.IsCycleRelevant() || (opts.ForceCycles && dep.Type.IsCycleForceable()) {
 			continue
 		}
 		pairs = append(pairs, [2]string{dep.IssueID, dep.DependsOnID})
@@ -76,6 +76,9 @@ type IssueSQLRepository interface {
 type CloseRowParams struct {
 	Reason  string
 	Session string
+	// RequireUnblocked makes the close write conditional on the row having no
+	// open direct blocker; when it does, Close fails with ErrCloseBlocked.
+	RequireUnblocked bool
 }
 
 type CloseRowResult struct {
@@ -947,7 +950,7 @@ func (u *issueUseCaseImpl) applyGraph(ctx context.Context, plan GraphPlan, actor
 			if err := u.depRepo.Insert(ctx, dep, actor, DepInsertOpts{UseWispsTable: useWisp}); err != nil {
 				return GraphApplyResult{}, fmt.Errorf("applyGraph: edge %d (%s -> %s): %w", i, fromID, toID, err)
 			}
-			if isSchedulingDep(depType) {
+			if depType.IsCycleRelevant() {
 				newSchedulingEdges = append(newSchedulingEdges, [2]string{fromID, toID})
 			}
 		}
@@ -1306,11 +1309,11 @@ func (u *issueUseCaseImpl) mintTopLevelID(ctx context.Context, issue *types.Issu
 }
 
 func (u *issueUseCaseImpl) CloseIssue(ctx context.Context, id string, params CloseIssueParams, actor string) (CloseIssueResult, error) {
-	return u.close(ctx, id, params, actor, false)
+	return u.close(ctx, id, params, actor, false, false)
 }
 
 func (u *issueUseCaseImpl) CloseWisp(ctx context.Context, id string, params CloseIssueParams, actor string) (CloseIssueResult, error) {
-	return u.close(ctx, id, params, actor, true)
+	return u.close(ctx, id, params, actor, true, false)
 }
 
 // CloseIssueChecked closes an issue, refusing with storage.ErrCloseBlocked when
@@ -1388,17 +1391,19 @@ func (u *issueUseCaseImpl) closeChecked(ctx context.Context, id string, params C
 			}
 		}
 	}
-	return u.close(ctx, id, params, actor, useWisp)
+	// The close write re-checks the direct blockers itself, so one that
+	// reopened after the guard read still refuses.
+	return u.close(ctx, id, params, actor, useWisp, !force)
 }
 
-func (u *issueUseCaseImpl) close(ctx context.Context, id string, params CloseIssueParams, actor string, useWisp bool) (CloseIssueResult, error) {
+func (u *issueUseCaseImpl) close(ctx context.Context, id string, params CloseIssueParams, actor string, useWisp, requireUnblocked bool) (CloseIssueResult, error) {
 	if id == "" {
 		return CloseIssueResult{}, fmt.Errorf("close: id must not be empty")
 	}
 	if actor == "" {
 		return CloseIssueResult{}, fmt.Errorf("close: actor must not be empty")
 	}
-	row, err := u.issueRepo.Close(ctx, id, CloseRowParams{Reason: params.Reason, Session: params.Session}, actor, IssueTableOpts{UseWispsTable: useWisp})
+	row, err := u.issueRepo.Close(ctx, id, CloseRowParams{Reason: params.Reason, Session: params.Session, RequireUnblocked: requireUnblocked}, actor, IssueTableOpts{UseWispsTable: useWisp})
 	if err != nil {
 		return CloseIssueResult{}, fmt.Errorf("close %s: %w", id, err)
 	}
@@ -11,7 +11,9 @@ type LabelOpts struct {
 
 type LabelSQLRepository interface {
 	Insert(ctx context.Context, issueID, label, actor string, opts LabelOpts) error
-	Delete(ctx context.Context, issueID, label, actor string, opts LabelOpts) error
+	// Delete returns how many rows it removed; 0 means the issue did not carry
+	// the label, and no event is recorded.
+	Delete(ctx context.Context, issueID, label, actor string, opts LabelOpts) (int64, error)
 	List(ctx context.Context, issueID string, opts LabelOpts) ([]string, error)
 	ListByIssueIDs(ctx context.Context, issueIDs []string, opts LabelOpts) (map[string][]string, error)
 	DeleteAllForIDs(ctx context.Context, ids []string, opts LabelOpts) (int, error)
@@ -20,7 +22,7 @@ type LabelSQLRepository interface {
 
 type LabelUseCase interface {
 	AddLabel(ctx context.Context, issueID, label, actor string) error
-	RemoveLabel(ctx context.Context, issueID, label, actor string) error
+	RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error)
 	AddLabels(ctx context.Context, issueID string, labels []string, actor string) error
 	RemoveLabels(ctx context.Context, issueID string, labels []string, actor string) error
 	SetLabels(ctx context.Context, issueID string, labels []string, actor string) error
@@ -29,7 +31,7 @@ type LabelUseCase interface {
 	InheritFromParent(ctx context.Context, childID, parentID, actor string, skipExisting []string) ([]string, error)
 
 	AddWispLabel(ctx context.Context, wispID, label, actor string) error
-	RemoveWispLabel(ctx context.Context, wispID, label, actor string) error
+	RemoveWispLabel(ctx context.Context, wispID, label, actor string) (int64, error)
 	AddWispLabels(ctx context.Context, wispID string, labels []string, actor string) error
 	RemoveWispLabels(ctx context.Context, wispID string, labels []string, actor string) error
 	SetWispLabels(ctx context.Context, wispID string, labels []string, actor string) error
@@ -69,25 +71,26 @@ func (u *labelUseCaseImpl) add(ctx context.Context, id, label, actor string, use
 	return nil
 }
 
-func (u *labelUseCaseImpl) RemoveLabel(ctx context.Context, issueID, label, actor string) error {
+func (u *labelUseCaseImpl) RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error) {
 	return u.remove(ctx, issueID, label, actor, false)
 }
 
-func (u *labelUseCaseImpl) RemoveWispLabel(ctx context.Context, wispID, label, actor string) error {
+func (u *labelUseCaseImpl) RemoveWispLabel(ctx context.Context, wispID, label, actor string) (int64, error) {
 	return u.remove(ctx, wispID, label, actor, true)
 }
 
-func (u *labelUseCaseImpl) remove(ctx context.Context, id, label, actor string, useWisp bool) error {
+func (u *labelUseCaseImpl) remove(ctx context.Context, id, label, actor string, useWisp bool) (int64, error) {
 	if id == "" {
-		return fmt.Errorf("remove label: id must not be empty")
+		return 0, fmt.Errorf("remove label: id must not be empty")
 	}
 	if label == "" {
-		return fmt.Errorf("remove label: label must not be empty")
+		return 0, fmt.Errorf("remove label: label must not be empty")
 	}
-	if err := u.labelRepo.Delete(ctx, id, label, actor, LabelOpts{UseWispsTable: useWisp}); err != nil {
-		return fmt.Errorf("remove label %s/%s: %w", id, label, err)
+	removed, err := u.labelRepo.Delete(ctx, id, label, actor, LabelOpts{UseWispsTable: useWisp})
+	if err != nil {
+		return 0, fmt.Errorf("remove label %s/%s: %w", id, label, err)
 	}
-	return nil
+	return removed, nil
 }
 
 func (u *labelUseCaseImpl) AddLabels(ctx context.Context, issueID string, labels []string, actor string) error {
@@ -131,7 +134,7 @@ func (u *labelUseCaseImpl) removeMany(ctx context.Context, id string, labels []s
 		if label == "" {
 			continue
 		}
-		if err := u.labelRepo.Delete(ctx, id, label, actor, opts); err != nil {
+		if _, err := u.labelRepo.Delete(ctx, id, label, actor, opts); err != nil {
 			return fmt.Errorf("remove labels: %s: %w", label, err)
 		}
 	}
@@ -165,7 +168,7 @@ func (u *labelUseCaseImpl) setMany(ctx context.Context, id string, labels []stri
 	for _, l := range current {
 		existing[l] = true
 		if !desired[l] {
-			if err := u.labelRepo.Delete(ctx, id, l, actor, opts); err != nil {
+			if _, err := u.labelRepo.Delete(ctx, id, l, actor, opts); err != nil {
 				return fmt.Errorf("set labels: remove %s: %w", l, err)
 			}
 		}
@@ -213,3 +213,66 @@ func TestEmbeddedCloseIssueCheckedVersionCAS(t *testing.T) {
 		t.Fatalf("casv-stale status = %q after nil-version close, want closed", iss.Status)
 	}
 }
+
+// TestEmbeddedCloseIssueCheckedRechecksBlockersInWrite simulates a blocker
+// reopening mid-close: the blocker goes back to open with a raw write that
+// leaves the target's is_blocked at 0, which is what the close transaction
+// sees when the reopen lands after its guard read. The is_blocked guard alone
+// would let the close through; the conditional close UPDATE re-checks the
+// edges and rejects it, leaving the issue open with no closed event. Only
+// Force gets past it. The transitive-block exemption still holds.
+func TestEmbeddedCloseIssueCheckedRechecksBlockersInWrite(t *testing.T) {
+	skipUnlessEmbeddedDolt(t)
+	te := newTestEnv(t, "civ")
+	ctx := t.Context()
+
+	for _, id := range []string{"civ-blocker", "civ-target", "civ-parent", "civ-child"} {
+		iss := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := te.store.CreateIssue(ctx, iss, "tester"); err != nil {
+			t.Fatalf("create %s: %v", id, err)
+		}
+	}
+	for _, dep := range []*types.Dependency{
+		{IssueID: "civ-target", DependsOnID: "civ-blocker", Type: types.DepBlocks},
+		{IssueID: "civ-parent", DependsOnID: "civ-blocker", Type: types.DepBlocks},
+		{IssueID: "civ-child", DependsOnID: "civ-parent", Type: types.DepParentChild},
+	} {
+		if err := te.store.AddDependency(ctx, dep, "tester"); err != nil {
+			t.Fatalf("AddDependency(%s -> %s): %v", dep.IssueID, dep.DependsOnID, err)
+		}
+	}
+
+	// A purely transitive block still closes.
+	if _, err := te.store.CloseIssueChecked(ctx, "civ-child", "tester", storage.CloseIssueOptions{Reason: "done"}); err != nil {
+		t.Fatalf("close of a transitively blocked child err = %v, want nil", err)
+	}
+
+	if err := te.store.CloseIssue(ctx, "civ-blocker", "done", "tester", ""); err != nil {
+		t.Fatalf("close blocker: %v", err)
+	}
+	if blocked, _, err := te.store.IsBlocked(ctx, "civ-target"); err != nil || blocked {
+		t.Fatalf("civ-target should be unblocked once its blocker closed (blocked=%v err=%v)", blocked, err)
+	}
+	// The blocker reopens between the guard read and the close write.
+	te.exec(t, ctx, "UPDATE issues SET status = 'open', closed_at = NULL WHERE id = ?", "civ-blocker")
+
+	res, err := te.store.CloseIssueChecked(ctx, "civ-target", "tester", storage.CloseIssueOptions{Reason: "done"})
+	if !errors.Is(err, storage.ErrCloseBlocked) {
+		t.Fatalf("close err = %v, want errors.Is(_, ErrCloseBlocked)", err)
+	}
+	if res.Unchanged {
+		t.Fatalf("res.Unchanged = true on refusal, want false")
+	}
+	if iss, _ := te.store.GetIssue(ctx, "civ-target"); iss.Status == types.StatusClosed {
+		t.Fatalf("civ-target closed despite a reopened blocker")
+	}
+	te.assertEventCount(t, ctx, "events", "civ-target", string(types.EventClosed), 0)
+
+	// Force is the only bypass.
+	if _, err := te.store.CloseIssueChecked(ctx, "civ-target", "tester", storage.CloseIssueOptions{Reason: "done", Force: true}); err != nil {
+		t.Fatalf("force close err = %v, want nil", err)
+	}
+	if iss, _ := te.store.GetIssue(ctx, "civ-target"); iss.Status != types.StatusClosed {
+		t.Fatalf("civ-target status = %q after force close, want closed", iss.Status)
+	}
+}
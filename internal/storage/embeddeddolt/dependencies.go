@@ -24,8 +24,10 @@ func (s *EmbeddedDoltStore) AddDependencyWithOptions(ctx context.Context, dep *t
 		// Embedded commits the whole working set on the connection, so the
 		// event-written flag is not needed for selective staging (unlike DoltStore).
 		_, err := issueops.AddDependencyInTx(ctx, tx, dep, actor, issueops.AddDependencyOpts{
-			IsCrossPrefix: types.ExtractPrefix(dep.IssueID) != types.ExtractPrefix(dep.DependsOnID),
-			EmitEvent:     addOpts.EmitEvent,
+			IsCrossPrefix:   types.ExtractPrefix(dep.IssueID) != types.ExtractPrefix(dep.DependsOnID),
+			EmitEvent:       addOpts.EmitEvent,
+			RejectDuplicate: addOpts.RejectDuplicate,
+			SkipCycleCheck:  addOpts.SkipCycleCheck,
 		})
 		return err
 	})
@@ -36,18 +38,22 @@ func (s *EmbeddedDoltStore) AddDependencyWithOptions(ctx context.Context, dep *t
 // batch, duplicate cleanup). The explicit bd dep remove verb calls
 // RemoveDependencyWithOptions with EmitEvent set.
 func (s *EmbeddedDoltStore) RemoveDependency(ctx context.Context, issueID, dependsOnID string, actor string) error {
-	return s.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, storage.DependencyRemoveOptions{})
+	_, err := s.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, storage.DependencyRemoveOptions{})
+	return err
 }
 
 // RemoveDependencyWithOptions removes a dependency; EmitEvent records a
 // dependency_removed history event for the explicit dep verb.
-func (s *EmbeddedDoltStore) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, rmOpts storage.DependencyRemoveOptions) error {
-	return s.withConn(ctx, true, func(tx *sql.Tx) error {
+func (s *EmbeddedDoltStore) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, rmOpts storage.DependencyRemoveOptions) (int64, error) {
+	var removed int64
+	err := s.withConn(ctx, true, func(tx *sql.Tx) error {
 		// Embedded commits the whole working set on the connection, so the
-		// event-written flag is not needed for selective staging (unlike DoltStore).
-		_, err := issueops.RemoveDependencyInTx(ctx, tx, issueID, dependsOnID, actor, rmOpts.EmitEvent)
+		// count is not needed for selective staging (unlike DoltStore).
+		var err error
+		removed, err = issueops.RemoveDependencyInTx(ctx, tx, issueID, dependsOnID, actor, rmOpts.EmitEvent)
 		return err
 	})
+	return removed, err
 }
 
 // GetIssuesByIDs retrieves multiple issues by ID.
@@ -26,8 +26,12 @@ func (s *EmbeddedDoltStore) AddLabel(ctx context.Context, issueID, label, actor
 }
 
 // RemoveLabel removes a label from an issue.
-func (s *EmbeddedDoltStore) RemoveLabel(ctx context.Context, issueID, label, actor string) error {
-	return s.withConn(ctx, true, func(tx *sql.Tx) error {
-		return issueops.RemoveLabelInTx(ctx, tx, "", "", issueID, label, actor)
+func (s *EmbeddedDoltStore) RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error) {
+	var removed int64
+	err := s.withConn(ctx, true, func(tx *sql.Tx) error {
+		var err error
+		removed, err = issueops.RemoveLabelInTx(ctx, tx, "", "", issueID, label, actor)
+		return err
 	})
+	return removed, err
 }
@@ -12,26 +12,11 @@ import (
 )
 
 func (s *EmbeddedDoltStore) GetStatistics(ctx context.Context) (*types.Statistics, error) {
-	stats := &types.Statistics{}
+	var stats *types.Statistics
 	err := s.withConn(ctx, false, func(tx *sql.Tx) error {
-		if err := issueops.ScanIssueCountsInTx(ctx, tx, stats); err != nil {
-			return err
-		}
-
-		var blockedCount int
-		if err := tx.QueryRowContext(ctx, `
-			SELECT COUNT(*) FROM issues
-			WHERE is_blocked = 1 AND status <> 'closed' AND status <> 'pinned'
-		`).Scan(&blockedCount); err != nil {
-			return err
-		}
-		stats.BlockedIssues = &blockedCount
-		ready := stats.OpenIssues - blockedCount
-		if ready < 0 {
-			ready = 0
-		}
-		stats.ReadyIssues = &ready
-		return nil
+		var err error
+		stats, err = issueops.GetStatisticsInTx(ctx, tx)
+		return err
 	})
 	if err != nil {
 		return nil, fmt.Errorf("embeddeddolt: get statistics: %w", err)
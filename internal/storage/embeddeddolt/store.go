@@ -25,6 +25,7 @@ import (
 // Compile-time interface checks.
 var _ storage.DoltStorage = (*EmbeddedDoltStore)(nil)
 var _ storage.StoreLocator = (*EmbeddedDoltStore)(nil)
+var _ storage.BackendVersionReporter = (*EmbeddedDoltStore)(nil)
 var _ storage.GarbageCollector = (*EmbeddedDoltStore)(nil)
 var _ storage.Flattener = (*EmbeddedDoltStore)(nil)
 var _ storage.Compactor = (*EmbeddedDoltStore)(nil)
@@ -741,6 +742,17 @@ func (s *EmbeddedDoltStore) Compact(ctx context.Context, initialHash, boundaryHa
 	})
 }
 
+// BackendVersions returns the embedded Dolt engine version and the applied
+// schema version.
+func (s *EmbeddedDoltStore) BackendVersions(ctx context.Context) (doltVersion string, schemaVersion int, err error) {
+	err = s.withConn(ctx, false, func(tx *sql.Tx) error {
+		var verr error
+		doltVersion, schemaVersion, verr = schema.BackendVersions(ctx, tx)
+		return verr
+	})
+	return doltVersion, schemaVersion, err
+}
+
 // Path returns the embedded dolt data directory (.beads/embeddeddolt/).
 func (s *EmbeddedDoltStore) Path() string {
 	return s.dataDir
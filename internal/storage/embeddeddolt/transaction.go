@@ -83,6 +83,13 @@ func (t *embeddedTransaction) CloseIssue(ctx context.Context, id string, reason
 	return err
 }
 
+func (t *embeddedTransaction) ClaimIssue(ctx context.Context, id string, actor string) error {
+	t.dirty.MarkDirty("issues")
+	t.dirty.MarkDirty("events")
+	_, err := issueops.ClaimIssueInTx(ctx, t.tx, id, actor)
+	return err
+}
+
 func (t *embeddedTransaction) DeleteIssue(ctx context.Context, id string) error {
 	t.dirty.MarkDirty("issues")
 	t.dirty.MarkDirty("dependencies")
@@ -112,9 +119,10 @@ func (t *embeddedTransaction) AddDependency(ctx context.Context, dep *types.Depe
 func (t *embeddedTransaction) AddDependencyWithOptions(ctx context.Context, dep *types.Dependency, actor string, addOpts storage.DependencyAddOptions) error {
 	_, _, eventTable, depTable := issueops.WispTableRouting(issueops.IsActiveWispInTx(ctx, t.tx, dep.IssueID))
 	eventWritten, err := issueops.AddDependencyInTx(ctx, t.tx, dep, actor, issueops.AddDependencyOpts{
-		IsCrossPrefix:  types.ExtractPrefix(dep.IssueID) != types.ExtractPrefix(dep.DependsOnID),
-		SkipCycleCheck: addOpts.SkipCycleCheck,
-		EmitEvent:      addOpts.EmitEvent,
+		IsCrossPrefix:   types.ExtractPrefix(dep.IssueID) != types.ExtractPrefix(dep.DependsOnID),
+		SkipCycleCheck:  addOpts.SkipCycleCheck,
+		EmitEvent:       addOpts.EmitEvent,
+		RejectDuplicate: addOpts.RejectDuplicate,
 	})
 	if err != nil {
 		return err
@@ -141,26 +149,27 @@ func (t *embeddedTransaction) CycleThroughEdges(ctx context.Context, edges [][2]
 }
 
 func (t *embeddedTransaction) RemoveDependency(ctx context.Context, issueID, dependsOnID string, actor string) error {
-	return t.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, storage.DependencyRemoveOptions{})
+	_, err := t.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, storage.DependencyRemoveOptions{})
+	return err
 }
 
-func (t *embeddedTransaction) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, rmOpts storage.DependencyRemoveOptions) error {
+func (t *embeddedTransaction) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, rmOpts storage.DependencyRemoveOptions) (int64, error) {
 	// Route dirty marking on the source's wisp status: a wisp-source remove
 	// stages wisp_dependencies/wisp_events, a permanent one dependencies/events.
 	_, _, eventTable, depTable := issueops.WispTableRouting(issueops.IsActiveWispInTx(ctx, t.tx, issueID))
-	eventWritten, err := issueops.RemoveDependencyInTx(ctx, t.tx, issueID, dependsOnID, actor, rmOpts.EmitEvent)
+	removed, err := issueops.RemoveDependencyInTx(ctx, t.tx, issueID, dependsOnID, actor, rmOpts.EmitEvent)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	t.dirty.MarkDirty(depTable)
 	// RemoveDependencyInTx records a dependency_removed event on the source's
 	// event table only for a genuine emit (explicit verb + edge removal); stage
 	// it so it commits with the edge. A structural or missing-edge remove writes
 	// no event.
-	if eventWritten {
+	if removed > 0 && rmOpts.EmitEvent {
 		t.dirty.MarkDirty(eventTable)
 	}
-	return nil
+	return removed, nil
 }
 
 func (t *embeddedTransaction) GetDependencyRecords(ctx context.Context, issueID string) ([]*types.Dependency, error) {
@@ -176,7 +185,7 @@ func (t *embeddedTransaction) AddLabel(ctx context.Context, issueID, label, acto
 	return issueops.AddLabelInTx(ctx, t.tx, "", "", issueID, label, actor)
 }
 
-func (t *embeddedTransaction) RemoveLabel(ctx context.Context, issueID, label, actor string) error {
+func (t *embeddedTransaction) RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error) {
 	t.dirty.MarkDirty("labels")
 	return issueops.RemoveLabelInTx(ctx, t.tx, "", "", issueID, label, actor)
 }
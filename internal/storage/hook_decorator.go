@@ -212,13 +212,17 @@ func (h *HookFiringStore) RemoveDependency(ctx context.Context, issueID, depends
 	return nil
 }
 
-// RemoveDependencyWithOptions removes a dependency with options and fires on_update.
-func (h *HookFiringStore) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, opts DependencyRemoveOptions) error {
-	if err := h.inner.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, opts); err != nil {
-		return err
+// RemoveDependencyWithOptions removes a dependency with options and fires
+// on_update when an edge was actually removed.
+func (h *HookFiringStore) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, opts DependencyRemoveOptions) (int64, error) {
+	removed, err := h.inner.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, opts)
+	if err != nil {
+		return 0, err
 	}
-	h.fireDependencyHookByID(ctx, issueID)
-	return nil
+	if removed > 0 {
+		h.fireDependencyHookByID(ctx, issueID)
+	}
+	return removed, nil
 }
 
 // ── Label mutations ─────────────────────────────────────────────────
@@ -232,13 +236,16 @@ func (h *HookFiringStore) AddLabel(ctx context.Context, issueID, label, actor st
 	return nil
 }
 
-// RemoveLabel removes a label and fires on_update.
-func (h *HookFiringStore) RemoveLabel(ctx context.Context, issueID, label, actor string) error {
-	if err := h.inner.RemoveLabel(ctx, issueID, label, actor); err != nil {
-		return err
+// RemoveLabel removes a label and fires on_update when the issue carried it.
+func (h *HookFiringStore) RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error) {
+	removed, err := h.inner.RemoveLabel(ctx, issueID, label, actor)
+	if err != nil {
+		return 0, err
 	}
-	h.fireHookByID(ctx, hooks.EventUpdate, issueID)
-	return nil
+	if removed > 0 {
+		h.fireHookByID(ctx, hooks.EventUpdate, issueID)
+	}
+	return removed, nil
 }
 
 // ── Comment mutations ───────────────────────────────────────────────
@@ -539,6 +546,16 @@ func (t *hookTrackingTransaction) CloseIssue(ctx context.Context, id string, rea
 	return nil
 }
 
+func (t *hookTrackingTransaction) ClaimIssue(ctx context.Context, id string, actor string) error {
+	if err := t.Transaction.ClaimIssue(ctx, id, actor); err != nil {
+		return err
+	}
+	if issue, err := t.Transaction.GetIssue(ctx, id); err == nil {
+		t.pending = append(t.pending, pendingHook{hooks.EventUpdate, issue})
+	}
+	return nil
+}
+
 func (t *hookTrackingTransaction) AddDependency(ctx context.Context, dep *types.Dependency, actor string) error {
 	return t.AddDependencyWithOptions(ctx, dep, actor, DependencyAddOptions{})
 }
@@ -554,17 +571,19 @@ func (t *hookTrackingTransaction) AddDependencyWithOptions(ctx context.Context,
 }
 
 func (t *hookTrackingTransaction) RemoveDependency(ctx context.Context, issueID, dependsOnID string, actor string) error {
-	return t.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, DependencyRemoveOptions{})
+	_, err := t.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, DependencyRemoveOptions{})
+	return err
 }
 
-func (t *hookTrackingTransaction) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, opts DependencyRemoveOptions) error {
-	if err := t.Transaction.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, opts); err != nil {
-		return err
+func (t *hookTrackingTransaction) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, opts DependencyRemoveOptions) (int64, error) {
+	removed, err := t.Transaction.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, opts)
+	if err != nil || removed == 0 {
+		return removed, err
 	}
 	if issue, err := dependencySnapshot(ctx, issueID, t.Transaction.GetIssue, t.Transaction.GetDependencyRecords); err == nil {
 		t.pending = append(t.pending, pendingHook{hooks.EventUpdate, issue})
 	}
-	return nil
+	return removed, nil
 }
 
 func (t *hookTrackingTransaction) AddLabel(ctx context.Context, issueID, label, actor string) error {
@@ -577,14 +596,15 @@ func (t *hookTrackingTransaction) AddLabel(ctx context.Context, issueID, label,
 	return nil
 }
 
-func (t *hookTrackingTransaction) RemoveLabel(ctx context.Context, issueID, label, actor string) error {
-	if err := t.Transaction.RemoveLabel(ctx, issueID, label, actor); err != nil {
-		return err
+func (t *hookTrackingTransaction) RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error) {
+	removed, err := t.Transaction.RemoveLabel(ctx, issueID, label, actor)
+	if err != nil || removed == 0 {
+		return removed, err
 	}
 	if issue, err := t.Transaction.GetIssue(ctx, issueID); err == nil {
 		t.pending = append(t.pending, pendingHook{hooks.EventUpdate, issue})
 	}
-	return nil
+	return removed, nil
 }
 
 func (t *hookTrackingTransaction) AddComment(ctx context.Context, issueID, actor, comment string) error {
@@ -273,6 +273,7 @@ func GetBlockedIssuesInTx(ctx context.Context, tx DBTX, filter types.WorkFilter)
 	}
 
 	blockerMap := make(map[string][]string)
+	reasonMap := make(map[string][]types.BlockedReason)
 	blockingDeps, err := loadBlockingDepsForIssueIDsInTx(ctx, tx, []string{"dependencies", "wisp_dependencies"}, blockedIDList)
 	if err != nil {
 		return nil, fmt.Errorf("get blocking deps: %w", err)
@@ -296,6 +297,7 @@ func GetBlockedIssuesInTx(ctx context.Context, tx DBTX, filter types.WorkFilter)
 				continue
 			}
 			blockerMap[rec.issueID] = append(blockerMap[rec.issueID], rec.dependsOnID)
+			reasonMap[rec.issueID] = append(reasonMap[rec.issueID], types.BlockedReason{Type: rec.depType, ID: rec.dependsOnID})
 		}
 	}
 
@@ -311,11 +313,20 @@ func GetBlockedIssuesInTx(ctx context.Context, tx DBTX, filter types.WorkFilter)
 			for childID, parentID := range parentMap {
 				if _, alreadyHas := blockerMap[childID]; !alreadyHas {
 					blockerMap[childID] = []string{parentID}
+					reasonMap[childID] = []types.BlockedReason{{Type: types.BlockedReasonParent, ID: parentID}}
 				}
 			}
 		}
 	}
 
+	if filter.DirectBlockedOnly {
+		for id, reasons := range reasonMap {
+			if reasons[0].Type == types.BlockedReasonParent {
+				delete(blockerMap, id)
+			}
+		}
+	}
+
 	displayIDs := make([]string, 0, len(blockerMap))
 	for id := range blockerMap {
 		displayIDs = append(displayIDs, id)
@@ -359,6 +370,7 @@ func GetBlockedIssuesInTx(ctx context.Context, tx DBTX, filter types.WorkFilter)
 			Issue:          *issue,
 			BlockedByCount: len(blockerIDs),
 			BlockedBy:      blockerIDs,
+			Reason:         reasonMap[id],
 		})
 	}
 
@@ -21,11 +21,21 @@ type CloseResult struct {
 // and recording the close event. Routes to the correct table (issues/wisps)
 // automatically. The caller is responsible for Dolt versioning if needed.
 func CloseIssueInTx(ctx context.Context, tx DBTX, id string, reason, actor, session string) (*CloseResult, error) {
-	return closeIssueInTx(ctx, tx, id, reason, actor, session, true)
+	return closeIssueInTx(ctx, tx, id, reason, actor, session, true, false)
 }
 
 func CloseIssueWithoutEventInTx(ctx context.Context, tx DBTX, id string, reason, actor, session string) (*CloseResult, error) {
-	return closeIssueInTx(ctx, tx, id, reason, actor, session, false)
+	return closeIssueInTx(ctx, tx, id, reason, actor, session, false, false)
+}
+
+// CloseIssueIfUnblockedInTx is CloseIssueInTx with the close made conditional
+// on the issue having no open direct blocker (blocks, conditional-blocks, or
+// an unsatisfied waits-for gate). The condition is part of the UPDATE's WHERE
+// clause and reads the dependency edges, not is_blocked, so the check and the
+// write are one statement. When it fails the issue is left open and the call
+// returns storage.ErrCloseBlocked; the caller's transaction should roll back.
+func CloseIssueIfUnblockedInTx(ctx context.Context, tx DBTX, id string, reason, actor, session string) (*CloseResult, error) {
+	return closeIssueInTx(ctx, tx, id, reason, actor, session, true, true)
 }
 
 // CloseIssueCheckedInTx closes an issue within a transaction, refusing with
@@ -41,6 +51,9 @@ func CloseIssueWithoutEventInTx(ctx context.Context, tx DBTX, id string, reason,
 // (a parent-child child of a blocked parent — historically closable) or a stale
 // is_blocked column whose direct blockers have since closed. Reading the live
 // blocker list self-heals against a stale column instead of acting on it.
+// The close itself then goes through CloseIssueIfUnblockedInTx, so a blocker
+// that reopened after the guard read (or a stale is_blocked=0) still refuses
+// with storage.ErrCloseBlocked. force skips both checks.
 //
 // When expectedVersion is non-nil it adds an ORTHOGONAL optimistic-concurrency
 // precondition: the row's current RowVersion (row_lock) must still equal
@@ -84,6 +97,9 @@ func CloseIssueCheckedInTx(ctx context.Context, tx DBTX, id, reason, actor, sess
 			}
 		}
 	}
+	if !force {
+		return CloseIssueIfUnblockedInTx(ctx, tx, id, reason, actor, session)
+	}
 	return CloseIssueInTx(ctx, tx, id, reason, actor, session)
 }
 
@@ -111,10 +127,38 @@ func isClosedInTx(ctx context.Context, tx DBTX, id string) (bool, error) {
 	return false, nil
 }
 
+// noOpenDirectBlockerSQL is the CloseIssueIfUnblockedInTx condition on a row
+// of issueTable: none of its blocks/conditional-blocks targets is open, and no
+// waits-for gate on it is unsatisfied. It is the direct-blocker half of the
+// is_blocked unmark predicate; a parent-child (transitive) block does not stop
+// a close, matching the CloseIssueCheckedInTx guard.
+func noOpenDirectBlockerSQL(issueTable, depTable string) string {
+	return fmt.Sprintf(`
+		NOT EXISTS (
+		  SELECT 1 FROM %[2]s d
+		  JOIN issues t ON t.id = d.depends_on_issue_id
+		  WHERE d.issue_id = %[1]s.id
+		    AND (d.type = 'blocks' OR d.type = 'conditional-blocks')
+		    AND t.status <> 'closed' AND t.status <> 'pinned'
+		)
+		AND NOT EXISTS (
+		  SELECT 1 FROM %[2]s d
+		  JOIN wisps t ON t.id = d.depends_on_wisp_id
+		  WHERE d.issue_id = %[1]s.id
+		    AND (d.type = 'blocks' OR d.type = 'conditional-blocks')
+		    AND t.status <> 'closed' AND t.status <> 'pinned'
+		)
+		AND NOT EXISTS (
+		  SELECT 1 FROM %[2]s d
+		  WHERE d.issue_id = %[1]s.id AND d.type = 'waits-for'
+		    AND (%[3]s)
+		)`, issueTable, depTable, waitsForGateBlockedSQL)
+}
+
 //nolint:gosec // G201: table names come from WispTableRouting (hardcoded constants)
-func closeIssueInTx(ctx context.Context, tx DBTX, id string, reason, actor, session string, recordEvent bool) (*CloseResult, error) {
+func closeIssueInTx(ctx context.Context, tx DBTX, id string, reason, actor, session string, recordEvent, requireUnblocked bool) (*CloseResult, error) {
 	isWisp := IsActiveWispInTx(ctx, tx, id)
-	issueTable, _, eventTable, _ := WispTableRouting(isWisp)
+	issueTable, _, eventTable, depTable := WispTableRouting(isWisp)
 
 	var affectedIssues, affectedWisps []string
 	var aerr error
@@ -133,11 +177,15 @@ func closeIssueInTx(ctx context.Context, tx DBTX, id string, reason, actor, sess
 	// row_lock) collides on this cell and is forced to conflict-and-retry rather
 	// than silently cell-merging a revert-to-ready over a completed close (see
 	// lease.go). The lease row is deleted below: a closed issue holds no lease.
+	condition := ""
+	if requireUnblocked {
+		condition = " AND " + noOpenDirectBlockerSQL(issueTable, depTable)
+	}
 	result, err := tx.ExecContext(ctx, fmt.Sprintf(`
 		UPDATE %s SET status = ?, closed_at = ?, updated_at = ?, close_reason = ?, closed_by_session = ?,
 			row_lock = ?
-		WHERE id = ? AND status != ?
-	`, issueTable), types.StatusClosed, now, now, reason, session, freshRowLock(), id, types.StatusClosed)
+		WHERE id = ? AND status != ?%s
+	`, issueTable, condition), types.StatusClosed, now, now, reason, session, freshRowLock(), id, types.StatusClosed)
 	if err != nil {
 		return nil, fmt.Errorf("failed to close issue: %w", err)
 	}
@@ -160,6 +208,9 @@ func closeIssueInTx(ctx context.Context, tx DBTX, id string, reason, actor, sess
 		if types.Status(status) == types.StatusClosed {
 			return &CloseResult{IsWisp: isWisp, AlreadyClosed: true}, nil
 		}
+		if requireUnblocked {
+			return nil, fmt.Errorf("%w: %s has an open blocker at close time", storage.ErrCloseBlocked, id)
+		}
 		return nil, fmt.Errorf("failed to close issue: %s", id)
 	}
 
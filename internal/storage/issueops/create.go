@@ -623,7 +623,7 @@ func InsertIssueIfNew(ctx context.Context, tx *sql.Tx, issueTable string, issue
 			return false, true, nil
 		}
 	}
-	if err := insertIssueIntoTable(ctx, tx, issueTable, issue, opts.RejectStaleUpserts); err != nil {
+	if err := insertIssueIntoTable(ctx, tx, issueTable, issue, opts.RejectStaleUpserts, opts.ReplaceAllColumns); err != nil {
 		return false, false, fmt.Errorf("failed to insert issue %s: %w", issue.ID, err)
 	}
 	return existingCount == 0, false, nil
@@ -785,7 +785,7 @@ func PersistDependenciesWithOptionsResult(ctx context.Context, tx *sql.Tx, issue
 					fmt.Sprintf("SELECT 1 FROM %s WHERE id = ?", lookupTable),
 					dep.DependsOnID).Scan(&exists); err != nil {
 					if err == sql.ErrNoRows {
-						recordSkippedDependency(opts, dep, "target not found")
+						recordSkippedDependency(opts, dep, SkipReasonTargetNotFound)
 						continue
 					}
 					return result, fmt.Errorf("failed to check dependency target %s for %s: %w", dep.DependsOnID, dep.IssueID, err)
@@ -849,6 +849,10 @@ func dependencyCreatedBy(dep *types.Dependency, actor string) string {
 	return actor
 }
 
+// SkipReasonTargetNotFound is the OnSkippedDependency reason for an edge
+// whose depends_on_id matches no issue in the batch or the database.
+const SkipReasonTargetNotFound = "target not found"
+
 func recordSkippedDependency(opts storage.BatchCreateOptions, dep *types.Dependency, reason string) {
 	if dep == nil {
 		return
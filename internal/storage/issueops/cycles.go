@@ -10,16 +10,18 @@ import (
 
 // DetectCyclesInTx finds dependency cycles across both the dependencies and
 // wisp_dependencies tables. Returns slices of issues forming each cycle.
-// Only considers "blocks" and "conditional-blocks" dependencies for cycle detection.
+// Only cycle-relevant dependencies (types.DependencyType.IsCycleRelevant) form
+// the graph, the same edge set AddDependency keeps acyclic unless forced.
 func DetectCyclesInTx(ctx context.Context, tx DBTX) ([][]*types.Issue, error) {
 	// Build adjacency list from both dependency tables.
-	graph := make(map[string][]string)
-	if err := AppendBlockingGraphInTx(ctx, tx, []string{"dependencies", "wisp_dependencies"}, graph); err != nil {
+	idx, err := LoadDepIndexInTx(ctx, tx)
+	if err != nil {
 		return nil, err
 	}
+	graph := idx.CycleGraph()
 
 	// Find cycles using DFS.
-	var cycles [][]*types.Issue
+	var cyclePaths [][]string
 	visited := make(map[string]bool)
 	recStack := make(map[string]bool)
 	path := make([]string, 0)
@@ -45,17 +47,7 @@ func DetectCyclesInTx(ctx context.Context, tx DBTX) ([][]*types.Issue, error) {
 					}
 				}
 				if cycleStart >= 0 {
-					cyclePath := path[cycleStart:]
-					var cycleIssues []*types.Issue
-					for _, id := range cyclePath {
-						issue, _ := GetIssueInTx(ctx, tx, id)
-						if issue != nil {
-							cycleIssues = append(cycleIssues, issue)
-						}
-					}
-					if len(cycleIssues) > 0 {
-						cycles = append(cycles, cycleIssues)
-					}
+					cyclePaths = append(cyclePaths, append([]string(nil), path[cycleStart:]...))
 				}
 			}
 		}
@@ -70,7 +62,42 @@ func DetectCyclesInTx(ctx context.Context, tx DBTX) ([][]*types.Issue, error) {
 			dfs(node)
 		}
 	}
+	if len(cyclePaths) == 0 {
+		return nil, nil
+	}
 
+	// Hydrate every cycle member with one batched read.
+	var ids []string
+	seen := make(map[string]bool)
+	for _, cyclePath := range cyclePaths {
+		for _, id := range cyclePath {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	issues, err := GetIssuesByIDsInTx(ctx, tx, ids, nil)
+	if err != nil {
+		return nil, fmt.Errorf("detect cycles: fetch issues: %w", err)
+	}
+	byID := make(map[string]*types.Issue, len(issues))
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+
+	var cycles [][]*types.Issue
+	for _, cyclePath := range cyclePaths {
+		var cycleIssues []*types.Issue
+		for _, id := range cyclePath {
+			if issue := byID[id]; issue != nil {
+				cycleIssues = append(cycleIssues, issue)
+			}
+		}
+		if len(cycleIssues) > 0 {
+			cycles = append(cycles, cycleIssues)
+		}
+	}
 	return cycles, nil
 }
 
@@ -82,17 +109,18 @@ func DetectCyclesInTx(ctx context.Context, tx DBTX) ([][]*types.Issue, error) {
 //
 //nolint:gosec // G201: depTable is hardcoded to "dependencies" or "wisp_dependencies"
 func AppendBlockingGraphInTx(ctx context.Context, tx DBTX, depTables []string, graph map[string][]string) error {
-	return appendDependencyGraphInTx(ctx, tx, depTables, graph, false)
+	return appendDependencyGraphInTx(ctx, tx, depTables, graph, func(t types.DependencyType) bool {
+		return t == types.DepBlocks || t == types.DepConditionalBlocks
+	})
 }
 
-// AppendSchedulingGraphInTx adds blocks, conditional-blocks, and parent-child
-// edges to graph for validating mutations against the combined scheduling
-// graph. DetectCycles intentionally continues to use AppendBlockingGraphInTx.
+// AppendSchedulingGraphInTx adds the cycle-relevant edges to graph for
+// validating mutations against the same graph DetectCycles walks.
 func AppendSchedulingGraphInTx(ctx context.Context, tx DBTX, depTables []string, graph map[string][]string) error {
-	return appendDependencyGraphInTx(ctx, tx, depTables, graph, true)
+	return appendDependencyGraphInTx(ctx, tx, depTables, graph, types.DependencyType.IsCycleRelevant)
 }
 
-func appendDependencyGraphInTx(ctx context.Context, tx DBTX, depTables []string, graph map[string][]string, includeParentChild bool) error {
+func appendDependencyGraphInTx(ctx context.Context, tx DBTX, depTables []string, graph map[string][]string, include func(types.DependencyType) bool) error {
 	for _, depTable := range depTables {
 		rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
 			SELECT issue_id, %s AS depends_on_id, type
@@ -107,8 +135,7 @@ func appendDependencyGraphInTx(ctx context.Context, tx DBTX, depTables []string,
 				_ = rows.Close()
 				return fmt.Errorf("dependency graph: scan %s: %w", depTable, err)
 			}
-			t := types.DependencyType(depType)
-			if t == types.DepBlocks || t == types.DepConditionalBlocks || (includeParentChild && t == types.DepParentChild) {
+			if include(types.DependencyType(depType)) {
 				graph[issueID] = append(graph[issueID], dependsOnID)
 			}
 		}
@@ -0,0 +1,127 @@
+package issueops
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// DepEdge is one dependency edge as seen from an endpoint: the issue on the
+// other side and the dependency type.
+type DepEdge struct {
+	ID   string
+	Type types.DependencyType
+}
+
+// DepIndex is an in-memory adjacency index over every dependency edge in
+// both the dependencies and wisp_dependencies tables. Graph commands (dep
+// tree, dep cycles) load it once per call with one scan per table and
+// traverse it in memory, instead of issuing a dependency query per visited
+// node. Edges keep the order the scan returned them in.
+type DepIndex struct {
+	out map[string][]DepEdge // issue_id -> depends_on targets
+	in  map[string][]DepEdge // depends_on target -> dependent issue_ids
+}
+
+// NewDepIndex returns an empty index; AddEdge populates it.
+func NewDepIndex() *DepIndex {
+	return &DepIndex{
+		out: make(map[string][]DepEdge),
+		in:  make(map[string][]DepEdge),
+	}
+}
+
+// AddEdge records that issueID depends on dependsOnID with depType.
+func (x *DepIndex) AddEdge(issueID, dependsOnID string, depType types.DependencyType) {
+	x.out[issueID] = append(x.out[issueID], DepEdge{ID: dependsOnID, Type: depType})
+	x.in[dependsOnID] = append(x.in[dependsOnID], DepEdge{ID: issueID, Type: depType})
+}
+
+// Dependencies returns the edges from id to the issues it depends on.
+func (x *DepIndex) Dependencies(id string) []DepEdge {
+	return x.out[id]
+}
+
+// Dependents returns the edges from id to the issues that depend on it.
+func (x *DepIndex) Dependents(id string) []DepEdge {
+	return x.in[id]
+}
+
+// Edges returns Dependents(id) when reverse is set, Dependencies(id)
+// otherwise — the neighbour set a dependency tree walks in that direction.
+func (x *DepIndex) Edges(id string, reverse bool) []DepEdge {
+	if reverse {
+		return x.in[id]
+	}
+	return x.out[id]
+}
+
+// BlockingGraph returns the hard "blocks" and "conditional-blocks" edges as
+// adjacency lists.
+func (x *DepIndex) BlockingGraph() map[string][]string {
+	graph := make(map[string][]string)
+	for issueID, edges := range x.out {
+		for _, e := range edges {
+			if e.Type == types.DepBlocks || e.Type == types.DepConditionalBlocks {
+				graph[issueID] = append(graph[issueID], e.ID)
+			}
+		}
+	}
+	return graph
+}
+
+// CycleGraph returns the adjacency lists of the cycle-relevant edges
+// (types.DependencyType.IsCycleRelevant), the graph DetectCycles walks.
+// Forceable edges stay in: bd dep add --force only skips the add-time
+// rejection, and a forced supersedes or duplicates loop is still reported.
+func (x *DepIndex) CycleGraph() map[string][]string {
+	graph := make(map[string][]string)
+	for issueID, edges := range x.out {
+		for _, e := range edges {
+			if e.Type.IsCycleRelevant() {
+				graph[issueID] = append(graph[issueID], e.ID)
+			}
+		}
+	}
+	return graph
+}
+
+// LoadDepIndexInTx builds a DepIndex from both dependency tables on tx.
+// A missing wisp_dependencies table is treated as empty.
+func LoadDepIndexInTx(ctx context.Context, tx DBTX) (*DepIndex, error) {
+	idx := NewDepIndex()
+	for _, depTable := range []string{"dependencies", "wisp_dependencies"} {
+		if err := loadDepIndexFromTable(ctx, tx, depTable, idx); err != nil {
+			if optionalBlockedTable(depTable) && isTableNotExistError(err) {
+				continue
+			}
+			return nil, err
+		}
+	}
+	return idx, nil
+}
+
+//nolint:gosec // G201: depTable is "dependencies" or "wisp_dependencies" (hardcoded by caller).
+func loadDepIndexFromTable(ctx context.Context, tx DBTX, depTable string, idx *DepIndex) error {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT issue_id, %s AS depends_on_id, type
+		FROM %s
+	`, DepTargetExpr, depTable))
+	if err != nil {
+		return fmt.Errorf("dependency index: query %s: %w", depTable, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var issueID, dependsOnID, depType string
+		if err := rows.Scan(&issueID, &dependsOnID, &depType); err != nil {
+			return fmt.Errorf("dependency index: scan %s: %w", depTable, err)
+		}
+		idx.AddEdge(issueID, dependsOnID, types.DependencyType(depType))
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("dependency index: rows %s: %w", depTable, err)
+	}
+	return nil
+}
@@ -120,6 +120,10 @@ type AddDependencyOpts struct {
 	// edge produces no event. This mirrors the proxied repository's
 	// DepInsertOpts.EmitEvent gate so both backends record identical history.
 	EmitEvent bool
+	// RejectDuplicate makes a same-type re-add fail with
+	// domain.ErrDuplicateDependency instead of the idempotent metadata update.
+	// bd dep add sets it to tell the user the edge already exists.
+	RejectDuplicate bool
 }
 
 // DepTargetPrecheck carries a target-issue row the caller has already read
@@ -154,6 +158,12 @@ type DepTargetPrecheck struct {
 // Dolt commit stage the events table only when an event row exists, so a
 // no-event add cannot sweep unrelated pending rows into the commit (GH#2455).
 func AddDependencyInTx(ctx context.Context, tx *sql.Tx, dep *types.Dependency, actor string, opts AddDependencyOpts) (bool, error) {
+	// The self-dep guard runs for every type and regardless of SkipCycleCheck,
+	// so bulk wiring cannot slip a self-edge past the skipped cycle probe.
+	if err := domain.CheckSelfDependency(dep); err != nil {
+		return false, err
+	}
+
 	// Auto-detect source routing if not provided.
 	sourceTable := opts.SourceTable
 	writeTable := opts.WriteTable
@@ -243,6 +253,9 @@ func AddDependencyInTx(ctx context.Context, tx *sql.Tx, dep *types.Dependency, a
 		dep.IssueID, dep.DependsOnID).Scan(&existingType)
 	if err == nil {
 		if existingType == string(dep.Type) {
+			if opts.RejectDuplicate {
+				return false, domain.DuplicateDependencyError(dep)
+			}
 			// Same type — idempotent; update metadata. No event is written, so the
 			// caller must not stage the events table for this re-add.
 			//nolint:gosec // G201: writeTable from WispTableRouting; depTargetEquals has no user input.
@@ -400,14 +413,15 @@ func markDirectBlockingDependencySourceInTx(ctx context.Context, tx *sql.Tx, sou
 }
 
 // CheckDependencyCycleInTx rejects self-dependencies and cycles across the
-// combined blocks, conditional-blocks, and parent-child graph before insert.
+// graph of cycle-relevant edges (types.CycleRelevantDependencyTypes) before
+// insert.
 // The caller may pass a restricted depTables list for a known storage bucket;
 // nil uses all dependency tables.
 func CheckDependencyCycleInTx(ctx context.Context, tx DBTX, dep *types.Dependency, depTables []string) error {
-	if dep.IssueID == dep.DependsOnID {
-		return fmt.Errorf("%w: %s cannot depend on itself", domain.ErrSelfDependency, dep.IssueID)
+	if err := domain.CheckSelfDependency(dep); err != nil {
+		return err
 	}
-	if !isSchedulingEdge(dep.Type) {
+	if !dep.Type.IsCycleRelevant() {
 		return nil
 	}
 	wouldCycle, err := WouldCreateSchedulingCycleInTx(ctx, tx, dep.IssueID, dep.DependsOnID, depTables)
@@ -439,12 +453,14 @@ func WouldCreateSchedulingCycleInTx(ctx context.Context, tx DBTX, issueID, depen
 // cycleReachabilityQuery uses UNION distinct recursion so cyclic and diamond
 // graphs terminate by unique reachable node instead of enumerating paths.
 //
-// The walked edge set is the union of scheduling-relevant edges: blocks,
-// conditional-blocks, and parent-child. Parent-child is included because a
-// blocked parent propagates its blocked state to its children in the
-// ready-work computation, so a chain mixing blocks and parent-child edges
-// can form a logical livelock that prevents anything from being ready.
+// The walked edge set is every cycle-relevant type, not just blocks. A blocked
+// parent propagates its blocked state to its children in the ready-work
+// computation, so a chain mixing blocks, waits-for and parent-child edges can
+// form a logical livelock that prevents anything from being ready; supersedes
+// and duplicates chains are walked with them so a loop through those is
+// caught too.
 func cycleReachabilityQuery(depTables []string) string {
+	typeList := cycleRelevantTypeList()
 	if len(depTables) == 1 {
 		return fmt.Sprintf(`
 			WITH RECURSIVE reachable(node) AS (
@@ -452,15 +468,15 @@ func cycleReachabilityQuery(depTables []string) string {
 				UNION
 				SELECT %s
 				FROM reachable r
-				JOIN %s d ON d.issue_id = r.node AND d.type IN ('blocks', 'conditional-blocks', 'parent-child')
+				JOIN %s d ON d.issue_id = r.node AND d.type IN (%s)
 			)
 			SELECT COUNT(*) FROM reachable WHERE node = ?
-		`, DepTargetExpr, depTables[0])
+		`, DepTargetExpr, depTables[0], typeList)
 	}
 
 	var unions []string
 	for _, t := range depTables {
-		unions = append(unions, fmt.Sprintf("SELECT issue_id, %s AS depends_on_id FROM %s WHERE type IN ('blocks', 'conditional-blocks', 'parent-child')", DepTargetExpr, t))
+		unions = append(unions, fmt.Sprintf("SELECT issue_id, %s AS depends_on_id FROM %s WHERE type IN (%s)", DepTargetExpr, t, typeList))
 	}
 	unionQuery := strings.Join(unions, " UNION ")
 	return fmt.Sprintf(`
@@ -479,16 +495,14 @@ func cycleDetectionTables() []string {
 	return []string{"dependencies", "wisp_dependencies"}
 }
 
-// isSchedulingEdge reports whether a dependency type belongs to the static
-// combined-cycle set: blocks, conditional-blocks, and parent-child. Waits-for
-// also affects readiness but is intentionally outside this validation rule.
-func isSchedulingEdge(t types.DependencyType) bool {
-	switch t {
-	case types.DepBlocks, types.DepConditionalBlocks, types.DepParentChild:
-		return true
-	default:
-		return false
+// cycleRelevantTypeList renders types.CycleRelevantDependencyTypes as a SQL
+// IN list. The values are compile-time constants, so inlining them is safe.
+func cycleRelevantTypeList() string {
+	quoted := make([]string, 0, len(types.CycleRelevantDependencyTypes()))
+	for _, t := range types.CycleRelevantDependencyTypes() {
+		quoted = append(quoted, "'"+string(t)+"'")
 	}
+	return strings.Join(quoted, ", ")
 }
 
 // CheckBlockingHierarchyInTx rejects blocking dependencies between an issue
@@ -888,13 +902,14 @@ func checkRenameTargetCollision(ctx context.Context, tx *sql.Tx, table, typedCol
 // proxied repository's DepInsertOpts.EmitEvent gate so both backends record
 // identical history.
 //
-// It returns whether a dependency_removed event was actually written, so callers
-// that stage tables for a Dolt commit stage the events table only when an event
-// row exists (avoiding the sweep-unrelated-rows hazard doltAddAndCommit guards
-// against, GH#2455).
+// It returns the number of edges the DELETE removed (0 for a missing edge), so
+// callers can report a remove that matched nothing, and so callers that stage
+// tables for a Dolt commit stage the events table only when an event row
+// exists (removed > 0 && emitEvent; avoiding the sweep-unrelated-rows hazard
+// doltAddAndCommit guards against, GH#2455).
 //
 //nolint:gosec // G201: depTable from WispTableRouting (hardcoded constants)
-func RemoveDependencyInTx(ctx context.Context, tx *sql.Tx, issueID, dependsOnID, actor string, emitEvent bool) (bool, error) {
+func RemoveDependencyInTx(ctx context.Context, tx *sql.Tx, issueID, dependsOnID, actor string, emitEvent bool) (int64, error) {
 	isWisp := IsActiveWispInTx(ctx, tx, issueID)
 	_, _, eventTable, depTable := WispTableRouting(isWisp)
 
@@ -906,29 +921,34 @@ func RemoveDependencyInTx(ctx context.Context, tx *sql.Tx, issueID, dependsOnID,
 		issueID, dependsOnID)
 	if err := row.Scan(&depType); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return false, nil
+			return 0, nil
 		}
-		return false, fmt.Errorf("lookup dependency type for %s -> %s: %w", issueID, dependsOnID, err)
+		return 0, fmt.Errorf("lookup dependency type for %s -> %s: %w", issueID, dependsOnID, err)
 	}
 
-	if _, err := tx.ExecContext(ctx, fmt.Sprintf(
+	result, err := tx.ExecContext(ctx, fmt.Sprintf(
 		`DELETE FROM %s WHERE issue_id = ? AND %s = ?`, depTable, DepTargetExpr),
-		issueID, dependsOnID); err != nil {
-		return false, fmt.Errorf("remove dependency: %w", err)
+		issueID, dependsOnID)
+	if err != nil {
+		return 0, fmt.Errorf("remove dependency: %w", err)
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("remove dependency: rows affected: %w", err)
+	}
+	if removed == 0 {
+		return 0, nil
 	}
 
-	// The lookup above returned early when no row matched, so reaching here means
-	// an edge was actually deleted. Record the dependency_removed event on the
+	// An edge was actually deleted. Record the dependency_removed event on the
 	// source issue's event table for bd CLI / library history observers — but only
 	// when emitEvent is set, so structural removes stay silent (parity with the
 	// proxied repo and with the symmetric AddDependencyInTx EmitEvent gate).
-	eventWritten := false
 	if emitEvent {
 		if err := RecordEventInTable(ctx, tx, eventTable, issueID, types.EventDependencyRemoved, actor,
 			fmt.Sprintf("Removed dependency on %s", dependsOnID)); err != nil {
-			return false, fmt.Errorf("record dependency_removed event: %w", err)
+			return 0, fmt.Errorf("record dependency_removed event: %w", err)
 		}
-		eventWritten = true
 	}
 
 	var affectedIssues, affectedWisps []string
@@ -939,12 +959,12 @@ func RemoveDependencyInTx(ctx context.Context, tx *sql.Tx, issueID, dependsOnID,
 		affectedIssues, affectedWisps, aerr = AffectedByDepChangeInTx(ctx, tx, issueID, dependsOnID, types.DependencyType(depType))
 	}
 	if aerr != nil {
-		return false, fmt.Errorf("affected by remove dependency %s -> %s: %w", issueID, dependsOnID, aerr)
+		return 0, fmt.Errorf("affected by remove dependency %s -> %s: %w", issueID, dependsOnID, aerr)
 	}
 	if err := RecomputeIsBlockedInTx(ctx, tx, affectedIssues, affectedWisps); err != nil {
-		return false, fmt.Errorf("recompute is_blocked after remove dependency %s -> %s: %w", issueID, dependsOnID, err)
+		return 0, fmt.Errorf("recompute is_blocked after remove dependency %s -> %s: %w", issueID, dependsOnID, err)
 	}
-	return eventWritten, nil
+	return removed, nil
 }
 
 // GetIssuesByIDsInTx retrieves multiple issues by ID within an existing
@@ -1182,7 +1202,7 @@ func GetDependentsWithMetadataInTx(ctx context.Context, tx DBTX, issueID string)
 // Queries both dependencies and wisp_dependencies tables.
 //
 //nolint:gosec // G201: table names come from hardcoded constants
-func GetDependenciesInTx(ctx context.Context, tx *sql.Tx, issueID string) ([]*types.Issue, error) {
+func GetDependenciesInTx(ctx context.Context, tx DBTX, issueID string) ([]*types.Issue, error) {
 	var ids []string
 	for _, depTable := range []string{"dependencies", "wisp_dependencies"} {
 		rows, err := tx.QueryContext(ctx, fmt.Sprintf(
@@ -2,61 +2,90 @@ package issueops
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 )
 
 // GetDependencyTreeInTx returns a flattened dependency tree for visualization.
-// It performs a recursive BFS traversal up to maxDepth, using GetIssueInTx and
-// GetDependenciesInTx/GetDependentsInTx which handle wisp routing.
+// It loads the dependency graph once into a DepIndex, collects every issue
+// reachable within maxDepth, hydrates them with one batched GetIssuesByIDsInTx
+// (which handles wisp routing), and then walks the tree in memory. The query
+// count is therefore fixed rather than proportional to the tree size.
 func GetDependencyTreeInTx(ctx context.Context, tx DBTX, issueID string, maxDepth int, showAllPaths bool, reverse bool) ([]*types.TreeNode, error) {
-	visited := make(map[string]bool)
-	return buildDependencyTreeInTx(ctx, tx, issueID, 0, maxDepth, reverse, visited, "", "")
-}
-
-func buildDependencyTreeInTx(ctx context.Context, tx DBTX, issueID string, depth, maxDepth int, reverse bool, visited map[string]bool, parentID string, edgeFromParent types.DependencyType) ([]*types.TreeNode, error) {
-	if depth >= maxDepth || visited[issueID] {
+	if maxDepth <= 0 {
 		return nil, nil
 	}
-	visited[issueID] = true
-
-	issue, err := GetIssueInTx(ctx, tx, issueID)
+	idx, err := LoadDepIndexInTx(ctx, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Use metadata-aware queries to get dependency type for tree annotation (GH#3565).
-	var related []*types.IssueWithDependencyMetadata
-	if reverse {
-		related, err = GetDependentsWithMetadataInTx(ctx, tx, issueID)
-	} else {
-		related, err = GetDependenciesWithMetadataInTx(ctx, tx, issueID)
-	}
+	candidates := reachableTreeIDs(idx, issueID, maxDepth, reverse)
+	issues, err := GetIssuesByIDsInTx(ctx, tx, candidates, nil)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("dependency tree: fetch issues: %w", err)
+	}
+	byID := make(map[string]*types.Issue, len(issues))
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+	if byID[issueID] == nil {
+		return nil, fmt.Errorf("%w: issue %s", storage.ErrNotFound, issueID)
 	}
 
-	node := &types.TreeNode{
-		Issue:          *issue,
-		Depth:          depth,
-		ParentID:       parentID,
-		EdgeFromParent: edgeFromParent,
+	visited := make(map[string]bool)
+	return walkDependencyTree(idx, byID, issueID, 0, maxDepth, reverse, visited, "", ""), nil
+}
+
+// reachableTreeIDs returns issueID plus every ID reachable from it over tree
+// edges within maxDepth levels. It is a superset of the nodes the walk
+// visits: targets that turn out not to resolve are pruned during the walk.
+func reachableTreeIDs(idx *DepIndex, issueID string, maxDepth int, reverse bool) []string {
+	ids := []string{issueID}
+	seen := map[string]bool{issueID: true}
+	frontier := []string{issueID}
+	for depth := 1; depth < maxDepth && len(frontier) > 0; depth++ {
+		var next []string
+		for _, id := range frontier {
+			for _, e := range idx.Edges(id, reverse) {
+				if !isDependencyTreeEdge(e.Type) || seen[e.ID] {
+					continue
+				}
+				seen[e.ID] = true
+				ids = append(ids, e.ID)
+				next = append(next, e.ID)
+			}
+		}
+		frontier = next
 	}
+	return ids
+}
+
+// walkDependencyTree flattens the tree rooted at issueID in depth-first
+// order. Each issue appears once (first path wins); edges whose far end did
+// not resolve to an issue (external refs, deleted issues) are skipped.
+func walkDependencyTree(idx *DepIndex, byID map[string]*types.Issue, issueID string, depth, maxDepth int, reverse bool, visited map[string]bool, parentID string, edgeFromParent types.DependencyType) []*types.TreeNode {
+	if depth >= maxDepth || visited[issueID] {
+		return nil
+	}
+	visited[issueID] = true
 
 	// TreeNode doesn't have Children field - return flat list
-	nodes := []*types.TreeNode{node}
-	for _, rel := range related {
-		if !isDependencyTreeEdge(rel.DependencyType) {
+	nodes := []*types.TreeNode{{
+		Issue:          *byID[issueID],
+		Depth:          depth,
+		ParentID:       parentID,
+		EdgeFromParent: edgeFromParent,
+	}}
+	for _, e := range idx.Edges(issueID, reverse) {
+		if !isDependencyTreeEdge(e.Type) || byID[e.ID] == nil {
 			continue
 		}
-		children, err := buildDependencyTreeInTx(ctx, tx, rel.ID, depth+1, maxDepth, reverse, visited, issueID, rel.DependencyType)
-		if err != nil {
-			return nil, err
-		}
-		nodes = append(nodes, children...)
+		nodes = append(nodes, walkDependencyTree(idx, byID, e.ID, depth+1, maxDepth, reverse, visited, issueID, e.Type)...)
 	}
-
-	return nodes, nil
+	return nodes
 }
 
 func isDependencyTreeEdge(depType types.DependencyType) bool {
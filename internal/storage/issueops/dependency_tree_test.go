@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"fmt"
 	"regexp"
 	"strings"
 	"testing"
@@ -58,14 +59,11 @@ func TestGetDependencyTreeInTxSkipsRelatesToEdges(t *testing.T) {
 	defer db.Close()
 
 	mock.ExpectBegin()
-	expectIssue(mock, "root", "Root")
-	expectDependencies(mock, "root", []dependencyRow{
-		{id: "blocker", depType: string(types.DepBlocks)},
-		{id: "related", depType: string(types.DepRelatesTo)},
+	expectDepIndex(mock, []depIndexRow{
+		{issueID: "root", dependsOnID: "blocker", depType: string(types.DepBlocks)},
+		{issueID: "root", dependsOnID: "related", depType: string(types.DepRelatesTo)},
 	})
-	expectIssueBatch(mock, []string{"blocker", "related"})
-	expectIssue(mock, "blocker", "Blocker")
-	expectDependencies(mock, "blocker", nil)
+	expectIssueBatch(mock, []string{"root", "blocker"})
 	mock.ExpectRollback()
 
 	tx, err := db.BeginTx(context.Background(), nil)
@@ -95,31 +93,114 @@ func TestGetDependencyTreeInTxSkipsRelatesToEdges(t *testing.T) {
 	}
 }
 
-type dependencyRow struct {
-	id      string
-	depType string
+// The walk runs against an in-memory index, so the tree costs the same
+// queries however many nodes it has; unresolved targets are pruned.
+func TestWalkDependencyTreeUsesIndex(t *testing.T) {
+	idx := NewDepIndex()
+	idx.AddEdge("root", "a", types.DepBlocks)
+	idx.AddEdge("root", "b", types.DepParentChild)
+	idx.AddEdge("a", "c", types.DepBlocks)
+	idx.AddEdge("b", "c", types.DepBlocks)
+	idx.AddEdge("c", "external:proj:x", types.DepBlocks)
+	idx.AddEdge("c", "deep", types.DepBlocks)
+
+	reachable := reachableTreeIDs(idx, "root", 3, false)
+	if got, want := strings.Join(reachable, ","), "root,a,b,c"; got != want {
+		t.Fatalf("reachable = %s, want %s (depth-limited)", got, want)
+	}
+
+	byID := map[string]*types.Issue{}
+	for _, id := range []string{"root", "a", "b", "c", "deep"} {
+		byID[id] = &types.Issue{ID: id}
+	}
+	tree := walkDependencyTree(idx, byID, "root", 0, 4, false, map[string]bool{}, "", "")
+	if got, want := strings.Join(treeIDs(tree), ","), "root,a,c,deep,b"; got != want {
+		t.Fatalf("tree = %s, want %s", got, want)
+	}
+	if tree[2].ParentID != "a" || tree[2].Depth != 2 {
+		t.Errorf("c: parent %q depth %d, want a/2 (first path wins)", tree[2].ParentID, tree[2].Depth)
+	}
+
+	up := walkDependencyTree(idx, byID, "c", 0, 4, true, map[string]bool{}, "", "")
+	if got, want := strings.Join(treeIDs(up), ","), "c,a,root,b"; got != want {
+		t.Fatalf("reverse tree = %s, want %s", got, want)
+	}
+}
+
+func TestDepIndexBlockingGraph(t *testing.T) {
+	idx := NewDepIndex()
+	idx.AddEdge("a", "b", types.DepBlocks)
+	idx.AddEdge("a", "c", types.DepParentChild)
+	idx.AddEdge("b", "a", types.DepConditionalBlocks)
+	graph := idx.BlockingGraph()
+	if len(graph) != 2 || len(graph["a"]) != 1 || graph["a"][0] != "b" || graph["b"][0] != "a" {
+		t.Fatalf("BlockingGraph = %v", graph)
+	}
+	if got := idx.Dependents("a"); len(got) != 1 || got[0].ID != "b" || got[0].Type != types.DepConditionalBlocks {
+		t.Fatalf("Dependents(a) = %v", got)
+	}
+}
+
+func TestDepIndexCycleGraph(t *testing.T) {
+	idx := NewDepIndex()
+	for i, typ := range types.CycleRelevantDependencyTypes() {
+		idx.AddEdge("a", fmt.Sprintf("r%d", i), typ)
+	}
+	for i, typ := range []types.DependencyType{types.DepRelated, types.DepRelatesTo, types.DepDiscoveredFrom, types.DepTracks} {
+		idx.AddEdge("a", fmt.Sprintf("x%d", i), typ)
+	}
+	graph := idx.CycleGraph()
+	if got, want := len(graph["a"]), len(types.CycleRelevantDependencyTypes()); got != want {
+		t.Fatalf("CycleGraph[a] = %v, want the %d cycle-relevant edges, forceable ones included", graph["a"], want)
+	}
+	for _, to := range graph["a"] {
+		if !strings.HasPrefix(to, "r") {
+			t.Errorf("CycleGraph kept non-cycle-relevant edge a -> %s", to)
+		}
+	}
+}
+
+// BenchmarkWalkDependencyTree_10kEdges walks a synthetic 10k-edge graph
+// (issue i depends on 2i+1 and 2i+2) from the in-memory index. The dolt
+// package's BenchmarkPerfDependencyTree_10kEdges measures the same shape
+// end to end against the per-node query walk it replaced.
+func BenchmarkWalkDependencyTree_10kEdges(b *testing.B) {
+	const n = 10001
+	idx := NewDepIndex()
+	byID := make(map[string]*types.Issue, n)
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("bd-%d", i)
+		byID[id] = &types.Issue{ID: id}
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < n {
+				idx.AddEdge(id, fmt.Sprintf("bd-%d", child), types.DepBlocks)
+			}
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree := walkDependencyTree(idx, byID, "bd-0", 0, 50, false, map[string]bool{}, "", "")
+		if len(tree) != n {
+			b.Fatalf("tree has %d nodes, want %d", len(tree), n)
+		}
+	}
 }
 
-func expectIssue(mock sqlmock.Sqlmock, id, title string) {
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT " + IssueSelectColumns + " FROM issues " + sqlbuild.LeaseJoin("issues") + " WHERE id = ?")).
-		WithArgs(id).
-		WillReturnRows(issueRows().AddRow(issueRowValues(id, title)...))
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT label FROM labels WHERE issue_id = ? ORDER BY label")).
-		WithArgs(id).
-		WillReturnRows(sqlmock.NewRows([]string{"label"}))
+type depIndexRow struct {
+	issueID     string
+	dependsOnID string
+	depType     string
 }
 
-func expectDependencies(mock sqlmock.Sqlmock, issueID string, deps []dependencyRow) {
-	rows := sqlmock.NewRows([]string{"depends_on_id", "type"})
+func expectDepIndex(mock sqlmock.Sqlmock, deps []depIndexRow) {
+	rows := sqlmock.NewRows([]string{"issue_id", "depends_on_id", "type"})
 	for _, dep := range deps {
-		rows.AddRow(dep.id, dep.depType)
+		rows.AddRow(dep.issueID, dep.dependsOnID, dep.depType)
 	}
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT " + DepTargetExpr + " AS depends_on_id, type FROM dependencies WHERE issue_id = ?")).
-		WithArgs(issueID).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT issue_id, " + DepTargetExpr + " AS depends_on_id, type FROM dependencies")).
 		WillReturnRows(rows)
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT " + DepTargetExpr + " AS depends_on_id, type FROM wisp_dependencies WHERE issue_id = ?")).
-		WithArgs(issueID).
-		WillReturnRows(sqlmock.NewRows([]string{"depends_on_id", "type"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT issue_id, " + DepTargetExpr + " AS depends_on_id, type FROM wisp_dependencies")).
+		WillReturnRows(sqlmock.NewRows([]string{"issue_id", "depends_on_id", "type"}))
 }
 
 func expectIssueBatch(mock sqlmock.Sqlmock, ids []string) {
@@ -96,7 +96,9 @@ func TestBuildIssueFilterClauses_QueryAsText(t *testing.T) {
 func TestBuildIssueFilterClauses_StatusFilter(t *testing.T) {
 	t.Parallel()
 
-	status := types.StatusOpen
+	// Not open: an open filter also admits expired deferrals (see
+	// sqlbuild's TestBuildIssueFilterClausesExpiredDeferralsCountAsOpen).
+	status := types.StatusInProgress
 	clauses, args, err := BuildIssueFilterClauses("", types.IssueFilter{Status: &status}, IssuesFilterTables)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -331,6 +333,22 @@ func TestBuildIssueFilterClauses_LabelPatternWispsTable(t *testing.T) {
 	}
 }
 
+func TestBuildIssueFilterClauses_LabelPatternNegated(t *testing.T) {
+	t.Parallel()
+
+	filter := types.IssueFilter{LabelPattern: "!wip-*"}
+	clauses, args, err := BuildIssueFilterClauses("", filter, IssuesFilterTables)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(clauses) != 1 || !strings.HasPrefix(clauses[0], "id NOT IN (SELECT issue_id FROM labels WHERE label LIKE ?") {
+		t.Fatalf("expected a NOT IN label LIKE clause, got %v", clauses)
+	}
+	if len(args) != 1 || args[0] != "wip-%" {
+		t.Errorf("expected the '!' stripped before glob conversion, got %v", args)
+	}
+}
+
 // TestBuildIssueFilterClauses_LabelRegex covers be-ucslk4 for the regex
 // variant: --label-regex was likewise being dropped on the floor.
 func TestBuildIssueFilterClauses_LabelRegex(t *testing.T) {
@@ -5,6 +5,7 @@
 package issueops
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -13,6 +14,8 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/steveyegge/beads/internal/config"
@@ -73,6 +76,21 @@ var issueUpsertColumns = []string{
 	"row_lock", "updated_at",
 }
 
+// issueReplaceColumns are the extra columns the UPSERT rewrites when the
+// caller asks for a full replace (`bd import --replace`), so an existing
+// issue ends up matching the incoming row rather than only its core
+// fields. id, ephemeral, and no_history are never rewritten: they decide
+// which table the row lives in.
+var issueReplaceColumns = []string{
+	"created_at", "created_by", "owner", "spec_id",
+	"compaction_level", "compacted_at", "compacted_at_commit", "original_size",
+	"sender", "wisp_type", "pinned", "is_template",
+	"mol_type", "work_type", "source_system",
+	"event_kind", "actor", "target", "payload",
+	"await_type", "await_id", "timeout_ns", "waiters",
+	"due_at", "defer_until",
+}
+
 // issueUpsertAssignments renders the ON DUPLICATE KEY UPDATE clause. With
 // rejectStaleUpdate, each assignment keeps the stored value unless the
 // incoming row is strictly newer (VALUES(updated_at) > updated_at) — the
@@ -85,9 +103,19 @@ var issueUpsertColumns = []string{
 // Tie rows are deliberately NOT short-circuited by the staleRejected
 // pre-check in InsertIssueIfNew, so their aux data (labels/comments/deps,
 // which never bump updated_at) still merges additively.
-func issueUpsertAssignments(table string, rejectStaleUpdate bool) string {
-	assignments := make([]string, 0, len(issueUpsertColumns))
-	for _, col := range issueUpsertColumns {
+//
+// With replaceAll, issueReplaceColumns are rewritten too, ahead of updated_at.
+func issueUpsertAssignments(table string, rejectStaleUpdate, replaceAll bool) string {
+	columns := issueUpsertColumns
+	if replaceAll {
+		last := len(issueUpsertColumns) - 1
+		columns = make([]string, 0, len(issueUpsertColumns)+len(issueReplaceColumns))
+		columns = append(columns, issueUpsertColumns[:last]...)
+		columns = append(columns, issueReplaceColumns...)
+		columns = append(columns, issueUpsertColumns[last])
+	}
+	assignments := make([]string, 0, len(columns))
+	for _, col := range columns {
 		if rejectStaleUpdate {
 			// Qualify existing-row references with the table name so the target value
 			// remains unambiguous after the SQLite upsert translation. VALUES(...) is
@@ -104,11 +132,11 @@ func issueUpsertAssignments(table string, rejectStaleUpdate bool) string {
 // InsertIssueIntoTable inserts an issue into the specified table ("issues" or "wisps"),
 // using ON DUPLICATE KEY UPDATE to handle pre-existing records gracefully.
 func InsertIssueIntoTable(ctx context.Context, tx *sql.Tx, table string, issue *types.Issue) error {
-	return insertIssueIntoTable(ctx, tx, table, issue, false)
+	return insertIssueIntoTable(ctx, tx, table, issue, false, false)
 }
 
 //nolint:gosec // G201: table is a hardcoded constant ("issues" or "wisps")
-func insertIssueIntoTable(ctx context.Context, tx *sql.Tx, table string, issue *types.Issue, rejectStaleUpdate bool) error {
+func insertIssueIntoTable(ctx context.Context, tx *sql.Tx, table string, issue *types.Issue, rejectStaleUpdate, replaceAll bool) error {
 	_, err := tx.ExecContext(ctx, fmt.Sprintf(`
 		INSERT INTO %s (
 			id, content_hash, title, description, design, acceptance_criteria, notes,
@@ -135,7 +163,7 @@ func insertIssueIntoTable(ctx context.Context, tx *sql.Tx, table string, issue *
 		)
 		ON DUPLICATE KEY UPDATE
 			%s
-	`, table, issueUpsertAssignments(table, rejectStaleUpdate)),
+	`, table, issueUpsertAssignments(table, rejectStaleUpdate, replaceAll)),
 		issue.ID, issue.ContentHash, issue.Title, issue.Description, issue.Design, issue.AcceptanceCriteria, issue.Notes,
 		issue.Status, issue.Priority, issue.IssueType, NullString(issue.Assignee), NullInt(issue.EstimatedMinutes),
 		issue.CreatedAt, issue.CreatedBy, issue.Owner, issue.UpdatedAt, issue.StartedAt, issue.ClosedAt, NullStringPtr(issue.ExternalRef), issue.SpecID,
@@ -424,6 +452,10 @@ func GetCustomTypesTx(ctx context.Context, tx *sql.Tx) ([]string, error) {
 
 // ValidateMetadataIfConfigured checks metadata against the schema from config.
 func ValidateMetadataIfConfigured(metadata json.RawMessage) error {
+	if err := ValidateMetadataJSONSchemaIfConfigured(metadata); err != nil {
+		return err
+	}
+
 	mode := config.MetadataValidationMode()
 	if mode == "none" || mode == "" {
 		return nil
@@ -468,6 +500,68 @@ func ValidateMetadataIfConfigured(metadata json.RawMessage) error {
 	return fmt.Errorf("metadata schema violation: %s", errs[0].Error())
 }
 
+// ValidateMetadataJSONSchemaIfConfigured checks metadata against the JSON
+// Schema file named by metadata.schema. Configuring the file is the opt-in,
+// so non-conforming metadata is always rejected. Only absent or JSON null
+// metadata skips the check; an empty object is validated like any other value,
+// so a schema with required keys rejects it.
+func ValidateMetadataJSONSchemaIfConfigured(metadata json.RawMessage) error {
+	path := config.MetadataSchemaFile()
+	if path == "" || len(bytes.TrimSpace(metadata)) == 0 {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(metadata, &value); err != nil {
+		return fmt.Errorf("invalid metadata: %w", err)
+	}
+	if value == nil {
+		return nil
+	}
+	schema, err := loadMetadataJSONSchema(path)
+	if err != nil {
+		return err
+	}
+	if errs := storage.ValidateMetadataJSONSchema(metadata, schema); len(errs) > 0 {
+		return fmt.Errorf("metadata schema violation: %s", errs[0].Error())
+	}
+	return nil
+}
+
+// metadataJSONSchemaCache holds the last schema file parsed, so writes do
+// not re-read it; a changed size or mtime reloads it.
+var metadataJSONSchemaCache struct {
+	mu      sync.Mutex
+	path    string
+	size    int64
+	modTime time.Time
+	schema  storage.MetadataJSONSchema
+}
+
+// loadMetadataJSONSchema returns the parsed schema at path, from the cache
+// when the file has not changed since it was last parsed.
+func loadMetadataJSONSchema(path string) (storage.MetadataJSONSchema, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading metadata.schema: %w", err)
+	}
+	c := &metadataJSONSchemaCache
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.schema != nil && c.path == path && c.size == info.Size() && c.modTime.Equal(info.ModTime()) {
+		return c.schema, nil
+	}
+	data, err := os.ReadFile(path) // #nosec G304 - controlled path from config
+	if err != nil {
+		return nil, fmt.Errorf("reading metadata.schema: %w", err)
+	}
+	schema, err := storage.ParseMetadataJSONSchema(data)
+	if err != nil {
+		return nil, fmt.Errorf("metadata.schema %s: %w", path, err)
+	}
+	c.path, c.size, c.modTime, c.schema = path, info.Size(), info.ModTime(), schema
+	return schema, nil
+}
+
 // ParseFieldSchema converts a raw config map into a MetadataFieldSchema.
 func ParseFieldSchema(m map[string]interface{}) storage.MetadataFieldSchema {
 	schema := storage.MetadataFieldSchema{}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
@@ -84,6 +86,60 @@ func TestMergeMetadataInTxSchemaValidation(t *testing.T) {
 	}
 }
 
+// TestValidateMetadataJSONSchemaIfConfigured checks the metadata.schema file
+// is only consulted when configured, and then rejects non-conforming metadata
+// even with validation.metadata.mode left at none.
+func TestValidateMetadataJSONSchemaIfConfigured(t *testing.T) {
+	t.Setenv("BEADS_TEST_IGNORE_REPO_CONFIG", "1")
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize: %v", err)
+	}
+	defer config.ResetForTesting()
+
+	invalid := json.RawMessage(`{"team":7}`)
+	if err := ValidateMetadataIfConfigured(invalid); err != nil {
+		t.Fatalf("free-form metadata rejected without a schema: %v", err)
+	}
+
+	schemaPath := filepath.Join(t.TempDir(), "schema.json")
+	schema := `{"type":"object","required":["team"],"properties":{"team":{"type":"string"}}}`
+	if err := os.WriteFile(schemaPath, []byte(schema), 0o644); err != nil {
+		t.Fatalf("write schema: %v", err)
+	}
+	config.Set("metadata.schema", schemaPath)
+
+	if err := ValidateMetadataIfConfigured(json.RawMessage(`{"team":"platform"}`)); err != nil {
+		t.Errorf("valid metadata rejected: %v", err)
+	}
+	for _, absent := range []string{"", "null", " null "} {
+		if err := ValidateMetadataIfConfigured(json.RawMessage(absent)); err != nil {
+			t.Errorf("absent metadata %q rejected: %v", absent, err)
+		}
+	}
+	// An empty object is present metadata: it is missing the required key.
+	if err := ValidateMetadataIfConfigured(json.RawMessage(` { } `)); err == nil || !strings.Contains(err.Error(), "team") {
+		t.Errorf("err = %v, want {} rejected for the required team key", err)
+	}
+	err := ValidateMetadataIfConfigured(invalid)
+	if err == nil || !strings.Contains(err.Error(), "metadata.team: expected string") {
+		t.Errorf("err = %v, want a metadata.team schema violation", err)
+	}
+
+	// The parsed schema is cached, but an edited file takes effect.
+	schema = `{"type":"object","properties":{"team":{"type":"integer"}}}`
+	if err := os.WriteFile(schemaPath, []byte(schema), 0o644); err != nil {
+		t.Fatalf("rewrite schema: %v", err)
+	}
+	if err := ValidateMetadataIfConfigured(invalid); err != nil {
+		t.Errorf("metadata valid under the edited schema rejected: %v", err)
+	}
+
+	config.Set("metadata.schema", filepath.Join(t.TempDir(), "missing.json"))
+	if err := ValidateMetadataIfConfigured(invalid); err == nil || !strings.Contains(err.Error(), "reading metadata.schema") {
+		t.Errorf("err = %v, want a missing schema file error", err)
+	}
+}
+
 // TestDeleteMetadataInTx covers the clear primitive's cheap branches: a missing
 // issue is ErrNotFound, and clearing a key that is absent is a no-op that issues
 // no write (and therefore records no event), matching the historical SlotClear.
@@ -183,7 +183,7 @@ func runReadyCountsInTx(ctx context.Context, tx *sql.Tx, tables FilterTables, li
 // re-runs the mega-query, so a single wisp no longer disables the fast path.
 // This backs the "Showing X of N" total `bd ready` prints when the page is
 // capped.
-func CountReadyWorkInTx(ctx context.Context, tx *sql.Tx, filter types.WorkFilter) (int, error) {
+func CountReadyWorkInTx(ctx context.Context, tx DBTX, filter types.WorkFilter) (int, error) {
 	countFilter := filter
 	countFilter.Limit = 0
 
@@ -238,7 +238,7 @@ func CountReadyWorkInTx(ctx context.Context, tx *sql.Tx, filter types.WorkFilter
 // only its placeholders (no ORDER BY params).
 //
 //nolint:gosec // G201: whereSQL is hardcoded fragments; user input rides ? placeholders.
-func countReadyPredicateInTx(ctx context.Context, tx *sql.Tx, table, whereSQL string, whereArgs []interface{}) (int, error) {
+func countReadyPredicateInTx(ctx context.Context, tx DBTX, table, whereSQL string, whereArgs []interface{}) (int, error) {
 	var n int
 	if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s %s", table, whereSQL), whereArgs...).Scan(&n); err != nil {
 		return 0, err
@@ -251,7 +251,7 @@ func countReadyPredicateInTx(ctx context.Context, tx *sql.Tx, table, whereSQL st
 // the issue row for such an ID, so |ready| = issueCount + wispCount - overlap.
 //
 //nolint:gosec // G201: whereSQL fragments are hardcoded; user input rides ? placeholders.
-func countReadyOverlapInTx(ctx context.Context, tx *sql.Tx, issuePreds, wispPreds *readyWorkPredicates) (int, error) {
+func countReadyOverlapInTx(ctx context.Context, tx DBTX, issuePreds, wispPreds *readyWorkPredicates) (int, error) {
 	q := fmt.Sprintf("SELECT COUNT(*) FROM issues %s AND id IN (SELECT id FROM wisps %s)", issuePreds.whereSQL, wispPreds.whereSQL)
 	args := make([]interface{}, 0, len(issuePreds.whereArgs)+len(wispPreds.whereArgs))
 	args = append(args, issuePreds.whereArgs...)
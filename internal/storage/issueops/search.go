@@ -15,7 +15,17 @@ import (
 // transaction and returns hydrated issues (labels, and optionally
 // dependencies via filter.IncludeDependencies). Routing, wisp-merge, and
 // overlap detection live in the shared searchInTx wrapper.
+//
+// A non-empty filter.Fields narrows the scan to those columns
+// (fieldsProjection); the result shape is unchanged.
 func SearchIssuesInTx(ctx context.Context, tx DBTX, query string, filter types.IssueFilter) ([]*types.Issue, error) {
+	if len(filter.Fields) > 0 {
+		proj, err := fieldsProjection(filter.Fields, filter.SortBy)
+		if err != nil {
+			return nil, fmt.Errorf("search issues: %w", err)
+		}
+		return searchInTx(ctx, tx, query, filter, proj)
+	}
 	return searchInTx(ctx, tx, query, filter, issueProjection)
 }
 
@@ -87,6 +97,36 @@ var idProjection = searchProjection[string]{
 	hydrate: nil,
 }
 
+// fieldsProjection is issueProjection narrowed to the given Issue JSON fields
+// (filter.Fields). The SELECT list keeps IssueSelectColumns' shape with the
+// unrequested columns replaced by constants (sqlbuild.ProjectIssueColumns),
+// so ScanIssueFrom and the Pattern B fetch work unchanged while the wide
+// text columns are never read. Labels are hydrated only when "labels" is
+// one of the fields.
+func fieldsProjection(fields []string, sortBy string) (searchProjection[*types.Issue], error) {
+	var columnFields []string
+	wantLabels := false
+	for _, field := range fields {
+		if field == "labels" {
+			wantLabels = true
+			continue
+		}
+		columnFields = append(columnFields, field)
+	}
+	columns, joinLeases, err := sqlbuild.ProjectIssueColumns(columnFields, sortBy)
+	if err != nil {
+		return searchProjection[*types.Issue]{}, err
+	}
+	proj := issueProjection
+	proj.columns = func(_ FilterTables) string { return columns }
+	proj.joinLeases = joinLeases
+	proj.hydrate = func(ctx context.Context, tx DBTX, tables FilterTables, issues []*types.Issue, filter types.IssueFilter) error {
+		filter.SkipLabels = filter.SkipLabels || !wantLabels
+		return hydrateIssueLabelsAndDeps(ctx, tx, tables, issues, filter)
+	}
+	return proj, nil
+}
+
 // hydrateIssueLabelsAndDeps bulk-loads labels (and optionally dependencies)
 // for the given issues. searchTableInTxT runs against exactly one of the
 // issues/wisps tables, so every ID here belongs to tables.Labels — we use
@@ -283,35 +323,10 @@ func searchTableInTxT[T any](ctx context.Context, tx DBTX, query string, filter
 		return searchTablePatternBT(ctx, tx, query, filter, tables, proj)
 	}
 
-	plan := sqlbuild.BuildLabelDrivenSearch(filter, tables)
-	whereClauses, args, err := BuildIssueFilterClauses(query, plan.Filter, tables)
+	querySQL, args, err := searchTableSQL(query, filter, tables, proj.columns(tables), proj.joinLeases)
 	if err != nil {
 		return nil, err
 	}
-	whereClauses, args = plan.MergeInto(whereClauses, args)
-
-	whereSQL := ""
-	if len(whereClauses) > 0 {
-		whereSQL = "WHERE " + strings.Join(whereClauses, " AND ")
-	}
-
-	limitSQL := ""
-	if eff := EffectiveSearchLimit(filter.Limit, filter.MaxRows); eff > 0 {
-		limitSQL = fmt.Sprintf(" LIMIT %d", eff)
-	}
-
-	selectKeyword := "SELECT "
-	if plan.Distinct {
-		selectKeyword = "SELECT DISTINCT "
-	}
-	fromSQL := plan.FromSQL
-	if proj.joinLeases {
-		fromSQL += " " + sqlbuild.LeaseJoin(tables.Main)
-	}
-
-	//nolint:gosec // G201: SQL fragments are built from fixed table/column names and parameterized filters.
-	querySQL := fmt.Sprintf(`%s%s FROM %s %s %s %s`,
-		selectKeyword, proj.columns(tables), fromSQL, whereSQL, sqlbuild.OrderBy(filter.SortBy, filter.SortDesc, ""), limitSQL)
 
 	rows, err := tx.QueryContext(ctx, querySQL, args...)
 	if err != nil {
@@ -347,6 +362,49 @@ func searchTableInTxT[T any](ctx context.Context, tx DBTX, query string, filter
 	return results, nil
 }
 
+// searchTableSQL builds the SELECT searchTableInTxT runs against one table:
+// the label-driven FROM, the filter's WHERE clauses, ORDER BY and LIMIT, with
+// every filter value left as a ? placeholder bound by args.
+func searchTableSQL(query string, filter types.IssueFilter, tables FilterTables, columns string, joinLeases bool) (string, []interface{}, error) {
+	plan := sqlbuild.BuildLabelDrivenSearch(filter, tables)
+	whereClauses, args, err := BuildIssueFilterClauses(query, plan.Filter, tables)
+	if err != nil {
+		return "", nil, err
+	}
+	whereClauses, args = plan.MergeInto(whereClauses, args)
+
+	whereSQL := ""
+	if len(whereClauses) > 0 {
+		whereSQL = "WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	limitSQL := ""
+	if eff := EffectiveSearchLimit(filter.Limit, filter.MaxRows); eff > 0 {
+		limitSQL = fmt.Sprintf(" LIMIT %d", eff)
+	}
+
+	selectKeyword := "SELECT "
+	if plan.Distinct {
+		selectKeyword = "SELECT DISTINCT "
+	}
+	fromSQL := plan.FromSQL
+	if joinLeases {
+		fromSQL += " " + sqlbuild.LeaseJoin(tables.Main)
+	}
+
+	//nolint:gosec // G201: SQL fragments are built from fixed table/column names and parameterized filters.
+	querySQL := fmt.Sprintf(`%s%s FROM %s %s %s %s`,
+		selectKeyword, columns, fromSQL, whereSQL, sqlbuild.OrderBy(filter.SortBy, filter.SortDesc, ""), limitSQL)
+	return querySQL, args, nil
+}
+
+// ExplainSearchSQL returns the statement SearchIssuesInTx runs against tables
+// to select the IDs matching query and filter, before any rows are hydrated.
+// Filter values stay ? placeholders; args lists their bindings in order.
+func ExplainSearchSQL(query string, filter types.IssueFilter, tables FilterTables) (string, []interface{}, error) {
+	return searchTableSQL(query, filter, tables, idProjection.columns(tables), idProjection.joinLeases)
+}
+
 // searchTablePatternBT runs Pattern B for wide projections. It reuses the
 // id-only search (idProjection) — byte-for-byte the non-hydrating query
 // SearchIssueIDsInTx runs against this table — to get the ordered, LIMIT-bound
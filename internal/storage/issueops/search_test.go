@@ -1,9 +1,13 @@
 package issueops
 
 import (
+	"context"
 	"errors"
+	"regexp"
 	"strings"
 	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
 )
 
 func TestEffectiveSearchLimit(t *testing.T) {
@@ -126,3 +130,68 @@ func TestErrTooManyRows_Error_WithoutSource(t *testing.T) {
 		t.Errorf("Error() = %q, should not contain dash prefix when Source empty", got)
 	}
 }
+
+// With filter.Fields set, the search reads only the requested columns (plus
+// id and the sort columns), skips the leases join and label hydration, and
+// still scans through ScanIssueFrom.
+func TestSearchIssuesProjectsFields(t *testing.T) {
+	t.Parallel()
+
+	_, mock, tx := beginMockTx(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, NULL AS content_hash, title, '' AS description") +
+		`.*` + regexp.QuoteMeta("NULL AS lease_expires_at, NULL AS heartbeat_at FROM issues ORDER BY priority")).
+		WillReturnRows(issueRows().AddRow(issueRowValues("bd-1", "Projected")...))
+
+	got, err := SearchIssuesInTx(context.Background(), tx, "", types.IssueFilter{
+		Fields:    []string{"title"},
+		SkipWisps: true,
+	})
+	if err != nil {
+		t.Fatalf("SearchIssuesInTx: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "bd-1" || got[0].Title != "Projected" {
+		t.Fatalf("SearchIssuesInTx = %+v, want bd-1 \"Projected\"", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet SQL expectations: %v", err)
+	}
+}
+
+func TestSearchIssuesRejectsUnknownField(t *testing.T) {
+	t.Parallel()
+
+	_, _, tx := beginMockTx(t)
+	if _, err := SearchIssuesInTx(context.Background(), tx, "", types.IssueFilter{Fields: []string{"bogus"}}); err == nil {
+		t.Fatal("SearchIssuesInTx accepted an unknown field")
+	}
+}
+
+func TestExplainSearchSQL(t *testing.T) {
+	t.Parallel()
+
+	priority := 1
+	typ := types.TypeBug
+	filter := types.IssueFilter{PriorityMax: &priority, IssueType: &typ, Limit: 10}
+
+	sql, args, err := ExplainSearchSQL("", filter, IssuesFilterTables)
+	if err != nil {
+		t.Fatalf("ExplainSearchSQL: %v", err)
+	}
+	if !strings.HasPrefix(sql, "SELECT issues.id FROM issues WHERE ") || !strings.Contains(sql, "LIMIT 10") {
+		t.Errorf("unexpected statement: %s", sql)
+	}
+	if strings.Count(sql, "?") != len(args) {
+		t.Errorf("%d placeholders for %d args: %s %v", strings.Count(sql, "?"), len(args), sql, args)
+	}
+	if strings.Contains(sql, "bug") {
+		t.Errorf("values must stay placeholders: %s", sql)
+	}
+
+	wispSQL, _, err := ExplainSearchSQL("", filter, WispsFilterTables)
+	if err != nil {
+		t.Fatalf("ExplainSearchSQL (wisps): %v", err)
+	}
+	if !strings.Contains(wispSQL, "FROM wisps") {
+		t.Errorf("wisps statement should select from wisps: %s", wispSQL)
+	}
+}
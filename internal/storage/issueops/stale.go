@@ -11,10 +11,15 @@ import (
 // GetStaleIssuesInTx returns issues that haven't been updated within the
 // given number of days. Only non-ephemeral issues are considered. When
 // filter.Status is empty, open and in_progress issues are returned.
-// Results are ordered by updated_at ascending (stalest first).
+// Results are ordered by updated_at ascending (stalest first). filter.Days
+// must be at least 1: zero would call every issue stale, and a negative value
+// moves the cutoff into the future.
 //
 // nolint:gosec // G201: statusClause contains only literal SQL or a single ? placeholder
 func GetStaleIssuesInTx(ctx context.Context, tx DBTX, filter types.StaleFilter) ([]*types.Issue, error) {
+	if filter.Days < 1 {
+		return nil, fmt.Errorf("stale days must be at least 1, got %d", filter.Days)
+	}
 	cutoff := time.Now().UTC().AddDate(0, 0, -filter.Days)
 
 	statusClause := "status IN ('open', 'in_progress')"
@@ -0,0 +1,59 @@
+package issueops
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestGetStaleIssuesInTxRejectsNonPositiveDays(t *testing.T) {
+	t.Parallel()
+
+	for _, days := range []int{0, -1} {
+		_, mock, tx := beginMockTx(t)
+		_, err := GetStaleIssuesInTx(context.Background(), tx, types.StaleFilter{Days: days})
+		if err == nil || !strings.Contains(err.Error(), "at least 1") {
+			t.Fatalf("Days=%d: error = %v, want an at-least-1 error", days, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("Days=%d should not reach the query: %v", days, err)
+		}
+	}
+}
+
+// cutoffArg matches a stale cutoff within a window around a day ago.
+type cutoffArg struct{ lo, hi time.Time }
+
+func (a cutoffArg) Match(v driver.Value) bool {
+	ts, ok := v.(time.Time)
+	return ok && !ts.Before(a.lo) && !ts.After(a.hi)
+}
+
+func TestGetStaleIssuesInTxOneDayCutoff(t *testing.T) {
+	t.Parallel()
+
+	_, mock, tx := beginMockTx(t)
+	start := time.Now().UTC()
+	// The cutoff must fall a full day back, so an issue updated just now
+	// (updated_at >= cutoff) is never stale for Days=1.
+	arg := cutoffArg{lo: start.Add(-24*time.Hour - time.Minute), hi: start.Add(-24 * time.Hour).Add(time.Minute)}
+	mock.ExpectQuery(`SELECT id FROM issues\s+WHERE updated_at < \?`).
+		WithArgs(arg, arg).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	got, err := GetStaleIssuesInTx(context.Background(), tx, types.StaleFilter{Days: 1})
+	if err != nil {
+		t.Fatalf("GetStaleIssuesInTx: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("got %d issues, want none", len(got))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
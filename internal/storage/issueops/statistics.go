@@ -34,12 +34,18 @@ func ScanIssueCountsInTx(ctx context.Context, tx DBTX, stats *types.Statistics)
 	return nil
 }
 
+// statisticsReadyFilter is the filter bd ready runs with when no flags are
+// given, so the ready count in the statistics is len(bd ready --json -n 0).
+var statisticsReadyFilter = types.WorkFilter{Status: types.StatusOpen}
+
 // GetStatisticsInTx computes the full summary statistics (counts + blocked + ready)
 // in one transaction, using only the normal issues table — no version-control state —
-// so it is portable across every SQL backend. Behaviorally identical to the Dolt and
-// embedded-Dolt implementations: ScanIssueCountsInTx for the status counts, a direct
-// blocked count (the is_blocked flag is maintained in-tx by the shared layer), then
-// ReadyIssues = OpenIssues - BlockedIssues clamped at zero.
+// so it is portable across every SQL backend. Every backend's GetStatistics goes
+// through here: ScanIssueCountsInTx for the status counts, a direct blocked count
+// (the is_blocked flag is maintained in-tx by the shared layer), then ReadyIssues
+// from CountReadyWorkInTx, the same ready predicate bd ready lists from. Deriving
+// ready as OpenIssues - BlockedIssues miscounted whenever a blocked issue was not
+// open, or an open issue was left out of bd ready (deferred parent, internal type).
 func GetStatisticsInTx(ctx context.Context, tx DBTX) (*types.Statistics, error) {
 	stats := &types.Statistics{}
 	if err := ScanIssueCountsInTx(ctx, tx, stats); err != nil {
@@ -53,9 +59,9 @@ func GetStatisticsInTx(ctx context.Context, tx DBTX) (*types.Statistics, error)
 		return nil, fmt.Errorf("count blocked issues: %w", err)
 	}
 	stats.BlockedIssues = &blocked
-	ready := stats.OpenIssues - blocked
-	if ready < 0 {
-		ready = 0
+	ready, err := CountReadyWorkInTx(ctx, tx, statisticsReadyFilter)
+	if err != nil {
+		return nil, fmt.Errorf("count ready issues: %w", err)
 	}
 	stats.ReadyIssues = &ready
 	return stats, nil
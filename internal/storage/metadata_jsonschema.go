@@ -0,0 +1,301 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// MetadataJSONSchema is a JSON Schema document that issue metadata is checked
+// against when metadata.schema is configured.
+//
+// The supported keywords are type, enum, const, properties, required,
+// additionalProperties, items, minItems, maxItems, minLength, maxLength,
+// pattern, minimum, maximum, exclusiveMinimum and exclusiveMaximum.
+// Annotations ($schema, title, description, ...) are accepted and ignored.
+// Any other keyword ($ref, oneOf, format, ...) is rejected when the schema is
+// parsed, since ignoring it would let through data the schema was written to
+// refuse.
+type MetadataJSONSchema map[string]interface{}
+
+// supportedJSONSchemaKeywords are the keywords the validator enforces.
+var supportedJSONSchemaKeywords = map[string]bool{
+	"type": true, "enum": true, "const": true,
+	"properties": true, "required": true, "additionalProperties": true,
+	"items": true, "minItems": true, "maxItems": true,
+	"minLength": true, "maxLength": true, "pattern": true,
+	"minimum": true, "maximum": true, "exclusiveMinimum": true, "exclusiveMaximum": true,
+}
+
+// jsonSchemaAnnotations are keywords that never affect validation.
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true,
+	"title": true, "description": true, "default": true, "examples": true,
+	"deprecated": true, "readOnly": true, "writeOnly": true,
+}
+
+// ParseMetadataJSONSchema decodes a JSON Schema document, which must be a
+// JSON object using only the supported keywords.
+func ParseMetadataJSONSchema(data []byte) (MetadataJSONSchema, error) {
+	var schema MetadataJSONSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("schema is not a JSON object: %w", err)
+	}
+	if schema == nil {
+		return nil, fmt.Errorf("schema is not a JSON object")
+	}
+	if err := checkJSONSchemaKeywords("#", schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// checkJSONSchemaKeywords rejects keywords the validator does not enforce,
+// walking every subschema. at is the JSON pointer of schema, for the error.
+func checkJSONSchemaKeywords(at string, schema map[string]interface{}) error {
+	keys := make([]string, 0, len(schema))
+	for key := range schema {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !supportedJSONSchemaKeywords[key] && !jsonSchemaAnnotations[key] {
+			return fmt.Errorf("unsupported keyword %q at %s", key, at)
+		}
+	}
+
+	if pattern, ok := schema["pattern"].(string); ok {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid pattern %q at %s: %v", pattern, at, err)
+		}
+	}
+	if properties, ok := schema["properties"].(map[string]interface{}); ok {
+		names := make([]string, 0, len(properties))
+		for name := range properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if prop, ok := properties[name].(map[string]interface{}); ok {
+				if err := checkJSONSchemaKeywords(at+"/properties/"+name, prop); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if extra, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+		if err := checkJSONSchemaKeywords(at+"/additionalProperties", extra); err != nil {
+			return err
+		}
+	}
+	switch items := schema["items"].(type) {
+	case map[string]interface{}:
+		return checkJSONSchemaKeywords(at+"/items", items)
+	case []interface{}:
+		return fmt.Errorf("unsupported tuple form of \"items\" at %s", at)
+	}
+	return nil
+}
+
+// ValidateMetadataJSONSchema validates a metadata blob against a JSON Schema.
+// Returns a list of validation errors, named by the path of the offending
+// value (e.g. "owner.team" or "tags[1]"). An empty list means validation
+// passed.
+func ValidateMetadataJSONSchema(metadata json.RawMessage, schema MetadataJSONSchema) []MetadataValidationError {
+	var value interface{}
+	if err := json.Unmarshal(metadata, &value); err != nil {
+		return []MetadataValidationError{{Field: "(root)", Message: "metadata is not valid JSON"}}
+	}
+	var errs []MetadataValidationError
+	validateJSONSchemaValue("", value, schema, &errs)
+	return errs
+}
+
+func validateJSONSchemaValue(path string, value interface{}, schema map[string]interface{}, errs *[]MetadataValidationError) {
+	fail := func(format string, args ...interface{}) {
+		field := path
+		if field == "" {
+			field = "(root)"
+		}
+		*errs = append(*errs, MetadataValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if want, ok := schema["type"]; ok {
+		allowed := jsonSchemaTypes(want)
+		if !matchesJSONSchemaType(allowed, value) {
+			fail("expected %s, got %s", strings.Join(allowed, " or "), jsonTypeName(value))
+			return
+		}
+	}
+	if allowed, ok := schema["enum"].([]interface{}); ok && !containsJSONValue(allowed, value) {
+		fail("value %s is not one of the allowed values", jsonText(value))
+	}
+	if want, ok := schema["const"]; ok && !reflect.DeepEqual(want, value) {
+		fail("value %s must be %s", jsonText(value), jsonText(want))
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		validateJSONSchemaObject(path, v, schema, errs, fail)
+	case []interface{}:
+		if n, ok := jsonSchemaNumber(schema, "minItems"); ok && float64(len(v)) < n {
+			fail("expected at least %v items, got %d", n, len(v))
+		}
+		if n, ok := jsonSchemaNumber(schema, "maxItems"); ok && float64(len(v)) > n {
+			fail("expected at most %v items, got %d", n, len(v))
+		}
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range v {
+				validateJSONSchemaValue(fmt.Sprintf("%s[%d]", path, i), item, items, errs)
+			}
+		}
+	case string:
+		length := float64(utf8.RuneCountInString(v))
+		if n, ok := jsonSchemaNumber(schema, "minLength"); ok && length < n {
+			fail("expected at least %v characters, got %v", n, length)
+		}
+		if n, ok := jsonSchemaNumber(schema, "maxLength"); ok && length > n {
+			fail("expected at most %v characters, got %v", n, length)
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				fail("invalid pattern %q in schema: %v", pattern, err)
+			} else if !re.MatchString(v) {
+				fail("value %q does not match pattern %q", v, pattern)
+			}
+		}
+	case float64:
+		if n, ok := jsonSchemaNumber(schema, "minimum"); ok && v < n {
+			fail("value %v is below minimum %v", v, n)
+		}
+		if n, ok := jsonSchemaNumber(schema, "maximum"); ok && v > n {
+			fail("value %v is above maximum %v", v, n)
+		}
+		if n, ok := jsonSchemaNumber(schema, "exclusiveMinimum"); ok && v <= n {
+			fail("value %v must be greater than %v", v, n)
+		}
+		if n, ok := jsonSchemaNumber(schema, "exclusiveMaximum"); ok && v >= n {
+			fail("value %v must be less than %v", v, n)
+		}
+	}
+}
+
+func validateJSONSchemaObject(path string, obj map[string]interface{}, schema map[string]interface{}, errs *[]MetadataValidationError, fail func(string, ...interface{})) {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, r := range required {
+			name, _ := r.(string)
+			if _, exists := obj[name]; name != "" && !exists {
+				*errs = append(*errs, MetadataValidationError{Field: joinJSONPath(path, name), Message: "required field is missing"})
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if prop, ok := properties[name].(map[string]interface{}); ok {
+			validateJSONSchemaValue(joinJSONPath(path, name), obj[name], prop, errs)
+			continue
+		}
+		if _, declared := properties[name]; declared {
+			continue
+		}
+		switch extra := schema["additionalProperties"].(type) {
+		case bool:
+			if !extra {
+				fail("unexpected field %q", name)
+			}
+		case map[string]interface{}:
+			validateJSONSchemaValue(joinJSONPath(path, name), obj[name], extra, errs)
+		}
+	}
+}
+
+// jsonSchemaTypes reads a type keyword, which is a name or a list of names.
+func jsonSchemaTypes(v interface{}) []string {
+	switch t := v.(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		var out []string
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func matchesJSONSchemaType(types []string, value interface{}) bool {
+	actual := jsonTypeName(value)
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonTypeName names the JSON Schema type of a decoded value; whole numbers
+// are "integer".
+func jsonTypeName(v interface{}) string {
+	switch n := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		if n == math.Trunc(n) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func jsonSchemaNumber(schema map[string]interface{}, key string) (float64, bool) {
+	n, ok := schema[key].(float64)
+	return n, ok
+}
+
+func containsJSONValue(values []interface{}, v interface{}) bool {
+	for _, allowed := range values {
+		if reflect.DeepEqual(allowed, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonText(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func joinJSONPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
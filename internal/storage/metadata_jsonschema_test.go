@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const testMetadataJSONSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "required": ["team"],
+  "additionalProperties": false,
+  "properties": {
+    "team": {"type": "string", "enum": ["platform", "frontend"]},
+    "points": {"type": "integer", "minimum": 0, "maximum": 13},
+    "ticket": {"type": "string", "pattern": "^[A-Z]+-[0-9]+$"},
+    "tags": {"type": "array", "maxItems": 2, "items": {"type": "string", "minLength": 1}},
+    "owner": {
+      "type": "object",
+      "required": ["name"],
+      "properties": {"name": {"type": "string"}, "oncall": {"type": ["boolean", "null"]}}
+    }
+  }
+}`
+
+func TestParseMetadataJSONSchema(t *testing.T) {
+	if _, err := ParseMetadataJSONSchema([]byte(testMetadataJSONSchema)); err != nil {
+		t.Fatalf("ParseMetadataJSONSchema: %v", err)
+	}
+	for _, bad := range []string{`[]`, `"object"`, `null`, `{`} {
+		if _, err := ParseMetadataJSONSchema([]byte(bad)); err == nil {
+			t.Errorf("ParseMetadataJSONSchema(%s) succeeded, want an error", bad)
+		}
+	}
+}
+
+// TestParseMetadataJSONSchema_UnsupportedKeywords checks that a keyword the
+// validator would ignore is rejected up front, wherever it appears, rather
+// than letting through data the schema was written to refuse.
+func TestParseMetadataJSONSchema_UnsupportedKeywords(t *testing.T) {
+	tests := []struct {
+		schema  string
+		wantErr string
+	}{
+		{`{"oneOf":[{"type":"string"}]}`, `unsupported keyword "oneOf" at #`},
+		{`{"properties":{"team":{"$ref":"#/$defs/team"}}}`, `unsupported keyword "$ref" at #/properties/team`},
+		{`{"items":{"type":"string","format":"email"}}`, `unsupported keyword "format" at #/items`},
+		{`{"additionalProperties":{"not":{"type":"null"}}}`, `unsupported keyword "not" at #/additionalProperties`},
+		{`{"patternProperties":{"^x-":{}}}`, `unsupported keyword "patternProperties" at #`},
+		{`{"items":[{"type":"string"}]}`, `unsupported tuple form of "items" at #`},
+		{`{"properties":{"ticket":{"pattern":"("}}}`, `invalid pattern "(" at #/properties/ticket`},
+	}
+	for _, tt := range tests {
+		_, err := ParseMetadataJSONSchema([]byte(tt.schema))
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("ParseMetadataJSONSchema(%s) error = %v, want %q", tt.schema, err, tt.wantErr)
+		}
+	}
+}
+
+func TestValidateMetadataJSONSchema(t *testing.T) {
+	schema, err := ParseMetadataJSONSchema([]byte(testMetadataJSONSchema))
+	if err != nil {
+		t.Fatalf("ParseMetadataJSONSchema: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		metadata  string
+		wantField string // "" means valid
+		wantMsg   string
+	}{
+		{"valid minimal", `{"team":"platform"}`, "", ""},
+		{"valid full", `{"team":"frontend","points":5,"ticket":"ENG-42","tags":["a","b"],"owner":{"name":"sam","oncall":null}}`, "", ""},
+		{"not an object", `["platform"]`, "(root)", "expected object"},
+		{"required missing", `{"points":1}`, "team", "required field is missing"},
+		{"enum", `{"team":"sales"}`, "team", "not one of the allowed values"},
+		{"integer", `{"team":"platform","points":1.5}`, "points", "expected integer, got number"},
+		{"minimum", `{"team":"platform","points":-1}`, "points", "below minimum"},
+		{"maximum", `{"team":"platform","points":21}`, "points", "above maximum"},
+		{"pattern", `{"team":"platform","ticket":"eng-42"}`, "ticket", "does not match pattern"},
+		{"max items", `{"team":"platform","tags":["a","b","c"]}`, "tags", "at most 2 items"},
+		{"item min length", `{"team":"platform","tags":["a",""]}`, "tags[1]", "at least 1 characters"},
+		{"nested required", `{"team":"platform","owner":{}}`, "owner.name", "required field is missing"},
+		{"type list", `{"team":"platform","owner":{"name":"sam","oncall":"yes"}}`, "owner.oncall", "expected boolean or null"},
+		{"additional properties", `{"team":"platform","extra":true}`, "(root)", `unexpected field "extra"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateMetadataJSONSchema(json.RawMessage(tt.metadata), schema)
+			if tt.wantField == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+			}
+			if errs[0].Field != tt.wantField || !strings.Contains(errs[0].Message, tt.wantMsg) {
+				t.Errorf("got %s, want field %q with %q", errs[0].Error(), tt.wantField, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestValidateMetadataJSONSchema_InvalidJSON(t *testing.T) {
+	errs := ValidateMetadataJSONSchema(json.RawMessage(`{not json`), MetadataJSONSchema{"type": "object"})
+	if len(errs) != 1 || errs[0].Field != "(root)" {
+		t.Errorf("expected a root error for invalid JSON, got %v", errs)
+	}
+}
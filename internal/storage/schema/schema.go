@@ -326,6 +326,18 @@ func CurrentVersion(ctx context.Context, db DBConn) (int, error) {
 	return mainSource.currentVersion(ctx, db)
 }
 
+// BackendVersions returns the Dolt engine version behind db and the schema
+// version applied to it.
+func BackendVersions(ctx context.Context, db DBConn) (doltVersion string, schemaVersion int, err error) {
+	if err := db.QueryRowContext(ctx, "SELECT dolt_version()").Scan(&doltVersion); err != nil {
+		return "", 0, fmt.Errorf("reading dolt version: %w", err)
+	}
+	if schemaVersion, err = CurrentVersion(ctx, db); err != nil {
+		return doltVersion, 0, fmt.Errorf("reading schema version: %w", err)
+	}
+	return doltVersion, schemaVersion, nil
+}
+
 func CurrentIgnoredVersion(ctx context.Context, db DBConn) (int, error) {
 	return ignoredSource.currentVersion(ctx, db)
 }
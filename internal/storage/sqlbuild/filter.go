@@ -2,6 +2,7 @@ package sqlbuild
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -27,6 +28,25 @@ import (
 // a change here then breaks the guard.
 const KeysetCreatedAtIDPredicate = "(created_at <= ? AND ((created_at < ?) OR (id > ?)))"
 
+// expiredDeferralPredicate matches deferred issues whose defer date has
+// passed. Status queries that ask for open work OR it in, so list and ready
+// see them as open without writing; the stored status catches up the next
+// time a writable command runs. Recurring deferrals (defer_every) stay
+// deferred until bd undefer rolls them forward.
+const expiredDeferralPredicate = "(status = 'deferred' AND defer_until IS NOT NULL AND defer_until <= UTC_TIMESTAMP() AND JSON_EXTRACT(metadata, '$.defer_every') IS NULL)"
+
+// filterAsksForOpen reports whether every status constraint in filter
+// admits open issues.
+func filterAsksForOpen(filter types.IssueFilter) bool {
+	if filter.Status != nil && *filter.Status != types.StatusOpen {
+		return false
+	}
+	if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, types.StatusOpen) {
+		return false
+	}
+	return filter.Status != nil || len(filter.Statuses) > 0
+}
+
 // BuildIssueFilterClauses builds WHERE clause fragments and args from a query
 // string and IssueFilter. The tables parameter controls which table names are
 // referenced in subqueries (issues vs wisps).
@@ -35,15 +55,25 @@ func BuildIssueFilterClauses(query string, filter types.IssueFilter, tables Filt
 	var args []any
 
 	if query != "" {
-		lowerQuery := strings.ToLower(query)
-		if LooksLikeIssueID(query) {
-			whereClauses = append(whereClauses, "(id = ? OR id LIKE ? OR LOWER(title) LIKE ? OR LOWER(external_ref) LIKE ?)")
-			args = append(args, lowerQuery, lowerQuery+"%", "%"+lowerQuery+"%", "%"+lowerQuery+"%")
-		} else {
-			whereClauses = append(whereClauses, "(LOWER(title) LIKE ? OR id LIKE ?)")
-			pattern := "%" + lowerQuery + "%"
-			args = append(args, pattern, pattern)
+		clause, clauseArgs := searchQueryClause(query)
+		whereClauses = append(whereClauses, clause)
+		args = append(args, clauseArgs...)
+	}
+
+	// Multi-term search: each term matches like a query on its own, and the
+	// terms are joined with AND (all must match) or OR (any may match).
+	if terms := nonEmptyTerms(filter.SearchTerms); len(terms) > 0 {
+		termClauses := make([]string, 0, len(terms))
+		for _, term := range terms {
+			clause, clauseArgs := searchQueryClause(term)
+			termClauses = append(termClauses, clause)
+			args = append(args, clauseArgs...)
 		}
+		joiner := " AND "
+		if filter.SearchAny {
+			joiner = " OR "
+		}
+		whereClauses = append(whereClauses, "("+strings.Join(termClauses, joiner)+")")
 	}
 
 	if filter.TitleSearch != "" {
@@ -66,13 +96,22 @@ func BuildIssueFilterClauses(query string, filter types.IssueFilter, tables Filt
 		whereClauses = append(whereClauses, "LOWER(external_ref) LIKE ?")
 		args = append(args, "%"+strings.ToLower(filter.ExternalRefContains)+"%")
 	}
+	if filter.CloseReasonContains != "" {
+		whereClauses = append(whereClauses, "LOWER(close_reason) LIKE ?")
+		args = append(args, "%"+strings.ToLower(filter.CloseReasonContains)+"%")
+	}
 	if filter.ExternalRef != nil {
 		whereClauses = append(whereClauses, "external_ref = ?")
 		args = append(args, *filter.ExternalRef)
 	}
+	if filter.CreatedBy != nil {
+		whereClauses = append(whereClauses, "created_by = ?")
+		args = append(args, *filter.CreatedBy)
+	}
 
+	var statusClauses []string
 	if filter.Status != nil {
-		whereClauses = append(whereClauses, "status = ?")
+		statusClauses = append(statusClauses, "status = ?")
 		args = append(args, *filter.Status)
 	}
 	if len(filter.Statuses) > 0 {
@@ -81,8 +120,22 @@ func BuildIssueFilterClauses(query string, filter types.IssueFilter, tables Filt
 			placeholders[i] = "?"
 			args = append(args, string(s))
 		}
-		whereClauses = append(whereClauses, fmt.Sprintf("status IN (%s)", strings.Join(placeholders, ",")))
+		statusClauses = append(statusClauses, fmt.Sprintf("status IN (%s)", strings.Join(placeholders, ",")))
+	}
+	if len(statusClauses) > 0 && filterAsksForOpen(filter) {
+		statusClauses = []string{fmt.Sprintf("((%s) OR %s)", strings.Join(statusClauses, " AND "), expiredDeferralPredicate)}
+	}
+	if len(statusClauses) > 0 && len(filter.StatusOrIDs) > 0 {
+		// The extra ids OR into the whole status predicate, so they match
+		// whatever Status/Statuses say about their stored status.
+		placeholders := make([]string, len(filter.StatusOrIDs))
+		for i, id := range filter.StatusOrIDs {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+		statusClauses = []string{fmt.Sprintf("((%s) OR id IN (%s))", strings.Join(statusClauses, " AND "), strings.Join(placeholders, ","))}
 	}
+	whereClauses = append(whereClauses, statusClauses...)
 	if len(filter.ExcludeStatus) > 0 {
 		placeholders := make([]string, len(filter.ExcludeStatus))
 		for i, s := range filter.ExcludeStatus {
@@ -96,6 +149,14 @@ func BuildIssueFilterClauses(query string, filter types.IssueFilter, tables Filt
 		whereClauses = append(whereClauses, "issue_type = ?")
 		args = append(args, *filter.IssueType)
 	}
+	if len(filter.Types) > 0 {
+		placeholders := make([]string, len(filter.Types))
+		for i, t := range filter.Types {
+			placeholders[i] = "?"
+			args = append(args, string(t))
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf("issue_type IN (%s)", strings.Join(placeholders, ",")))
+	}
 	if len(filter.ExcludeTypes) > 0 {
 		placeholders := make([]string, len(filter.ExcludeTypes))
 		for i, t := range filter.ExcludeTypes {
@@ -109,6 +170,10 @@ func BuildIssueFilterClauses(query string, filter types.IssueFilter, tables Filt
 		whereClauses = append(whereClauses, "assignee = ?")
 		args = append(args, *filter.Assignee)
 	}
+	if filter.AssigneePattern != "" {
+		whereClauses = append(whereClauses, "assignee LIKE ? ESCAPE '|'")
+		args = append(args, globToLikePattern(filter.AssigneePattern))
+	}
 
 	if filter.Priority != nil {
 		whereClauses = append(whereClauses, "priority = ?")
@@ -135,6 +200,10 @@ func BuildIssueFilterClauses(query string, filter types.IssueFilter, tables Filt
 		whereClauses = append(whereClauses, "id LIKE ?")
 		args = append(args, filter.IDPrefix+"%")
 	}
+	if filter.SpecID != "" {
+		whereClauses = append(whereClauses, "spec_id = ?")
+		args = append(args, filter.SpecID)
+	}
 	if filter.SpecIDPrefix != "" {
 		whereClauses = append(whereClauses, "spec_id LIKE ?")
 		args = append(args, filter.SpecIDPrefix+"%")
@@ -184,8 +253,9 @@ func BuildIssueFilterClauses(query string, filter types.IssueFilter, tables Filt
 		whereClauses = append(whereClauses, fmt.Sprintf("id NOT IN (SELECT DISTINCT issue_id FROM %s)", tables.Labels))
 	}
 	if filter.LabelPattern != "" {
-		whereClauses = append(whereClauses, fmt.Sprintf("id IN (SELECT issue_id FROM %s WHERE label LIKE ? ESCAPE '|')", tables.Labels))
-		args = append(args, globToLikePattern(filter.LabelPattern))
+		clause, arg := labelPatternClause(filter.LabelPattern, tables.Labels)
+		whereClauses = append(whereClauses, clause)
+		args = append(args, arg)
 	}
 	if filter.LabelRegex != "" {
 		whereClauses = append(whereClauses, fmt.Sprintf("id IN (SELECT issue_id FROM %s WHERE label REGEXP ?)", tables.Labels))
@@ -318,6 +388,18 @@ func AppendMetadataClauses(where []string, args []any, hasKey string, fields map
 	return where, args, nil
 }
 
+// labelPatternClause builds the WHERE fragment for a --label-pattern glob:
+// issues carrying at least one label that matches. A leading '!' negates the
+// pattern, keeping only issues with no matching label (including unlabeled
+// ones).
+func labelPatternClause(pattern, labelsTable string) (string, any) {
+	op := "IN"
+	if rest, ok := strings.CutPrefix(pattern, "!"); ok {
+		op, pattern = "NOT IN", rest
+	}
+	return fmt.Sprintf("id %s (SELECT issue_id FROM %s WHERE label LIKE ? ESCAPE '|')", op, labelsTable), globToLikePattern(pattern)
+}
+
 // globToLikePattern converts a shell-style glob (* and ?) to a SQL LIKE
 // pattern. Literal % and _ in the input — and the '|' escape char itself —
 // are escaped so they don't act as LIKE wildcards. The resulting SQL must
@@ -341,6 +423,30 @@ func globToLikePattern(pattern string) string {
 	return b.String()
 }
 
+// searchQueryClause builds the WHERE fragment for one free-text search query.
+// ID-like queries use exact/prefix matching on id (hq-319); anything else is a
+// case-insensitive substring match on title or id.
+func searchQueryClause(query string) (string, []any) {
+	lowerQuery := strings.ToLower(query)
+	if LooksLikeIssueID(query) {
+		return "(id = ? OR id LIKE ? OR LOWER(title) LIKE ? OR LOWER(external_ref) LIKE ?)",
+			[]any{lowerQuery, lowerQuery + "%", "%" + lowerQuery + "%", "%" + lowerQuery + "%"}
+	}
+	pattern := "%" + lowerQuery + "%"
+	return "(LOWER(title) LIKE ? OR id LIKE ?)", []any{pattern, pattern}
+}
+
+// nonEmptyTerms drops blank search terms.
+func nonEmptyTerms(terms []string) []string {
+	var out []string
+	for _, term := range terms {
+		if term = strings.TrimSpace(term); term != "" {
+			out = append(out, term)
+		}
+	}
+	return out
+}
+
 // LooksLikeIssueID returns true if the query string looks like a beads issue ID.
 func LooksLikeIssueID(query string) bool {
 	idx := strings.Index(query, "-")
@@ -1,6 +1,11 @@
 package sqlbuild
 
-import "testing"
+import (
+	"slices"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
 
 // TestGlobToLikePattern exercises globToLikePattern directly against the
 // package that actually calls it from BuildIssueFilterClauses (be-ucslk4).
@@ -35,3 +40,95 @@ func TestGlobToLikePattern(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildIssueFilterClausesTypes(t *testing.T) {
+	t.Parallel()
+
+	filter := types.IssueFilter{Types: []types.IssueType{types.TypeBug, types.TypeFeature}}
+	where, args, err := BuildIssueFilterClauses("", filter, IssuesFilterTables)
+	if err != nil {
+		t.Fatalf("BuildIssueFilterClauses: %v", err)
+	}
+	if !slices.Contains(where, "issue_type IN (?,?)") {
+		t.Errorf("where = %v, want an issue_type IN (?,?) clause", where)
+	}
+	if !slices.Equal(args, []any{"bug", "feature"}) {
+		t.Errorf("args = %v, want [bug feature]", args)
+	}
+}
+
+func TestBuildIssueFilterClausesStatusOrIDs(t *testing.T) {
+	t.Parallel()
+
+	filter := types.IssueFilter{
+		Statuses:    []types.Status{types.StatusInProgress, types.StatusBlocked},
+		StatusOrIDs: []string{"bd-1", "bd-2"},
+	}
+	where, args, err := BuildIssueFilterClauses("", filter, IssuesFilterTables)
+	if err != nil {
+		t.Fatalf("BuildIssueFilterClauses: %v", err)
+	}
+	if !slices.Contains(where, "((status IN (?,?)) OR id IN (?,?))") {
+		t.Errorf("where = %v, want the status predicate ORed with the ids", where)
+	}
+	if !slices.Equal(args, []any{"in_progress", "blocked", "bd-1", "bd-2"}) {
+		t.Errorf("args = %v, want [in_progress blocked bd-1 bd-2]", args)
+	}
+}
+
+func TestBuildIssueFilterClausesExpiredDeferralsCountAsOpen(t *testing.T) {
+	t.Parallel()
+
+	open, deferred := types.StatusOpen, types.StatusDeferred
+	tests := []struct {
+		name      string
+		filter    types.IssueFilter
+		wantWhere string
+	}{
+		{"status open", types.IssueFilter{Status: &open}, "((status = ?) OR " + expiredDeferralPredicate + ")"},
+		{"statuses with open", types.IssueFilter{Statuses: []types.Status{types.StatusOpen, types.StatusBlocked}}, "((status IN (?,?)) OR " + expiredDeferralPredicate + ")"},
+		{"status deferred", types.IssueFilter{Status: &deferred}, "status = ?"},
+		{"statuses without open", types.IssueFilter{Statuses: []types.Status{types.StatusBlocked}}, "status IN (?)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			where, _, err := BuildIssueFilterClauses("", tt.filter, IssuesFilterTables)
+			if err != nil {
+				t.Fatalf("BuildIssueFilterClauses: %v", err)
+			}
+			if !slices.Contains(where, tt.wantWhere) {
+				t.Errorf("where = %v, want %q", where, tt.wantWhere)
+			}
+		})
+	}
+}
+
+func TestBuildIssueFilterClausesSearchTerms(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		searchAny bool
+		wantWhere string
+	}{
+		{"all", false, "((LOWER(title) LIKE ? OR id LIKE ?) AND (LOWER(title) LIKE ? OR id LIKE ?))"},
+		{"any", true, "((LOWER(title) LIKE ? OR id LIKE ?) OR (LOWER(title) LIKE ? OR id LIKE ?))"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			filter := types.IssueFilter{SearchTerms: []string{"Auth", " ", "login"}, SearchAny: tt.searchAny}
+			where, args, err := BuildIssueFilterClauses("", filter, IssuesFilterTables)
+			if err != nil {
+				t.Fatalf("BuildIssueFilterClauses: %v", err)
+			}
+			if !slices.Contains(where, tt.wantWhere) {
+				t.Errorf("where = %v, want %q", where, tt.wantWhere)
+			}
+			if want := []any{"%auth%", "%auth%", "%login%", "%login%"}; !slices.Equal(args, want) {
+				t.Errorf("args = %v, want %v", args, want)
+			}
+		})
+	}
+}
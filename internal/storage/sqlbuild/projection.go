@@ -0,0 +1,137 @@
+package sqlbuild
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// projectionPlaceholders holds the literal selected in place of an
+// unprojected column whose scan destination in issueops.ScanIssueFrom is not
+// nullable. Every other unprojected column is selected as NULL.
+var projectionPlaceholders = map[string]string{
+	"title":               "''",
+	"description":         "''",
+	"design":              "''",
+	"acceptance_criteria": "''",
+	"notes":               "''",
+	"status":              "''",
+	"issue_type":          "''",
+	"priority":            "0",
+	"compaction_level":    "0",
+}
+
+// projectionFieldAliases maps issue JSON field names that differ from the
+// backing column name.
+var projectionFieldAliases = map[string]string{
+	"timeout":          "timeout_ns",
+	"lease_expires_at": "leases.lease_expires_at",
+	"heartbeat_at":     "leases.heartbeat_at",
+}
+
+// unprojectableColumns are columns with no JSON field (json:"-" on Issue).
+var unprojectableColumns = map[string]bool{
+	"content_hash": true,
+	"source_repo":  true,
+	"row_lock":     true,
+}
+
+// issueSelectColumnList is IssueSelectColumns split into its entries.
+var issueSelectColumnList = splitColumns(IssueSelectColumns)
+
+func splitColumns(columns string) []string {
+	parts := strings.Split(columns, ",")
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+	}
+	return parts
+}
+
+// projectionColumn returns the IssueSelectColumns entry backing an issue
+// JSON field, or "" when the field is not a stored column.
+func projectionColumn(field string) string {
+	if col, ok := projectionFieldAliases[field]; ok {
+		return col
+	}
+	for _, col := range issueSelectColumnList {
+		if col == field && !unprojectableColumns[col] {
+			return col
+		}
+	}
+	return ""
+}
+
+// IsProjectableIssueField reports whether field (an Issue JSON name) is
+// backed by a column ProjectIssueColumns can select.
+func IsProjectableIssueField(field string) bool {
+	return projectionColumn(field) != ""
+}
+
+// ProjectableIssueFields lists the Issue JSON field names accepted by
+// ProjectIssueColumns, sorted.
+func ProjectableIssueFields() []string {
+	var fields []string
+	for _, col := range issueSelectColumnList {
+		if unprojectableColumns[col] || strings.HasPrefix(col, "leases.") || col == "timeout_ns" {
+			continue
+		}
+		fields = append(fields, col)
+	}
+	for field := range projectionFieldAliases {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// ProjectIssueColumns renders a column projection of IssueSelectColumns for
+// the given Issue JSON fields. The result keeps IssueSelectColumns' shape —
+// same arity and order — so issueops.ScanIssueFrom scans it unchanged, but
+// every column not requested is replaced by a constant, so the database
+// neither reads nor ships it. id is always selected, as are the columns the
+// sortBy ordering compares (sqlbuild.Less re-sorts merged issues+wisps legs
+// in Go). joinLeases reports whether the result references the leases
+// overlay and so needs LeaseJoin in the FROM clause.
+func ProjectIssueColumns(fields []string, sortBy string) (columns string, joinLeases bool, err error) {
+	keep := map[string]bool{"id": true}
+	for _, field := range fields {
+		col := projectionColumn(field)
+		if col == "" {
+			return "", false, fmt.Errorf("field %q is not an issue column", field)
+		}
+		keep[col] = true
+	}
+	for _, col := range sortColumns(sortBy) {
+		keep[col] = true
+	}
+
+	out := make([]string, len(issueSelectColumnList))
+	for i, col := range issueSelectColumnList {
+		if keep[col] {
+			out[i] = col
+			if strings.HasPrefix(col, "leases.") {
+				joinLeases = true
+			}
+			continue
+		}
+		name := col
+		if dot := strings.LastIndex(col, "."); dot >= 0 {
+			name = col[dot+1:]
+		}
+		placeholder, ok := projectionPlaceholders[name]
+		if !ok {
+			placeholder = "NULL"
+		}
+		out[i] = placeholder + " AS " + name
+	}
+	return strings.Join(out, ", "), joinLeases, nil
+}
+
+// sortColumns returns the columns OrderBy/Less compare for sortBy.
+func sortColumns(sortBy string) []string {
+	cols := []string{"priority", "created_at", "id"}
+	if def, ok := SortDefs[sortBy]; ok {
+		cols = append(cols, def.Column)
+	}
+	return cols
+}
@@ -0,0 +1,83 @@
+package sqlbuild
+
+import (
+	"strings"
+	"testing"
+)
+
+// readColumns returns the entries of a select list that read a stored
+// column rather than a constant placeholder.
+func readColumns(columns string) []string {
+	var read []string
+	for _, col := range splitColumns(columns) {
+		if strings.Contains(col, " AS ") {
+			continue
+		}
+		read = append(read, col)
+	}
+	return read
+}
+
+func TestProjectIssueColumnsReadsFewerColumns(t *testing.T) {
+	t.Parallel()
+
+	cols, joinLeases, err := ProjectIssueColumns([]string{"title", "status"}, "")
+	if err != nil {
+		t.Fatalf("ProjectIssueColumns: %v", err)
+	}
+	if got, full := len(splitColumns(cols)), len(issueSelectColumnList); got != full {
+		t.Fatalf("projection has %d entries, want %d (must scan like IssueSelectColumns)", got, full)
+	}
+	read := readColumns(cols)
+	// id, plus title/status, plus priority/created_at for the default order.
+	if want := "id, title, status, priority, created_at"; strings.Join(read, ", ") != want {
+		t.Errorf("read columns = %v, want %s", read, want)
+	}
+	if len(read) >= len(readColumns(IssueSelectColumns)) {
+		t.Errorf("projection reads %d columns, full row reads %d", len(read), len(readColumns(IssueSelectColumns)))
+	}
+	if joinLeases {
+		t.Error("projection without lease fields should not need the leases join")
+	}
+	for _, want := range []string{"'' AS description", "0 AS compaction_level", "NULL AS assignee", "NULL AS lease_expires_at"} {
+		if !strings.Contains(cols, want) {
+			t.Errorf("projection missing placeholder %q:\n%s", want, cols)
+		}
+	}
+}
+
+func TestProjectIssueColumnsKeepsSortAndLeaseColumns(t *testing.T) {
+	t.Parallel()
+
+	cols, joinLeases, err := ProjectIssueColumns([]string{"lease_expires_at", "timeout"}, "assignee")
+	if err != nil {
+		t.Fatalf("ProjectIssueColumns: %v", err)
+	}
+	read := strings.Join(readColumns(cols), ", ")
+	for _, want := range []string{"assignee", "timeout_ns", "leases.lease_expires_at"} {
+		if !strings.Contains(read, want) {
+			t.Errorf("read columns %q missing %s", read, want)
+		}
+	}
+	if !joinLeases {
+		t.Error("lease field requested but joinLeases = false")
+	}
+}
+
+func TestProjectIssueColumnsRejectsUnknownField(t *testing.T) {
+	t.Parallel()
+
+	for _, field := range []string{"labels", "content_hash", "bogus"} {
+		if _, _, err := ProjectIssueColumns([]string{field}, ""); err == nil {
+			t.Errorf("ProjectIssueColumns(%q) succeeded, want error", field)
+		}
+		if IsProjectableIssueField(field) {
+			t.Errorf("IsProjectableIssueField(%q) = true", field)
+		}
+	}
+	for _, field := range ProjectableIssueFields() {
+		if !IsProjectableIssueField(field) {
+			t.Errorf("listed field %q is not projectable", field)
+		}
+	}
+}
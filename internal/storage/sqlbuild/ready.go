@@ -85,10 +85,13 @@ type ReadyWorkWhereInputs struct {
 // suite); all ready predicates live here.
 func BuildReadyWorkWhere(filter types.WorkFilter, tables FilterTables, in ReadyWorkWhereInputs) (string, []any, error) {
 	var statusClause string
-	if filter.Status != "" {
+	switch filter.Status {
+	case "":
+		statusClause = "(status IN ('open', 'in_progress') OR " + expiredDeferralPredicate + ")"
+	case types.StatusOpen:
+		statusClause = "(status = ? OR " + expiredDeferralPredicate + ")"
+	default:
 		statusClause = "status = ?"
-	} else {
-		statusClause = "status IN ('open', 'in_progress')"
 	}
 	whereClauses := []string{
 		statusClause,
@@ -162,6 +165,17 @@ func BuildReadyWorkWhere(filter types.WorkFilter, tables FilterTables, in ReadyW
 		}
 		whereClauses = append(whereClauses, fmt.Sprintf("id NOT IN (SELECT issue_id FROM %s WHERE label IN (%s))", tables.Labels, strings.Join(placeholders, ", ")))
 	}
+	// bd list --ready carries its label pattern and regex here; they select
+	// the same issues as on the SearchIssues path.
+	if filter.LabelPattern != "" {
+		clause, arg := labelPatternClause(filter.LabelPattern, tables.Labels)
+		whereClauses = append(whereClauses, clause)
+		args = append(args, arg)
+	}
+	if filter.LabelRegex != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("id IN (SELECT issue_id FROM %s WHERE label REGEXP ?)", tables.Labels))
+		args = append(args, filter.LabelRegex)
+	}
 
 	// Parent filtering: return all transitive descendants of parentID.
 	// GH#3396: a one-hop subquery silently dropped grandchildren despite the
@@ -194,6 +194,29 @@ func TestBuildReadyWorkWhereLabelsAny(t *testing.T) {
 	}
 }
 
+// bd list --ready routes --label-pattern and --label-regex through
+// WorkFilter; BuildReadyWorkWhere must apply them like the search path does.
+func TestBuildReadyWorkWhereLabelPattern(t *testing.T) {
+	t.Parallel()
+
+	filter := types.WorkFilter{LabelPattern: "!wip-*", LabelRegex: "tech-.*"}
+	where, args, err := BuildReadyWorkWhere(filter, IssuesFilterTables, ReadyWorkWhereInputs{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantPattern := "id NOT IN (SELECT issue_id FROM " + IssuesFilterTables.Labels + " WHERE label LIKE ? ESCAPE '|')"
+	if !strings.Contains(where, wantPattern) {
+		t.Errorf("negated LabelPattern clause missing.\n where = %s\n want substring = %s", where, wantPattern)
+	}
+	wantRegex := "id IN (SELECT issue_id FROM " + IssuesFilterTables.Labels + " WHERE label REGEXP ?)"
+	if !strings.Contains(where, wantRegex) {
+		t.Errorf("LabelRegex clause missing.\n where = %s\n want substring = %s", where, wantRegex)
+	}
+	if len(args) < 2 || args[len(args)-2] != "wip-%" || args[len(args)-1] != "tech-.*" {
+		t.Errorf("args = %v, want trailing wip-%% and tech-.*", args)
+	}
+}
+
 func TestSearchCountsSQLShape(t *testing.T) {
 	t.Parallel()
 
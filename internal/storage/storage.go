@@ -158,7 +158,8 @@ type Storage interface {
 	// explicit dependency verb (bd dep remove) passes EmitEvent to record a
 	// dependency_removed history event; RemoveDependency is the no-event default
 	// used by structural callers (issue delete, reparent, batch, duplicate cleanup).
-	RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, opts DependencyRemoveOptions) error
+	// It returns how many edges were removed; 0 means no such edge existed.
+	RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, opts DependencyRemoveOptions) (int64, error)
 	GetDependencies(ctx context.Context, issueID string) ([]*types.Issue, error)
 	GetDependents(ctx context.Context, issueID string) ([]*types.Issue, error)
 	GetDependenciesWithMetadata(ctx context.Context, issueID string) ([]*types.IssueWithDependencyMetadata, error)
@@ -167,7 +168,9 @@ type Storage interface {
 
 	// Labels
 	AddLabel(ctx context.Context, issueID, label, actor string) error
-	RemoveLabel(ctx context.Context, issueID, label, actor string) error
+	// RemoveLabel returns how many labels were removed; 0 means the issue did
+	// not carry the label.
+	RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error)
 	GetLabels(ctx context.Context, issueID string) ([]string, error)
 	GetIssuesByLabel(ctx context.Context, label string) ([]*types.Issue, error)
 
@@ -419,6 +422,13 @@ type StoreLocator interface {
 	CLIDir() string
 }
 
+// BackendVersionReporter reports the Dolt engine version and the applied
+// schema version of a store, for diagnostics such as bd config backend-info.
+// Callers should type-assert to this interface.
+type BackendVersionReporter interface {
+	BackendVersions(ctx context.Context) (doltVersion string, schemaVersion int, err error)
+}
+
 // GarbageCollector provides Dolt garbage collection capability.
 // Callers that need to reclaim disk space should type-assert to this interface.
 type GarbageCollector interface {
@@ -574,6 +584,10 @@ type Transaction interface {
 	CreateIssues(ctx context.Context, issues []*types.Issue, actor string) error
 	UpdateIssue(ctx context.Context, id string, updates map[string]interface{}, actor string) error
 	CloseIssue(ctx context.Context, id string, reason string, actor string, session string) error
+	// ClaimIssue is Storage.ClaimIssue inside the transaction: the same
+	// compare-and-swap claim, returning ErrAlreadyClaimed when another actor
+	// holds the issue. Lets callers claim several issues all-or-nothing.
+	ClaimIssue(ctx context.Context, id string, actor string) error
 	DeleteIssue(ctx context.Context, id string) error
 	GetIssue(ctx context.Context, id string) (*types.Issue, error)                                    // For read-your-writes within transaction
 	SearchIssues(ctx context.Context, query string, filter types.IssueFilter) ([]*types.Issue, error) // For read-your-writes within transaction
@@ -586,7 +600,8 @@ type Transaction interface {
 	// RemoveDependencyWithOptions removes a dependency with explicit options.
 	// EmitEvent records a dependency_removed history event for the explicit
 	// bd dep remove verb; RemoveDependency stays silent for structural teardown.
-	RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, opts DependencyRemoveOptions) error
+	// It returns how many edges were removed; 0 means no such edge existed.
+	RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, opts DependencyRemoveOptions) (int64, error)
 	GetDependencyRecords(ctx context.Context, issueID string) ([]*types.Dependency, error)
 	// CycleThroughEdges reports a rendered cycle in the static scheduling set
 	// (blocks, conditional-blocks, parent-child; not waits-for) that traverses
@@ -600,7 +615,9 @@ type Transaction interface {
 
 	// Label operations
 	AddLabel(ctx context.Context, issueID, label, actor string) error
-	RemoveLabel(ctx context.Context, issueID, label, actor string) error
+	// RemoveLabel returns how many labels were removed; 0 means the issue did
+	// not carry the label.
+	RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error)
 	GetLabels(ctx context.Context, issueID string) ([]string, error)
 
 	// Config operations (for atomic config + issue workflows)
@@ -684,13 +701,17 @@ type DependencyAddOptions struct {
 	// that set it MUST run Transaction.CycleThroughEdges before commit and fail
 	// on new blocks/conditional-blocks/parent-child cycles (waits-for is excluded) — skipping the per-edge check trades
 	// per-edge cost for one whole-graph check, never graph integrity
-	// (bd-6dnrw.8).
+	// (bd-6dnrw.8). The one exception is bd dep add --force, which sets it
+	// without a final check for a single edge whose type IsCycleForceable.
 	SkipCycleCheck bool
 	// EmitEvent records a dependency_added history event on the source's event
 	// table for a genuine new edge. Only the explicit dependency verbs set it;
 	// create-with-deps and structural edge wiring leave it unset so implicit
 	// edges stay quiet, matching the proxied DepInsertOpts.EmitEvent gate.
 	EmitEvent bool
+	// RejectDuplicate fails a same-type re-add of an existing edge with
+	// domain.ErrDuplicateDependency instead of treating it as a no-op.
+	RejectDuplicate bool
 }
 
 // DependencyRemoveOptions controls dependency removal for both the store-level
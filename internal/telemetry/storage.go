@@ -323,15 +323,15 @@ func (s *InstrumentedStorage) RemoveDependency(ctx context.Context, issueID, dep
 	return err
 }
 
-func (s *InstrumentedStorage) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, opts storage.DependencyRemoveOptions) error {
+func (s *InstrumentedStorage) RemoveDependencyWithOptions(ctx context.Context, issueID, dependsOnID string, actor string, opts storage.DependencyRemoveOptions) (int64, error) {
 	attrs := []attribute.KeyValue{
 		attribute.String("bd.dep.from", issueID),
 		attribute.String("bd.dep.to", dependsOnID),
 	}
 	ctx, span, t := s.op(ctx, "RemoveDependency", attrs...)
-	err := s.inner.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, opts)
+	removed, err := s.inner.RemoveDependencyWithOptions(ctx, issueID, dependsOnID, actor, opts)
 	s.done(ctx, span, t, err, attrs...)
-	return err
+	return removed, err
 }
 
 func (s *InstrumentedStorage) GetDependencies(ctx context.Context, issueID string) ([]*types.Issue, error) {
@@ -390,15 +390,15 @@ func (s *InstrumentedStorage) AddLabel(ctx context.Context, issueID, label, acto
 	return err
 }
 
-func (s *InstrumentedStorage) RemoveLabel(ctx context.Context, issueID, label, actor string) error {
+func (s *InstrumentedStorage) RemoveLabel(ctx context.Context, issueID, label, actor string) (int64, error) {
 	attrs := []attribute.KeyValue{
 		attribute.String("bd.issue.id", issueID),
 		attribute.String("bd.label", label),
 	}
 	ctx, span, t := s.op(ctx, "RemoveLabel", attrs...)
-	err := s.inner.RemoveLabel(ctx, issueID, label, actor)
+	removed, err := s.inner.RemoveLabel(ctx, issueID, label, actor)
 	s.done(ctx, span, t, err, attrs...)
-	return err
+	return removed, err
 }
 
 func (s *InstrumentedStorage) GetLabels(ctx context.Context, issueID string) ([]string, error) {
@@ -0,0 +1,83 @@
+package timeparsing
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultWorkdayHours is the workday length used to convert day and week
+// estimates to minutes when estimate.workday_hours is unset.
+const DefaultWorkdayHours = 8
+
+// workdaysPerWeek is the number of workdays in a "w" estimate.
+const workdaysPerWeek = 5
+
+// estimatePartRe matches one amount+unit term of a human estimate.
+var estimatePartRe = regexp.MustCompile(`(\d+)(w|d|h|m)`)
+
+// ParseEstimate converts a time estimate to minutes.
+//
+// A bare integer is minutes ("90"). Otherwise the estimate is one or more
+// amount+unit terms, largest unit first:
+//
+//   - w = workweeks (5 workdays)
+//   - d = workdays (workdayHours hours)
+//   - h = hours
+//   - m = minutes
+//
+// Examples, with an 8-hour workday:
+//   - "45m"   -> 45
+//   - "1h30m" -> 90
+//   - "1d"    -> 480
+//   - "2w"    -> 4800
+//
+// Unlike ParseCompactDuration, "m" means minutes here: estimates measure
+// effort, not calendar time. workdayHours <= 0 selects DefaultWorkdayHours.
+func ParseEstimate(s string, workdayHours int) (int, error) {
+	s = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), " ", ""))
+	if s == "" {
+		return 0, fmt.Errorf("empty estimate")
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 0 {
+			return 0, fmt.Errorf("estimate must be non-negative, got %q", s)
+		}
+		return n, nil
+	}
+	if workdayHours <= 0 {
+		workdayHours = DefaultWorkdayHours
+	}
+
+	unitMinutes := map[string]int{
+		"w": workdaysPerWeek * workdayHours * 60,
+		"d": workdayHours * 60,
+		"h": 60,
+		"m": 1,
+	}
+	order := "wdhm"
+	last := -1
+	total, consumed := 0, 0
+	for _, m := range estimatePartRe.FindAllStringSubmatchIndex(s, -1) {
+		if m[0] != consumed {
+			break
+		}
+		unit := s[m[4]:m[5]]
+		pos := strings.Index(order, unit)
+		if pos <= last {
+			return 0, fmt.Errorf("invalid estimate %q (units must be in w, d, h, m order, each at most once)", s)
+		}
+		last = pos
+		amount, err := strconv.Atoi(s[m[2]:m[3]])
+		if err != nil {
+			return 0, fmt.Errorf("invalid estimate amount in %q", s)
+		}
+		total += amount * unitMinutes[unit]
+		consumed = m[1]
+	}
+	if consumed != len(s) {
+		return 0, fmt.Errorf("invalid estimate %q (expected minutes like 90, or durations like 45m, 1h30m, 1d, 2w)", s)
+	}
+	return total, nil
+}
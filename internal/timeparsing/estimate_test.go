@@ -0,0 +1,45 @@
+package timeparsing
+
+import "testing"
+
+func TestParseEstimate(t *testing.T) {
+	tests := []struct {
+		input   string
+		workday int
+		want    int
+		wantErr bool
+	}{
+		{input: "60", workday: 8, want: 60},
+		{input: "0", workday: 8, want: 0},
+		{input: "45m", workday: 8, want: 45},
+		{input: "2h", workday: 8, want: 120},
+		{input: "1h30m", workday: 8, want: 90},
+		{input: "1d", workday: 8, want: 480},
+		{input: "1d", workday: 6, want: 360},
+		{input: "1d 2h", workday: 6, want: 480},
+		{input: "1w", workday: 6, want: 1800},
+		{input: "2W", workday: 8, want: 4800},
+		{input: "1d", workday: 0, want: 480},
+		{input: "", wantErr: true},
+		{input: "-5", wantErr: true},
+		{input: "1y", wantErr: true},
+		{input: "soon", wantErr: true},
+		{input: "30m1h", wantErr: true},
+		{input: "1h1h", wantErr: true},
+		{input: "1h30", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseEstimate(tt.input, tt.workday)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseEstimate(%q, %d) = %d, want error", tt.input, tt.workday, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseEstimate(%q, %d): unexpected error: %v", tt.input, tt.workday, err)
+		} else if got != tt.want {
+			t.Errorf("ParseEstimate(%q, %d) = %d, want %d", tt.input, tt.workday, got, tt.want)
+		}
+	}
+}
@@ -285,3 +285,28 @@ func TestParseRelativeTime_LayerPrecedence(t *testing.T) {
 		t.Errorf("ParseRelativeTime(\"2025-01-20\") = %v, want Jan 20, 2025", t2)
 	}
 }
+
+// TestParseRelativeTime_ZonelessUsesNowLocation verifies that absolute forms
+// without a zone are read in now's location rather than the process zone.
+func TestParseRelativeTime_ZonelessUsesNowLocation(t *testing.T) {
+	zone := time.FixedZone("UTC+2", 2*60*60)
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, zone)
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"2025-01-20", time.Date(2025, 1, 20, 0, 0, 0, 0, zone)},
+		{"2025-01-20T09:30:00", time.Date(2025, 1, 20, 9, 30, 0, 0, zone)},
+		{"2025-01-20 09:30:00", time.Date(2025, 1, 20, 9, 30, 0, 0, zone)},
+	}
+	for _, tt := range tests {
+		got, err := ParseRelativeTime(tt.input, now)
+		if err != nil {
+			t.Fatalf("ParseRelativeTime(%q) failed: %v", tt.input, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseRelativeTime(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
@@ -139,6 +139,9 @@ var dateOnlyRe = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
 //  2. Absolute formats (date-only, RFC3339) - checked before NLP to avoid misinterpretation
 //  3. Natural language (tomorrow, next monday)
 //
+// Absolute forms without a zone are read in now's location, which is the
+// local zone when now comes from time.Now().
+//
 // Returns the parsed time or an error if no layer could parse the input.
 func ParseRelativeTime(s string, now time.Time) (time.Time, error) {
 	// Layer 1: Compact duration
@@ -151,7 +154,7 @@ func ParseRelativeTime(s string, now time.Time) (time.Time, error) {
 
 	// Try date-only format (YYYY-MM-DD)
 	if dateOnlyRe.MatchString(s) {
-		if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		if t, err := time.ParseInLocation("2006-01-02", s, now.Location()); err == nil {
 			return t, nil
 		}
 	}
@@ -162,12 +165,12 @@ func ParseRelativeTime(s string, now time.Time) (time.Time, error) {
 	}
 
 	// Try ISO 8601 datetime without timezone (2025-01-15T10:00:00)
-	if t, err := time.ParseInLocation("2006-01-02T15:04:05", s, time.Local); err == nil {
+	if t, err := time.ParseInLocation("2006-01-02T15:04:05", s, now.Location()); err == nil {
 		return t, nil
 	}
 
 	// Try datetime with space (2025-01-15 10:00:00)
-	if t, err := time.ParseInLocation("2006-01-02 15:04:05", s, time.Local); err == nil {
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", s, now.Location()); err == nil {
 		return t, nil
 	}
 
@@ -752,7 +752,7 @@ func syncIssueLabels(ctx context.Context, tx storage.Transaction, issueID string
 		if _, ok := desiredSet[label]; ok {
 			continue
 		}
-		if err := tx.RemoveLabel(ctx, issueID, label, actor); err != nil {
+		if _, err := tx.RemoveLabel(ctx, issueID, label, actor); err != nil {
 			return err
 		}
 	}
@@ -940,6 +940,10 @@ func (e *Engine) doPush(ctx context.Context, opts SyncOptions, skipIDs, forceIDs
 
 		extRef := derefStr(issue.ExternalRef)
 		willCreate := extRef == "" || !e.Tracker.IsExternalRef(extRef)
+		if willCreate && opts.UpdateOnly {
+			stats.Skipped++
+			continue
+		}
 
 		if opts.DryRun {
 			if willCreate {
@@ -1074,6 +1078,10 @@ func (e *Engine) collectBatchPushIssues(issues []*types.Issue, opts SyncOptions,
 			skipped++
 			continue
 		}
+		if willCreate && opts.UpdateOnly {
+			skipped++
+			continue
+		}
 		pushIssues = append(pushIssues, e.formatPushIssue(issue))
 	}
 	return pushIssues, skipped
@@ -523,6 +523,65 @@ func TestEnginePushOnly(t *testing.T) {
 	}
 }
 
+func TestEnginePushCreateOnlyAndUpdateOnly(t *testing.T) {
+	ctx := context.Background()
+
+	seed := func(t *testing.T) *dolt.DoltStore {
+		t.Helper()
+		store := newTestStore(t)
+		for _, issue := range []*types.Issue{
+			{ID: "bd-new", Title: "Not linked yet", Status: types.StatusOpen, IssueType: types.TypeTask, Priority: 2},
+			{ID: "bd-linked", Title: "Linked", Status: types.StatusOpen, IssueType: types.TypeTask, Priority: 2,
+				ExternalRef: strPtr("https://test.test/EXT-7")},
+		} {
+			if err := store.CreateIssue(ctx, issue, "test-actor"); err != nil {
+				t.Fatalf("CreateIssue(%s) error: %v", issue.ID, err)
+			}
+		}
+		return store
+	}
+
+	t.Run("create_only", func(t *testing.T) {
+		store := seed(t)
+		tracker := newMockTracker("test")
+		result, err := NewEngine(tracker, store, "test-actor").Sync(ctx, SyncOptions{Push: true, CreateOnly: true})
+		if err != nil {
+			t.Fatalf("Sync() error: %v", err)
+		}
+		if result.PushStats.Created != 1 || result.PushStats.Updated != 0 || result.PushStats.Skipped != 1 {
+			t.Errorf("PushStats = %+v, want Created=1 Updated=0 Skipped=1", result.PushStats)
+		}
+		if len(tracker.created) != 1 || tracker.created[0].ID != "bd-new" {
+			t.Errorf("tracker.created = %v, want bd-new only", tracker.created)
+		}
+	})
+
+	t.Run("update_only", func(t *testing.T) {
+		store := seed(t)
+		tracker := newMockTracker("test")
+		result, err := NewEngine(tracker, store, "test-actor").Sync(ctx, SyncOptions{Push: true, UpdateOnly: true})
+		if err != nil {
+			t.Fatalf("Sync() error: %v", err)
+		}
+		if result.PushStats.Created != 0 || result.PushStats.Updated != 1 || result.PushStats.Skipped != 1 {
+			t.Errorf("PushStats = %+v, want Created=0 Updated=1 Skipped=1", result.PushStats)
+		}
+		if len(tracker.created) != 0 {
+			t.Errorf("tracker.created = %v, want none", tracker.created)
+		}
+		if _, ok := tracker.updated["EXT-7"]; !ok {
+			t.Errorf("tracker.updated = %v, want EXT-7", tracker.updated)
+		}
+		issue, err := store.GetIssue(ctx, "bd-new")
+		if err != nil {
+			t.Fatalf("GetIssue() error: %v", err)
+		}
+		if issue.ExternalRef != nil {
+			t.Errorf("bd-new external_ref = %q, want unset", *issue.ExternalRef)
+		}
+	})
+}
+
 func TestEnginePushUsesBatchTrackerWhenAvailable(t *testing.T) {
 	ctx := context.Background()
 	store := newTestStore(t)
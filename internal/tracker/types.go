@@ -80,6 +80,9 @@ type SyncOptions struct {
 	DryRun bool
 	// CreateOnly only creates new issues, doesn't update existing.
 	CreateOnly bool
+	// UpdateOnly only updates issues already linked to the external tracker,
+	// doesn't create new ones.
+	UpdateOnly bool
 	// State filters issues: "open", "closed", or "all".
 	State string
 	// ConflictResolution specifies how to handle bidirectional conflicts.
@@ -2,12 +2,14 @@
 package types
 
 import (
+	"cmp"
 	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"hash"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -834,6 +836,12 @@ type IssueDetails struct {
 	Comments     []*Comment                     `json:"comments,omitempty"`
 	Parent       *string                        `json:"parent,omitempty"`
 
+	// Duplicate relation, visible from both endpoints: DuplicateOf is the
+	// canonical this issue duplicates, DuplicatedBy the issues marked as
+	// duplicates of it.
+	DuplicateOf  *string  `json:"duplicate_of,omitempty"`
+	DuplicatedBy []string `json:"duplicated_by,omitempty"`
+
 	// Cardinality fields — emitted by default (count-only mode).
 	// Slice fields (Dependents, Comments) are nil when count-only is active.
 	// Use --include-dependents / --include-comments to populate the slices.
@@ -847,6 +855,38 @@ type IssueDetails struct {
 	EpicCloseable      *bool `json:"epic_closeable,omitempty"`
 }
 
+// NormalizeLabels returns labels sorted and de-duplicated, so JSON output and
+// set comparisons do not depend on insertion order. A nil slice stays nil.
+func NormalizeLabels(labels []string) []string {
+	if labels == nil {
+		return nil
+	}
+	out := slices.Clone(labels)
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// NormalizeOrder puts the issue's labels and dependency edges in a stable
+// order for output: labels via NormalizeLabels, edges by target then type.
+func (i *Issue) NormalizeOrder() {
+	i.Labels = NormalizeLabels(i.Labels)
+	slices.SortStableFunc(i.Dependencies, func(a, b *Dependency) int {
+		return cmp.Or(cmp.Compare(a.DependsOnID, b.DependsOnID), cmp.Compare(a.Type, b.Type))
+	})
+}
+
+// NormalizeOrder applies Issue.NormalizeOrder to the details and orders the
+// labels, dependencies and dependents lists the same way.
+func (d *IssueDetails) NormalizeOrder() {
+	d.Issue.NormalizeOrder()
+	d.Labels = NormalizeLabels(d.Labels)
+	byID := func(a, b *IssueWithDependencyMetadata) int {
+		return cmp.Or(cmp.Compare(a.ID, b.ID), cmp.Compare(a.DependencyType, b.DependencyType))
+	}
+	slices.SortStableFunc(d.Dependencies, byID)
+	slices.SortStableFunc(d.Dependents, byID)
+}
+
 // DependencyType categorizes the relationship
 type DependencyType string
 
@@ -928,6 +968,35 @@ func (d DependencyType) IsBlockingEdge() bool {
 	return d == DepBlocks || d == DepConditionalBlocks || d == DepWaitsFor
 }
 
+// CycleRelevantDependencyTypes returns the dependency types cycle detection
+// walks: every edge that affects ready work, plus the supersedes and
+// duplicates chains, which must not loop back on themselves either.
+func CycleRelevantDependencyTypes() []DependencyType {
+	return []DependencyType{
+		DepBlocks, DepConditionalBlocks, DepWaitsFor, DepParentChild, DepSupersedes, DepDuplicates,
+	}
+}
+
+// IsCycleRelevant returns true if this dependency type is an edge of the graph
+// that AddDependency and DetectCycles keep acyclic.
+func (d DependencyType) IsCycleRelevant() bool {
+	for _, t := range CycleRelevantDependencyTypes() {
+		if d == t {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCycleForceable returns true if bd dep add --force may close a cycle
+// through this dependency type: cycle detection walks it, but it does not
+// affect ready work, so a loop through it cannot stall anything. The
+// ready-gating types (blocks, conditional-blocks, waits-for, parent-child)
+// are never forceable. Types cycle detection ignores need no force at all.
+func (d DependencyType) IsCycleForceable() bool {
+	return d.IsCycleRelevant() && !d.AffectsReadyWork()
+}
+
 // WaitsForMeta holds metadata for waits-for dependencies (fanout gates).
 // Stored as JSON in the Dependency.Metadata field.
 type WaitsForMeta struct {
@@ -1090,8 +1159,21 @@ const (
 // BlockedIssue extends Issue with blocking information
 type BlockedIssue struct {
 	Issue
-	BlockedByCount int      `json:"blocked_by_count"`
-	BlockedBy      []string `json:"blocked_by"`
+	BlockedByCount int             `json:"blocked_by_count"`
+	BlockedBy      []string        `json:"blocked_by"`
+	Reason         []BlockedReason `json:"reason"`
+}
+
+// BlockedReasonParent is the BlockedReason type of an issue blocked only
+// because its parent is blocked.
+const BlockedReasonParent = "parent"
+
+// BlockedReason is one cause of a BlockedIssue: an open blocking dependency
+// (Type is the dependency type, e.g. "blocks" or "waits-for") or, for an issue
+// with no open blocker of its own, its blocked parent (Type "parent").
+type BlockedReason struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
 }
 
 // ReadyExplanation provides reasoning for why issues are ready or blocked.
@@ -1233,6 +1315,7 @@ type TreeNode struct {
 	ParentID       string         `json:"parent_id"`
 	EdgeFromParent DependencyType `json:"edge_from_parent,omitempty"`
 	Truncated      bool           `json:"truncated"`
+	Highlighted    bool           `json:"highlighted,omitempty"` // Marked by bd dep tree --highlight
 }
 
 // MoleculeProgressStats provides efficient progress info for large molecules.
@@ -1276,15 +1359,20 @@ type IssueFilter struct {
 	Statuses      []Status // Multiple status OR filter (from comma-separated --status)
 	Priority      *int
 	IssueType     *IssueType
+	Types         []IssueType // Multiple type OR filter (from comma-separated or repeated --type)
 	Assignee      *string
+	CreatedBy     *string  // exact match on created_by, the actor that created the issue
 	Labels        []string // AND semantics: issue must have ALL these labels
 	LabelsAny     []string // OR semantics: issue must have AT LEAST ONE of these labels
 	ExcludeLabels []string // Exclusion: issue must NOT have ANY of these labels
 	LabelPattern  string   // Glob pattern for label matching (e.g., "tech-*")
 	LabelRegex    string   // Regex pattern for label matching (e.g., "tech-(debt|legacy)")
 	TitleSearch   string
+	SearchTerms   []string // Multi-term text search: each term matches title or ID (AND unless SearchAny)
+	SearchAny     bool     // OR semantics for SearchTerms: issue must match AT LEAST ONE term
 	IDs           []string // Filter by specific issue IDs
 	IDPrefix      string   // Filter by ID prefix (e.g., "bd-" to match "bd-abc123")
+	SpecID        string   // Filter by exact spec_id
 	SpecIDPrefix  string   // Filter by spec_id prefix
 	Limit         int
 
@@ -1293,7 +1381,9 @@ type IssueFilter struct {
 	DescriptionContains string
 	NotesContains       string
 	ExternalRefContains string
+	CloseReasonContains string
 	ExternalRef         *string // exact match on external_ref
+	AssigneePattern     string  // glob on assignee (e.g., "team-a/*" for rig/role/name identities)
 
 	// Date ranges
 	CreatedAfter  *time.Time
@@ -1346,6 +1436,11 @@ type IssueFilter struct {
 	// column alone is not a filter; this optional predicate makes it one.
 	IsBlocked *bool // nil = any, true = only is_blocked, false = only unblocked
 
+	// StatusOrIDs widens the Status/Statuses predicate: a row whose id is
+	// listed matches it whatever its stored status. bd list --status blocked
+	// uses it to add bd blocked's derived set to the stored blocked status.
+	StatusOrIDs []string
+
 	// Template filtering
 	IsTemplate *bool // Filter by template flag (nil = any, true = only templates, false = exclude templates)
 
@@ -1386,6 +1481,12 @@ type IssueFilter struct {
 	// Opt-in performance flag for the bd list --skip-labels code path.
 	SkipLabels bool
 
+	// Fields, when non-empty, projects search results onto these Issue JSON
+	// fields: only their columns (plus id and the sort columns) are read,
+	// and every other field is left zero. "labels" is hydrated only when
+	// listed. Used by bd list --json --fields; nil reads full rows.
+	Fields []string
+
 	// Performance escape hatches
 	SkipWisps  bool // Q2: skip wisps table merge entirely (for callers that never return ephemeral results)
 	NoIDShrink bool // Q3: force Pattern A (full 47-col scan) even when Limit > 0
@@ -1477,6 +1578,11 @@ type WorkFilter struct {
 	// Time-based deferral filtering (GH#820)
 	IncludeDeferred bool // If true, include issues with future defer_until timestamps
 
+	// Blocked-set scope (GetBlockedIssues only). When true, only issues with
+	// an open blocker of their own are kept; issues blocked solely through a
+	// blocked parent are dropped (bd blocked without --transitive).
+	DirectBlockedOnly bool
+
 	// Ephemeral issue filtering
 	// By default, GetReadyWork excludes ephemeral wisps but includes
 	// no-history wisps because they are durable work items without Dolt history.
@@ -1506,7 +1612,7 @@ type WorkFilter struct {
 
 // StaleFilter is used to filter stale issue queries
 type StaleFilter struct {
-	Days   int    // Issues not updated in this many days
+	Days   int    // Issues not updated in this many days; must be at least 1
 	Status string // Filter by status (open|in_progress|blocked), empty = all non-closed
 	Limit  int    // Maximum issues to return
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -779,6 +780,15 @@ func TestDependencyTypeAffectsReadyWork(t *testing.T) {
 	}
 }
 
+func TestDependencyTypeIsCycleForceable(t *testing.T) {
+	forceable := map[DependencyType]bool{DepSupersedes: true, DepDuplicates: true}
+	for _, dt := range WellKnownDependencyTypes() {
+		if got := dt.IsCycleForceable(); got != forceable[dt] {
+			t.Errorf("DependencyType(%q).IsCycleForceable() = %v, want %v", dt, got, forceable[dt])
+		}
+	}
+}
+
 func TestParseWaitsForGateMetadata(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1837,3 +1847,58 @@ func TestCheckFieldLen(t *testing.T) {
 		t.Errorf("CheckFieldLen(256 runes) = %v, want errors.Is(ErrFieldTooLong)", err)
 	}
 }
+
+func TestNormalizeLabels(t *testing.T) {
+	if got := NormalizeLabels(nil); got != nil {
+		t.Errorf("NormalizeLabels(nil) = %v, want nil", got)
+	}
+	in := []string{"zeta", "alpha", "Beta", "alpha", "mid"}
+	got := NormalizeLabels(in)
+	want := []string{"Beta", "alpha", "mid", "zeta"}
+	if !slices.Equal(got, want) {
+		t.Errorf("NormalizeLabels(%v) = %v, want %v", in, got, want)
+	}
+	if in[0] != "zeta" {
+		t.Errorf("NormalizeLabels mutated its input: %v", in)
+	}
+}
+
+func TestIssueDetailsNormalizeOrder(t *testing.T) {
+	d := &IssueDetails{
+		Issue: Issue{
+			Labels: []string{"b", "a", "b"},
+			Dependencies: []*Dependency{
+				{DependsOnID: "bd-9", Type: DepBlocks},
+				{DependsOnID: "bd-2", Type: DepRelated},
+				{DependsOnID: "bd-2", Type: DepBlocks},
+			},
+		},
+		Labels: []string{"urgent", "backend", "urgent"},
+		Dependencies: []*IssueWithDependencyMetadata{
+			{Issue: Issue{ID: "bd-5"}, DependencyType: DepBlocks},
+			{Issue: Issue{ID: "bd-1"}, DependencyType: DepParentChild},
+		},
+		Dependents: []*IssueWithDependencyMetadata{
+			{Issue: Issue{ID: "bd-8"}, DependencyType: DepBlocks},
+			{Issue: Issue{ID: "bd-3"}, DependencyType: DepBlocks},
+		},
+	}
+	d.NormalizeOrder()
+
+	if want := []string{"a", "b"}; !slices.Equal(d.Issue.Labels, want) {
+		t.Errorf("issue labels = %v, want %v", d.Issue.Labels, want)
+	}
+	if want := []string{"backend", "urgent"}; !slices.Equal(d.Labels, want) {
+		t.Errorf("details labels = %v, want %v", d.Labels, want)
+	}
+	var edges []string
+	for _, dep := range d.Issue.Dependencies {
+		edges = append(edges, dep.DependsOnID+"/"+string(dep.Type))
+	}
+	if want := []string{"bd-2/blocks", "bd-2/related", "bd-9/blocks"}; !slices.Equal(edges, want) {
+		t.Errorf("dependency edges = %v, want %v", edges, want)
+	}
+	if d.Dependencies[0].ID != "bd-1" || d.Dependents[0].ID != "bd-3" {
+		t.Errorf("dependencies/dependents not sorted by ID: %s, %s", d.Dependencies[0].ID, d.Dependents[0].ID)
+	}
+}
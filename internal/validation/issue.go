@@ -2,6 +2,7 @@ package validation
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -99,6 +100,31 @@ func NotClosed() IssueValidator {
 	}
 }
 
+// IsClosed validates that an issue is closed, the only status reopen can
+// leave. A deferred issue that was closed is still closed.
+func IsClosed() IssueValidator {
+	return func(id string, issue *types.Issue) error {
+		if issue == nil || issue.Status == types.StatusClosed {
+			return nil
+		}
+		return fmt.Errorf("issue %s is already %s; only closed issues can be reopened", id, issue.Status)
+	}
+}
+
+// IsDeferred validates that an issue is deferred: its status is deferred, or
+// its defer_until still lies after now, which keeps it out of ready work.
+func IsDeferred(now time.Time) IssueValidator {
+	return func(id string, issue *types.Issue) error {
+		if issue == nil || issue.Status == types.StatusDeferred {
+			return nil
+		}
+		if issue.DeferUntil != nil && issue.DeferUntil.After(now) {
+			return nil
+		}
+		return fmt.Errorf("issue %s is not deferred (status: %s)", id, issue.Status)
+	}
+}
+
 // NotHooked validates that an issue is not in hooked status.
 func NotHooked(force bool) IssueValidator {
 	return func(id string, issue *types.Issue) error {
@@ -188,12 +214,21 @@ func forDelete() IssueValidator {
 	)
 }
 
-// forReopen returns a validator chain for reopen operations.
+// ForUndefer returns a validator chain for undefer operations.
+// Validates: issue exists and is deferred.
+func ForUndefer(now time.Time) IssueValidator {
+	return Chain(
+		Exists(),
+		IsDeferred(now),
+	)
+}
+
+// ForReopen returns a validator chain for reopen operations.
 // Validates: issue exists, is not a template, and is closed.
-func forReopen() IssueValidator {
+func ForReopen() IssueValidator {
 	return Chain(
 		Exists(),
 		NotTemplate(),
-		HasStatus(types.StatusClosed),
+		IsClosed(),
 	)
 }
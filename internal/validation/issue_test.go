@@ -3,6 +3,7 @@ package validation
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -487,3 +488,109 @@ func TestForClose(t *testing.T) {
 		})
 	}
 }
+
+func TestForReopen(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour)
+	tests := []struct {
+		name    string
+		issue   *types.Issue
+		wantErr string
+	}{
+		{
+			name:    "nil issue fails",
+			issue:   nil,
+			wantErr: "not found",
+		},
+		{
+			name:    "template fails",
+			issue:   &types.Issue{ID: "bd-test", Status: types.StatusClosed, IsTemplate: true},
+			wantErr: "template",
+		},
+		{
+			name:    "open issue fails",
+			issue:   &types.Issue{ID: "bd-test", Status: types.StatusOpen},
+			wantErr: "issue bd-test is already open",
+		},
+		{
+			name:    "deferred issue fails",
+			issue:   &types.Issue{ID: "bd-test", Status: types.StatusDeferred, DeferUntil: &future},
+			wantErr: "issue bd-test is already deferred",
+		},
+		{
+			name:  "closed issue passes",
+			issue: &types.Issue{ID: "bd-test", Status: types.StatusClosed},
+		},
+		{
+			name:  "closed deferred issue passes",
+			issue: &types.Issue{ID: "bd-test", Status: types.StatusClosed, DeferUntil: &future},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ForReopen()("bd-test", tt.issue)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ForReopen() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ForReopen() error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestForUndefer(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	future, past := now.Add(time.Hour), now.Add(-time.Hour)
+	tests := []struct {
+		name    string
+		issue   *types.Issue
+		wantErr bool
+	}{
+		{
+			name:    "nil issue fails",
+			issue:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "open issue fails",
+			issue:   &types.Issue{ID: "bd-test", Status: types.StatusOpen},
+			wantErr: true,
+		},
+		{
+			name:    "open issue with a past defer date fails",
+			issue:   &types.Issue{ID: "bd-test", Status: types.StatusOpen, DeferUntil: &past},
+			wantErr: true,
+		},
+		{
+			name:    "closed issue fails",
+			issue:   &types.Issue{ID: "bd-test", Status: types.StatusClosed},
+			wantErr: true,
+		},
+		{
+			name:    "deferred issue passes",
+			issue:   &types.Issue{ID: "bd-test", Status: types.StatusDeferred},
+			wantErr: false,
+		},
+		{
+			name:    "open issue with a future defer date passes",
+			issue:   &types.Issue{ID: "bd-test", Status: types.StatusOpen, DeferUntil: &future},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ForUndefer(now)("bd-test", tt.issue)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ForUndefer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && tt.issue != nil && !strings.Contains(err.Error(), "is not deferred") {
+				t.Errorf("ForUndefer() error = %v, want 'is not deferred'", err)
+			}
+		})
+	}
+}
@@ -89,7 +89,9 @@ func TestOpenBestAvailable_NoCGO_ServerMode_FailsWithoutServer(t *testing.T) {
 	if openErr == nil {
 		t.Fatal("OpenBestAvailable (server mode) should fail when no server is running")
 	}
-	if !strings.Contains(openErr.Error(), "unreachable") {
-		t.Errorf("expected 'unreachable' in error, got: %v", openErr)
+	for _, want := range []string{"not reachable", "bd dolt doctor"} {
+		if !strings.Contains(openErr.Error(), want) {
+			t.Errorf("expected %q in error, got: %v", want, openErr)
+		}
 	}
 }